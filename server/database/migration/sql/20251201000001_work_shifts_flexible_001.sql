@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =================================================================
+-- FLEXIBLE SHIFTS
+-- =================================================================
+-- Flexible shifts (is_flexible = TRUE) use start_time/end_time as the
+-- window in which attendance is accepted. Lateness and early leave are
+-- measured only against the core hours, and the day is complete when
+-- the employee has worked required_work_minutes (break excluded).
+
+ALTER TABLE work_shifts
+    ADD COLUMN IF NOT EXISTS core_start_time TIME,
+    ADD COLUMN IF NOT EXISTS core_end_time TIME,
+    ADD COLUMN IF NOT EXISTS required_work_minutes INTEGER DEFAULT 0;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE work_shifts
+    DROP COLUMN IF EXISTS core_start_time,
+    DROP COLUMN IF EXISTS core_end_time,
+    DROP COLUMN IF EXISTS required_work_minutes;
+-- +goose StatementEnd
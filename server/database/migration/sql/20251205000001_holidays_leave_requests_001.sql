@@ -0,0 +1,65 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =================================================================
+-- COMPANY HOLIDAYS TABLE
+-- =================================================================
+-- Public holidays / company days off. Attendance summaries on these
+-- days are recorded as "holiday" instead of "absent".
+
+CREATE TABLE IF NOT EXISTS company_holidays (
+    holiday_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    company_id UUID NOT NULL REFERENCES companies(company_id) ON DELETE CASCADE,
+    holiday_date DATE NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    created_by UUID REFERENCES users(user_id),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    UNIQUE (company_id, holiday_date)
+);
+
+-- =================================================================
+-- LEAVE REQUESTS TABLE
+-- =================================================================
+-- Leave requests from employees, approved/rejected by manager.
+-- Approved leave days are recorded as "on_leave" instead of "absent".
+
+CREATE TABLE IF NOT EXISTS leave_requests (
+    request_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    company_id UUID NOT NULL REFERENCES companies(company_id) ON DELETE CASCADE,
+    employee_id UUID NOT NULL REFERENCES employees(employee_id) ON DELETE CASCADE,
+
+    -- Leave type: 0=annual, 1=sick, 2=unpaid, 3=other
+    leave_type INT2 DEFAULT 0 NOT NULL CHECK (leave_type IN (0, 1, 2, 3)),
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    reason TEXT,
+
+    -- Request status: 0=pending, 1=approved, 2=rejected, 3=cancelled
+    status INT2 DEFAULT 0 NOT NULL CHECK (status IN (0, 1, 2, 3)),
+
+    -- Approval/Rejection details
+    approved_by UUID REFERENCES users(user_id),
+    approved_at TIMESTAMP WITH TIME ZONE,
+    rejection_reason TEXT,
+
+    meta_data JSONB DEFAULT '{}' NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    CHECK (end_date >= start_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_leave_requests_employee_dates ON leave_requests(employee_id, start_date, end_date);
+CREATE INDEX IF NOT EXISTS idx_leave_requests_pending_company ON leave_requests(company_id, status) WHERE status = 0;
+CREATE INDEX IF NOT EXISTS idx_leave_requests_approved_dates ON leave_requests(company_id, start_date, end_date) WHERE status = 1;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS leave_requests;
+DROP TABLE IF EXISTS company_holidays;
+-- +goose StatementEnd
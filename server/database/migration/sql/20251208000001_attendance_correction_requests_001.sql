@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =================================================================
+-- ATTENDANCE CORRECTION REQUESTS TABLE
+-- =================================================================
+-- Employees dispute a missed or wrong punch by proposing the check-in
+-- and/or check-out of a work day. On approval the daily summary is
+-- recomputed with the proposed values. Both the original summary values
+-- (snapshot at request time) and the corrected result are kept.
+
+CREATE TABLE IF NOT EXISTS attendance_correction_requests (
+    request_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    company_id UUID NOT NULL REFERENCES companies(company_id) ON DELETE CASCADE,
+    employee_id UUID NOT NULL REFERENCES employees(employee_id) ON DELETE CASCADE,
+    shift_id UUID REFERENCES work_shifts(shift_id) ON DELETE SET NULL,
+    work_date DATE NOT NULL,
+
+    -- Proposed values (at least one is required)
+    proposed_check_in TIMESTAMP WITH TIME ZONE,
+    proposed_check_out TIMESTAMP WITH TIME ZONE,
+    reason TEXT NOT NULL,
+
+    -- Original summary values at request time (NULL if no summary yet)
+    original_check_in TIMESTAMP WITH TIME ZONE,
+    original_check_out TIMESTAMP WITH TIME ZONE,
+    original_status INT2,
+
+    -- Corrected summary values after approval
+    corrected_check_in TIMESTAMP WITH TIME ZONE,
+    corrected_check_out TIMESTAMP WITH TIME ZONE,
+    corrected_status INT2,
+
+    -- Request status: 0=pending, 1=approved, 2=rejected
+    status INT2 DEFAULT 0 NOT NULL CHECK (status IN (0, 1, 2)),
+
+    -- Review details
+    reviewed_by UUID REFERENCES users(user_id),
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    review_note TEXT,
+
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    CHECK (proposed_check_in IS NOT NULL OR proposed_check_out IS NOT NULL),
+    CHECK (proposed_check_in IS NULL OR proposed_check_out IS NULL OR proposed_check_out > proposed_check_in)
+);
+
+CREATE INDEX IF NOT EXISTS idx_correction_requests_employee ON attendance_correction_requests(employee_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_correction_requests_pending_company ON attendance_correction_requests(company_id, status) WHERE status = 0;
+CREATE INDEX IF NOT EXISTS idx_correction_requests_approved_date ON attendance_correction_requests(company_id, employee_id, work_date) WHERE status = 1;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS attendance_correction_requests;
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =================================================================
+-- DEVICE HEARTBEATS
+-- =================================================================
+-- Devices report a heartbeat (CPU, memory, temperature, firmware) on a
+-- fixed interval. devices.last_heartbeat keeps the latest one, the
+-- history table keeps every heartbeat for uptime reporting.
+--
+-- auto_offline marks devices flipped to OFFLINE by the heartbeat sweeper
+-- (not by an admin), only those are set back to ONLINE on the next heartbeat.
+
+ALTER TABLE devices ADD COLUMN IF NOT EXISTS auto_offline BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_devices_online_heartbeat ON devices(last_heartbeat) WHERE status = 1;
+
+CREATE TABLE IF NOT EXISTS device_heartbeats (
+    heartbeat_id BIGSERIAL PRIMARY KEY,
+    device_id UUID NOT NULL REFERENCES devices(device_id) ON DELETE CASCADE,
+    company_id UUID NOT NULL REFERENCES companies(company_id) ON DELETE CASCADE,
+    cpu_usage REAL, -- Percent (0-100)
+    memory_usage REAL, -- Percent (0-100)
+    temperature REAL, -- Celsius
+    firmware_version VARCHAR(20),
+    ip_address INET,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_device_heartbeats_device_time ON device_heartbeats(device_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_device_heartbeats_created_at ON device_heartbeats(created_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS device_heartbeats;
+DROP INDEX IF EXISTS idx_devices_online_heartbeat;
+ALTER TABLE devices DROP COLUMN IF EXISTS auto_offline;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =================================================================
+-- DEVICE CONFIGURATION
+-- =================================================================
+-- devices.settings keeps the typed device configuration (liveness and
+-- match threshold, working hours, UI language, verification methods).
+--
+-- config_version is bumped on every change and used for optimistic
+-- concurrency between admins. applied_config_version is the latest
+-- version the device acknowledged after applying it.
+
+ALTER TABLE devices ADD COLUMN IF NOT EXISTS config_version BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE devices ADD COLUMN IF NOT EXISTS applied_config_version BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE devices ADD COLUMN IF NOT EXISTS config_applied_at TIMESTAMP WITH TIME ZONE;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE devices DROP COLUMN IF EXISTS config_applied_at;
+ALTER TABLE devices DROP COLUMN IF EXISTS applied_config_version;
+ALTER TABLE devices DROP COLUMN IF EXISTS config_version;
+-- +goose StatementEnd
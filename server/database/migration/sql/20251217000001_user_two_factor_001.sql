@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =================================================================
+-- TWO-FACTOR AUTHENTICATION (TOTP)
+-- =================================================================
+-- secret is the TOTP secret encrypted with the service_auth key, it is
+-- written on enrollment and only becomes active (is_enabled) after the
+-- first code is verified.
+--
+-- last_used_step is the last accepted TOTP time step, codes of the same
+-- or an older step are rejected so a code cannot be replayed.
+-- recovery_codes keeps the SHA-256 hash of the unused recovery codes.
+
+CREATE TABLE IF NOT EXISTS user_two_factor (
+    user_id UUID PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    secret TEXT NOT NULL,
+    is_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    recovery_codes TEXT[] NOT NULL DEFAULT '{}',
+    enabled_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Roles (0: SYSTEM_ADMIN, 1: COMPANY_ADMIN) that must use 2FA, a company
+-- can override it with the same key in company_settings.
+INSERT INTO system_settings (setting_key, setting_value, setting_type, description, is_system)
+VALUES ('two_factor_required_roles', '[0,1]', 3, 'Roles required to use two-factor authentication', TRUE)
+ON CONFLICT (setting_key) DO NOTHING;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM system_settings WHERE setting_key = 'two_factor_required_roles';
+DROP TABLE IF EXISTS user_two_factor;
+-- +goose StatementEnd
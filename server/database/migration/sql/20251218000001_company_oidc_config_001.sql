@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =================================================================
+-- COMPANY OPENID CONNECT (SSO)
+-- =================================================================
+-- One identity provider per company. client_secret is encrypted with
+-- the service_auth key and never returned by the API.
+--
+-- Users are matched to existing users rows by email (email_claim of the
+-- ID token). role_claim + role_mapping map IdP values (groups, roles) to
+-- application roles (1: COMPANY_ADMIN, 2: USER), e.g. {"hr-admins": 1,
+-- "staff": 2}. When role_claim is empty the role in users is used.
+
+CREATE TABLE IF NOT EXISTS company_oidc_configs (
+    company_id UUID PRIMARY KEY REFERENCES companies(company_id) ON DELETE CASCADE,
+    issuer VARCHAR(500) NOT NULL,
+    client_id VARCHAR(255) NOT NULL,
+    client_secret TEXT NOT NULL,
+    scopes TEXT[] NOT NULL DEFAULT '{openid,email,profile}',
+    email_claim VARCHAR(100) NOT NULL DEFAULT 'email',
+    role_claim VARCHAR(100) NOT NULL DEFAULT '',
+    role_mapping JSONB NOT NULL DEFAULT '{}',
+    is_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS company_oidc_configs;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+package password
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func hashBcrypt(password string, params BcryptConfig) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), params.Cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// verifyBcrypt bcrypt tự so sánh constant-time, lỗi còn lại đều do hash sai định dạng
+func verifyBcrypt(password, encoded string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return false, fmt.Errorf("%w: %v", ErrInvalidHash, err)
+}
+
+func bcryptCost(encoded string) int {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	if err != nil {
+		return 0
+	}
+	return cost
+}
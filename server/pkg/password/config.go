@@ -0,0 +1,74 @@
+package password
+
+// Thuật toán hash mật khẩu hỗ trợ
+const (
+	AlgorithmArgon2id = "argon2id"
+	AlgorithmBcrypt   = "bcrypt"
+)
+
+// Config holds configuration for password hashing
+type Config struct {
+	// Thuật toán dùng cho hash mới: argon2id | bcrypt
+	Algorithm string `mapstructure:"algorithm" yaml:"algorithm"`
+
+	Argon2id Argon2idConfig `mapstructure:"argon2id" yaml:"argon2id"`
+	Bcrypt   BcryptConfig   `mapstructure:"bcrypt" yaml:"bcrypt"`
+}
+
+// Argon2idConfig holds argon2id cost parameters
+type Argon2idConfig struct {
+	Memory      uint32 `mapstructure:"memory" yaml:"memory"`           // KiB
+	Iterations  uint32 `mapstructure:"iterations" yaml:"iterations"`   // Số vòng lặp
+	Parallelism uint8  `mapstructure:"parallelism" yaml:"parallelism"` // Số luồng
+	SaltLength  uint32 `mapstructure:"salt_length" yaml:"salt_length"` // Bytes
+	KeyLength   uint32 `mapstructure:"key_length" yaml:"key_length"`   // Bytes
+}
+
+// BcryptConfig holds bcrypt cost parameters
+type BcryptConfig struct {
+	Cost int `mapstructure:"cost" yaml:"cost"`
+}
+
+// DefaultConfig returns default configuration (argon2id theo khuyến nghị OWASP)
+func DefaultConfig() Config {
+	return Config{
+		Algorithm: AlgorithmArgon2id,
+		Argon2id: Argon2idConfig{
+			Memory:      64 * 1024,
+			Iterations:  3,
+			Parallelism: 2,
+			SaltLength:  16,
+			KeyLength:   32,
+		},
+		Bcrypt: BcryptConfig{
+			Cost: 12,
+		},
+	}
+}
+
+// withDefaults điền giá trị mặc định cho các trường không được cấu hình
+func (c Config) withDefaults() Config {
+	def := DefaultConfig()
+	if c.Algorithm == "" {
+		c.Algorithm = def.Algorithm
+	}
+	if c.Argon2id.Memory == 0 {
+		c.Argon2id.Memory = def.Argon2id.Memory
+	}
+	if c.Argon2id.Iterations == 0 {
+		c.Argon2id.Iterations = def.Argon2id.Iterations
+	}
+	if c.Argon2id.Parallelism == 0 {
+		c.Argon2id.Parallelism = def.Argon2id.Parallelism
+	}
+	if c.Argon2id.SaltLength == 0 {
+		c.Argon2id.SaltLength = def.Argon2id.SaltLength
+	}
+	if c.Argon2id.KeyLength == 0 {
+		c.Argon2id.KeyLength = def.Argon2id.KeyLength
+	}
+	if c.Bcrypt.Cost == 0 {
+		c.Bcrypt.Cost = def.Bcrypt.Cost
+	}
+	return c
+}
@@ -0,0 +1,7 @@
+module github.com/youknow2509/cio_verify_face/server/pkg/password
+
+go 1.24.3
+
+require golang.org/x/crypto v0.41.0
+
+require golang.org/x/sys v0.35.0 // indirect
@@ -0,0 +1,94 @@
+package password
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrUnsupportedAlgorithm = errors.New("password: unsupported algorithm")
+	ErrInvalidHash          = errors.New("password: invalid encoded hash")
+)
+
+// Hasher hash và kiểm tra mật khẩu, dùng chung giữa các service
+type Hasher interface {
+	// Hash trả về chuỗi tự mô tả thuật toán và tham số (PHC với argon2id, $2a$ với bcrypt)
+	Hash(password string) (string, error)
+	// Verify so sánh constant-time, salt chỉ dùng cho hash legacy sha256(password + salt)
+	Verify(password, salt, encoded string) (bool, error)
+	// NeedsRehash true khi hash là legacy hoặc khác thuật toán, tham số đang cấu hình
+	NeedsRehash(encoded string) bool
+}
+
+type hasher struct {
+	cfg Config
+}
+
+// New create new hasher with config, trường không cấu hình dùng giá trị mặc định
+func New(cfg Config) (Hasher, error) {
+	cfg = cfg.withDefaults()
+	switch cfg.Algorithm {
+	case AlgorithmArgon2id, AlgorithmBcrypt:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, cfg.Algorithm)
+	}
+	return &hasher{cfg: cfg}, nil
+}
+
+// Hash implements Hasher.
+func (h *hasher) Hash(password string) (string, error) {
+	if h.cfg.Algorithm == AlgorithmBcrypt {
+		return hashBcrypt(password, h.cfg.Bcrypt)
+	}
+	return hashArgon2id(password, h.cfg.Argon2id)
+}
+
+// Verify implements Hasher.
+func (h *hasher) Verify(password, salt, encoded string) (bool, error) {
+	switch {
+	case isArgon2id(encoded):
+		return verifyArgon2id(password, encoded)
+	case isBcrypt(encoded):
+		return verifyBcrypt(password, encoded)
+	case isLegacy(encoded):
+		return verifyLegacy(password, salt, encoded), nil
+	default:
+		return false, ErrInvalidHash
+	}
+}
+
+// NeedsRehash implements Hasher.
+func (h *hasher) NeedsRehash(encoded string) bool {
+	switch {
+	case isArgon2id(encoded):
+		if h.cfg.Algorithm != AlgorithmArgon2id {
+			return true
+		}
+		params, _, key, err := decodeArgon2id(encoded)
+		if err != nil {
+			return true
+		}
+		return params.Memory != h.cfg.Argon2id.Memory ||
+			params.Iterations != h.cfg.Argon2id.Iterations ||
+			params.Parallelism != h.cfg.Argon2id.Parallelism ||
+			uint32(len(key)) != h.cfg.Argon2id.KeyLength
+	case isBcrypt(encoded):
+		if h.cfg.Algorithm != AlgorithmBcrypt {
+			return true
+		}
+		return bcryptCost(encoded) != h.cfg.Bcrypt.Cost
+	default:
+		return true
+	}
+}
+
+func isArgon2id(encoded string) bool {
+	return strings.HasPrefix(encoded, "$argon2id$")
+}
+
+func isBcrypt(encoded string) bool {
+	return strings.HasPrefix(encoded, "$2a$") ||
+		strings.HasPrefix(encoded, "$2b$") ||
+		strings.HasPrefix(encoded, "$2y$")
+}
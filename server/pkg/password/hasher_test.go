@@ -0,0 +1,168 @@
+package password
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// testConfig tham số nhỏ để test chạy nhanh
+func testConfig(algorithm string) Config {
+	return Config{
+		Algorithm: algorithm,
+		Argon2id: Argon2idConfig{
+			Memory:      1024,
+			Iterations:  1,
+			Parallelism: 1,
+			SaltLength:  16,
+			KeyLength:   32,
+		},
+		Bcrypt: BcryptConfig{
+			Cost: 4,
+		},
+	}
+}
+
+func newTestHasher(t *testing.T, cfg Config) Hasher {
+	t.Helper()
+	h, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return h
+}
+
+func TestHashVerifyRoundTrip(t *testing.T) {
+	tests := []struct {
+		algorithm string
+		prefix    string
+	}{
+		{AlgorithmArgon2id, "$argon2id$v=19$m=1024,t=1,p=1$"},
+		{AlgorithmBcrypt, "$2a$04$"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.algorithm, func(t *testing.T) {
+			h := newTestHasher(t, testConfig(tt.algorithm))
+			encoded, err := h.Hash("correct horse")
+			if err != nil {
+				t.Fatalf("Hash() error = %v", err)
+			}
+			if !strings.HasPrefix(encoded, tt.prefix) {
+				t.Fatalf("Hash() = %q, want prefix %q", encoded, tt.prefix)
+			}
+			other, _ := h.Hash("correct horse")
+			if other == encoded {
+				t.Fatal("Hash() must use a random salt")
+			}
+
+			ok, err := h.Verify("correct horse", "", encoded)
+			if err != nil || !ok {
+				t.Fatalf("Verify(correct) = %v, %v, want true, nil", ok, err)
+			}
+			ok, err = h.Verify("wrong horse", "", encoded)
+			if err != nil || ok {
+				t.Fatalf("Verify(wrong) = %v, %v, want false, nil", ok, err)
+			}
+			if h.NeedsRehash(encoded) {
+				t.Fatal("NeedsRehash() = true for hash with current params")
+			}
+		})
+	}
+}
+
+func TestVerifyLegacy(t *testing.T) {
+	h := newTestHasher(t, testConfig(AlgorithmArgon2id))
+	sum := sha256.Sum256([]byte("secret" + "salt123"))
+	encoded := hex.EncodeToString(sum[:])
+
+	ok, err := h.Verify("secret", "salt123", encoded)
+	if err != nil || !ok {
+		t.Fatalf("Verify(correct) = %v, %v, want true, nil", ok, err)
+	}
+	ok, err = h.Verify("secret", "other-salt", encoded)
+	if err != nil || ok {
+		t.Fatalf("Verify(wrong salt) = %v, %v, want false, nil", ok, err)
+	}
+	ok, err = h.Verify("wrong", "salt123", encoded)
+	if err != nil || ok {
+		t.Fatalf("Verify(wrong password) = %v, %v, want false, nil", ok, err)
+	}
+	if !h.NeedsRehash(encoded) {
+		t.Fatal("NeedsRehash() = false for legacy hash")
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	argon := newTestHasher(t, testConfig(AlgorithmArgon2id))
+	bcryptHasher := newTestHasher(t, testConfig(AlgorithmBcrypt))
+	argonHash, _ := argon.Hash("secret")
+	bcryptHash, _ := bcryptHasher.Hash("secret")
+
+	stronger := testConfig(AlgorithmArgon2id)
+	stronger.Argon2id.Iterations = 2
+	strongerArgon := newTestHasher(t, stronger)
+
+	strongerBcryptCfg := testConfig(AlgorithmBcrypt)
+	strongerBcryptCfg.Bcrypt.Cost = 5
+	strongerBcrypt := newTestHasher(t, strongerBcryptCfg)
+
+	tests := []struct {
+		name    string
+		hasher  Hasher
+		encoded string
+		want    bool
+	}{
+		{"argon2id same params", argon, argonHash, false},
+		{"argon2id params changed", strongerArgon, argonHash, true},
+		{"argon2id when bcrypt configured", bcryptHasher, argonHash, true},
+		{"bcrypt same cost", bcryptHasher, bcryptHash, false},
+		{"bcrypt cost changed", strongerBcrypt, bcryptHash, true},
+		{"bcrypt when argon2id configured", argon, bcryptHash, true},
+		{"malformed argon2id", argon, "$argon2id$v=19$m=1024,t=1,p=1$bad", true},
+		{"unknown format", argon, "plaintext", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hasher.NeedsRehash(tt.encoded); got != tt.want {
+				t.Fatalf("NeedsRehash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyMalformedHash(t *testing.T) {
+	h := newTestHasher(t, testConfig(AlgorithmArgon2id))
+	tests := []struct {
+		name    string
+		encoded string
+	}{
+		{"empty", ""},
+		{"unknown format", "plaintext"},
+		{"argon2id missing parts", "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA"},
+		{"argon2id wrong version", "$argon2id$v=16$m=1024,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5"},
+		{"argon2id bad params", "$argon2id$v=19$m=x,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5"},
+		{"argon2id bad salt", "$argon2id$v=19$m=1024,t=1,p=1$!!!$a2V5"},
+		{"argon2id empty key", "$argon2id$v=19$m=1024,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$"},
+		{"bcrypt truncated", "$2a$04$short"},
+		{"legacy wrong length", strings.Repeat("a", 63)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, err := h.Verify("secret", "", tt.encoded)
+			if ok {
+				t.Fatal("Verify() = true for malformed hash")
+			}
+			if !errors.Is(err, ErrInvalidHash) {
+				t.Fatalf("Verify() error = %v, want ErrInvalidHash", err)
+			}
+		})
+	}
+}
+
+func TestNewUnsupportedAlgorithm(t *testing.T) {
+	if _, err := New(Config{Algorithm: "md5"}); !errors.Is(err, ErrUnsupportedAlgorithm) {
+		t.Fatalf("New() error = %v, want ErrUnsupportedAlgorithm", err)
+	}
+}
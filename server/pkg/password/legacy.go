@@ -0,0 +1,22 @@
+package password
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+)
+
+// isLegacy hash cũ là hex sha256(password + salt), không có tiền tố thuật toán
+func isLegacy(encoded string) bool {
+	if len(encoded) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(encoded)
+	return err == nil
+}
+
+// verifyLegacy chỉ dùng để kiểm tra và migrate hash cũ khi đăng nhập, không tạo hash legacy mới
+func verifyLegacy(password, salt, encoded string) bool {
+	hash := sha256.Sum256([]byte(password + salt))
+	return subtle.ConstantTimeCompare([]byte(hex.EncodeToString(hash[:])), []byte(encoded)) == 1
+}
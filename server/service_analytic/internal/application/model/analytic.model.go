@@ -52,10 +52,13 @@ type DailyReportDetailEmployeeRow struct {
 	LateMinutes       int        `db:"late_minutes"`
 	EarlyLeaveMinutes int        `db:"early_leave_minutes"`
 	TotalWorkMinutes  int        `db:"total_work_minutes"`
+	ScheduledMinutes  int        `db:"scheduled_minutes"`
+	BreakMinutes      int        `db:"break_minutes"`
+	OvertimeMinutes   int        `db:"overtime_minutes"`
+	NetWorkMinutes    int        `db:"net_work_minutes"`
 	Notes             string     `db:"notes"`
 	UpdatedAt         time.Time  `db:"updated_at"`
 	// Calculated fields (not in ScyllaDB, computed on demand)
-	OvertimeMinutes      int     `db:"-"` // Calculated field
 	AttendancePercentage float64 `db:"-"` // Calculated field
 }
 
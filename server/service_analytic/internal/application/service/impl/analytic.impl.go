@@ -475,9 +475,12 @@ func (s *AnalyticServiceImpl) GetDailyReportDetail(ctx context.Context, input *m
 			LateMinutes:          item.LateMinutes,
 			EarlyLeaveMinutes:    item.EarlyLeaveMinutes,
 			TotalWorkMinutes:     item.TotalWorkMinutes,
+			ScheduledMinutes:     item.ScheduledMinutes,
+			BreakMinutes:         item.BreakMinutes,
+			OvertimeMinutes:      item.OvertimeMinutes,
+			NetWorkMinutes:       item.NetWorkMinutes,
 			Notes:                item.Notes,
 			UpdatedAt:            item.UpdatedAt,
-			OvertimeMinutes:      item.OvertimeMinutes,
 			AttendancePercentage: item.AttendancePercentage,
 		}
 		out.Items = append(out.Items, row)
@@ -731,18 +734,22 @@ func (s *AnalyticServiceImpl) GetSummaryReport(ctx context.Context, input *model
 	}
 	totalEmployees := int(totalEmployees64)
 	totalPresentDays := 0
+	totalExcludedDays := 0 // Nghỉ phép/nghỉ lễ
 	totalWorkingMinutes := 0
 	totalOvertimeMinutes := 0
 	for _, summary := range summaries {
 		if summary.AttendanceStatus == 0 {
 			totalPresentDays++
 		}
+		if domainModel.IsExcludedFromAttendanceRate(summary.AttendanceStatus) {
+			totalExcludedDays++
+		}
 		totalWorkingMinutes += summary.TotalWorkMinutes
 		totalOvertimeMinutes += summary.OvertimeMinutes
 	}
 	averageAttendanceRate := 0.0
-	if totalEmployees > 0 && totalWorkingDays > 0 {
-		averageAttendanceRate = float64(totalPresentDays) / float64(totalEmployees*totalWorkingDays) * 100
+	if expectedDays := totalEmployees*totalWorkingDays - totalExcludedDays; expectedDays > 0 {
+		averageAttendanceRate = float64(totalPresentDays) / float64(expectedDays) * 100
 	}
 	weeklySummary := s.calculateWeeklySummary(ctx, startDate, endDate, companyID, totalEmployees)
 	topEmployees := s.getTopAttendanceEmployees(ctx, summaries, 10)
@@ -779,6 +786,7 @@ func (s *AnalyticServiceImpl) getEmployeeSummaryReport(ctx context.Context, comp
 
 	totalWorkingDays := endDate.Day()
 	totalPresentDays := 0
+	totalExcludedDays := 0 // Nghỉ phép/nghỉ lễ
 	totalWorkingMinutes := 0
 	totalOvertimeMinutes := 0
 
@@ -786,14 +794,17 @@ func (s *AnalyticServiceImpl) getEmployeeSummaryReport(ctx context.Context, comp
 		if summary.AttendanceStatus == 0 { // PRESENT
 			totalPresentDays++
 		}
+		if domainModel.IsExcludedFromAttendanceRate(summary.AttendanceStatus) {
+			totalExcludedDays++
+		}
 		totalWorkingMinutes += summary.TotalWorkMinutes
 		totalOvertimeMinutes += summary.OvertimeMinutes
 	}
 
-	// Calculate attendance rate for this employee
+	// Calculate attendance rate for this employee, excluding leave and holiday days
 	averageAttendanceRate := 0.0
-	if totalWorkingDays > 0 {
-		averageAttendanceRate = float64(totalPresentDays) / float64(totalWorkingDays) * 100
+	if expectedDays := totalWorkingDays - totalExcludedDays; expectedDays > 0 {
+		averageAttendanceRate = float64(totalPresentDays) / float64(expectedDays) * 100
 	}
 
 	// For employee view, weekly summary is calculated only for this employee
@@ -841,16 +852,20 @@ func (s *AnalyticServiceImpl) calculateWeeklySummaryForEmployee(ctx context.Cont
 		}
 
 		totalPresentDays := 0
+		totalExcludedDays := 0
 		totalMinutes := 0
 		for _, summary := range weekSummaries {
 			if summary.AttendanceStatus == 0 { // PRESENT
 				totalPresentDays++
 			}
+			if domainModel.IsExcludedFromAttendanceRate(summary.AttendanceStatus) {
+				totalExcludedDays++
+			}
 			totalMinutes += summary.TotalWorkMinutes
 		}
 
 		// For employee, attendance rate is based on actual working days in the week
-		daysInWeek := int(weekEnd.Sub(weekStart).Hours()/24) + 1
+		daysInWeek := int(weekEnd.Sub(weekStart).Hours()/24) + 1 - totalExcludedDays
 		attendanceRate := 0.0
 		if daysInWeek > 0 {
 			attendanceRate = float64(totalPresentDays) / float64(daysInWeek) * 100
@@ -1476,17 +1491,21 @@ func (s *AnalyticServiceImpl) calculateWeeklySummary(ctx context.Context, startD
 		}
 
 		totalPresentDays := 0
+		totalExcludedDays := 0
 		totalMinutes := 0
 		for _, summary := range weekSummaries {
 			if summary.AttendanceStatus == 0 { // PRESENT
 				totalPresentDays++
 			}
+			if domainModel.IsExcludedFromAttendanceRate(summary.AttendanceStatus) {
+				totalExcludedDays++
+			}
 			totalMinutes += summary.TotalWorkMinutes
 		}
 
 		attendanceRate := 0.0
-		if totalEmployees > 0 {
-			attendanceRate = float64(totalPresentDays) / float64(totalEmployees*7) * 100
+		if expectedDays := totalEmployees*7 - totalExcludedDays; expectedDays > 0 {
+			attendanceRate = float64(totalPresentDays) / float64(expectedDays) * 100
 		}
 
 		weeklySummaries = append(weeklySummaries, model.WeeklySummary{
@@ -1506,6 +1525,7 @@ func (s *AnalyticServiceImpl) calculateWeeklySummary(ctx context.Context, startD
 
 func (s *AnalyticServiceImpl) getTopAttendanceEmployees(ctx context.Context, summaries []*domainModel.DailySummary, limit int) []model.EmployeeAttendanceStat {
 	employeeMap := make(map[uuid.UUID]*model.EmployeeAttendanceStat)
+	excludedDays := make(map[uuid.UUID]int) // Nghỉ phép/nghỉ lễ của từng nhân viên
 
 	for _, summary := range summaries {
 		if _, exists := employeeMap[summary.EmployeeID]; !exists {
@@ -1532,13 +1552,18 @@ func (s *AnalyticServiceImpl) getTopAttendanceEmployees(ctx context.Context, sum
 		if summary.AttendanceStatus == 0 { // PRESENT
 			emp.PresentDays++
 		}
+		if domainModel.IsExcludedFromAttendanceRate(summary.AttendanceStatus) {
+			excludedDays[summary.EmployeeID]++
+		}
 		emp.TotalHours += summary.TotalWorkMinutes / 60
 	}
 
 	// Convert to slice and sort by present days (descending)
 	result := make([]model.EmployeeAttendanceStat, 0, len(employeeMap))
-	for _, emp := range employeeMap {
-		emp.AttendanceRate = roundFloat(float64(emp.PresentDays)/30*100, 2) // Assuming 30 days
+	for employeeID, emp := range employeeMap {
+		if expectedDays := 30 - excludedDays[employeeID]; expectedDays > 0 { // Assuming 30 days
+			emp.AttendanceRate = roundFloat(float64(emp.PresentDays)/float64(expectedDays)*100, 2)
+		}
 		result = append(result, *emp)
 	}
 
@@ -1627,6 +1652,9 @@ func (s *AnalyticServiceImpl) writeCSV(path string, summaries []*domainModel.Dai
 		"employee_id",
 		"shift_id",
 		"total_work_minutes",
+		"scheduled_minutes",
+		"break_minutes",
+		"net_work_minutes",
 		"overtime_minutes",
 		"late_minutes",
 		"early_leave_minutes",
@@ -1643,6 +1671,9 @@ func (s *AnalyticServiceImpl) writeCSV(path string, summaries []*domainModel.Dai
 			s.EmployeeID.String(),
 			s.ShiftID.String(),
 			fmt.Sprintf("%d", s.TotalWorkMinutes),
+			fmt.Sprintf("%d", s.ScheduledMinutes),
+			fmt.Sprintf("%d", s.BreakMinutes),
+			fmt.Sprintf("%d", s.NetWorkMinutes),
 			fmt.Sprintf("%d", s.OvertimeMinutes),
 			fmt.Sprintf("%d", s.LateMinutes),
 			fmt.Sprintf("%d", s.EarlyLeaveMinutes),
@@ -2978,6 +2978,9 @@ const docTemplate = `{
                 "attendance_status": {
                     "type": "integer"
                 },
+                "break_minutes": {
+                    "type": "integer"
+                },
                 "company_id": {
                     "type": "string"
                 },
@@ -2990,11 +2993,16 @@ const docTemplate = `{
                 "late_minutes": {
                     "type": "integer"
                 },
+                "net_work_minutes": {
+                    "type": "integer"
+                },
                 "notes": {
                     "type": "string"
                 },
                 "overtime_minutes": {
-                    "description": "Calculated field",
+                    "type": "integer"
+                },
+                "scheduled_minutes": {
                     "type": "integer"
                 },
                 "shift_id": {
@@ -14,8 +14,16 @@ const (
 	AttendanceStatusLate       AttendanceStatus = 1
 	AttendanceStatusEarlyLeave AttendanceStatus = 2
 	AttendanceStatusAbsent     AttendanceStatus = 3
+	// Ngày nghỉ hợp lệ do service_attendance ghi nhận, không tính vào tỉ lệ chuyên cần
+	AttendanceStatusOnLeave AttendanceStatus = 6
+	AttendanceStatusHoliday AttendanceStatus = 7
 )
 
+// IsExcludedFromAttendanceRate ngày nghỉ phép/nghỉ lễ không được tính là ngày công phải đi làm
+func IsExcludedFromAttendanceRate(status int) bool {
+	return status == int(AttendanceStatusOnLeave) || status == int(AttendanceStatusHoliday)
+}
+
 // DailySummary represents the daily attendance summary model from ScyllaDB
 // Table: daily_summaries
 // PRIMARY KEY ((company_id, summary_month), work_date, employee_id)
@@ -31,11 +39,14 @@ type DailySummary struct {
 	LateMinutes       int        `db:"late_minutes"`
 	EarlyLeaveMinutes int        `db:"early_leave_minutes"`
 	TotalWorkMinutes  int        `db:"total_work_minutes"`
+	ScheduledMinutes  int        `db:"scheduled_minutes"`
+	BreakMinutes      int        `db:"break_minutes"`
+	OvertimeMinutes   int        `db:"overtime_minutes"`
+	NetWorkMinutes    int        `db:"net_work_minutes"`
 	Notes             string     `db:"notes"`
 	UpdatedAt         time.Time  `db:"updated_at"`
 
 	// Calculated fields (not in ScyllaDB, computed on demand)
-	OvertimeMinutes      int     `db:"-"` // Calculated field
 	AttendancePercentage float64 `db:"-"` // Calculated field
 }
 
@@ -203,6 +214,10 @@ type DailySummaryByUser struct {
 	LateMinutes       int        `db:"late_minutes"`
 	EarlyLeaveMinutes int        `db:"early_leave_minutes"`
 	TotalWorkMinutes  int        `db:"total_work_minutes"`
+	ScheduledMinutes  int        `db:"scheduled_minutes"`
+	BreakMinutes      int        `db:"break_minutes"`
+	OvertimeMinutes   int        `db:"overtime_minutes"`
+	NetWorkMinutes    int        `db:"net_work_minutes"`
 	Notes             string     `db:"notes"`
 	UpdatedAt         time.Time  `db:"updated_at"`
 }
@@ -25,7 +25,8 @@ func (r *AnalyticRepositoryImpl) GetDailySummariesByDatePage(ctx context.Context
 	month := workDate.Format("2006-01")
 	query := `SELECT company_id, summary_month, work_date, employee_id, shift_id,
 		actual_check_in, actual_check_out, attendance_status, late_minutes,
-		early_leave_minutes, total_work_minutes, notes, updated_at
+		early_leave_minutes, total_work_minutes, scheduled_minutes, break_minutes,
+		overtime_minutes, net_work_minutes, notes, updated_at
 		FROM daily_summaries
 		WHERE company_id = ? AND summary_month = ? AND work_date = ?`
 	if pageState != nil {
@@ -130,7 +131,8 @@ func (r *AnalyticRepositoryImpl) GetDailySummariesByDate(ctx context.Context, co
 
 	query := `SELECT company_id, summary_month, work_date, employee_id, shift_id,
 		actual_check_in, actual_check_out, attendance_status, late_minutes,
-		early_leave_minutes, total_work_minutes, notes, updated_at
+		early_leave_minutes, total_work_minutes, scheduled_minutes, break_minutes,
+		overtime_minutes, net_work_minutes, notes, updated_at
 		FROM daily_summaries
 		WHERE company_id = ? AND summary_month = ? AND work_date = ?`
 
@@ -142,7 +144,8 @@ func (r *AnalyticRepositoryImpl) GetDailySummariesByDate(ctx context.Context, co
 func (r *AnalyticRepositoryImpl) GetDailySummariesByMonth(ctx context.Context, companyID uuid.UUID, month string) ([]*model.DailySummary, error) {
 	query := `SELECT company_id, summary_month, work_date, employee_id, shift_id,
 		actual_check_in, actual_check_out, attendance_status, late_minutes,
-		early_leave_minutes, total_work_minutes, notes, updated_at
+		early_leave_minutes, total_work_minutes, scheduled_minutes, break_minutes,
+		overtime_minutes, net_work_minutes, notes, updated_at
 		FROM daily_summaries
 		WHERE company_id = ? AND summary_month = ?`
 
@@ -184,7 +187,8 @@ func (r *AnalyticRepositoryImpl) GetDailySummariesByDateRange(ctx context.Contex
 func (r *AnalyticRepositoryImpl) GetDailySummariesByEmployeeMonth(ctx context.Context, companyID, employeeID uuid.UUID, month string) ([]*model.DailySummary, error) {
 	query := `SELECT company_id, summary_month, work_date, employee_id, shift_id,
 		actual_check_in, actual_check_out, attendance_status, late_minutes,
-		early_leave_minutes, total_work_minutes, notes, updated_at
+		early_leave_minutes, total_work_minutes, scheduled_minutes, break_minutes,
+		overtime_minutes, net_work_minutes, notes, updated_at
 		FROM daily_summaries_by_user
 		WHERE company_id = ? AND summary_month = ? AND employee_id = ?`
 
@@ -222,7 +226,8 @@ func (r *AnalyticRepositoryImpl) GetDailySummariesByEmployeeDateRange(ctx contex
 func (r *AnalyticRepositoryImpl) GetDailySummaryByEmployeeDate(ctx context.Context, companyID uuid.UUID, month string, workDate time.Time, employeeID uuid.UUID) (*model.DailySummary, error) {
 	query := `SELECT company_id, summary_month, work_date, employee_id, shift_id,
 		actual_check_in, actual_check_out, attendance_status, late_minutes,
-		early_leave_minutes, total_work_minutes, notes, updated_at
+		early_leave_minutes, total_work_minutes, scheduled_minutes, break_minutes,
+		overtime_minutes, net_work_minutes, notes, updated_at
 		FROM daily_summaries_by_user
 		WHERE company_id = ? AND summary_month = ? AND work_date = ? AND employee_id = ?`
 
@@ -230,7 +235,8 @@ func (r *AnalyticRepositoryImpl) GetDailySummaryByEmployeeDate(ctx context.Conte
 	err := r.scyllaSession.Query(query, uuidToGocql(companyID), month, workDate, uuidToGocql(employeeID)).
 		Scan(&summary.CompanyID, &summary.SummaryMonth, &summary.WorkDate, &summary.EmployeeID, &summary.ShiftID,
 			&summary.ActualCheckIn, &summary.ActualCheckOut, &summary.AttendanceStatus, &summary.LateMinutes,
-			&summary.EarlyLeaveMinutes, &summary.TotalWorkMinutes, &summary.Notes, &summary.UpdatedAt)
+			&summary.EarlyLeaveMinutes, &summary.TotalWorkMinutes, &summary.ScheduledMinutes, &summary.BreakMinutes,
+			&summary.OvertimeMinutes, &summary.NetWorkMinutes, &summary.Notes, &summary.UpdatedAt)
 
 	if err == gocql.ErrNotFound {
 		return nil, nil
@@ -246,7 +252,8 @@ func (r *AnalyticRepositoryImpl) GetDailySummaryByEmployeeDate(ctx context.Conte
 func (r *AnalyticRepositoryImpl) GetDailySummariesByUser(ctx context.Context, companyID, employeeID uuid.UUID, month string) ([]*model.DailySummaryByUser, error) {
 	query := `SELECT company_id, employee_id, summary_month, work_date, shift_id,
 		actual_check_in, actual_check_out, attendance_status, late_minutes,
-		early_leave_minutes, total_work_minutes, notes, updated_at
+		early_leave_minutes, total_work_minutes, scheduled_minutes, break_minutes,
+		overtime_minutes, net_work_minutes, notes, updated_at
 		FROM daily_summaries_by_user
 		WHERE company_id = ? AND employee_id = ? AND summary_month = ?`
 
@@ -258,7 +265,8 @@ func (r *AnalyticRepositoryImpl) GetDailySummariesByUser(ctx context.Context, co
 func (r *AnalyticRepositoryImpl) GetDailySummaryByUserDate(ctx context.Context, companyID, employeeID uuid.UUID, month string, workDate time.Time) (*model.DailySummaryByUser, error) {
 	query := `SELECT company_id, employee_id, summary_month, work_date, shift_id,
 		actual_check_in, actual_check_out, attendance_status, late_minutes,
-		early_leave_minutes, total_work_minutes, notes, updated_at
+		early_leave_minutes, total_work_minutes, scheduled_minutes, break_minutes,
+		overtime_minutes, net_work_minutes, notes, updated_at
 		FROM daily_summaries_by_user
 		WHERE company_id = ? AND employee_id = ? AND summary_month = ? AND work_date = ?`
 
@@ -266,7 +274,8 @@ func (r *AnalyticRepositoryImpl) GetDailySummaryByUserDate(ctx context.Context,
 	err := r.scyllaSession.Query(query, uuidToGocql(companyID), uuidToGocql(employeeID), month, workDate).
 		Scan(&summary.CompanyID, &summary.EmployeeID, &summary.SummaryMonth, &summary.WorkDate, &summary.ShiftID,
 			&summary.ActualCheckIn, &summary.ActualCheckOut, &summary.AttendanceStatus, &summary.LateMinutes,
-			&summary.EarlyLeaveMinutes, &summary.TotalWorkMinutes, &summary.Notes, &summary.UpdatedAt)
+			&summary.EarlyLeaveMinutes, &summary.TotalWorkMinutes, &summary.ScheduledMinutes, &summary.BreakMinutes,
+			&summary.OvertimeMinutes, &summary.NetWorkMinutes, &summary.Notes, &summary.UpdatedAt)
 
 	if err == gocql.ErrNotFound {
 		return nil, nil
@@ -776,7 +785,8 @@ func scanDailySummaries(iter *gocql.Iter) ([]*model.DailySummary, error) {
 	for iter.Scan(
 		&companyUuid, &summary.SummaryMonth, &summary.WorkDate, &employeeUuid, &shiftUuid,
 		&summary.ActualCheckIn, &summary.ActualCheckOut, &summary.AttendanceStatus, &summary.LateMinutes,
-		&summary.EarlyLeaveMinutes, &summary.TotalWorkMinutes, &summary.Notes, &summary.UpdatedAt,
+		&summary.EarlyLeaveMinutes, &summary.TotalWorkMinutes, &summary.ScheduledMinutes, &summary.BreakMinutes,
+		&summary.OvertimeMinutes, &summary.NetWorkMinutes, &summary.Notes, &summary.UpdatedAt,
 	) {
 		summary.CompanyID = uuid.UUID(companyUuid)
 		summary.EmployeeID = uuid.UUID(employeeUuid)
@@ -802,7 +812,8 @@ func scanDailySummariesByUser(iter *gocql.Iter) ([]*model.DailySummaryByUser, er
 	for iter.Scan(
 		&companyUUID, &employeeUUID, &summary.SummaryMonth, &summary.WorkDate, &shiftUUID,
 		&summary.ActualCheckIn, &summary.ActualCheckOut, &summary.AttendanceStatus, &summary.LateMinutes,
-		&summary.EarlyLeaveMinutes, &summary.TotalWorkMinutes, &summary.Notes, &summary.UpdatedAt,
+		&summary.EarlyLeaveMinutes, &summary.TotalWorkMinutes, &summary.ScheduledMinutes, &summary.BreakMinutes,
+		&summary.OvertimeMinutes, &summary.NetWorkMinutes, &summary.Notes, &summary.UpdatedAt,
 	) {
 		// Convert gocql.UUID to uuid.UUID
 		summary.CompanyID = uuid.UUID(companyUUID)
@@ -27,8 +27,11 @@ type DailyReportDetailEmployee struct {
 	LateMinutes          int        `json:"late_minutes"`
 	EarlyLeaveMinutes    int        `json:"early_leave_minutes"`
 	TotalWorkMinutes     int        `json:"total_work_minutes"`
+	ScheduledMinutes     int        `json:"scheduled_minutes"`
+	BreakMinutes         int        `json:"break_minutes"`
+	OvertimeMinutes      int        `json:"overtime_minutes"`
+	NetWorkMinutes       int        `json:"net_work_minutes"`
 	Notes                string     `json:"notes"`
 	UpdatedAt            time.Time  `json:"updated_at"`
-	OvertimeMinutes      int        `json:"overtime_minutes"`      // Calculated field
 	AttendancePercentage float64    `json:"attendance_percentage"` // Calculated field
 }
@@ -43,6 +43,12 @@ type ShiftTimeEmployee struct {
 	EndTime               time.Time  `json:"end_time"`
 	GracePeriodMinutes    int        `json:"grace_period_minutes"`
 	EarlyDepartureMinutes int        `json:"early_departure_minutes"`
+	BreakDurationMinutes  int        `json:"break_duration_minutes"`
+	OvertimeAfterMinutes  int        `json:"overtime_after_minutes"`
+	IsFlexible            bool       `json:"is_flexible"`
+	CoreStartTime         *time.Time `json:"core_start_time,omitempty"` // Core hours of flexible shift
+	CoreEndTime           *time.Time `json:"core_end_time,omitempty"`
+	RequiredWorkMinutes   int        `json:"required_work_minutes"`
 	WorkDays              []int32    `json:"work_days"`
 	EffectiveFrom         time.Time  `json:"effective_from"`
 	EffectiveTo           *time.Time `json:"effective_to"`
@@ -66,19 +72,32 @@ type GetDailyAttendanceSummaryResultModel struct {
 }
 
 type DailySummariesCompanyInfo struct {
-	CompanyId         uuid.UUID `json:"company_id"`
-	SummaryMonth      string    `json:"summary_month"`
-	WorkDate          time.Time `json:"work_date"`
-	EmployeeId        uuid.UUID `json:"employee_id"`
-	ShiftId           uuid.UUID `json:"shift_id"`
-	ActualCheckIn     time.Time `json:"actual_check_in"`
-	ActualCheckOut    time.Time `json:"actual_check_out"`
-	AttendanceStatus  string    `json:"attendance_status"`
-	LateMinutes       int       `json:"late_minutes"`
-	EarlyLeaveMinutes int       `json:"early_leave_minutes"`
-	TotalWorkMinutes  int       `json:"total_work_minutes"`
-	Notes             string    `json:"notes"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	CompanyId         uuid.UUID      `json:"company_id"`
+	SummaryMonth      string         `json:"summary_month"`
+	WorkDate          time.Time      `json:"work_date"`
+	EmployeeId        uuid.UUID      `json:"employee_id"`
+	ShiftId           uuid.UUID      `json:"shift_id"`
+	ActualCheckIn     time.Time      `json:"actual_check_in"`
+	ActualCheckOut    time.Time      `json:"actual_check_out"`
+	AttendanceStatus  string         `json:"attendance_status"`
+	LateMinutes       int            `json:"late_minutes"`
+	EarlyLeaveMinutes int            `json:"early_leave_minutes"`
+	TotalWorkMinutes  int            `json:"total_work_minutes"`
+	ScheduledMinutes  int            `json:"scheduled_minutes"`
+	BreakMinutes      int            `json:"break_minutes"`
+	OvertimeMinutes   int            `json:"overtime_minutes"`
+	NetWorkMinutes    int            `json:"net_work_minutes"`
+	WorkIntervals     []WorkInterval `json:"work_intervals,omitempty"`
+	UnpairedPunches   int            `json:"unpaired_punches"`
+	Notes             string         `json:"notes"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+}
+
+// WorkInterval một cặp check-in/check-out trong ngày
+type WorkInterval struct {
+	CheckIn  *time.Time `json:"check_in,omitempty"`
+	CheckOut *time.Time `json:"check_out,omitempty"`
+	Minutes  int        `json:"minutes"`
 }
 
 // For GetDailyAttendanceSummaryEmployee
@@ -89,19 +108,25 @@ type GetDailyAttendanceSummaryEmployeeResultModel struct {
 }
 
 type DailySummariesEmployeeInfo struct {
-	CompanyId         uuid.UUID `json:"company_id"`
-	SummaryMonth      string    `json:"summary_month"`
-	WorkDate          time.Time `json:"work_date"`
-	EmployeeId        uuid.UUID `json:"employee_id"`
-	ShiftId           uuid.UUID `json:"shift_id"`
-	ActualCheckIn     time.Time `json:"actual_check_in"`
-	ActualCheckOut    time.Time `json:"actual_check_out"`
-	AttendanceStatus  string    `json:"attendance_status"`
-	LateMinutes       int       `json:"late_minutes"`
-	EarlyLeaveMinutes int       `json:"early_leave_minutes"`
-	TotalWorkMinutes  int       `json:"total_work_minutes"`
-	Notes             string    `json:"notes"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	CompanyId         uuid.UUID      `json:"company_id"`
+	SummaryMonth      string         `json:"summary_month"`
+	WorkDate          time.Time      `json:"work_date"`
+	EmployeeId        uuid.UUID      `json:"employee_id"`
+	ShiftId           uuid.UUID      `json:"shift_id"`
+	ActualCheckIn     time.Time      `json:"actual_check_in"`
+	ActualCheckOut    time.Time      `json:"actual_check_out"`
+	AttendanceStatus  string         `json:"attendance_status"`
+	LateMinutes       int            `json:"late_minutes"`
+	EarlyLeaveMinutes int            `json:"early_leave_minutes"`
+	TotalWorkMinutes  int            `json:"total_work_minutes"`
+	ScheduledMinutes  int            `json:"scheduled_minutes"`
+	BreakMinutes      int            `json:"break_minutes"`
+	OvertimeMinutes   int            `json:"overtime_minutes"`
+	NetWorkMinutes    int            `json:"net_work_minutes"`
+	WorkIntervals     []WorkInterval `json:"work_intervals,omitempty"`
+	UnpairedPunches   int            `json:"unpaired_punches"`
+	Notes             string         `json:"notes"`
+	UpdatedAt         time.Time      `json:"updated_at"`
 }
 
 type GetDailyAttendanceSummaryEmployeeModel struct {
@@ -169,11 +194,19 @@ type AddAttendanceModel struct {
 	VerificationScore   float64   `json:"verification_score"`
 	FaceImageURL        string    `json:"face_image_url"`
 	LocationCoordinates string    `json:"location_coordinates"`
+	// Client-supplied event id, a replay with the same key returns the original outcome
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
 	// Session information
 	Session        *SessionReq     `json:"session"`
 	ServiceSession *ServiceSession `json:"service_session,omitempty"`
 }
 
+type AddAttendanceResultModel struct {
+	RecordTime time.Time `json:"record_time"`
+	RecordType int       `json:"record_type"` // 0: check in, 1: check out, -1: no shift matched
+	Replayed   bool      `json:"replayed"`
+}
+
 type ServiceSession struct {
 	ServiceId   string `json:"service_id"`
 	ServiceName string `json:"service_name"`
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// For CreateCorrectionRequest
+type CreateCorrectionRequestModel struct {
+	Session *SessionReq `json:"session"`
+	//
+	CompanyID        uuid.UUID  `json:"company_id"`
+	EmployeeID       uuid.UUID  `json:"employee_id"`
+	WorkDate         time.Time  `json:"work_date"`
+	ProposedCheckIn  *time.Time `json:"proposed_check_in,omitempty"`
+	ProposedCheckOut *time.Time `json:"proposed_check_out,omitempty"`
+	Reason           string     `json:"reason"`
+}
+
+type CreateCorrectionRequestResultModel struct {
+	RequestID uuid.UUID `json:"request_id"`
+}
+
+// For ApproveCorrectionRequest, RejectCorrectionRequest
+type ReviewCorrectionRequestModel struct {
+	Session *SessionReq `json:"session"`
+	//
+	CompanyID  uuid.UUID `json:"company_id"`
+	RequestID  uuid.UUID `json:"request_id"`
+	ReviewNote string    `json:"review_note,omitempty"`
+}
+
+type ApproveCorrectionRequestResultModel struct {
+	RequestID         uuid.UUID  `json:"request_id"`
+	CorrectedCheckIn  *time.Time `json:"corrected_check_in,omitempty"`
+	CorrectedCheckOut *time.Time `json:"corrected_check_out,omitempty"`
+	CorrectedStatus   int        `json:"corrected_status"`
+}
+
+// For ListPendingCorrectionRequests
+type ListPendingCorrectionRequestsModel struct {
+	Session *SessionReq `json:"session"`
+	//
+	CompanyID uuid.UUID `json:"company_id"`
+	Limit     int       `json:"limit,omitempty"`
+	Offset    int       `json:"offset,omitempty"`
+}
+
+// For ListCorrectionRequestsEmployee
+type ListCorrectionRequestsEmployeeModel struct {
+	Session *SessionReq `json:"session"`
+	//
+	CompanyID  uuid.UUID `json:"company_id"`
+	EmployeeID uuid.UUID `json:"employee_id"`
+	Limit      int       `json:"limit,omitempty"`
+	Offset     int       `json:"offset,omitempty"`
+}
+
+type ListCorrectionRequestsResultModel struct {
+	Records []CorrectionRequestInfo `json:"records"`
+	Limit   int                     `json:"limit"`
+	Offset  int                     `json:"offset"`
+}
+
+type CorrectionRequestInfo struct {
+	RequestID         uuid.UUID  `json:"request_id"`
+	CompanyID         uuid.UUID  `json:"company_id"`
+	EmployeeID        uuid.UUID  `json:"employee_id"`
+	ShiftID           uuid.UUID  `json:"shift_id"`
+	WorkDate          time.Time  `json:"work_date"`
+	ProposedCheckIn   *time.Time `json:"proposed_check_in,omitempty"`
+	ProposedCheckOut  *time.Time `json:"proposed_check_out,omitempty"`
+	Reason            string     `json:"reason"`
+	OriginalCheckIn   *time.Time `json:"original_check_in,omitempty"`
+	OriginalCheckOut  *time.Time `json:"original_check_out,omitempty"`
+	OriginalStatus    *int       `json:"original_status,omitempty"`
+	CorrectedCheckIn  *time.Time `json:"corrected_check_in,omitempty"`
+	CorrectedCheckOut *time.Time `json:"corrected_check_out,omitempty"`
+	CorrectedStatus   *int       `json:"corrected_status,omitempty"`
+	Status            int        `json:"status"`
+	ReviewedBy        *uuid.UUID `json:"reviewed_by,omitempty"`
+	ReviewedAt        *time.Time `json:"reviewed_at,omitempty"`
+	ReviewNote        string     `json:"review_note,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
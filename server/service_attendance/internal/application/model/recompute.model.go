@@ -0,0 +1,71 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Trạng thái job tính lại bản tổng hợp
+const (
+	RecomputeJobStatusPending   = "pending"
+	RecomputeJobStatusRunning   = "running"
+	RecomputeJobStatusCompleted = "completed"
+	RecomputeJobStatusFailed    = "failed"
+)
+
+// For RecomputeDailySummaries
+type RecomputeDailySummariesModel struct {
+	Session *SessionReq `json:"session"`
+	//
+	CompanyID   uuid.UUID   `json:"company_id"`
+	Month       time.Time   `json:"month"` // Khác zero: tính lại cả tháng, thay cho from_date/to_date
+	FromDate    time.Time   `json:"from_date"`
+	ToDate      time.Time   `json:"to_date"`
+	EmployeeIDs []uuid.UUID `json:"employee_ids,omitempty"` // Rỗng: toàn bộ nhân viên có phân ca
+	DryRun      bool        `json:"dry_run"`                // true: chỉ trả về khác biệt, không ghi
+	Immediate   bool        `json:"immediate"`              // true: tính ngay trong request, không lấy khóa job của công ty
+}
+
+// For GetRecomputeJob
+type GetRecomputeJobModel struct {
+	Session *SessionReq `json:"session"`
+	//
+	CompanyID uuid.UUID `json:"company_id"`
+	JobID     uuid.UUID `json:"job_id"`
+}
+
+// RecomputeJobModel trạng thái và tiến độ của job tính lại bản tổng hợp
+type RecomputeJobModel struct {
+	JobID          uuid.UUID            `json:"job_id"`
+	CompanyID      uuid.UUID            `json:"company_id"`
+	Status         string               `json:"status"`
+	DryRun         bool                 `json:"dry_run"`
+	FromDate       time.Time            `json:"from_date"`
+	ToDate         time.Time            `json:"to_date"`
+	EmployeeIDs    []uuid.UUID          `json:"employee_ids,omitempty"`
+	Total          int                  `json:"total"`     // Số bản tổng hợp cần tính
+	Processed      int                  `json:"processed"` // Số bản tổng hợp đã tính
+	Changed        int                  `json:"changed"`   // Số bản tổng hợp khác với dữ liệu hiện tại
+	Failed         int                  `json:"failed"`
+	Diffs          []RecomputeDiffModel `json:"diffs,omitempty"`
+	DiffsTruncated bool                 `json:"diffs_truncated"`
+	Error          string               `json:"error,omitempty"`
+	CreatedBy      uuid.UUID            `json:"created_by"`
+	CreatedAt      time.Time            `json:"created_at"`
+	FinishedAt     *time.Time           `json:"finished_at,omitempty"`
+}
+
+// RecomputeDiffModel khác biệt giữa bản tổng hợp hiện tại và bản tính lại của một nhân viên trong một ngày
+type RecomputeDiffModel struct {
+	EmployeeID uuid.UUID                   `json:"employee_id"`
+	WorkDate   time.Time                   `json:"work_date"`
+	Missing    bool                        `json:"missing"` // true: chưa có bản tổng hợp
+	Changes    []RecomputeFieldChangeModel `json:"changes,omitempty"`
+}
+
+type RecomputeFieldChangeModel struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
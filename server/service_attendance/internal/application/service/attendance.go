@@ -15,7 +15,7 @@ type IAttendanceService interface {
 	GetDailyAttendanceSummaryEmployeeForCompany(ctx context.Context, req *model.GetDailyAttendanceSummaryEmployeeModel) (*model.GetDailyAttendanceSummaryEmployeeResultModel, *applicationErrors.Error)
 	GetAttendanceRecordsEmployeeForConpany(ctx context.Context, req *model.GetAttendanceRecordsEmployeeModel) (*model.GetAttendanceRecordsCompanyResultModel, *applicationErrors.Error)
 	GetAttendanceRecordsCompany(ctx context.Context, req *model.GetAttendanceRecordsCompanyModel) (*model.GetAttendanceRecordsCompanyResultModel, *applicationErrors.Error)
-	AddAttendance(ctx context.Context, req *model.AddAttendanceModel) *applicationErrors.Error
+	AddAttendance(ctx context.Context, req *model.AddAttendanceModel) (*model.AddAttendanceResultModel, *applicationErrors.Error)
 	DeleteAttendanceRecord(ctx context.Context, req *model.DeleteAttendanceModel) *applicationErrors.Error
 	DeleteAttendanceEmployeeBeforeTime(ctx context.Context, req *model.DeleteAttendanceModel) *applicationErrors.Error
 	DeleteAttendanceNoShift(ctx context.Context, req *model.DeleteAttendanceRecordNoShiftModel) *applicationErrors.Error
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	applicationErrors "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/errors"
+	model "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/model"
+)
+
+// ============================================
+// Correction Service Interfaces
+// ============================================
+type ICorrectionService interface {
+	CreateCorrectionRequest(ctx context.Context, req *model.CreateCorrectionRequestModel) (*model.CreateCorrectionRequestResultModel, *applicationErrors.Error)
+	ApproveCorrectionRequest(ctx context.Context, req *model.ReviewCorrectionRequestModel) (*model.ApproveCorrectionRequestResultModel, *applicationErrors.Error)
+	RejectCorrectionRequest(ctx context.Context, req *model.ReviewCorrectionRequestModel) *applicationErrors.Error
+	ListPendingCorrectionRequests(ctx context.Context, req *model.ListPendingCorrectionRequestsModel) (*model.ListCorrectionRequestsResultModel, *applicationErrors.Error)
+	ListCorrectionRequestsEmployee(ctx context.Context, req *model.ListCorrectionRequestsEmployeeModel) (*model.ListCorrectionRequestsResultModel, *applicationErrors.Error)
+}
+
+// Manager instance of correction service
+var _vICorrectionService ICorrectionService
+
+// Getter for correction service instance
+func GetCorrectionService() ICorrectionService {
+	return _vICorrectionService
+}
+
+// Setter for correction service instance
+func SetCorrectionService(service ICorrectionService) error {
+	if service == nil {
+		return errors.New("correction service set is nil")
+	}
+	if _vICorrectionService != nil {
+		return errors.New("correction service is already set")
+	}
+	_vICorrectionService = service
+	return nil
+}
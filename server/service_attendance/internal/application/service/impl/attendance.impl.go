@@ -14,8 +14,8 @@ import (
 	domainCache "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/cache"
 	domainLogger "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/logger"
 	domainModel "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/model"
+	domainMq "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/mq"
 	domainRepo "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/repository"
-	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/global"
 	utilsCache "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/shared/utils/cache"
 	utilsCrypto "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/shared/utils/crypto"
 )
@@ -26,9 +26,11 @@ import (
 type AttendanceService struct {
 	attendanceRepo   domainRepo.IAttendanceRepository
 	userRepo         domainRepo.IUserRepository
+	idempotencyRepo  domainRepo.IIdempotencyRepository
 	logger           domainLogger.ILogger
 	localCache       domainCache.ILocalCache
 	distributedCache domainCache.IDistributedCache
+	kafkaWriter      domainMq.IKafkaWrite // nil nếu summary pipeline chưa bật
 }
 
 // DeleteDailyAttendanceSummary implements service.IAttendanceService.
@@ -123,8 +125,8 @@ func (a *AttendanceService) DeleteAttendanceRecord(ctx context.Context, req *mod
 	if err := a.attendanceRepo.DeleteAttendanceRecordAllYearMonth(
 		ctx,
 		&domainModel.DeleteAttendanceRecordInput{
-			CompanyID:  req.CompanyID,
-			YearMonth:  req.YearMonth,
+			CompanyID: req.CompanyID,
+			YearMonth: req.YearMonth,
 		},
 	); err != nil {
 		a.logger.Error("Failed to delete attendance record", "error", err)
@@ -333,6 +335,12 @@ func (a *AttendanceService) GetDailyAttendanceSummaryEmployeeForCompany(ctx cont
 			LateMinutes:       item.LateMinutes,
 			EarlyLeaveMinutes: item.EarlyLeaveMinutes,
 			TotalWorkMinutes:  item.TotalWorkMinutes,
+			ScheduledMinutes:  item.ScheduledMinutes,
+			BreakMinutes:      item.BreakMinutes,
+			OvertimeMinutes:   item.OvertimeMinutes,
+			NetWorkMinutes:    item.NetWorkMinutes,
+			WorkIntervals:     toWorkIntervals(item.WorkIntervals),
+			UnpairedPunches:   item.UnpairedPunches,
 			Notes:             item.Notes,
 			UpdatedAt:         item.UpdatedAt,
 		})
@@ -421,6 +429,12 @@ func (a *AttendanceService) GetDailyAttendanceSummaryForCompany(ctx context.Cont
 			LateMinutes:       item.LateMinutes,
 			EarlyLeaveMinutes: item.EarlyLeaveMinutes,
 			TotalWorkMinutes:  item.TotalWorkMinutes,
+			ScheduledMinutes:  item.ScheduledMinutes,
+			BreakMinutes:      item.BreakMinutes,
+			OvertimeMinutes:   item.OvertimeMinutes,
+			NetWorkMinutes:    item.NetWorkMinutes,
+			WorkIntervals:     toWorkIntervals(item.WorkIntervals),
+			UnpairedPunches:   item.UnpairedPunches,
 			Notes:             item.Notes,
 			UpdatedAt:         item.UpdatedAt,
 		})
@@ -520,7 +534,7 @@ func (a *AttendanceService) GetAttendanceRecordsCompany(ctx context.Context, req
 }
 
 // AddAttendance implements service.IAttendanceService.
-func (a *AttendanceService) AddAttendance(ctx context.Context, req *model.AddAttendanceModel) *errors.Error {
+func (a *AttendanceService) AddAttendance(ctx context.Context, req *model.AddAttendanceModel) (*model.AddAttendanceResultModel, *errors.Error) {
 	// 1. Check permission
 	sessionInfo, errSession := checkPermissionForManagerAdminService(
 		ctx,
@@ -530,7 +544,21 @@ func (a *AttendanceService) AddAttendance(ctx context.Context, req *model.AddAtt
 	)
 	if errSession != nil {
 		a.logger.Warn("Permission Denied", "session", req.Session, "service_session", req.ServiceSession)
-		return errSession
+		return nil, errSession
+	}
+	// 1.1. Idempotency key: lần gửi lại trả về kết quả đã ghi nhận thay vì ghi thêm bản ghi
+	var claim *idempotencyClaim
+	if req.IdempotencyKey != "" {
+		var existing *domainModel.AttendanceIdempotencyRecord
+		var errClaim *errors.Error
+		claim, existing, errClaim = a.claimIdempotencyKey(ctx, req)
+		if errClaim != nil {
+			return nil, errClaim
+		}
+		if existing != nil {
+			a.logger.Info("Replay attendance by idempotency key", "employee_id", req.EmployeeID, "record_time", req.RecordTime)
+			return replayIdempotencyKey(req, existing)
+		}
 	}
 	var mapDataSession = map[string]string{}
 	switch sessionInfo {
@@ -578,7 +606,8 @@ func (a *AttendanceService) AddAttendance(ctx context.Context, req *model.AddAtt
 		)
 		if err != nil {
 			a.logger.Error("Failed to get list shift time employee from DB", "error", err)
-			return &errors.Error{
+			a.releaseIdempotencyKey(ctx, claim, req)
+			return nil, &errors.Error{
 				ErrorSystem: err,
 				ErrorClient: "InternalError",
 			}
@@ -591,6 +620,12 @@ func (a *AttendanceService) AddAttendance(ctx context.Context, req *model.AddAtt
 				EndTime:               item.EndTime,
 				GracePeriodMinutes:    item.GracePeriodMinutes,
 				EarlyDepartureMinutes: item.EarlyDepartureMinutes,
+				BreakDurationMinutes:  item.BreakDurationMinutes,
+				OvertimeAfterMinutes:  item.OvertimeAfterMinutes,
+				IsFlexible:            item.IsFlexible,
+				CoreStartTime:         item.CoreStartTime,
+				CoreEndTime:           item.CoreEndTime,
+				RequiredWorkMinutes:   item.RequiredWorkMinutes,
 				WorkDays:              item.WorkDays,
 				EffectiveFrom:         item.EffectiveFrom,
 				EffectiveTo:           item.EffectiveTo,
@@ -623,8 +658,32 @@ func (a *AttendanceService) AddAttendance(ctx context.Context, req *model.AddAtt
 		}
 		if err := a.attendanceRepo.AddAttendanceRecordNoShift(ctx, inputAddAttendanceRecord); err != nil {
 			a.logger.Error("Failed to add attendance record no shift", "error", err)
+			a.releaseIdempotencyKey(ctx, claim, req)
+			return nil, &errors.Error{
+				ErrorSystem: err,
+				ErrorClient: "InternalError",
+			}
 		}
-		return nil
+		a.completeIdempotencyKey(ctx, claim, req, domainModel.RecordTypeNoShift)
+		return &model.AddAttendanceResultModel{
+			RecordTime: req.RecordTime,
+			RecordType: domainModel.RecordTypeNoShift,
+		}, nil
+	}
+
+	// 3.1. Nếu trong ca đã có lần chấm công trước đó thì luân phiên check-in/check-out
+	//      theo lần chấm công gần nhất (hỗ trợ nhiều lần ra/vào trong ngày)
+	shiftStart, _ := resolveShiftBounds(req.RecordTime, matchedShift)
+	punches, err := a.attendanceRepo.GetAttendancePunches(ctx, &domainModel.GetAttendancePunchesInput{
+		CompanyID:  req.CompanyID,
+		EmployeeID: req.EmployeeID,
+		From:       shiftStart.Add(-time.Hour),
+		To:         req.RecordTime.Add(-time.Millisecond),
+	})
+	if err != nil {
+		a.logger.Warn("Failed to get previous punches, fallback to shift midpoint", "error", err)
+	} else if len(punches) > 0 {
+		isCheckIn = punches[len(punches)-1].RecordType == domainModel.RecordTypeCheckOut
 	}
 
 	// 4. Add attendance record
@@ -652,33 +711,49 @@ func (a *AttendanceService) AddAttendance(ctx context.Context, req *model.AddAtt
 		inputAddAttendanceRecord,
 	); err != nil {
 		a.logger.Error("Failed to add attendance record", "error", err)
-		return &errors.Error{
+		a.releaseIdempotencyKey(ctx, claim, req)
+		return nil, &errors.Error{
 			ErrorSystem: err,
 			ErrorClient: "InternalError",
 		}
 	}
-
 	// 5. Send to message queue for worker processing daily_summaries if checkout
+	//    Lỗi thì nhả khóa idempotency để client thử lại, lần thử lại ghi đè cùng bản ghi và tính lại tổng hợp
 	if !isCheckIn {
-		// TODO: feature gửi lên mq tự xử lí với hiệu năng cao
 		a.logger.Info("Handle cal daily summary", "mathced_shift", matchedShift, "record_time", req.RecordTime)
-		global.AttendanceServiceWorker.AddJobToDailySummaryWorkerV2(
-			req.CompanyID,
-			req.EmployeeID,
-			req.RecordTime,
+		if err := a.enqueueDailySummary(
+			ctx,
+			req,
 			domainModel.ShiftTimeEmployee{
 				ShiftID:               matchedShift.ShiftID,
 				StartTime:             matchedShift.StartTime,
 				EndTime:               matchedShift.EndTime,
 				GracePeriodMinutes:    matchedShift.GracePeriodMinutes,
 				EarlyDepartureMinutes: matchedShift.EarlyDepartureMinutes,
+				BreakDurationMinutes:  matchedShift.BreakDurationMinutes,
+				OvertimeAfterMinutes:  matchedShift.OvertimeAfterMinutes,
+				IsFlexible:            matchedShift.IsFlexible,
+				CoreStartTime:         matchedShift.CoreStartTime,
+				CoreEndTime:           matchedShift.CoreEndTime,
+				RequiredWorkMinutes:   matchedShift.RequiredWorkMinutes,
 				WorkDays:              matchedShift.WorkDays,
 				EffectiveFrom:         matchedShift.EffectiveFrom,
 				EffectiveTo:           matchedShift.EffectiveTo,
 			},
-		)
+		); err != nil {
+			a.logger.Error("Failed to enqueue daily summary", "error", err)
+			a.releaseIdempotencyKey(ctx, claim, req)
+			return nil, &errors.Error{
+				ErrorSystem: err,
+				ErrorClient: "InternalError",
+			}
+		}
 	}
-	return nil
+	a.completeIdempotencyKey(ctx, claim, req, inputAddAttendanceRecord.RecordType)
+	return &model.AddAttendanceResultModel{
+		RecordTime: req.RecordTime,
+		RecordType: inputAddAttendanceRecord.RecordType,
+	}, nil
 }
 
 // NewAttendanceService creates a new instance of AttendanceService
@@ -689,14 +764,17 @@ func NewAttendanceService() service.IAttendanceService {
 	logger := domainLogger.GetLogger()
 	localCache, _ := domainCache.GetLocalCache()
 	distributedCache, _ := domainCache.GetDistributedCache()
+	kafkaWriter, _ := domainMq.GetKafkaWriteService()
 
 	// Create service instance
 	return &AttendanceService{
 		attendanceRepo:   attendanceRepo,
 		userRepo:         userRepo,
+		idempotencyRepo:  domainRepo.GetIdempotencyRepository(),
 		logger:           logger,
 		localCache:       localCache,
 		distributedCache: distributedCache,
+		kafkaWriter:      kafkaWriter,
 	}
 }
 
@@ -742,18 +820,7 @@ func findShiftAndDetermineCheckIn(recordTime time.Time, shiftTimes []model.Shift
 		}
 
 		// C. Chuẩn hóa thời gian bắt đầu/kết thúc ca về cùng ngày với ngày chấm công
-		year, month, day := recordTime.Date()
-		shiftStart := time.Date(year, month, day, shift.StartTime.Hour(), shift.StartTime.Minute(), 0, 0, recordTime.Location())
-		shiftEnd := time.Date(year, month, day, shift.EndTime.Hour(), shift.EndTime.Minute(), 0, 0, recordTime.Location())
-
-		// Xử lý ca đêm (ví dụ: 22:00 - 06:00)
-		if shiftEnd.Before(shiftStart) {
-			if recordTime.Hour() < 12 {
-				shiftStart = shiftStart.Add(-24 * time.Hour)
-			} else {
-				shiftEnd = shiftEnd.Add(24 * time.Hour)
-			}
-		}
+		shiftStart, shiftEnd := resolveShiftBounds(recordTime, shift)
 
 		// D. Tính toán độ chênh lệch
 		diffStart := recordTime.Sub(shiftStart).Minutes() // Dương nếu đến muộn, Âm nếu đến sớm
@@ -783,6 +850,18 @@ func findShiftAndDetermineCheckIn(recordTime time.Time, shiftTimes []model.Shift
 			// LOGIC QUYẾT ĐỊNH CHECK-IN HAY CHECK-OUT:
 			// 1. Tính điểm giữa ca làm việc (midpoint)
 			midPoint := shiftStart.Add(shiftEnd.Sub(shiftStart) / 2)
+			//    Ca linh hoạt có giờ lõi: dùng điểm giữa giờ lõi vì khung giờ ca thường rất rộng
+			if shift.IsFlexible && shift.CoreStartTime != nil && shift.CoreEndTime != nil {
+				coreStart := time.Date(shiftStart.Year(), shiftStart.Month(), shiftStart.Day(), shift.CoreStartTime.Hour(), shift.CoreStartTime.Minute(), 0, 0, recordTime.Location())
+				if coreStart.Before(shiftStart) {
+					coreStart = coreStart.Add(24 * time.Hour)
+				}
+				coreEnd := time.Date(coreStart.Year(), coreStart.Month(), coreStart.Day(), shift.CoreEndTime.Hour(), shift.CoreEndTime.Minute(), 0, 0, recordTime.Location())
+				if coreEnd.Before(coreStart) {
+					coreEnd = coreEnd.Add(24 * time.Hour)
+				}
+				midPoint = coreStart.Add(coreEnd.Sub(coreStart) / 2)
+			}
 
 			// 2. Nếu thời gian chấm công nằm trước điểm giữa -> Check In
 			//    Nếu thời gian chấm công nằm sau điểm giữa -> Check Out
@@ -802,6 +881,39 @@ func findShiftAndDetermineCheckIn(recordTime time.Time, shiftTimes []model.Shift
 	return matchedShift, isCheckIn, foundValidShift
 }
 
+// resolveShiftBounds chuẩn hóa thời gian bắt đầu/kết thúc ca về ngày chấm công
+func resolveShiftBounds(recordTime time.Time, shift model.ShiftTimeEmployee) (time.Time, time.Time) {
+	year, month, day := recordTime.Date()
+	shiftStart := time.Date(year, month, day, shift.StartTime.Hour(), shift.StartTime.Minute(), 0, 0, recordTime.Location())
+	shiftEnd := time.Date(year, month, day, shift.EndTime.Hour(), shift.EndTime.Minute(), 0, 0, recordTime.Location())
+
+	// Xử lý ca đêm (ví dụ: 22:00 - 06:00)
+	if shiftEnd.Before(shiftStart) {
+		if recordTime.Hour() < 12 {
+			shiftStart = shiftStart.Add(-24 * time.Hour)
+		} else {
+			shiftEnd = shiftEnd.Add(24 * time.Hour)
+		}
+	}
+	return shiftStart, shiftEnd
+}
+
+// toWorkIntervals mapping khoảng làm việc từ domain sang application model
+func toWorkIntervals(intervals []domainModel.WorkInterval) []model.WorkInterval {
+	if len(intervals) == 0 {
+		return nil
+	}
+	result := make([]model.WorkInterval, 0, len(intervals))
+	for _, interval := range intervals {
+		result = append(result, model.WorkInterval{
+			CheckIn:  interval.CheckIn,
+			CheckOut: interval.CheckOut,
+			Minutes:  interval.Minutes,
+		})
+	}
+	return result
+}
+
 // handler ttl time cache local
 func getTTLTimeCacheLocal(ttlDistributed int64) int64 {
 	ttlLocal := ttlDistributed / 3
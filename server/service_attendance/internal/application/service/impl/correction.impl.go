@@ -0,0 +1,542 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/errors"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/model"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/service"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/constants"
+	domainLogger "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/logger"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/model"
+	domainRepo "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/repository"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/global"
+)
+
+const (
+	correctionDefaultLimit = 20
+	correctionMaxLimit     = 100
+)
+
+// =================================================
+// Correction Service
+// =================================================
+type CorrectionService struct {
+	correctionRepo domainRepo.ICorrectionRepository
+	attendanceRepo domainRepo.IAttendanceRepository
+	userRepo       domainRepo.IUserRepository
+	auditRepo      domainRepo.IAuditRepository
+	logger         domainLogger.ILogger
+}
+
+// CreateCorrectionRequest implements service.ICorrectionService.
+func (s *CorrectionService) CreateCorrectionRequest(ctx context.Context, req *model.CreateCorrectionRequestModel) (*model.CreateCorrectionRequestResultModel, *errors.Error) {
+	// 1. Check permission
+	if err := checkPermissionEmployee(ctx, *req.Session, req.EmployeeID); err != nil {
+		s.logger.Warn("Permission Denied", "session", req.Session, "employee_id", req.EmployeeID)
+		return nil, err
+	}
+	// 2. Validate request
+	if req.Reason == "" {
+		return nil, &errors.Error{
+			ErrorClient: "InvalidReason",
+		}
+	}
+	if req.ProposedCheckIn == nil && req.ProposedCheckOut == nil {
+		return nil, &errors.Error{
+			ErrorClient: "InvalidProposedTime",
+		}
+	}
+	if req.ProposedCheckIn != nil && req.ProposedCheckOut != nil && !req.ProposedCheckOut.After(*req.ProposedCheckIn) {
+		return nil, &errors.Error{
+			ErrorClient: "InvalidProposedTime",
+		}
+	}
+	now := time.Now()
+	workDate := time.Date(req.WorkDate.Year(), req.WorkDate.Month(), req.WorkDate.Day(), 0, 0, 0, 0, req.WorkDate.Location())
+	if req.WorkDate.IsZero() || workDate.After(now) {
+		return nil, &errors.Error{
+			ErrorClient: "InvalidWorkDate",
+		}
+	}
+	if (req.ProposedCheckIn != nil && req.ProposedCheckIn.After(now)) ||
+		(req.ProposedCheckOut != nil && req.ProposedCheckOut.After(now)) {
+		return nil, &errors.Error{
+			ErrorClient: "InvalidProposedTime",
+		}
+	}
+	isEmployee, err := s.userRepo.UserIsEmployeeInCompany(ctx, &domainModel.UserIsEmployeeInCompanyInput{
+		UserID:    req.EmployeeID,
+		CompanyID: req.CompanyID,
+	})
+	if err != nil {
+		s.logger.Error("Failed to check employee in company", "employee_id", req.EmployeeID, "error", err)
+		return nil, &errors.Error{
+			ErrorSystem: err,
+			ErrorClient: "InternalError",
+		}
+	}
+	if !isEmployee {
+		return nil, &errors.Error{
+			ErrorClient: "PermissionDenied",
+		}
+	}
+	// 3. Mỗi ngày làm việc chỉ có một yêu cầu đang chờ duyệt
+	pending, err := s.correctionRepo.CountPendingCorrectionRequests(ctx, &domainModel.CountPendingCorrectionRequestsInput{
+		EmployeeID: req.EmployeeID,
+		WorkDate:   workDate,
+	})
+	if err != nil {
+		s.logger.Error("Failed to count pending correction requests", "employee_id", req.EmployeeID, "error", err)
+		return nil, &errors.Error{
+			ErrorSystem: err,
+			ErrorClient: "InternalError",
+		}
+	}
+	if pending > 0 {
+		return nil, &errors.Error{
+			ErrorClient: "CorrectionRequestPending",
+		}
+	}
+	// 4. Lưu lại giá trị gốc của bản tổng hợp tại thời điểm tạo yêu cầu
+	input := &domainModel.CreateCorrectionRequestInput{
+		CompanyID:        req.CompanyID,
+		EmployeeID:       req.EmployeeID,
+		WorkDate:         workDate,
+		ProposedCheckIn:  req.ProposedCheckIn,
+		ProposedCheckOut: req.ProposedCheckOut,
+		Reason:           req.Reason,
+	}
+	summary, err := s.attendanceRepo.GetDailySummaryEmployee(ctx, &domainModel.GetDailySummaryEmployeeInput{
+		CompanyID:  req.CompanyID,
+		EmployeeID: req.EmployeeID,
+		WorkDate:   workDate,
+	})
+	if err != nil {
+		s.logger.Warn("Failed to get daily summary, create request without original values", "employee_id", req.EmployeeID, "error", err)
+	} else if summary != nil {
+		input.ShiftID = summary.ShiftId
+		input.OriginalCheckIn = timePtrOrNil(summary.ActualCheckIn)
+		input.OriginalCheckOut = timePtrOrNil(summary.ActualCheckOut)
+		if status, err := strconv.Atoi(summary.AttendanceStatus); err == nil {
+			input.OriginalStatus = &status
+		}
+	}
+	// 5. Giờ đề xuất phải nằm trong khoảng chấm công của ca ngày làm việc, giống khoảng worker dùng để ghép cặp
+	shifts, err := s.userRepo.GetListTimeShiftEmployee(ctx, &domainModel.GetListTimeShiftEmployeeInput{
+		EmployeeID: req.EmployeeID,
+		CompanyID:  req.CompanyID,
+	})
+	if err != nil {
+		s.logger.Error("Failed to get list shift time employee from DB", "error", err)
+		return nil, &errors.Error{
+			ErrorSystem: err,
+			ErrorClient: "InternalError",
+		}
+	}
+	shift, found := findShiftForWorkDate(shifts, input.ShiftID, workDate)
+	if !found {
+		return nil, &errors.Error{
+			ErrorClient: "NoShiftForWorkDate",
+		}
+	}
+	windowFrom, windowTo := global.AttendanceServiceWorker.PunchWindow(workDate, shift)
+	for _, proposed := range []*time.Time{req.ProposedCheckIn, req.ProposedCheckOut} {
+		if proposed != nil && (proposed.Before(windowFrom) || proposed.After(windowTo)) {
+			return nil, &errors.Error{
+				ErrorClient: "ProposedTimeOutsideShift",
+			}
+		}
+	}
+	requestID, err := s.correctionRepo.CreateCorrectionRequest(ctx, input)
+	if err != nil {
+		s.logger.Error("Failed to create correction request", "employee_id", req.EmployeeID, "error", err)
+		return nil, &errors.Error{
+			ErrorSystem: err,
+			ErrorClient: "InternalError",
+		}
+	}
+	return &model.CreateCorrectionRequestResultModel{
+		RequestID: requestID,
+	}, nil
+}
+
+// ApproveCorrectionRequest implements service.ICorrectionService.
+func (s *CorrectionService) ApproveCorrectionRequest(ctx context.Context, req *model.ReviewCorrectionRequestModel) (*model.ApproveCorrectionRequestResultModel, *errors.Error) {
+	// 1. Check permission
+	if err := checkPermisionManager(ctx, *req.Session, req.CompanyID); err != nil {
+		s.logger.Warn("Permission Denied", "session", req.Session)
+		return nil, err
+	}
+	// 2. Get request
+	correction, errApp := s.getPendingCorrection(ctx, req.RequestID, req.CompanyID)
+	if errApp != nil {
+		return nil, errApp
+	}
+	if errApp := checkNotSelfReview(req.Session, correction); errApp != nil {
+		s.logger.Warn("Permission Denied, review own correction request", "session", req.Session, "request_id", req.RequestID)
+		return nil, errApp
+	}
+	// 3. Xác định ca làm việc của ngày cần điều chỉnh
+	shifts, err := s.userRepo.GetListTimeShiftEmployee(ctx, &domainModel.GetListTimeShiftEmployeeInput{
+		EmployeeID: correction.EmployeeID,
+		CompanyID:  correction.CompanyID,
+	})
+	if err != nil {
+		s.logger.Error("Failed to get list shift time employee from DB", "error", err)
+		return nil, &errors.Error{
+			ErrorSystem: err,
+			ErrorClient: "InternalError",
+		}
+	}
+	shift, found := findShiftForWorkDate(shifts, correction.ShiftID, correction.WorkDate)
+	if !found {
+		return nil, &errors.Error{
+			ErrorClient: "NoShiftForWorkDate",
+		}
+	}
+	// 4. Approve request
+	ok, err := s.correctionRepo.ApproveCorrectionRequest(ctx, &domainModel.ReviewCorrectionRequestInput{
+		RequestID:  req.RequestID,
+		CompanyID:  req.CompanyID,
+		ReviewedBy: req.Session.UserId,
+		ReviewNote: req.ReviewNote,
+	})
+	if err != nil {
+		s.logger.Error("Failed to approve correction request", "request_id", req.RequestID, "error", err)
+		return nil, &errors.Error{
+			ErrorSystem: err,
+			ErrorClient: "InternalError",
+		}
+	}
+	if !ok {
+		return nil, &errors.Error{
+			ErrorClient: "CorrectionRequestAlreadyProcessed",
+		}
+	}
+	// 5. Tính lại bản tổng hợp với giờ vào/ra đã được duyệt,
+	//    lỗi thì đưa yêu cầu về chờ duyệt để không có yêu cầu đã duyệt mà bản tổng hợp chưa cập nhật
+	summary, err := global.AttendanceServiceWorker.RecalculateDailySummary(
+		ctx,
+		correction.CompanyID,
+		correction.EmployeeID,
+		correction.WorkDate,
+		shift,
+	)
+	if err != nil {
+		s.logger.Error("Failed to recalculate daily summary", "request_id", req.RequestID, "error", err)
+		s.revertApproval(ctx, req.RequestID, req.CompanyID)
+		return nil, &errors.Error{
+			ErrorSystem: err,
+			ErrorClient: "InternalError",
+		}
+	}
+	result := &model.ApproveCorrectionRequestResultModel{
+		RequestID:         req.RequestID,
+		CorrectedCheckIn:  timePtrOrNil(summary.ActualCheckIn),
+		CorrectedCheckOut: timePtrOrNil(summary.ActualCheckOut),
+		CorrectedStatus:   summary.AttendanceStatus,
+	}
+	if err := s.correctionRepo.UpdateCorrectionResult(ctx, &domainModel.UpdateCorrectionResultInput{
+		RequestID:         req.RequestID,
+		CorrectedCheckIn:  result.CorrectedCheckIn,
+		CorrectedCheckOut: result.CorrectedCheckOut,
+		CorrectedStatus:   result.CorrectedStatus,
+	}); err != nil {
+		s.logger.Warn("Failed to save correction result", "request_id", req.RequestID, "error", err)
+	}
+	// 6. Audit log
+	details := buildCorrectionAuditDetails(correction, req.ReviewNote)
+	details["new_check_in"] = formatTimePtr(result.CorrectedCheckIn)
+	details["new_check_out"] = formatTimePtr(result.CorrectedCheckOut)
+	details["new_status"] = strconv.Itoa(result.CorrectedStatus)
+	s.addAuditLog(ctx, req.Session, correction, constants.AuditActionApproveCorrectionRequest, details)
+	return result, nil
+}
+
+// RejectCorrectionRequest implements service.ICorrectionService.
+func (s *CorrectionService) RejectCorrectionRequest(ctx context.Context, req *model.ReviewCorrectionRequestModel) *errors.Error {
+	// 1. Check permission
+	if err := checkPermisionManager(ctx, *req.Session, req.CompanyID); err != nil {
+		s.logger.Warn("Permission Denied", "session", req.Session)
+		return err
+	}
+	// 2. Get request
+	correction, errApp := s.getPendingCorrection(ctx, req.RequestID, req.CompanyID)
+	if errApp != nil {
+		return errApp
+	}
+	if errApp := checkNotSelfReview(req.Session, correction); errApp != nil {
+		s.logger.Warn("Permission Denied, review own correction request", "session", req.Session, "request_id", req.RequestID)
+		return errApp
+	}
+	// 3. Reject request
+	ok, err := s.correctionRepo.RejectCorrectionRequest(ctx, &domainModel.ReviewCorrectionRequestInput{
+		RequestID:  req.RequestID,
+		CompanyID:  req.CompanyID,
+		ReviewedBy: req.Session.UserId,
+		ReviewNote: req.ReviewNote,
+	})
+	if err != nil {
+		s.logger.Error("Failed to reject correction request", "request_id", req.RequestID, "error", err)
+		return &errors.Error{
+			ErrorSystem: err,
+			ErrorClient: "InternalError",
+		}
+	}
+	if !ok {
+		return &errors.Error{
+			ErrorClient: "CorrectionRequestAlreadyProcessed",
+		}
+	}
+	// 4. Audit log
+	s.addAuditLog(ctx, req.Session, correction, constants.AuditActionRejectCorrectionRequest, buildCorrectionAuditDetails(correction, req.ReviewNote))
+	return nil
+}
+
+// ListPendingCorrectionRequests implements service.ICorrectionService.
+func (s *CorrectionService) ListPendingCorrectionRequests(ctx context.Context, req *model.ListPendingCorrectionRequestsModel) (*model.ListCorrectionRequestsResultModel, *errors.Error) {
+	// 1. Check permission
+	if err := checkPermisionManager(ctx, *req.Session, req.CompanyID); err != nil {
+		s.logger.Warn("Permission Denied", "session", req.Session)
+		return nil, err
+	}
+	// 2. Get data
+	limit, offset := normalizeCorrectionPaging(req.Limit, req.Offset)
+	records, err := s.correctionRepo.ListPendingCorrectionRequests(ctx, &domainModel.ListPendingCorrectionRequestsInput{
+		CompanyID: req.CompanyID,
+		Limit:     int32(limit),
+		Offset:    int32(offset),
+	})
+	if err != nil {
+		s.logger.Error("Failed to list pending correction requests", "company_id", req.CompanyID, "error", err)
+		return nil, &errors.Error{
+			ErrorSystem: err,
+			ErrorClient: "InternalError",
+		}
+	}
+	return &model.ListCorrectionRequestsResultModel{
+		Records: toCorrectionRequestInfos(records),
+		Limit:   limit,
+		Offset:  offset,
+	}, nil
+}
+
+// ListCorrectionRequestsEmployee implements service.ICorrectionService.
+func (s *CorrectionService) ListCorrectionRequestsEmployee(ctx context.Context, req *model.ListCorrectionRequestsEmployeeModel) (*model.ListCorrectionRequestsResultModel, *errors.Error) {
+	// 1. Check permission
+	if err := checkPermissionEmployee(ctx, *req.Session, req.EmployeeID); err != nil {
+		s.logger.Warn("Permission Denied", "session", req.Session, "employee_id", req.EmployeeID)
+		return nil, err
+	}
+	// 2. Get data
+	limit, offset := normalizeCorrectionPaging(req.Limit, req.Offset)
+	records, err := s.correctionRepo.ListCorrectionRequestsByEmployee(ctx, &domainModel.ListCorrectionRequestsByEmployeeInput{
+		EmployeeID: req.EmployeeID,
+		Limit:      int32(limit),
+		Offset:     int32(offset),
+	})
+	if err != nil {
+		s.logger.Error("Failed to list correction requests", "employee_id", req.EmployeeID, "error", err)
+		return nil, &errors.Error{
+			ErrorSystem: err,
+			ErrorClient: "InternalError",
+		}
+	}
+	return &model.ListCorrectionRequestsResultModel{
+		Records: toCorrectionRequestInfos(records),
+		Limit:   limit,
+		Offset:  offset,
+	}, nil
+}
+
+// getPendingCorrection lấy yêu cầu điều chỉnh và kiểm tra yêu cầu còn chờ duyệt
+func (s *CorrectionService) getPendingCorrection(ctx context.Context, requestID, companyID uuid.UUID) (*domainModel.AttendanceCorrection, *errors.Error) {
+	correction, err := s.correctionRepo.GetCorrectionRequest(ctx, &domainModel.GetCorrectionRequestInput{
+		RequestID: requestID,
+		CompanyID: companyID,
+	})
+	if err != nil {
+		s.logger.Error("Failed to get correction request", "request_id", requestID, "error", err)
+		return nil, &errors.Error{
+			ErrorSystem: err,
+			ErrorClient: "InternalError",
+		}
+	}
+	if correction == nil {
+		return nil, &errors.Error{
+			ErrorClient: "NoData",
+		}
+	}
+	if correction.Status != domainModel.CorrectionStatusPending {
+		return nil, &errors.Error{
+			ErrorClient: "CorrectionRequestAlreadyProcessed",
+		}
+	}
+	return correction, nil
+}
+
+// revertApproval đưa yêu cầu đã duyệt về trạng thái chờ duyệt, vẫn chạy khi request bị hủy
+func (s *CorrectionService) revertApproval(ctx context.Context, requestID, companyID uuid.UUID) {
+	ok, err := s.correctionRepo.RevertCorrectionApproval(context.WithoutCancel(ctx), &domainModel.GetCorrectionRequestInput{
+		RequestID: requestID,
+		CompanyID: companyID,
+	})
+	if err != nil || !ok {
+		s.logger.Error("Failed to revert correction approval", "request_id", requestID, "reverted", ok, "error", err)
+	}
+}
+
+// addAuditLog ghi nhật ký duyệt/từ chối yêu cầu điều chỉnh, lỗi ghi log không làm hỏng nghiệp vụ
+func (s *CorrectionService) addAuditLog(ctx context.Context, session *model.SessionReq, correction *domainModel.AttendanceCorrection, action string, details map[string]string) {
+	if err := s.auditRepo.AddAuditLog(ctx, &domainModel.AuditLog{
+		CompanyID:      correction.CompanyID,
+		YearMonth:      time.Now().Format("2006-01"),
+		ActorID:        session.UserId,
+		ActionCategory: constants.AuditCategoryAttendance,
+		ActionName:     action,
+		ResourceType:   constants.AuditResourceTypeCorrectionRequest,
+		ResourceID:     correction.RequestID,
+		Details:        details,
+		IP_Address:     session.ClientIp,
+		UserAgent:      session.ClientAgent,
+		Status:         constants.AuditStatusSuccess,
+	}); err != nil {
+		s.logger.Warn("Failed to add audit log", "action", action, "request_id", correction.RequestID, "error", err)
+	}
+}
+
+// NewCorrectionService creates a new instance of CorrectionService
+func NewCorrectionService() (service.ICorrectionService, error) {
+	auditRepo, err := domainRepo.GetAuditRepository()
+	if err != nil {
+		return nil, err
+	}
+	return &CorrectionService{
+		correctionRepo: domainRepo.GetCorrectionRepository(),
+		attendanceRepo: domainRepo.GetAttendanceRepository(),
+		userRepo:       domainRepo.GetUserRepository(),
+		auditRepo:      auditRepo,
+		logger:         domainLogger.GetLogger(),
+	}, nil
+}
+
+// =================================================
+// Helper Functions
+// =================================================
+
+// findShiftForWorkDate tìm ca áp dụng cho ngày làm việc, ưu tiên ca đã ghi nhận trong bản tổng hợp
+func findShiftForWorkDate(shifts []domainModel.ShiftTimeEmployee, shiftID uuid.UUID, workDate time.Time) (domainModel.ShiftTimeEmployee, bool) {
+	weekday := int32(workDate.Weekday())
+	if weekday == 0 {
+		weekday = 7
+	}
+	var fallback *domainModel.ShiftTimeEmployee
+	for i := range shifts {
+		shift := shifts[i]
+		if workDate.Before(shift.EffectiveFrom) || (shift.EffectiveTo != nil && workDate.After(*shift.EffectiveTo)) {
+			continue
+		}
+		if shiftID != uuid.Nil && shift.ShiftID == shiftID {
+			return shift, true
+		}
+		if fallback == nil {
+			for _, day := range shift.WorkDays {
+				if day == weekday {
+					fallback = &shifts[i]
+					break
+				}
+			}
+		}
+	}
+	if fallback == nil {
+		return domainModel.ShiftTimeEmployee{}, false
+	}
+	return *fallback, true
+}
+
+// checkNotSelfReview quản lý không được tự duyệt/từ chối yêu cầu điều chỉnh của chính mình
+func checkNotSelfReview(session *model.SessionReq, correction *domainModel.AttendanceCorrection) *errors.Error {
+	if session.UserId == correction.EmployeeID {
+		return &errors.Error{
+			ErrorClient: "PermissionDenied",
+		}
+	}
+	return nil
+}
+
+func buildCorrectionAuditDetails(correction *domainModel.AttendanceCorrection, reviewNote string) map[string]string {
+	details := map[string]string{
+		"employee_id":        correction.EmployeeID.String(),
+		"work_date":          correction.WorkDate.Format("2006-01-02"),
+		"reason":             correction.Reason,
+		"old_check_in":       formatTimePtr(correction.OriginalCheckIn),
+		"old_check_out":      formatTimePtr(correction.OriginalCheckOut),
+		"proposed_check_in":  formatTimePtr(correction.ProposedCheckIn),
+		"proposed_check_out": formatTimePtr(correction.ProposedCheckOut),
+		"review_note":        reviewNote,
+	}
+	if correction.OriginalStatus != nil {
+		details["old_status"] = strconv.Itoa(*correction.OriginalStatus)
+	}
+	return details
+}
+
+func toCorrectionRequestInfos(records []domainModel.AttendanceCorrection) []model.CorrectionRequestInfo {
+	result := make([]model.CorrectionRequestInfo, len(records))
+	for i, r := range records {
+		result[i] = model.CorrectionRequestInfo{
+			RequestID:         r.RequestID,
+			CompanyID:         r.CompanyID,
+			EmployeeID:        r.EmployeeID,
+			ShiftID:           r.ShiftID,
+			WorkDate:          r.WorkDate,
+			ProposedCheckIn:   r.ProposedCheckIn,
+			ProposedCheckOut:  r.ProposedCheckOut,
+			Reason:            r.Reason,
+			OriginalCheckIn:   r.OriginalCheckIn,
+			OriginalCheckOut:  r.OriginalCheckOut,
+			OriginalStatus:    r.OriginalStatus,
+			CorrectedCheckIn:  r.CorrectedCheckIn,
+			CorrectedCheckOut: r.CorrectedCheckOut,
+			CorrectedStatus:   r.CorrectedStatus,
+			Status:            r.Status,
+			ReviewedBy:        r.ReviewedBy,
+			ReviewedAt:        r.ReviewedAt,
+			ReviewNote:        r.ReviewNote,
+			CreatedAt:         r.CreatedAt,
+			UpdatedAt:         r.UpdatedAt,
+		}
+	}
+	return result
+}
+
+func normalizeCorrectionPaging(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = correctionDefaultLimit
+	}
+	if limit > correctionMaxLimit {
+		limit = correctionMaxLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+func timePtrOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func formatTimePtr(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,142 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/errors"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/model"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/constants"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/model"
+	utilsCache "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/shared/utils/cache"
+	utilsCrypto "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/shared/utils/crypto"
+)
+
+// Độ dài tối đa của idempotency key do client gửi lên
+const maxIdempotencyKeyLength = 128
+
+// idempotencyClaim key đã được giữ cho lần xử lý hiện tại
+type idempotencyClaim struct {
+	cacheKey string
+}
+
+// claimIdempotencyKey giữ idempotency key trước khi ghi chấm công.
+// Trả về bản ghi đã lưu nếu key đã được dùng (replay), nếu không trả về claim để hoàn tất sau khi ghi.
+// LWT trên ScyllaDB là nguồn dữ liệu chính, Redis chỉ cache kết quả đã hoàn tất để replay nhanh,
+// nên Redis lỗi hay mất dữ liệu cũng không làm một key được giữ hai lần.
+func (a *AttendanceService) claimIdempotencyKey(ctx context.Context, req *model.AddAttendanceModel) (*idempotencyClaim, *domainModel.AttendanceIdempotencyRecord, *errors.Error) {
+	if len(req.IdempotencyKey) > maxIdempotencyKeyLength {
+		return nil, nil, &errors.Error{
+			ErrorClient: "InvalidIdempotencyKey",
+		}
+	}
+	claim := &idempotencyClaim{
+		cacheKey: utilsCache.GetKeyAttendanceIdempotency(
+			utilsCrypto.GetHash(req.CompanyID.String()),
+			utilsCrypto.GetHash(req.IdempotencyKey),
+		),
+	}
+	// 1. Đọc cache, chỉ chứa bản ghi đã hoàn tất
+	if value, err := a.distributedCache.Get(ctx, claim.cacheKey); err != nil {
+		a.logger.Warn("Failed to get idempotency key from redis, use LWT", "key", claim.cacheKey, "error", err)
+	} else if value != "" {
+		var existing domainModel.AttendanceIdempotencyRecord
+		if err := json.Unmarshal([]byte(value), &existing); err == nil {
+			return nil, &existing, nil
+		}
+		a.logger.Warn("Failed to unmarshal cached idempotency record, use LWT", "key", claim.cacheKey)
+	}
+	// 2. Giữ key bằng LWT
+	applied, existing, err := a.idempotencyRepo.ClaimIdempotencyKey(ctx, &domainModel.ClaimIdempotencyKeyInput{
+		CompanyID:      req.CompanyID,
+		IdempotencyKey: req.IdempotencyKey,
+		EmployeeID:     req.EmployeeID,
+		RecordTime:     req.RecordTime,
+		TTLSeconds:     constants.TTL_ATTENDANCE_IDEMPOTENCY_PENDING,
+	})
+	if err != nil {
+		a.logger.Error("Failed to claim idempotency key", "key", claim.cacheKey, "error", err)
+		return nil, nil, &errors.Error{
+			ErrorSystem: err,
+			ErrorClient: "InternalError",
+		}
+	}
+	if !applied {
+		if existing.Status == domainModel.IdempotencyStatusCompleted {
+			a.cacheIdempotencyRecord(ctx, claim.cacheKey, existing)
+		}
+		return nil, existing, nil
+	}
+	return claim, nil, nil
+}
+
+// completeIdempotencyKey lưu kết quả của lần ghi chấm công để các lần gửi lại trả về kết quả này
+func (a *AttendanceService) completeIdempotencyKey(ctx context.Context, claim *idempotencyClaim, req *model.AddAttendanceModel, recordType int) {
+	if claim == nil {
+		return
+	}
+	if err := a.idempotencyRepo.CompleteIdempotencyKey(ctx, &domainModel.CompleteIdempotencyKeyInput{
+		CompanyID:      req.CompanyID,
+		IdempotencyKey: req.IdempotencyKey,
+		RecordType:     recordType,
+		TTLSeconds:     constants.TTL_ATTENDANCE_IDEMPOTENCY_KEY_DB,
+	}); err != nil {
+		// Bản ghi pending trên LWT sẽ hết hạn, cache bên dưới vẫn chặn gửi lại trong thời gian đó
+		a.logger.Warn("Failed to complete idempotency key", "key", claim.cacheKey, "error", err)
+	}
+	a.cacheIdempotencyRecord(ctx, claim.cacheKey, &domainModel.AttendanceIdempotencyRecord{
+		CompanyID:      req.CompanyID,
+		IdempotencyKey: req.IdempotencyKey,
+		EmployeeID:     req.EmployeeID,
+		RecordTime:     req.RecordTime,
+		RecordType:     recordType,
+		Status:         domainModel.IdempotencyStatusCompleted,
+	})
+}
+
+// releaseIdempotencyKey nhả key khi ghi chấm công thất bại để client có thể gửi lại
+func (a *AttendanceService) releaseIdempotencyKey(ctx context.Context, claim *idempotencyClaim, req *model.AddAttendanceModel) {
+	if claim == nil {
+		return
+	}
+	if err := a.idempotencyRepo.ReleaseIdempotencyKey(ctx, &domainModel.ReleaseIdempotencyKeyInput{
+		CompanyID:      req.CompanyID,
+		IdempotencyKey: req.IdempotencyKey,
+	}); err != nil {
+		a.logger.Warn("Failed to release idempotency key", "key", claim.cacheKey, "error", err)
+	}
+}
+
+// cacheIdempotencyRecord cache bản ghi đã hoàn tất, lỗi Redis chỉ làm lần replay sau phải đọc LWT
+func (a *AttendanceService) cacheIdempotencyRecord(ctx context.Context, cacheKey string, record *domainModel.AttendanceIdempotencyRecord) {
+	value, _ := json.Marshal(record)
+	if err := a.distributedCache.SetTTL(ctx, cacheKey, string(value), constants.TTL_ATTENDANCE_IDEMPOTENCY_KEY); err != nil {
+		a.logger.Warn("Failed to cache idempotency record", "key", cacheKey, "error", err)
+	}
+}
+
+// replayIdempotencyKey trả về kết quả đã ghi nhận của key, key dùng lại cho sự kiện khác bị từ chối
+func replayIdempotencyKey(req *model.AddAttendanceModel, existing *domainModel.AttendanceIdempotencyRecord) (*model.AddAttendanceResultModel, *errors.Error) {
+	if existing.EmployeeID != req.EmployeeID || !existing.RecordTime.Equal(req.RecordTime) {
+		return nil, &errors.Error{
+			ErrorClient: "IdempotencyKeyConflict",
+		}
+	}
+	switch existing.Status {
+	case domainModel.IdempotencyStatusPending:
+		return nil, &errors.Error{
+			ErrorClient: "RequestInProgress",
+		}
+	case domainModel.IdempotencyStatusCompleted:
+		return &model.AddAttendanceResultModel{
+			RecordTime: existing.RecordTime,
+			RecordType: existing.RecordType,
+			Replayed:   true,
+		}, nil
+	}
+	return nil, &errors.Error{
+		ErrorSystem: fmt.Errorf("unknown idempotency status %q", existing.Status),
+		ErrorClient: "InternalError",
+	}
+}
@@ -0,0 +1,498 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/errors"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/model"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/service"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/constants"
+	domainCache "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/cache"
+	domainLogger "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/logger"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/model"
+	domainRepo "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/repository"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/global"
+	utilsCache "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/shared/utils/cache"
+	utilsCrypto "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/shared/utils/crypto"
+)
+
+const (
+	// Khoảng ngày tối đa của một job
+	recomputeMaxDays = 93
+	// Số nhân viên tối đa được chỉ định trong một job
+	recomputeMaxEmployees = 1000
+	// Số khác biệt tối đa lưu trong kết quả job
+	recomputeMaxDiffs = 500
+	// Lưu tiến độ sau mỗi n bản tổng hợp
+	recomputeProgressEvery = 20
+	// Chu kỳ gia hạn khóa job, nhỏ hơn nhiều so với TTL của khóa
+	recomputeLockRefreshInterval = time.Minute
+)
+
+const (
+	luaAcquireRecomputeLock = `
+		if redis.call("SET", KEYS[1], ARGV[1], "NX", "EX", ARGV[2]) then
+			return 1
+		end
+		return 0
+	`
+	luaRefreshRecomputeLock = `
+		if redis.call("GET", KEYS[1]) == ARGV[1] then
+			return redis.call("EXPIRE", KEYS[1], ARGV[2])
+		end
+		return 0
+	`
+	luaReleaseRecomputeLock = `
+		if redis.call("GET", KEYS[1]) == ARGV[1] then
+			return redis.call("DEL", KEYS[1])
+		end
+		return 0
+	`
+)
+
+// =================================================
+// Recompute Service
+// =================================================
+type RecomputeService struct {
+	attendanceRepo   domainRepo.IAttendanceRepository
+	userRepo         domainRepo.IUserRepository
+	auditRepo        domainRepo.IAuditRepository
+	logger           domainLogger.ILogger
+	distributedCache domainCache.IDistributedCache
+}
+
+// recomputeTask một bản tổng hợp cần tính lại
+type recomputeTask struct {
+	employeeID uuid.UUID
+	workDate   time.Time
+	shift      domainModel.ShiftTimeEmployee
+}
+
+// RecomputeDailySummaries implements service.IRecomputeService.
+func (s *RecomputeService) RecomputeDailySummaries(ctx context.Context, req *model.RecomputeDailySummariesModel) (*model.RecomputeJobModel, *errors.Error) {
+	// 1. Check permission
+	if err := checkPermisionManager(ctx, *req.Session, req.CompanyID); err != nil {
+		s.logger.Warn("Permission Denied", "session", req.Session)
+		return nil, err
+	}
+	// 2. Validate request
+	now := time.Now()
+	fromDate, toDate, ok := resolveRecomputeDateRange(req, now)
+	if !ok {
+		return nil, &errors.Error{
+			ErrorClient: "InvalidDateRange",
+		}
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, toDate.Location())
+	// Chỉ tính lại các ngày đã kết thúc, ca của hôm nay vẫn do luồng check-out xử lý
+	if toDate.Before(fromDate) || !toDate.Before(today) || toDate.Sub(fromDate) >= recomputeMaxDays*24*time.Hour {
+		return nil, &errors.Error{
+			ErrorClient: "InvalidDateRange",
+		}
+	}
+	if len(req.EmployeeIDs) > recomputeMaxEmployees {
+		return nil, &errors.Error{
+			ErrorClient: "TooManyEmployees",
+		}
+	}
+	// Tính ngay chỉ dành cho phạm vi nhỏ: danh sách nhân viên hoặc một ngày (duyệt nghỉ phép, thêm ngày lễ)
+	if req.Immediate && len(req.EmployeeIDs) == 0 && !fromDate.Equal(toDate) {
+		return nil, &errors.Error{
+			ErrorClient: "InvalidRecomputeScope",
+		}
+	}
+	// 3. Mỗi công ty chỉ chạy một job tại một thời điểm
+	job := &model.RecomputeJobModel{
+		JobID:       uuid.New(),
+		CompanyID:   req.CompanyID,
+		Status:      model.RecomputeJobStatusPending,
+		DryRun:      req.DryRun,
+		FromDate:    fromDate,
+		ToDate:      toDate,
+		EmployeeIDs: req.EmployeeIDs,
+		CreatedBy:   req.Session.UserId,
+		CreatedAt:   now,
+	}
+	if req.Immediate {
+		return s.runImmediateRecompute(ctx, req.Session, job)
+	}
+	lockKey := utilsCache.GetKeyRecomputeJobLock(utilsCrypto.GetHash(req.CompanyID.String()))
+	result, err := s.distributedCache.LuaScript(ctx, luaAcquireRecomputeLock, []string{lockKey}, job.JobID.String(), constants.TTL_RECOMPUTE_JOB_LOCK)
+	if err != nil {
+		s.logger.Error("Failed to acquire recompute job lock", "company_id", req.CompanyID, "error", err)
+		return nil, &errors.Error{
+			ErrorSystem: err,
+			ErrorClient: "InternalError",
+		}
+	}
+	if acquired, ok := result.(int64); !ok || acquired != 1 {
+		return nil, &errors.Error{
+			ErrorClient: "RecomputeJobRunning",
+		}
+	}
+	if err := s.saveRecomputeJob(ctx, job); err != nil {
+		s.logger.Error("Failed to save recompute job", "job_id", job.JobID, "error", err)
+		s.releaseRecomputeLock(ctx, lockKey, job.JobID)
+		return nil, &errors.Error{
+			ErrorSystem: err,
+			ErrorClient: "InternalError",
+		}
+	}
+	// 4. Audit log
+	s.addAuditLog(ctx, req.Session, job)
+	// 5. Chạy nền, job dừng khi service shutdown hoặc mất khóa
+	global.WaitGroup.Add(1)
+	go func(job model.RecomputeJobModel) {
+		defer global.WaitGroup.Done()
+		jobCtx, cancel := context.WithCancel(global.ShutdownContext)
+		defer cancel()
+		defer s.releaseRecomputeLock(context.WithoutCancel(jobCtx), lockKey, job.JobID)
+		go s.keepRecomputeLock(jobCtx, cancel, lockKey, job.JobID)
+		s.runRecomputeJob(jobCtx, &job)
+	}(*job)
+	return job, nil
+}
+
+// GetRecomputeJob implements service.IRecomputeService.
+func (s *RecomputeService) GetRecomputeJob(ctx context.Context, req *model.GetRecomputeJobModel) (*model.RecomputeJobModel, *errors.Error) {
+	// 1. Check permission
+	if err := checkPermisionManager(ctx, *req.Session, req.CompanyID); err != nil {
+		s.logger.Warn("Permission Denied", "session", req.Session)
+		return nil, err
+	}
+	// 2. Get job
+	value, err := s.distributedCache.Get(ctx, utilsCache.GetKeyRecomputeJob(req.JobID.String()))
+	if err != nil {
+		s.logger.Error("Failed to get recompute job", "job_id", req.JobID, "error", err)
+		return nil, &errors.Error{
+			ErrorSystem: err,
+			ErrorClient: "InternalError",
+		}
+	}
+	if value == "" {
+		return nil, &errors.Error{
+			ErrorClient: "NoData",
+		}
+	}
+	var job model.RecomputeJobModel
+	if err := json.Unmarshal([]byte(value), &job); err != nil {
+		s.logger.Error("Failed to unmarshal recompute job", "job_id", req.JobID, "error", err)
+		return nil, &errors.Error{
+			ErrorSystem: err,
+			ErrorClient: "InternalError",
+		}
+	}
+	if job.CompanyID != req.CompanyID {
+		return nil, &errors.Error{
+			ErrorClient: "NoData",
+		}
+	}
+	return &job, nil
+}
+
+// runRecomputeJob tính lại các bản tổng hợp từ dữ liệu chấm công gốc và cập nhật tiến độ của job.
+// Chế độ dry-run chỉ ghi nhận khác biệt, không ghi đè bản tổng hợp hiện tại.
+func (s *RecomputeService) runRecomputeJob(ctx context.Context, job *model.RecomputeJobModel) {
+	job.Status = model.RecomputeJobStatusRunning
+	s.updateRecomputeJob(ctx, job)
+
+	tasks, err := s.collectRecomputeTasks(ctx, job)
+	if err != nil {
+		s.logger.Error("Failed to collect recompute tasks", "job_id", job.JobID, "error", err)
+		s.finishRecomputeJob(ctx, job, err)
+		return
+	}
+	job.Total = len(tasks)
+	s.updateRecomputeJob(ctx, job)
+
+	for _, task := range tasks {
+		if ctx.Err() != nil {
+			s.logger.Warn("Recompute job cancelled", "job_id", job.JobID, "processed", job.Processed, "total", job.Total)
+			s.finishRecomputeJob(ctx, job, ctx.Err())
+			return
+		}
+		if err := s.recomputeDailySummary(ctx, job, task); err != nil {
+			job.Failed++
+			s.logger.Warn("Failed to recompute daily summary", "job_id", job.JobID, "employee_id", task.employeeID, "work_date", task.workDate, "error", err)
+		}
+		job.Processed++
+		if job.Processed%recomputeProgressEvery == 0 {
+			s.updateRecomputeJob(ctx, job)
+		}
+	}
+	s.finishRecomputeJob(ctx, job, nil)
+}
+
+// runImmediateRecompute tính lại trong request, không lấy khóa job của công ty nên không bị từ chối khi công ty đang có job chạy.
+// Phạm vi nhỏ nên trả lỗi khi có bản tổng hợp tính lại thất bại để bên gọi thử lại.
+func (s *RecomputeService) runImmediateRecompute(ctx context.Context, session *model.SessionReq, job *model.RecomputeJobModel) (*model.RecomputeJobModel, *errors.Error) {
+	s.addAuditLog(ctx, session, job)
+	s.runRecomputeJob(ctx, job)
+	if job.Status == model.RecomputeJobStatusFailed || job.Failed > 0 {
+		return nil, &errors.Error{
+			ErrorSystem: fmt.Errorf("recompute job %s: %d/%d failed: %s", job.JobID, job.Failed, job.Total, job.Error),
+			ErrorClient: "InternalError",
+		}
+	}
+	return job, nil
+}
+
+// collectRecomputeTasks lấy phân ca còn hiệu lực của từng ngày trong khoảng, mỗi nhân viên một bản tổng hợp mỗi ngày
+func (s *RecomputeService) collectRecomputeTasks(ctx context.Context, job *model.RecomputeJobModel) ([]recomputeTask, error) {
+	var employeeFilter map[uuid.UUID]bool
+	if len(job.EmployeeIDs) > 0 {
+		employeeFilter = make(map[uuid.UUID]bool, len(job.EmployeeIDs))
+		for _, employeeID := range job.EmployeeIDs {
+			employeeFilter[employeeID] = true
+		}
+	}
+	var tasks []recomputeTask
+	for workDate := job.FromDate; !workDate.After(job.ToDate); workDate = workDate.AddDate(0, 0, 1) {
+		assignments, err := s.userRepo.GetListActiveEmployeeShiftCompany(ctx, &domainModel.GetListActiveEmployeeShiftCompanyInput{
+			CompanyID: job.CompanyID,
+			Date:      workDate,
+		})
+		if err != nil {
+			return nil, err
+		}
+		seen := make(map[uuid.UUID]bool, len(assignments))
+		for _, assignment := range assignments {
+			if seen[assignment.EmployeeID] || (employeeFilter != nil && !employeeFilter[assignment.EmployeeID]) {
+				continue
+			}
+			if !shiftWorksOn(assignment.Shift, workDate) {
+				continue
+			}
+			seen[assignment.EmployeeID] = true
+			tasks = append(tasks, recomputeTask{
+				employeeID: assignment.EmployeeID,
+				workDate:   workDate,
+				shift:      assignment.Shift,
+			})
+		}
+	}
+	return tasks, nil
+}
+
+// recomputeDailySummary tính lại một bản tổng hợp, so sánh với bản hiện tại và ghi đè nếu khác
+func (s *RecomputeService) recomputeDailySummary(ctx context.Context, job *model.RecomputeJobModel, task recomputeTask) error {
+	summary, err := global.AttendanceServiceWorker.ComputeDailySummary(ctx, job.CompanyID, task.employeeID, task.workDate, task.shift)
+	if err != nil {
+		return err
+	}
+	existing, err := s.attendanceRepo.GetDailySummaryEmployee(ctx, &domainModel.GetDailySummaryEmployeeInput{
+		CompanyID:  job.CompanyID,
+		EmployeeID: task.employeeID,
+		WorkDate:   task.workDate,
+	})
+	if err != nil {
+		return err
+	}
+	diff := diffDailySummary(existing, summary)
+	if diff == nil {
+		return nil
+	}
+	job.Changed++
+	if len(job.Diffs) < recomputeMaxDiffs {
+		job.Diffs = append(job.Diffs, *diff)
+	} else {
+		job.DiffsTruncated = true
+	}
+	if job.DryRun {
+		return nil
+	}
+	return s.attendanceRepo.AddDailySummaries(ctx, summary)
+}
+
+// finishRecomputeJob lưu trạng thái cuối của job, vẫn lưu được khi ctx của job đã bị hủy
+func (s *RecomputeService) finishRecomputeJob(ctx context.Context, job *model.RecomputeJobModel, err error) {
+	ctx = context.WithoutCancel(ctx)
+	finishedAt := time.Now()
+	job.FinishedAt = &finishedAt
+	job.Status = model.RecomputeJobStatusCompleted
+	if err != nil {
+		job.Status = model.RecomputeJobStatusFailed
+		job.Error = err.Error()
+	}
+	s.updateRecomputeJob(ctx, job)
+	s.logger.Info("Recompute job finished", "job_id", job.JobID, "status", job.Status, "total", job.Total, "changed", job.Changed, "failed", job.Failed)
+}
+
+func (s *RecomputeService) saveRecomputeJob(ctx context.Context, job *model.RecomputeJobModel) error {
+	value, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return s.distributedCache.SetTTL(ctx, utilsCache.GetKeyRecomputeJob(job.JobID.String()), string(value), constants.TTL_RECOMPUTE_JOB)
+}
+
+// updateRecomputeJob lưu tiến độ, lỗi lưu tiến độ không dừng job
+func (s *RecomputeService) updateRecomputeJob(ctx context.Context, job *model.RecomputeJobModel) {
+	if err := s.saveRecomputeJob(ctx, job); err != nil {
+		s.logger.Warn("Failed to save recompute job progress", "job_id", job.JobID, "error", err)
+	}
+}
+
+// keepRecomputeLock gia hạn khóa trong khi job chạy, hủy job nếu khóa đã bị replica khác chiếm
+func (s *RecomputeService) keepRecomputeLock(ctx context.Context, cancel context.CancelFunc, lockKey string, jobID uuid.UUID) {
+	ticker := time.NewTicker(recomputeLockRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			result, err := s.distributedCache.LuaScript(ctx, luaRefreshRecomputeLock, []string{lockKey}, jobID.String(), constants.TTL_RECOMPUTE_JOB_LOCK)
+			if err != nil {
+				// Lỗi tạm thời, thử lại ở lần sau trước khi khóa hết hạn
+				s.logger.Warn("Failed to refresh recompute job lock", "job_id", jobID, "error", err)
+				continue
+			}
+			if refreshed, ok := result.(int64); !ok || refreshed != 1 {
+				s.logger.Error("Recompute job lock lost, cancelling job", "job_id", jobID)
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+func (s *RecomputeService) releaseRecomputeLock(ctx context.Context, lockKey string, jobID uuid.UUID) {
+	if _, err := s.distributedCache.LuaScript(ctx, luaReleaseRecomputeLock, []string{lockKey}, jobID.String()); err != nil {
+		s.logger.Warn("Failed to release recompute job lock", "job_id", jobID, "error", err)
+	}
+}
+
+// addAuditLog ghi nhật ký tạo job tính lại, lỗi ghi log không làm hỏng nghiệp vụ
+func (s *RecomputeService) addAuditLog(ctx context.Context, session *model.SessionReq, job *model.RecomputeJobModel) {
+	if err := s.auditRepo.AddAuditLog(ctx, &domainModel.AuditLog{
+		CompanyID:      job.CompanyID,
+		YearMonth:      time.Now().Format("2006-01"),
+		ActorID:        session.UserId,
+		ActionCategory: constants.AuditCategoryAttendance,
+		ActionName:     constants.AuditActionRecomputeDailySummaries,
+		ResourceType:   constants.AuditResourceTypeRecomputeJob,
+		ResourceID:     job.JobID,
+		Details: map[string]string{
+			"from_date": job.FromDate.Format("2006-01-02"),
+			"to_date":   job.ToDate.Format("2006-01-02"),
+			"employees": strconv.Itoa(len(job.EmployeeIDs)),
+			"dry_run":   strconv.FormatBool(job.DryRun),
+		},
+		IP_Address: session.ClientIp,
+		UserAgent:  session.ClientAgent,
+		Status:     constants.AuditStatusSuccess,
+	}); err != nil {
+		s.logger.Warn("Failed to add audit log", "action", constants.AuditActionRecomputeDailySummaries, "job_id", job.JobID, "error", err)
+	}
+}
+
+// NewRecomputeService creates a new instance of RecomputeService
+func NewRecomputeService() (service.IRecomputeService, error) {
+	auditRepo, err := domainRepo.GetAuditRepository()
+	if err != nil {
+		return nil, err
+	}
+	distributedCache, err := domainCache.GetDistributedCache()
+	if err != nil {
+		return nil, err
+	}
+	return &RecomputeService{
+		attendanceRepo:   domainRepo.GetAttendanceRepository(),
+		userRepo:         domainRepo.GetUserRepository(),
+		auditRepo:        auditRepo,
+		logger:           domainLogger.GetLogger(),
+		distributedCache: distributedCache,
+	}, nil
+}
+
+// =================================================
+// Helper Functions
+// =================================================
+
+// resolveRecomputeDateRange lấy khoảng ngày cần tính lại từ tháng hoặc from_date/to_date.
+// Tháng hiện tại chỉ tính đến hôm qua.
+func resolveRecomputeDateRange(req *model.RecomputeDailySummariesModel, now time.Time) (time.Time, time.Time, bool) {
+	if !req.Month.IsZero() {
+		if !req.FromDate.IsZero() || !req.ToDate.IsZero() {
+			return time.Time{}, time.Time{}, false
+		}
+		fromDate := time.Date(req.Month.Year(), req.Month.Month(), 1, 0, 0, 0, 0, req.Month.Location())
+		toDate := fromDate.AddDate(0, 1, -1)
+		if yesterday := time.Date(now.Year(), now.Month(), now.Day()-1, 0, 0, 0, 0, fromDate.Location()); toDate.After(yesterday) {
+			toDate = yesterday
+		}
+		return fromDate, toDate, true
+	}
+	if req.FromDate.IsZero() || req.ToDate.IsZero() {
+		return time.Time{}, time.Time{}, false
+	}
+	fromDate := time.Date(req.FromDate.Year(), req.FromDate.Month(), req.FromDate.Day(), 0, 0, 0, 0, req.FromDate.Location())
+	toDate := time.Date(req.ToDate.Year(), req.ToDate.Month(), req.ToDate.Day(), 0, 0, 0, 0, req.ToDate.Location())
+	return fromDate, toDate, true
+}
+
+// shiftWorksOn kiểm tra ngày có nằm trong lịch làm việc của ca (ISO 8601: 1=Thứ hai...7=Chủ nhật)
+func shiftWorksOn(shift domainModel.ShiftTimeEmployee, date time.Time) bool {
+	weekday := int32(date.Weekday())
+	if weekday == 0 {
+		weekday = 7
+	}
+	for _, day := range shift.WorkDays {
+		if day == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// diffDailySummary so sánh bản tổng hợp hiện tại với bản tính lại, trả về nil nếu không có khác biệt
+func diffDailySummary(existing *domainModel.DailySummariesEmployeeInfo, summary *domainModel.AddDailySummariesInput) *model.RecomputeDiffModel {
+	diff := &model.RecomputeDiffModel{
+		EmployeeID: summary.EmployeeID,
+		WorkDate:   summary.WorkDate,
+	}
+	if existing == nil {
+		diff.Missing = true
+		return diff
+	}
+	addChange := func(field, before, after string) {
+		if before != after {
+			diff.Changes = append(diff.Changes, model.RecomputeFieldChangeModel{
+				Field:  field,
+				Before: before,
+				After:  after,
+			})
+		}
+	}
+	addChange("shift_id", existing.ShiftId.String(), summary.ShiftID.String())
+	addChange("attendance_status", existing.AttendanceStatus, strconv.Itoa(summary.AttendanceStatus))
+	addChange("actual_check_in", formatSummaryTime(existing.ActualCheckIn), formatSummaryTime(summary.ActualCheckIn))
+	addChange("actual_check_out", formatSummaryTime(existing.ActualCheckOut), formatSummaryTime(summary.ActualCheckOut))
+	addChange("late_minutes", strconv.Itoa(existing.LateMinutes), strconv.Itoa(summary.LateMinutes))
+	addChange("early_leave_minutes", strconv.Itoa(existing.EarlyLeaveMinutes), strconv.Itoa(summary.EarlyLeaveMinutes))
+	addChange("total_work_minutes", strconv.Itoa(existing.TotalWorkMinutes), strconv.Itoa(summary.TotalWorkMinutes))
+	addChange("scheduled_minutes", strconv.Itoa(existing.ScheduledMinutes), strconv.Itoa(summary.ScheduledMinutes))
+	addChange("break_minutes", strconv.Itoa(existing.BreakMinutes), strconv.Itoa(summary.BreakMinutes))
+	addChange("overtime_minutes", strconv.Itoa(existing.OvertimeMinutes), strconv.Itoa(summary.OvertimeMinutes))
+	addChange("net_work_minutes", strconv.Itoa(existing.NetWorkMinutes), strconv.Itoa(summary.NetWorkMinutes))
+	addChange("unpaired_punches", strconv.Itoa(existing.UnpairedPunches), strconv.Itoa(summary.UnpairedPunches))
+	addChange("notes", existing.Notes, summary.Notes)
+	if len(diff.Changes) == 0 {
+		return nil
+	}
+	return diff
+}
+
+// formatSummaryTime định dạng thời gian theo UTC với độ chính xác giây như khi lưu trên ScyllaDB
+func formatSummaryTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Truncate(time.Second).Format(time.RFC3339)
+}
@@ -0,0 +1,72 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/model"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/constants"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/model"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/global"
+)
+
+const (
+	// Số lần gửi sự kiện check-out lên kafka trước khi tính tổng hợp trực tiếp
+	summaryPublishMaxAttempts = 3
+	// Thời gian chờ giữa các lần gửi, nhân đôi sau mỗi lần lỗi
+	summaryPublishRetryBaseDelay = 200 * time.Millisecond
+)
+
+// enqueueDailySummary gửi sự kiện check-out lên kafka để consumer group tính tổng hợp ngày.
+// Sự kiện được gửi với key là employee_id để các lần check-out của một nhân viên vào cùng partition, giữ thứ tự.
+// Khi pipeline chưa bật hoặc gửi lỗi sau nhiều lần thử thì tính và ghi trực tiếp vào DB,
+// lỗi được trả về để request chấm công thất bại và client thử lại thay vì mất bản tổng hợp.
+func (a *AttendanceService) enqueueDailySummary(ctx context.Context, req *model.AddAttendanceModel, shift domainModel.ShiftTimeEmployee) error {
+	if a.kafkaWriter != nil {
+		err := a.publishCheckoutEvent(ctx, req, shift)
+		if err == nil {
+			return nil
+		}
+		a.logger.Warn("Failed to publish checkout event, compute daily summary synchronously", "employee_id", req.EmployeeID, "error", err)
+	}
+	return global.AttendanceServiceWorker.ProcessDailySummaryJob(
+		ctx,
+		req.CompanyID,
+		req.EmployeeID,
+		req.RecordTime,
+		shift,
+	)
+}
+
+// publishCheckoutEvent gửi sự kiện check-out lên kafka, thử lại với thời gian chờ tăng dần
+func (a *AttendanceService) publishCheckoutEvent(ctx context.Context, req *model.AddAttendanceModel, shift domainModel.ShiftTimeEmployee) error {
+	event, err := json.Marshal(domainModel.DailySummaryCheckoutEvent{
+		CompanyID:  req.CompanyID,
+		EmployeeID: req.EmployeeID,
+		RecordTime: req.RecordTime,
+		Shift:      shift,
+	})
+	if err != nil {
+		return err
+	}
+	delay := summaryPublishRetryBaseDelay
+	for attempt := 1; ; attempt++ {
+		err = a.kafkaWriter.WriteMessageRequireAllAck(
+			ctx,
+			constants.KAFKA_TOPIC_ATTENDANCE_CHECKOUT,
+			req.EmployeeID.String(),
+			event,
+		)
+		if err == nil || attempt >= summaryPublishMaxAttempts {
+			return err
+		}
+		a.logger.Warn("Failed to publish checkout event, retrying", "employee_id", req.EmployeeID, "attempt", attempt, "error", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
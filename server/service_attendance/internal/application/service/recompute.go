@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	applicationErrors "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/errors"
+	model "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/model"
+)
+
+// ============================================
+// Recompute Service Interfaces
+// ============================================
+type IRecomputeService interface {
+	RecomputeDailySummaries(ctx context.Context, req *model.RecomputeDailySummariesModel) (*model.RecomputeJobModel, *applicationErrors.Error)
+	GetRecomputeJob(ctx context.Context, req *model.GetRecomputeJobModel) (*model.RecomputeJobModel, *applicationErrors.Error)
+}
+
+// Manager instance of recompute service
+var _vIRecomputeService IRecomputeService
+
+// Getter for recompute service instance
+func GetRecomputeService() IRecomputeService {
+	return _vIRecomputeService
+}
+
+// Setter for recompute service instance
+func SetRecomputeService(service IRecomputeService) error {
+	if service == nil {
+		return errors.New("recompute service set is nil")
+	}
+	if _vIRecomputeService != nil {
+		return errors.New("recompute service is already set")
+	}
+	_vIRecomputeService = service
+	return nil
+}
@@ -7,4 +7,12 @@ const (
 	AuditActionUpdateSessionDevice = "update_session_device"
 	AuditActionDeleteSessionDevice = "delete_session_device"
 	AuditResourceTypeDevice        = "device"
+
+	AuditCategoryAttendance             = "attendance"
+	AuditActionApproveCorrectionRequest = "approve_correction_request"
+	AuditActionRejectCorrectionRequest  = "reject_correction_request"
+	AuditResourceTypeCorrectionRequest  = "attendance_correction_request"
+	AuditActionRecomputeDailySummaries  = "recompute_daily_summaries"
+	AuditResourceTypeRecomputeJob       = "daily_summary_recompute_job"
+	AuditStatusSuccess                  = "success"
 )
@@ -28,6 +28,8 @@ const (
 	KAFKA_TOPIC_MEDIA_PROCESSING_JOBS    = "media_processing_jobs"
 	KAFKA_TOPIC_AUDIT_EVENTS             = "audit_events"
 	KAFKA_TOPIC_DB_REPLICATION_EVENTS    = "db_replication_events"
+	KAFKA_TOPIC_ATTENDANCE_CHECKOUT      = "attendance_checkout_events"
+	KAFKA_TOPIC_ATTENDANCE_CHECKOUT_DLQ  = "attendance_checkout_events_dlq"
 	// v.v
 )
 
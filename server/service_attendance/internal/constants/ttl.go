@@ -13,6 +13,13 @@ const (
 	// attendance records
 	TTL_ATTENDANCE_RECORDS_EMPLOYEE = 60 * 5 // 5 minutes
 	TTL_SHIFT_TIME_EMPLOYEE         = 60 * 10 // 30 minutes
+	// idempotency key of attendance ingestion
+	TTL_ATTENDANCE_IDEMPOTENCY_PENDING = 60               // 1 minute, while request is processing
+	TTL_ATTENDANCE_IDEMPOTENCY_KEY     = 60 * 60 * 24     // 1 day (redis cache of completed keys)
+	TTL_ATTENDANCE_IDEMPOTENCY_KEY_DB  = 60 * 60 * 24 * 7 // 7 days (scylladb, source of truth)
+	// daily summary recompute job
+	TTL_RECOMPUTE_JOB      = 60 * 60 * 24 * 7 // 7 days, keep job result for admin review
+	TTL_RECOMPUTE_JOB_LOCK = 60 * 10          // 10 minutes, one running job per company, renewed while the job runs
 )
 
 // For user auth and info
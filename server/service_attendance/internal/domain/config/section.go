@@ -8,6 +8,8 @@ package config
 type (
 	Setting struct {
 		WorkerAttendance  WorkerAttendanceSetting `mapstructure:"worker_attendance"`
+		AbsenceScheduler  AbsenceSchedulerSetting `mapstructure:"absence_scheduler"`
+		SummaryPipeline   SummaryPipelineSetting  `mapstructure:"summary_pipeline"`
 		ServiceAuth       ServiceAuthSetting      `mapstructure:"service_auth"`
 		Grpc              GrpcSetting             `mapstructure:"grpc"`
 		Server            ServerSetting           `mapstructure:"server"`
@@ -51,6 +53,24 @@ type WorkerAttendanceSetting struct {
 	SizeBufferChan int `mapstructure:"size_buffer_chan"`
 }
 
+// AbsenceSchedulerSetting
+type AbsenceSchedulerSetting struct {
+	Enabled         bool `mapstructure:"enabled"`
+	IntervalSeconds int  `mapstructure:"interval_seconds"` // Chu kỳ quét
+	GraceMinutes    int  `mapstructure:"grace_minutes"`    // Thời gian chờ sau khi kết thúc ca
+	LookbackHours   int  `mapstructure:"lookback_hours"`   // Chỉ xử lý các ca kết thúc trong khoảng này
+	LockTTLSeconds  int  `mapstructure:"lock_ttl_seconds"` // TTL khóa phân tán giữa các replica
+	BatchSize       int  `mapstructure:"batch_size"`       // Số phân ca đọc mỗi trang khi quét
+}
+
+// SummaryPipelineSetting
+type SummaryPipelineSetting struct {
+	Enabled        bool `mapstructure:"enabled"`          // false: tính tổng hợp bằng worker trong bộ nhớ như cũ
+	NumConsumers   int  `mapstructure:"num_consumers"`    // Số consumer trong group trên mỗi replica
+	MaxRetries     int  `mapstructure:"max_retries"`      // Số lần thử lại trước khi chuyển sang dead-letter topic
+	RetryBackoffMs int  `mapstructure:"retry_backoff_ms"` // Thời gian chờ ban đầu giữa các lần thử lại, tăng gấp đôi mỗi lần
+}
+
 // ServiceAuthSetting
 type ServiceAuthSetting struct {
 	Enabled  bool   `mapstructure:"enabled"`
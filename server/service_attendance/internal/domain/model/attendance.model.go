@@ -40,21 +40,25 @@ type AddAttendanceRecordNoShiftInput struct {
 	LocationCoordinates string    `json:"location_coordinates"`
 }
 
-type GetFirstCheckInInput struct {
-	CompanyID      uuid.UUID `json:"company_id"`
-	EmployeeID     uuid.UUID `json:"employee_id"`
-	YearMonth      string    `json:"year_month"`
-	ShiftTimeStart time.Time `json:"shift_time_start"`
-	ShiftTimeEnd   time.Time `json:"shift_time_end"`
-	DateCheckOut   time.Time `json:"date_check_out"`
+type GetAttendancePunchesInput struct {
+	CompanyID  uuid.UUID `json:"company_id"`
+	EmployeeID uuid.UUID `json:"employee_id"`
+	From       time.Time `json:"from"`
+	To         time.Time `json:"to"`
 }
 
-type GetFirstCheckInOutput struct {
-	RecordTime          time.Time `json:"record_time"`
-	DeviceID            uuid.UUID `json:"device_id"`
-	VerificationMethod  string    `json:"verification_method"`
-	VerificationScore   float64   `json:"verification_score"`
-	LocationCoordinates string    `json:"location_coordinates"`
+// AttendancePunch một lần chấm công (check-in/check-out) của nhân viên
+type AttendancePunch struct {
+	RecordTime time.Time `json:"record_time"`
+	RecordType int       `json:"record_type"`
+	DeviceID   uuid.UUID `json:"device_id"`
+}
+
+// WorkInterval một cặp check-in/check-out, thiếu một đầu nếu là lần chấm công không ghép cặp được
+type WorkInterval struct {
+	CheckIn  *time.Time `json:"check_in,omitempty"`
+	CheckOut *time.Time `json:"check_out,omitempty"`
+	Minutes  int        `json:"minutes"`
 }
 
 type ShiftTimeEmployee struct {
@@ -63,6 +67,12 @@ type ShiftTimeEmployee struct {
 	EndTime               time.Time  `json:"end_time"`
 	GracePeriodMinutes    int        `json:"grace_period_minutes"`
 	EarlyDepartureMinutes int        `json:"early_departure_minutes"`
+	BreakDurationMinutes  int        `json:"break_duration_minutes"`
+	OvertimeAfterMinutes  int        `json:"overtime_after_minutes"`
+	IsFlexible            bool       `json:"is_flexible"`
+	CoreStartTime         *time.Time `json:"core_start_time,omitempty"` // Core hours of flexible shift
+	CoreEndTime           *time.Time `json:"core_end_time,omitempty"`
+	RequiredWorkMinutes   int        `json:"required_work_minutes"`
 	WorkDays              []int32    `json:"work_days"`
 	EffectiveFrom         time.Time  `json:"effective_from"`
 	EffectiveTo           *time.Time `json:"effective_to"`
@@ -80,19 +90,25 @@ type DailySummariesEmployeeOutput struct {
 }
 
 type DailySummariesEmployeeInfo struct {
-	CompanyId         uuid.UUID `json:"company_id"`
-	SummaryMonth      string    `json:"summary_month"`
-	WorkDate          time.Time `json:"work_date"`
-	EmployeeId        uuid.UUID `json:"employee_id"`
-	ShiftId           uuid.UUID `json:"shift_id"`
-	ActualCheckIn     time.Time `json:"actual_check_in"`
-	ActualCheckOut    time.Time `json:"actual_check_out"`
-	AttendanceStatus  string    `json:"attendance_status"`
-	LateMinutes       int       `json:"late_minutes"`
-	EarlyLeaveMinutes int       `json:"early_leave_minutes"`
-	TotalWorkMinutes  int       `json:"total_work_minutes"`
-	Notes             string    `json:"notes"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	CompanyId         uuid.UUID      `json:"company_id"`
+	SummaryMonth      string         `json:"summary_month"`
+	WorkDate          time.Time      `json:"work_date"`
+	EmployeeId        uuid.UUID      `json:"employee_id"`
+	ShiftId           uuid.UUID      `json:"shift_id"`
+	ActualCheckIn     time.Time      `json:"actual_check_in"`
+	ActualCheckOut    time.Time      `json:"actual_check_out"`
+	AttendanceStatus  string         `json:"attendance_status"`
+	LateMinutes       int            `json:"late_minutes"`
+	EarlyLeaveMinutes int            `json:"early_leave_minutes"`
+	TotalWorkMinutes  int            `json:"total_work_minutes"`
+	ScheduledMinutes  int            `json:"scheduled_minutes"`
+	BreakMinutes      int            `json:"break_minutes"`
+	OvertimeMinutes   int            `json:"overtime_minutes"`
+	NetWorkMinutes    int            `json:"net_work_minutes"`
+	WorkIntervals     []WorkInterval `json:"work_intervals,omitempty"`
+	UnpairedPunches   int            `json:"unpaired_punches"`
+	Notes             string         `json:"notes"`
+	UpdatedAt         time.Time      `json:"updated_at"`
 }
 
 type DailySummariesCompanyOutput struct {
@@ -102,19 +118,25 @@ type DailySummariesCompanyOutput struct {
 }
 
 type DailySummariesCompanyInfo struct {
-	CompanyId         uuid.UUID `json:"company_id"`
-	SummaryMonth      string    `json:"summary_month"`
-	WorkDate          time.Time `json:"work_date"`
-	EmployeeId        uuid.UUID `json:"employee_id"`
-	ShiftId           uuid.UUID `json:"shift_id"`
-	ActualCheckIn     time.Time `json:"actual_check_in"`
-	ActualCheckOut    time.Time `json:"actual_check_out"`
-	AttendanceStatus  string    `json:"attendance_status"`
-	LateMinutes       int       `json:"late_minutes"`
-	EarlyLeaveMinutes int       `json:"early_leave_minutes"`
-	TotalWorkMinutes  int       `json:"total_work_minutes"`
-	Notes             string    `json:"notes"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	CompanyId         uuid.UUID      `json:"company_id"`
+	SummaryMonth      string         `json:"summary_month"`
+	WorkDate          time.Time      `json:"work_date"`
+	EmployeeId        uuid.UUID      `json:"employee_id"`
+	ShiftId           uuid.UUID      `json:"shift_id"`
+	ActualCheckIn     time.Time      `json:"actual_check_in"`
+	ActualCheckOut    time.Time      `json:"actual_check_out"`
+	AttendanceStatus  string         `json:"attendance_status"`
+	LateMinutes       int            `json:"late_minutes"`
+	EarlyLeaveMinutes int            `json:"early_leave_minutes"`
+	TotalWorkMinutes  int            `json:"total_work_minutes"`
+	ScheduledMinutes  int            `json:"scheduled_minutes"`
+	BreakMinutes      int            `json:"break_minutes"`
+	OvertimeMinutes   int            `json:"overtime_minutes"`
+	NetWorkMinutes    int            `json:"net_work_minutes"`
+	WorkIntervals     []WorkInterval `json:"work_intervals,omitempty"`
+	UnpairedPunches   int            `json:"unpaired_punches"`
+	Notes             string         `json:"notes"`
+	UpdatedAt         time.Time      `json:"updated_at"`
 }
 
 type GetDailySummariesCompanyForEmployeeInput struct {
@@ -144,6 +166,13 @@ type UpdateDailySummariesEmployeeInput struct {
 	AttendanceStatus int       `json:"attendance_status"`
 }
 
+type ExistsDailySummaryEmployeeInput struct {
+	CompanyID    uuid.UUID `json:"company_id"`
+	EmployeeID   uuid.UUID `json:"employee_id"`
+	SummaryMonth string    `json:"summary_month"`
+	WorkDate     time.Time `json:"work_date"`
+}
+
 type DeleteDailySummariesInput struct {
 	CompanyID    uuid.UUID `json:"company_id"`
 	SummaryMonth string    `json:"summary_month"`
@@ -202,19 +231,25 @@ type GetAttendanceRecordCompanyInput struct {
 }
 
 type AddDailySummariesInput struct {
-	CompanyID         uuid.UUID `json:"company_id"`
-	SummaryMonth      string    `json:"summary_month"`
-	WorkDate          time.Time `json:"work_date"`
-	EmployeeID        uuid.UUID `json:"employee_id"`
-	ShiftID           uuid.UUID `json:"shift_id"`
-	ActualCheckIn     time.Time `json:"actual_check_in"`
-	ActualCheckOut    time.Time `json:"actual_check_out"`
-	AttendanceStatus  int       `json:"attendance_status"`
-	LateMinutes       int       `json:"late_minutes"`
-	EarlyLeaveMinutes int       `json:"early_leave_minutes"`
-	TotalWorkMinutes  int       `json:"total_work_minutes"`
-	Notes             string    `json:"notes"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	CompanyID         uuid.UUID      `json:"company_id"`
+	SummaryMonth      string         `json:"summary_month"`
+	WorkDate          time.Time      `json:"work_date"`
+	EmployeeID        uuid.UUID      `json:"employee_id"`
+	ShiftID           uuid.UUID      `json:"shift_id"`
+	ActualCheckIn     time.Time      `json:"actual_check_in"`
+	ActualCheckOut    time.Time      `json:"actual_check_out"`
+	AttendanceStatus  int            `json:"attendance_status"`
+	LateMinutes       int            `json:"late_minutes"`
+	EarlyLeaveMinutes int            `json:"early_leave_minutes"`
+	TotalWorkMinutes  int            `json:"total_work_minutes"`
+	ScheduledMinutes  int            `json:"scheduled_minutes"`
+	BreakMinutes      int            `json:"break_minutes"`
+	OvertimeMinutes   int            `json:"overtime_minutes"`
+	NetWorkMinutes    int            `json:"net_work_minutes"`
+	WorkIntervals     []WorkInterval `json:"work_intervals,omitempty"`
+	UnpairedPunches   int            `json:"unpaired_punches"`
+	Notes             string         `json:"notes"`
+	UpdatedAt         time.Time      `json:"updated_at"`
 }
 
 type AddAttendanceRecordInput struct {
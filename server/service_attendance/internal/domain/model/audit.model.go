@@ -11,7 +11,7 @@ type AuditLog struct {
 	ActionName     string
 	ResourceType   string
 	ResourceID     uuid.UUID
-	Details        string
+	Details        map[string]string
 	IP_Address     string
 	UserAgent      string
 	Status         string
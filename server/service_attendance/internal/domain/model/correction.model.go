@@ -0,0 +1,105 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ============================================
+// Attendance Correction Model
+// ============================================
+
+// AttendanceCorrection yêu cầu điều chỉnh giờ vào/ra của một ngày làm việc
+type AttendanceCorrection struct {
+	RequestID         uuid.UUID
+	CompanyID         uuid.UUID
+	EmployeeID        uuid.UUID
+	ShiftID           uuid.UUID
+	WorkDate          time.Time
+	ProposedCheckIn   *time.Time
+	ProposedCheckOut  *time.Time
+	Reason            string
+	OriginalCheckIn   *time.Time
+	OriginalCheckOut  *time.Time
+	OriginalStatus    *int
+	CorrectedCheckIn  *time.Time
+	CorrectedCheckOut *time.Time
+	CorrectedStatus   *int
+	Status            int
+	ReviewedBy        *uuid.UUID
+	ReviewedAt        *time.Time
+	ReviewNote        string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// For CreateCorrectionRequest
+type CreateCorrectionRequestInput struct {
+	CompanyID        uuid.UUID
+	EmployeeID       uuid.UUID
+	ShiftID          uuid.UUID
+	WorkDate         time.Time
+	ProposedCheckIn  *time.Time
+	ProposedCheckOut *time.Time
+	Reason           string
+	OriginalCheckIn  *time.Time
+	OriginalCheckOut *time.Time
+	OriginalStatus   *int
+}
+
+// For CountPendingCorrectionRequests
+type CountPendingCorrectionRequestsInput struct {
+	EmployeeID uuid.UUID
+	WorkDate   time.Time
+}
+
+// For GetCorrectionRequest
+type GetCorrectionRequestInput struct {
+	RequestID uuid.UUID
+	CompanyID uuid.UUID
+}
+
+// For GetApprovedCorrection
+type GetApprovedCorrectionInput struct {
+	CompanyID  uuid.UUID
+	EmployeeID uuid.UUID
+	WorkDate   time.Time
+}
+
+// For ListCorrectionRequestsByEmployee
+type ListCorrectionRequestsByEmployeeInput struct {
+	EmployeeID uuid.UUID
+	Limit      int32
+	Offset     int32
+}
+
+// For ListPendingCorrectionRequests
+type ListPendingCorrectionRequestsInput struct {
+	CompanyID uuid.UUID
+	Limit     int32
+	Offset    int32
+}
+
+// For ApproveCorrectionRequest, RejectCorrectionRequest
+type ReviewCorrectionRequestInput struct {
+	RequestID  uuid.UUID
+	CompanyID  uuid.UUID
+	ReviewedBy uuid.UUID
+	ReviewNote string
+}
+
+// For UpdateCorrectionResult
+type UpdateCorrectionResultInput struct {
+	RequestID         uuid.UUID
+	CorrectedCheckIn  *time.Time
+	CorrectedCheckOut *time.Time
+	CorrectedStatus   int
+}
+
+// For GetDailySummaryEmployee
+type GetDailySummaryEmployeeInput struct {
+	CompanyID  uuid.UUID
+	EmployeeID uuid.UUID
+	WorkDate   time.Time
+}
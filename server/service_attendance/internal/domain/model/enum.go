@@ -16,4 +16,29 @@ const (
 	StatusEarlyLeave        = 2
 	StatusLateAndEarlyLeave = 3
 	StatusAbsent            = 4
+	StatusInsufficientHours = 5 // Ca linh hoạt: không đủ số phút làm việc yêu cầu
+	StatusOnLeave           = 6 // Nghỉ phép đã được duyệt
+	StatusHoliday           = 7 // Ngày nghỉ lễ của công ty
+)
+
+// AttendanceRecordType enum
+const (
+	RecordTypeCheckIn  = 0
+	RecordTypeCheckOut = 1
+	RecordTypeNoShift  = -1 // Chấm công không khớp ca nào (attendance_records_no_shift)
+)
+
+// LeaveType enum (leave_requests.leave_type)
+const (
+	LeaveTypeAnnual = 0
+	LeaveTypeSick   = 1
+	LeaveTypeUnpaid = 2
+	LeaveTypeOther  = 3
+)
+
+// CorrectionStatus enum (attendance_correction_requests.status)
+const (
+	CorrectionStatusPending  = 0
+	CorrectionStatusApproved = 1
+	CorrectionStatusRejected = 2
 )
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Trạng thái của idempotency key
+const (
+	IdempotencyStatusPending   = "pending"   // đang xử lý, chưa có kết quả
+	IdempotencyStatusCompleted = "completed" // đã ghi nhận chấm công
+)
+
+// Kết quả đã ghi nhận của một lần chấm công có idempotency key
+type AttendanceIdempotencyRecord struct {
+	CompanyID      uuid.UUID `json:"company_id"`
+	IdempotencyKey string    `json:"idempotency_key"`
+	EmployeeID     uuid.UUID `json:"employee_id"`
+	RecordTime     time.Time `json:"record_time"`
+	RecordType     int       `json:"record_type"`
+	Status         string    `json:"status"`
+}
+
+type ClaimIdempotencyKeyInput struct {
+	CompanyID      uuid.UUID
+	IdempotencyKey string
+	EmployeeID     uuid.UUID
+	RecordTime     time.Time
+	TTLSeconds     int64
+}
+
+type CompleteIdempotencyKeyInput struct {
+	CompanyID      uuid.UUID
+	IdempotencyKey string
+	RecordType     int
+	TTLSeconds     int64
+}
+
+type ReleaseIdempotencyKeyInput struct {
+	CompanyID      uuid.UUID
+	IdempotencyKey string
+}
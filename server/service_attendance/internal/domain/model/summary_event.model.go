@@ -0,0 +1,24 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DailySummaryCheckoutEvent sự kiện check-out gửi lên kafka, consumer group dùng để tính tổng hợp ngày
+type DailySummaryCheckoutEvent struct {
+	CompanyID  uuid.UUID         `json:"company_id"`
+	EmployeeID uuid.UUID         `json:"employee_id"`
+	RecordTime time.Time         `json:"record_time"`
+	Shift      ShiftTimeEmployee `json:"shift"`
+}
+
+// DailySummaryDeadLetter sự kiện không xử lý được sau khi hết số lần thử lại, gửi sang dead-letter topic
+type DailySummaryDeadLetter struct {
+	Event    json.RawMessage `json:"event"`
+	Error    string          `json:"error"`
+	Attempts int             `json:"attempts"`
+	FailedAt time.Time       `json:"failed_at"`
+}
@@ -1,6 +1,10 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // ============================================
 // User Model
@@ -26,3 +30,40 @@ type GetCompanyIdUserInput struct {
 type GetCompanyIdUserOutput struct {
 	CompanyID uuid.UUID
 }
+
+// For GetListActiveEmployeeShift
+type GetListActiveEmployeeShiftInput struct {
+	Date time.Time // Ngày cần lấy các phân ca còn hiệu lực
+	// Phân trang theo khóa (employee_id, shift_id, effective_from) của phân ca cuối trang trước,
+	// để trống khi lấy trang đầu
+	AfterEmployeeID    uuid.UUID
+	AfterShiftID       uuid.UUID
+	AfterEffectiveFrom time.Time
+	Limit              int
+}
+
+// For GetListActiveEmployeeShiftCompany
+type GetListActiveEmployeeShiftCompanyInput struct {
+	CompanyID uuid.UUID
+	Date      time.Time // Ngày cần lấy các phân ca còn hiệu lực
+}
+
+type EmployeeShiftAssignment struct {
+	CompanyID  uuid.UUID
+	EmployeeID uuid.UUID
+	Shift      ShiftTimeEmployee
+}
+
+// For GetEmployeeDayOff
+type GetEmployeeDayOffInput struct {
+	CompanyID  uuid.UUID
+	EmployeeID uuid.UUID
+	WorkDate   time.Time
+}
+
+// EmployeeDayOff ngày nghỉ hợp lệ của nhân viên (nghỉ lễ công ty hoặc nghỉ phép đã duyệt)
+type EmployeeDayOff struct {
+	Status    int    // StatusHoliday hoặc StatusOnLeave
+	Name      string // Tên ngày lễ
+	LeaveType int    // Loại nghỉ phép
+}
@@ -17,11 +17,13 @@ type IAttendanceRepository interface {
 	AddAttendanceRecordNoShift(ctx context.Context, input *model.AddAttendanceRecordNoShiftInput) error
 	// Get
 	GetAttendanceRecordNoShift(ctx context.Context, input *model.GetAttendanceRecordNoShiftInput) (*model.AttendanceRecordNoShiftOutput, error)
-	GetFirstCheckIn(ctx context.Context, input *model.GetFirstCheckInInput) (*model.GetFirstCheckInOutput, error)
+	GetAttendancePunches(ctx context.Context, input *model.GetAttendancePunchesInput) ([]model.AttendancePunch, error)
 	GetAttendanceRecordCompany(ctx context.Context, input *model.GetAttendanceRecordCompanyInput) (*model.AttendanceRecordOutput, error)
 	GetAttendanceRecordCompanyForEmployee(ctx context.Context, input *model.GetAttendanceRecordCompanyForEmployeeInput) (*model.AttendanceRecordOutput, error)
 	GetDailySummarieCompany(ctx context.Context, input *model.GetDailySummariesCompanyInput) (*model.DailySummariesCompanyOutput, error)
 	GetDailySummarieCompanyForEmployee(ctx context.Context, input *model.GetDailySummariesCompanyForEmployeeInput) (*model.DailySummariesEmployeeOutput, error)
+	ExistsDailySummaryEmployee(ctx context.Context, input *model.ExistsDailySummaryEmployeeInput) (bool, error)
+	GetDailySummaryEmployee(ctx context.Context, input *model.GetDailySummaryEmployeeInput) (*model.DailySummariesEmployeeInfo, error)
 	// Delete
 	DeleteAttendanceRecordNoShift(ctx context.Context, input *model.DeleteAttendanceRecordNoShiftInput) error
 	DeleteAttendanceRecordNoShiftBeforeTimestamp(ctx context.Context, input *model.DeleteAttendanceRecordNoShiftInput) error
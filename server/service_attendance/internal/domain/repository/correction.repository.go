@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	model "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/model"
+)
+
+// ============================================
+// Interface for Correction repository
+// ============================================
+type ICorrectionRepository interface {
+	CreateCorrectionRequest(ctx context.Context, input *model.CreateCorrectionRequestInput) (uuid.UUID, error)
+	CountPendingCorrectionRequests(ctx context.Context, input *model.CountPendingCorrectionRequestsInput) (int64, error)
+	GetCorrectionRequest(ctx context.Context, input *model.GetCorrectionRequestInput) (*model.AttendanceCorrection, error)
+	GetApprovedCorrection(ctx context.Context, input *model.GetApprovedCorrectionInput) (*model.AttendanceCorrection, error)
+	ListCorrectionRequestsByEmployee(ctx context.Context, input *model.ListCorrectionRequestsByEmployeeInput) ([]model.AttendanceCorrection, error)
+	ListPendingCorrectionRequests(ctx context.Context, input *model.ListPendingCorrectionRequestsInput) ([]model.AttendanceCorrection, error)
+	ApproveCorrectionRequest(ctx context.Context, input *model.ReviewCorrectionRequestInput) (bool, error)
+	RejectCorrectionRequest(ctx context.Context, input *model.ReviewCorrectionRequestInput) (bool, error)
+	RevertCorrectionApproval(ctx context.Context, input *model.GetCorrectionRequestInput) (bool, error)
+	UpdateCorrectionResult(ctx context.Context, input *model.UpdateCorrectionResultInput) error
+}
+
+// ============================================
+// Variable for Correction repository instance
+// ============================================
+var _vCorrectionRepository ICorrectionRepository
+
+// ============================================
+// Set the Correction repository instance
+// ============================================
+func SetCorrectionRepository(v ICorrectionRepository) error {
+	if v == nil {
+		return errors.New("Correction repository initialization failed, nil value")
+	}
+	if _vCorrectionRepository != nil {
+		return errors.New("Correction repository initialization failed, not nil")
+	}
+	_vCorrectionRepository = v
+	return nil
+}
+
+// ============================================
+// Get the Correction repository instance
+// ============================================
+func GetCorrectionRepository() ICorrectionRepository {
+	return _vCorrectionRepository
+}
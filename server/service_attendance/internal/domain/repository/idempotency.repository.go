@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	model "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/model"
+)
+
+// ============================================
+// Interface for Idempotency repository
+// ============================================
+type IIdempotencyRepository interface {
+	// ClaimIdempotencyKey giữ key bằng LWT, trả về false cùng bản ghi hiện có nếu key đã tồn tại
+	ClaimIdempotencyKey(ctx context.Context, input *model.ClaimIdempotencyKeyInput) (bool, *model.AttendanceIdempotencyRecord, error)
+	CompleteIdempotencyKey(ctx context.Context, input *model.CompleteIdempotencyKeyInput) error
+	ReleaseIdempotencyKey(ctx context.Context, input *model.ReleaseIdempotencyKeyInput) error
+}
+
+// ============================================
+// Variable for Idempotency repository instance
+// ============================================
+var _vIdempotencyRepository IIdempotencyRepository
+
+// ============================================
+// Set the Idempotency repository instance
+// ============================================
+func SetIdempotencyRepository(v IIdempotencyRepository) error {
+	if v == nil {
+		return errors.New("Idempotency repository initialization failed, nil value")
+	}
+	if _vIdempotencyRepository != nil {
+		return errors.New("Idempotency repository initialization failed, not nil")
+	}
+	_vIdempotencyRepository = v
+	return nil
+}
+
+// ============================================
+// Get the Idempotency repository instance
+// ============================================
+func GetIdempotencyRepository() IIdempotencyRepository {
+	return _vIdempotencyRepository
+}
@@ -12,9 +12,12 @@ import (
 // ============================================
 type IUserRepository interface {
 	GetListTimeShiftEmployee(ctx context.Context, input *model.GetListTimeShiftEmployeeInput) ([]model.ShiftTimeEmployee, error)
+	GetListActiveEmployeeShift(ctx context.Context, input *model.GetListActiveEmployeeShiftInput) ([]model.EmployeeShiftAssignment, error)
+	GetListActiveEmployeeShiftCompany(ctx context.Context, input *model.GetListActiveEmployeeShiftCompanyInput) ([]model.EmployeeShiftAssignment, error)
 	UserIsManagerCompany(ctx context.Context, input *model.UserIsManagerCompanyInput) (bool, error)
 	UserIsEmployeeInCompany(ctx context.Context, input *model.UserIsEmployeeInCompanyInput) (bool, error)
 	GetCompanyIdUser(ctx context.Context, input *model.GetCompanyIdUserInput) (*model.GetCompanyIdUserOutput, error)
+	GetEmployeeDayOff(ctx context.Context, input *model.GetEmployeeDayOffInput) (*model.EmployeeDayOff, error)
 }
 
 // ============================================
@@ -0,0 +1,326 @@
+package attendance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	domainCache "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/cache"
+	domainConfig "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/config"
+	domainLogger "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/logger"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/model"
+	domainRepo "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/repository"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/worker"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/global"
+)
+
+// ============================================
+// Worker for scheduled absence (no-show) detection
+// ============================================
+
+const (
+	absenceSchedulerLockKey   = "attendance:absence:scheduler:lock"
+	absenceProcessedKeyFormat = "attendance:absence:processed:%s:%s:%s" // company:employee:work_date
+
+	defaultAbsenceIntervalSeconds = 300
+	defaultAbsenceLookbackHours   = 24
+	defaultAbsenceLockTTLSeconds  = 240
+	defaultAbsenceBatchSize       = 500
+)
+
+// Khóa chỉ được giải phóng bởi chính replica đã giữ khóa
+const (
+	luaAcquireLock = `
+		if redis.call("SET", KEYS[1], ARGV[1], "NX", "EX", ARGV[2]) then
+			return 1
+		end
+		return 0
+	`
+	luaReleaseLock = `
+		if redis.call("GET", KEYS[1]) == ARGV[1] then
+			return redis.call("DEL", KEYS[1])
+		end
+		return 0
+	`
+)
+
+type AbsenceSchedulerWorker struct {
+	logger           domainLogger.ILogger
+	attendanceRepo   domainRepo.IAttendanceRepository
+	userRepo         domainRepo.IUserRepository
+	distributedCache domainCache.IDistributedCache
+	config           domainConfig.AbsenceSchedulerSetting
+	instanceID       string
+}
+
+// Running scheduler to mark no-shows as absent (or holiday/on leave), dừng khi ctx bị hủy
+func (w *AbsenceSchedulerWorker) RunAbsenceScheduler(ctx context.Context) error {
+	if !w.config.Enabled {
+		w.logger.Warn("absence scheduler is disabled, skipping scheduler startup")
+		return nil
+	}
+	interval := time.Duration(w.config.IntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = defaultAbsenceIntervalSeconds * time.Second
+	}
+	global.WaitGroup.Add(1)
+	go func() {
+		defer global.WaitGroup.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				w.logger.Info("absence scheduler stopped")
+				return
+			case <-ticker.C:
+				w.RunAbsenceScan(ctx, time.Now())
+			}
+		}
+	}()
+	return nil
+}
+
+// RunAbsenceScan quét các ca đã kết thúc (cộng thời gian chờ) và ghi nhận vắng mặt
+// cho nhân viên không có bất kỳ lần chấm công nào. Chỉ một replica chạy tại một thời điểm.
+func (w *AbsenceSchedulerWorker) RunAbsenceScan(ctx context.Context, now time.Time) {
+	if !w.acquireLock(ctx) {
+		return
+	}
+	defer w.releaseLock(ctx)
+
+	grace := time.Duration(w.config.GraceMinutes) * time.Minute
+	lookbackHours := w.config.LookbackHours
+	if lookbackHours <= 0 {
+		lookbackHours = defaultAbsenceLookbackHours
+	}
+	lookbackFrom := now.Add(-time.Duration(lookbackHours) * time.Hour)
+	batchSize := w.config.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultAbsenceBatchSize
+	}
+
+	// Duyệt từ ngày xa nhất (tính cả ca qua đêm bắt đầu từ hôm trước) tới hôm nay
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	for workDate := time.Date(lookbackFrom.Year(), lookbackFrom.Month(), lookbackFrom.Day()-1, 0, 0, 0, 0, now.Location()); !workDate.After(today); workDate = workDate.AddDate(0, 0, 1) {
+		// Đọc phân ca theo từng trang để không tải toàn bộ phân ca của mọi công ty trong một lần
+		input := &domainModel.GetListActiveEmployeeShiftInput{
+			Date:  workDate,
+			Limit: batchSize,
+		}
+		for {
+			if ctx.Err() != nil {
+				return
+			}
+			assignments, err := w.userRepo.GetListActiveEmployeeShift(ctx, input)
+			if err != nil {
+				w.logger.Error("absence scheduler: get list active employee shift", "workDate", workDate, "error", err)
+				break
+			}
+			for _, assignment := range assignments {
+				if !isWorkDay(workDate, assignment.Shift.WorkDays) {
+					continue
+				}
+				shiftStart, shiftEnd := buildShiftBoundsForDate(workDate, assignment.Shift.StartTime, assignment.Shift.EndTime)
+				// Chỉ xử lý ca đã hết thời gian chờ và nằm trong khoảng lookback
+				if shiftEnd.Add(grace).After(now) || shiftEnd.Before(lookbackFrom) {
+					continue
+				}
+				if err := w.markAbsentIfNoShow(ctx, assignment, workDate, shiftStart, shiftEnd, now.Sub(lookbackFrom)); err != nil {
+					w.logger.Error("absence scheduler: mark absent", "employeeID", assignment.EmployeeID, "workDate", workDate, "error", err)
+				}
+			}
+			if len(assignments) < batchSize {
+				break
+			}
+			last := assignments[len(assignments)-1]
+			input.AfterEmployeeID = last.EmployeeID
+			input.AfterShiftID = last.Shift.ShiftID
+			input.AfterEffectiveFrom = last.Shift.EffectiveFrom
+		}
+	}
+}
+
+// markAbsentIfNoShow ghi summary vắng mặt nếu nhân viên chưa có summary và không có lần chấm công nào trong ca
+func (w *AbsenceSchedulerWorker) markAbsentIfNoShow(
+	ctx context.Context,
+	assignment domainModel.EmployeeShiftAssignment,
+	workDate time.Time,
+	shiftStart time.Time,
+	shiftEnd time.Time,
+	processedTTL time.Duration,
+) error {
+	processedKey := fmt.Sprintf(absenceProcessedKeyFormat, assignment.CompanyID, assignment.EmployeeID, workDate.Format("2006-01-02"))
+	if processed, err := w.distributedCache.Exists(ctx, processedKey); err == nil && processed {
+		return nil
+	}
+	markProcessed := func() {
+		_ = w.distributedCache.SetTTL(ctx, processedKey, "1", int64((processedTTL + 24*time.Hour).Seconds()))
+	}
+
+	summaryMonth := workDate.Format("2006-01")
+	exists, err := w.attendanceRepo.ExistsDailySummaryEmployee(ctx, &domainModel.ExistsDailySummaryEmployeeInput{
+		CompanyID:    assignment.CompanyID,
+		EmployeeID:   assignment.EmployeeID,
+		SummaryMonth: summaryMonth,
+		WorkDate:     workDate,
+	})
+	if err != nil {
+		return err
+	}
+	if exists {
+		markProcessed()
+		return nil
+	}
+
+	punches, err := w.attendanceRepo.GetAttendancePunches(ctx, &domainModel.GetAttendancePunchesInput{
+		CompanyID:  assignment.CompanyID,
+		EmployeeID: assignment.EmployeeID,
+		From:       shiftStart.Add(-punchWindowBeforeShift),
+		To:         shiftEnd.Add(time.Duration(w.config.GraceMinutes) * time.Minute),
+	})
+	if err != nil {
+		return err
+	}
+	if len(punches) > 0 {
+		markProcessed()
+		return nil
+	}
+
+	// Ngày nghỉ lễ/nghỉ phép đã duyệt không tính là vắng mặt
+	status, notes, err := resolveNoShowStatus(ctx, w.userRepo, assignment.CompanyID, assignment.EmployeeID, workDate, "Absent: no attendance record")
+	if err != nil {
+		return err
+	}
+
+	shift := assignment.Shift
+	scheduledMinutes := 0
+	if status == domainModel.StatusAbsent {
+		scheduledMinutes = calculateScheduledMinutes(shiftStart, shiftEnd, shift.BreakDurationMinutes)
+		if shift.IsFlexible && shift.RequiredWorkMinutes > 0 {
+			scheduledMinutes = shift.RequiredWorkMinutes
+		}
+	}
+	if err := w.attendanceRepo.AddDailySummaries(ctx, &domainModel.AddDailySummariesInput{
+		CompanyID:        assignment.CompanyID,
+		SummaryMonth:     summaryMonth,
+		WorkDate:         workDate,
+		EmployeeID:       assignment.EmployeeID,
+		ShiftID:          shift.ShiftID,
+		AttendanceStatus: status,
+		ScheduledMinutes: scheduledMinutes,
+		Notes:            notes,
+		UpdatedAt:        time.Now().UTC(),
+	}); err != nil {
+		return err
+	}
+	markProcessed()
+	w.logger.Info("absence scheduler: marked no-show", "employeeID", assignment.EmployeeID, "workDate", workDate, "status", status)
+	return nil
+}
+
+// resolveNoShowStatus trả về trạng thái và ghi chú cho ngày không có cặp chấm công nào:
+// nghỉ lễ công ty, nghỉ phép đã duyệt, hoặc vắng mặt (absentNotes)
+func resolveNoShowStatus(
+	ctx context.Context,
+	userRepo domainRepo.IUserRepository,
+	companyID uuid.UUID,
+	employeeID uuid.UUID,
+	workDate time.Time,
+	absentNotes string,
+) (int, string, error) {
+	dayOff, err := userRepo.GetEmployeeDayOff(ctx, &domainModel.GetEmployeeDayOffInput{
+		CompanyID:  companyID,
+		EmployeeID: employeeID,
+		WorkDate:   workDate,
+	})
+	if err != nil {
+		return domainModel.StatusAbsent, absentNotes, err
+	}
+	if dayOff == nil {
+		return domainModel.StatusAbsent, absentNotes, nil
+	}
+	if dayOff.Status == domainModel.StatusHoliday {
+		return domainModel.StatusHoliday, "Holiday: " + dayOff.Name, nil
+	}
+	return domainModel.StatusOnLeave, "On leave: " + leaveTypeName(dayOff.LeaveType), nil
+}
+
+func leaveTypeName(leaveType int) string {
+	switch leaveType {
+	case domainModel.LeaveTypeAnnual:
+		return "annual"
+	case domainModel.LeaveTypeSick:
+		return "sick"
+	case domainModel.LeaveTypeUnpaid:
+		return "unpaid"
+	default:
+		return "other"
+	}
+}
+
+func (w *AbsenceSchedulerWorker) acquireLock(ctx context.Context) bool {
+	ttl := w.config.LockTTLSeconds
+	if ttl <= 0 {
+		ttl = defaultAbsenceLockTTLSeconds
+	}
+	result, err := w.distributedCache.LuaScript(ctx, luaAcquireLock, []string{absenceSchedulerLockKey}, w.instanceID, ttl)
+	if err != nil {
+		w.logger.Warn("absence scheduler: acquire lock failed, skipping run", "error", err)
+		return false
+	}
+	val, ok := result.(int64)
+	return ok && val == 1
+}
+
+func (w *AbsenceSchedulerWorker) releaseLock(ctx context.Context) {
+	if _, err := w.distributedCache.LuaScript(ctx, luaReleaseLock, []string{absenceSchedulerLockKey}, w.instanceID); err != nil {
+		w.logger.Warn("absence scheduler: release lock failed", "error", err)
+	}
+}
+
+// isWorkDay kiểm tra ngày có nằm trong lịch làm việc của ca (ISO 8601: 1=Thứ hai...7=Chủ nhật)
+func isWorkDay(date time.Time, workDays []int32) bool {
+	weekday := int32(date.Weekday())
+	if weekday == 0 {
+		weekday = 7
+	}
+	for _, day := range workDays {
+		if day == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// buildShiftBoundsForDate dựng shiftStart/shiftEnd của ca bắt đầu trong ngày workDate
+func buildShiftBoundsForDate(workDate time.Time, startTimeOfDay, endTimeOfDay time.Time) (time.Time, time.Time) {
+	year, month, day := workDate.Date()
+	loc := workDate.Location()
+
+	start := time.Date(year, month, day, startTimeOfDay.Hour(), startTimeOfDay.Minute(), startTimeOfDay.Second(), 0, loc)
+	end := time.Date(year, month, day, endTimeOfDay.Hour(), endTimeOfDay.Minute(), endTimeOfDay.Second(), 0, loc)
+	// Ca qua đêm
+	if end.Before(start) {
+		end = end.Add(24 * time.Hour)
+	}
+	return start, end
+}
+
+func NewAbsenceSchedulerWorker(
+	config domainConfig.AbsenceSchedulerSetting,
+	logger domainLogger.ILogger,
+	attendanceRepo domainRepo.IAttendanceRepository,
+	userRepo domainRepo.IUserRepository,
+	distributedCache domainCache.IDistributedCache,
+) worker.IWorkerAbsenceScheduler {
+	return &AbsenceSchedulerWorker{
+		logger:           logger,
+		attendanceRepo:   attendanceRepo,
+		userRepo:         userRepo,
+		distributedCache: distributedCache,
+		config:           config,
+		instanceID:       uuid.NewString(),
+	}
+}
@@ -2,7 +2,6 @@ package attendance
 
 import (
 	"context"
-	"errors"
 	"strconv"
 	"time"
 
@@ -12,7 +11,6 @@ import (
 	domainModel "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/model"
 	domainRepo "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/repository"
 	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/worker"
-	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/global"
 )
 
 // ============================================
@@ -22,86 +20,85 @@ import (
 type AttendanceServiceWorker struct {
 	logger         domainLogger.ILogger
 	attendanceRepo domainRepo.IAttendanceRepository
-	summaryJobChan chan *domainModel.AddDailySummariesInput
+	userRepo       domainRepo.IUserRepository
+	correctionRepo domainRepo.ICorrectionRepository
 	config         domainConfig.WorkerAttendanceSetting
 }
 
-// Running worker to process daily summary jobs
-func (w *AttendanceServiceWorker) RunDailySummaryWorker() error {
-	if w.config.NumWorkers <= 0 {
-		w.logger.Warn("number of daily summary workers is set to 0 or less, skipping worker startup")
-		return errors.New("number worker less than 0")
-	}
-	for i := 0; i < w.config.NumWorkers; i++ {
-		global.WaitGroup.Add(1)
-		go func(workerID int) {
-			defer global.WaitGroup.Done()
-			ctx := context.Background()
-			for job := range w.summaryJobChan {
-				w.logger.Info("processing daily summary job", "worker number", workerID, "employeeID", job.EmployeeID, "workDate", job.WorkDate) // TODO: remove log in production
-				if err := w.attendanceRepo.AddDailySummaries(ctx, job); err != nil {
-					w.logger.Error("add daily simmaries", "worker number", workerID, "error", err)
-				}
-			}
-		}(i)
-	}
-	return nil
-}
-
-// add job to worker channel v2
-func (w *AttendanceServiceWorker) AddJobToDailySummaryWorkerV2(
+// ProcessDailySummaryJob tính và ghi đồng bộ bản tổng hợp của ca chứa lần check-out,
+// lỗi được trả về để consumer kafka thử lại thay vì bỏ qua
+func (w *AttendanceServiceWorker) ProcessDailySummaryJob(
+	ctx context.Context,
 	companyID uuid.UUID,
 	employeeID uuid.UUID,
 	recordTime time.Time,
 	matchedShift domainModel.ShiftTimeEmployee,
-) {
-	ctx := context.Background()
-	workDate := time.Date(recordTime.Year(), recordTime.Month(), recordTime.Day(), 0, 0, 0, 0, recordTime.Location())
-	w.logger.Info("recordTime -> work date", "recordTime", recordTime, "workDate", workDate) // TODO: remove log in production
-	dailySummary, err := w.calculateDailySummary(ctx, companyID, employeeID, workDate, recordTime, matchedShift)
+) error {
+	dailySummary, err := w.calculateDailySummaryForCheckout(ctx, companyID, employeeID, recordTime, matchedShift)
 	if err != nil {
-		w.logger.Error("calculate daily summary", "error", err)
-		return
-	}
-	if dailySummary != nil {
-		select {
-		case w.summaryJobChan <- dailySummary:
-			w.logger.Info("added daily summary job to channel", "employeeID", employeeID, "workDate", workDate) // TODO: remove log in production
-		default:
-			w.logger.Warn("daily summary job channel is full, dropping job")
-			ctx := context.Background()
-			if err := w.attendanceRepo.AddDailySummaries(ctx, dailySummary); err != nil {
-				w.logger.Error("failed to process synchronous fallback", "error", err)
-			}
-		}
-	} else {
-		w.logger.Warn("no daily summary to add, skipping", "employeeID", employeeID, "workDate", workDate) // TODO: remove log in production
+		return err
+	}
+	if dailySummary == nil {
+		return nil
 	}
+	return w.attendanceRepo.AddDailySummaries(ctx, dailySummary)
 }
 
-// add job to worker channel
-func (w *AttendanceServiceWorker) AddJobToDailySummaryWorker(job *domainModel.AddDailySummariesInput) {
-	select {
-	case w.summaryJobChan <- job:
-	default:
-		w.logger.Warn("daily summary job channel is full, dropping job")
-		ctx := context.Background()
-		if err := w.attendanceRepo.AddDailySummaries(ctx, job); err != nil {
-			w.logger.Error("failed to process synchronous fallback", "error", err)
-		}
+// ComputeDailySummary tính bản tổng hợp của ca bắt đầu trong ngày workDate từ dữ liệu chấm công gốc, không ghi vào DB
+func (w *AttendanceServiceWorker) ComputeDailySummary(
+	ctx context.Context,
+	companyID uuid.UUID,
+	employeeID uuid.UUID,
+	workDate time.Time,
+	shift domainModel.ShiftTimeEmployee,
+) (*domainModel.AddDailySummariesInput, error) {
+	shiftStart, shiftEnd := buildShiftBoundsForDate(workDate, shift.StartTime, shift.EndTime)
+	_, to := w.PunchWindow(workDate, shift)
+	if now := time.Now(); to.After(now) {
+		to = now
 	}
+	return w.calculateDailySummary(ctx, companyID, employeeID, shiftStart, shiftEnd, to, shift)
+}
+
+// PunchWindow khoảng thời gian chấm công được ghép vào ca bắt đầu trong ngày workDate,
+// từ trước giờ bắt đầu ca (check-in sớm) đến sau giờ kết thúc ca (tăng ca)
+func (w *AttendanceServiceWorker) PunchWindow(workDate time.Time, shift domainModel.ShiftTimeEmployee) (time.Time, time.Time) {
+	shiftStart, shiftEnd := buildShiftBoundsForDate(workDate, shift.StartTime, shift.EndTime)
+	return shiftStart.Add(-punchWindowBeforeShift), shiftEnd.Add(punchWindowAfterShift)
+}
+
+// RecalculateDailySummary tính lại và ghi đè bản tổng hợp của ca bắt đầu trong ngày workDate,
+// dùng khi dữ liệu chấm công của ngày thay đổi (ví dụ duyệt yêu cầu điều chỉnh giờ vào/ra)
+func (w *AttendanceServiceWorker) RecalculateDailySummary(
+	ctx context.Context,
+	companyID uuid.UUID,
+	employeeID uuid.UUID,
+	workDate time.Time,
+	shift domainModel.ShiftTimeEmployee,
+) (*domainModel.AddDailySummariesInput, error) {
+	dailySummary, err := w.ComputeDailySummary(ctx, companyID, employeeID, workDate, shift)
+	if err != nil {
+		return nil, err
+	}
+	if err := w.attendanceRepo.AddDailySummaries(ctx, dailySummary); err != nil {
+		return nil, err
+	}
+	return dailySummary, nil
 }
 
 func NewAttendanceServiceWorker(
 	config domainConfig.WorkerAttendanceSetting,
 	logger domainLogger.ILogger,
 	attendanceRepo domainRepo.IAttendanceRepository,
+	userRepo domainRepo.IUserRepository,
+	correctionRepo domainRepo.ICorrectionRepository,
 ) worker.IWorkerAttendanceServiceWorker {
 	// initialize worker
 	return &AttendanceServiceWorker{
 		logger:         logger,
 		attendanceRepo: attendanceRepo,
-		summaryJobChan: make(chan *domainModel.AddDailySummariesInput, config.SizeBufferChan),
+		userRepo:       userRepo,
+		correctionRepo: correctionRepo,
 		config:         config,
 	}
 }
@@ -110,35 +107,115 @@ func NewAttendanceServiceWorker(
 // Helper functions
 // ============================================
 
+const (
+	// Cho phép check-in sớm trước giờ bắt đầu ca
+	punchWindowBeforeShift = time.Hour
+	// Hai lần chấm công cùng loại trong khoảng này được coi là trùng lặp
+	duplicatePunchWindow = 2 * time.Minute
+	// Khi tính lại bản tổng hợp, lấy cả các lần check-out muộn (tăng ca) sau giờ kết thúc ca
+	punchWindowAfterShift = 6 * time.Hour
+)
+
+// calculateDailySummaryForCheckout tính tổng hợp của ca chứa lần check-out recordTime
+func (w *AttendanceServiceWorker) calculateDailySummaryForCheckout(
+	ctx context.Context,
+	companyID uuid.UUID,
+	employeeID uuid.UUID,
+	recordTime time.Time,
+	matchedShift domainModel.ShiftTimeEmployee,
+) (*domainModel.AddDailySummariesInput, error) {
+	// Dựng lại shiftStart/shiftEnd của ca chứa lần check-out (qua đêm)
+	shiftStart, shiftEnd := buildShiftBoundsForRecord(recordTime, matchedShift.StartTime, matchedShift.EndTime)
+	return w.calculateDailySummary(ctx, companyID, employeeID, shiftStart, shiftEnd, recordTime, matchedShift)
+}
+
 // calculateDailySummary tính toán thông tin tổng hợp chấm công hàng ngày
+// từ các lần chấm công trong cửa sổ ca đến thời điểm checkOutTime
 func (w *AttendanceServiceWorker) calculateDailySummary(
 	ctx context.Context,
 	companyID uuid.UUID,
 	employeeID uuid.UUID,
-	workDate time.Time,
+	shiftStart time.Time,
+	shiftEnd time.Time,
 	checkOutTime time.Time,
 	shift domainModel.ShiftTimeEmployee,
 ) (*domainModel.AddDailySummariesInput, error) {
 
-	// 1. Dựng lại shiftStart/shiftEnd theo cùng logic dùng cho check-in (qua đêm)
-	shiftStart, shiftEnd := buildShiftBounds(workDate, checkOutTime, shift.StartTime, shift.EndTime)
+	// 1. Ngày làm việc là ngày bắt đầu ca
+	workDate := time.Date(shiftStart.Year(), shiftStart.Month(), shiftStart.Day(), 0, 0, 0, 0, shiftStart.Location())
 
 	yearMonth := workDate.Format("2006-01")
 
-	checkInRecord, err := w.attendanceRepo.GetFirstCheckIn(ctx, &domainModel.GetFirstCheckInInput{
-		CompanyID:      companyID,
-		EmployeeID:     employeeID,
-		YearMonth:      yearMonth,
-		ShiftTimeStart: shift.StartTime, // time-of-day
-		ShiftTimeEnd:   shift.EndTime,   // time-of-day
-		DateCheckOut:   checkOutTime,
+	// Lấy toàn bộ lần chấm công trong cửa sổ ca (cho phép check-in sớm trước giờ bắt đầu ca)
+	punches, err := w.attendanceRepo.GetAttendancePunches(ctx, &domainModel.GetAttendancePunchesInput{
+		CompanyID:  companyID,
+		EmployeeID: employeeID,
+		From:       shiftStart.Add(-punchWindowBeforeShift),
+		To:         checkOutTime,
 	})
 	if err != nil {
 		return nil, err
 	}
+	// Áp dụng giờ vào/ra của yêu cầu điều chỉnh đã được duyệt (nếu có)
+	correction, err := w.correctionRepo.GetApprovedCorrection(ctx, &domainModel.GetApprovedCorrectionInput{
+		CompanyID:  companyID,
+		EmployeeID: employeeID,
+		WorkDate:   workDate,
+	})
+	if err != nil {
+		w.logger.Warn("get approved correction, skip applying", "employeeID", employeeID, "workDate", workDate, "error", err)
+	} else if correction != nil {
+		punches = applyCorrection(punches, correction.ProposedCheckIn, correction.ProposedCheckOut)
+	}
+	workIntervals, unpairedPunches := pairPunches(punches)
 
-	// Nếu không có check-in → tạo summary vắng mặt (tuỳ yêu cầu)
-	if checkInRecord == nil {
+	// Thời gian làm việc theo lịch của ca (đã trừ thời gian nghỉ)
+	scheduledMinutes := calculateScheduledMinutes(shiftStart, shiftEnd, shift.BreakDurationMinutes)
+	// Ca linh hoạt: thời gian yêu cầu thay cho thời lượng ca
+	if shift.IsFlexible && shift.RequiredWorkMinutes > 0 {
+		scheduledMinutes = shift.RequiredWorkMinutes
+	}
+
+	// Các khoảng làm việc đã ghép cặp đầy đủ check-in/check-out
+	var (
+		actualCheckIn, actualCheckOut time.Time
+		totalWorkMinutes, gapMinutes  int
+		lastPairedCheckOut            time.Time
+	)
+	for _, interval := range workIntervals {
+		if interval.CheckIn == nil || interval.CheckOut == nil {
+			continue
+		}
+		if actualCheckIn.IsZero() {
+			actualCheckIn = *interval.CheckIn
+		} else {
+			gapMinutes += int(interval.CheckIn.Sub(lastPairedCheckOut).Minutes())
+		}
+		lastPairedCheckOut = *interval.CheckOut
+		actualCheckOut = *interval.CheckOut
+		totalWorkMinutes += interval.Minutes
+	}
+
+	// Không có cặp check-in/check-out nào → tạo summary vắng mặt,
+	// hoặc nghỉ lễ/nghỉ phép nếu ngày làm việc là ngày nghỉ hợp lệ
+	if actualCheckIn.IsZero() {
+		attendanceStatus, notes, err := resolveNoShowStatus(ctx, w.userRepo, companyID, employeeID, workDate, "Absent: no check-in")
+		if err != nil {
+			w.logger.Warn("get employee day off, fallback to absent", "employeeID", employeeID, "workDate", workDate, "error", err)
+		}
+		if attendanceStatus != domainModel.StatusAbsent {
+			scheduledMinutes = 0
+		}
+		if unpairedPunches > 0 {
+			notes += " | Unpaired punches: " + strconv.Itoa(unpairedPunches)
+		}
+		if correction != nil {
+			notes += " | Corrected by request " + correction.RequestID.String()
+		}
+		var lastPunchTime time.Time
+		if len(punches) > 0 {
+			lastPunchTime = punches[len(punches)-1].RecordTime
+		}
 		return &domainModel.AddDailySummariesInput{
 			CompanyID:         companyID,
 			SummaryMonth:      yearMonth,
@@ -146,54 +223,72 @@ func (w *AttendanceServiceWorker) calculateDailySummary(
 			EmployeeID:        employeeID,
 			ShiftID:           shift.ShiftID,
 			ActualCheckIn:     time.Time{},
-			ActualCheckOut:    checkOutTime,
-			AttendanceStatus:  domainModel.StatusAbsent,
+			ActualCheckOut:    lastPunchTime,
+			AttendanceStatus:  attendanceStatus,
 			LateMinutes:       0,
 			EarlyLeaveMinutes: 0,
 			TotalWorkMinutes:  0,
-			Notes:             "Absent: no check-in",
+			ScheduledMinutes:  scheduledMinutes,
+			WorkIntervals:     workIntervals,
+			UnpairedPunches:   unpairedPunches,
+			Notes:             notes,
 			UpdatedAt:         time.Now().UTC(),
 		}, nil
 	}
 
-	actualCheckIn := checkInRecord.RecordTime
+	// Mốc tính đi muộn/về sớm: ca cố định dùng giờ bắt đầu/kết thúc ca,
+	// ca linh hoạt chỉ dùng giờ lõi (không có giờ lõi thì không tính đi muộn/về sớm)
+	lateRef, earlyRef := shiftStart, shiftEnd
+	checkLateEarly := true
+	if shift.IsFlexible {
+		if shift.CoreStartTime != nil && shift.CoreEndTime != nil {
+			lateRef, earlyRef = buildCoreBounds(shiftStart, *shift.CoreStartTime, *shift.CoreEndTime)
+		} else {
+			checkLateEarly = false
+		}
+	}
 
 	// 2. Lateness
-	grace := time.Duration(shift.GracePeriodMinutes) * time.Minute
-	lateness := actualCheckIn.Sub(shiftStart) - grace
-	if lateness < 0 {
-		lateness = 0
+	lateMinutes := 0
+	if checkLateEarly {
+		grace := time.Duration(shift.GracePeriodMinutes) * time.Minute
+		lateness := actualCheckIn.Sub(lateRef) - grace
+		if lateness < 0 {
+			lateness = 0
+		}
+		lateMinutes = int(lateness.Minutes())
 	}
-	lateMinutes := int(lateness.Minutes())
 
 	// 3. Early leave
-	allowedEarly := time.Duration(shift.EarlyDepartureMinutes) * time.Minute
-	earlyLeave := shiftEnd.Sub(checkOutTime) - allowedEarly
-	if earlyLeave < 0 {
-		earlyLeave = 0
+	earlyLeaveMinutes := 0
+	if checkLateEarly {
+		allowedEarly := time.Duration(shift.EarlyDepartureMinutes) * time.Minute
+		earlyLeave := earlyRef.Sub(actualCheckOut) - allowedEarly
+		if earlyLeave < 0 {
+			earlyLeave = 0
+		}
+		earlyLeaveMinutes = int(earlyLeave.Minutes())
 	}
-	earlyLeaveMinutes := int(earlyLeave.Minutes())
 
-	// 4. Total work
-	totalWorkMinutes := int(checkOutTime.Sub(actualCheckIn).Minutes())
-	if totalWorkMinutes < 0 {
-		totalWorkMinutes = 0
+	// 4. Break: thời gian ra ngoài giữa các cặp chấm công đã được tính là nghỉ,
+	//    chỉ trừ phần nghỉ theo ca còn lại và tối đa bằng thời gian làm việc thực tế
+	breakMinutes := shift.BreakDurationMinutes - gapMinutes
+	if breakMinutes < 0 {
+		breakMinutes = 0
 	}
-
-	// 5. Overtime (nếu cần)
-	overtimeMinutes := 0
-	if checkOutTime.After(shiftEnd) {
-		overtimeMinutes = int(checkOutTime.Sub(shiftEnd).Minutes())
+	if breakMinutes > totalWorkMinutes {
+		breakMinutes = totalWorkMinutes
 	}
-	_ = overtimeMinutes // currently not used
+	netWorkMinutes := totalWorkMinutes - breakMinutes
 
-	// 6. Scheduled minutes
-	scheduledMinutes := int(shiftEnd.Sub(shiftStart).Minutes())
-	if scheduledMinutes < 0 {
-		scheduledMinutes = 0
+	// 5. Overtime
+	overtimeAfterMinutes := shift.OvertimeAfterMinutes
+	if shift.IsFlexible && overtimeAfterMinutes <= 0 {
+		overtimeAfterMinutes = shift.RequiredWorkMinutes
 	}
+	overtimeMinutes := calculateOvertimeMinutes(netWorkMinutes, actualCheckOut, shiftEnd, overtimeAfterMinutes)
 
-	// 7. Attendance status
+	// 6. Attendance status
 	var attendanceStatus int
 	switch {
 	case lateMinutes > 0 && earlyLeaveMinutes > 0:
@@ -205,16 +300,20 @@ func (w *AttendanceServiceWorker) calculateDailySummary(
 	default:
 		attendanceStatus = domainModel.StatusPresent
 	}
+	// Ca linh hoạt: trạng thái phụ thuộc vào việc đủ thời gian làm việc yêu cầu
+	if shift.IsFlexible && netWorkMinutes < scheduledMinutes {
+		attendanceStatus = domainModel.StatusInsufficientHours
+	}
 
-	// Edge: inconsistent times
-	notes := buildAttendanceNotes(lateMinutes, earlyLeaveMinutes, totalWorkMinutes, shift)
-	if actualCheckIn.After(checkOutTime) {
-		attendanceStatus = domainModel.StatusAbsent
-		notes += " | Inconsistent timestamps (check-in after check-out)"
-		totalWorkMinutes = 0
+	notes := buildAttendanceNotes(lateMinutes, earlyLeaveMinutes, netWorkMinutes, scheduledMinutes, overtimeMinutes)
+	if unpairedPunches > 0 {
+		notes += " | Unpaired punches: " + strconv.Itoa(unpairedPunches)
+	}
+	if correction != nil {
+		notes += " | Corrected by request " + correction.RequestID.String()
 	}
 
-	// 8. Trả về
+	// 7. Trả về
 	return &domainModel.AddDailySummariesInput{
 		CompanyID:         companyID,
 		SummaryMonth:      yearMonth,
@@ -222,42 +321,175 @@ func (w *AttendanceServiceWorker) calculateDailySummary(
 		EmployeeID:        employeeID,
 		ShiftID:           shift.ShiftID,
 		ActualCheckIn:     actualCheckIn,
-		ActualCheckOut:    checkOutTime,
+		ActualCheckOut:    actualCheckOut,
 		AttendanceStatus:  attendanceStatus,
 		LateMinutes:       lateMinutes,
 		EarlyLeaveMinutes: earlyLeaveMinutes,
 		TotalWorkMinutes:  totalWorkMinutes,
-		// OvertimeMinutes:   overtimeMinutes,  // (thêm field nếu model hỗ trợ)
-		// ScheduledMinutes:  scheduledMinutes, // (thêm field nếu model hỗ trợ)
+		ScheduledMinutes:  scheduledMinutes,
+		BreakMinutes:      breakMinutes,
+		OvertimeMinutes:   overtimeMinutes,
+		NetWorkMinutes:    netWorkMinutes,
+		WorkIntervals:     workIntervals,
+		UnpairedPunches:   unpairedPunches,
 		Notes:             notes,
 		UpdatedAt:         time.Now().UTC(),
 	}, nil
 }
 
-// buildShiftBounds: chuẩn hóa xử lý ca qua đêm
-func buildShiftBounds(workDate, checkOut time.Time, startTimeOfDay, endTimeOfDay time.Time) (time.Time, time.Time) {
-	// Giờ/phút/giây:
-	shStartH, shStartM, shStartS := startTimeOfDay.Hour(), startTimeOfDay.Minute(), startTimeOfDay.Second()
-	shEndH, shEndM, shEndS := endTimeOfDay.Hour(), endTimeOfDay.Minute(), endTimeOfDay.Second()
-
-	// Giả định workDate là ngày bắt đầu ca
-	year, month, day := workDate.Date()
-	loc := workDate.Location()
+// buildShiftBoundsForRecord dựng shiftStart/shiftEnd của ca chứa thời điểm chấm công,
+// ca qua đêm được tính bắt đầu từ ngày hôm trước nếu thời điểm chấm công nằm sau nửa đêm
+func buildShiftBoundsForRecord(recordTime time.Time, startTimeOfDay, endTimeOfDay time.Time) (time.Time, time.Time) {
+	year, month, day := recordTime.Date()
+	loc := recordTime.Location()
 
-	start := time.Date(year, month, day, shStartH, shStartM, shStartS, 0, loc)
-	end := time.Date(year, month, day, shEndH, shEndM, shEndS, 0, loc)
+	start := time.Date(year, month, day, startTimeOfDay.Hour(), startTimeOfDay.Minute(), startTimeOfDay.Second(), 0, loc)
+	end := time.Date(year, month, day, endTimeOfDay.Hour(), endTimeOfDay.Minute(), endTimeOfDay.Second(), 0, loc)
 
 	// Ca qua đêm
 	if end.Before(start) {
-		end = end.Add(24 * time.Hour)
-		// Nếu checkOut thuộc ngày tiếp theo và workDate = ngày của start thì không đổi.
+		if recordTime.Before(start) {
+			start = start.Add(-24 * time.Hour)
+		} else {
+			end = end.Add(24 * time.Hour)
+		}
 	}
 
 	return start, end
 }
 
+// pairPunches ghép các lần chấm công (đã sắp xếp theo thời gian) thành các khoảng làm việc.
+// Check-in không có check-out tương ứng hoặc check-out không có check-in trước đó
+// được giữ lại dưới dạng khoảng thiếu một đầu và đếm là chấm công không ghép cặp.
+func pairPunches(punches []domainModel.AttendancePunch) ([]domainModel.WorkInterval, int) {
+	var (
+		intervals []domainModel.WorkInterval
+		openIn    *time.Time
+		lastPunch *domainModel.AttendancePunch
+		unpaired  int
+	)
+	for i := range punches {
+		punch := punches[i]
+		// Bỏ qua lần chấm công lặp (cùng loại, sát nhau) do thiết bị gửi lại
+		if lastPunch != nil && lastPunch.RecordType == punch.RecordType &&
+			punch.RecordTime.Sub(lastPunch.RecordTime) < duplicatePunchWindow {
+			continue
+		}
+		lastPunch = &punches[i]
+
+		recordTime := punch.RecordTime
+		switch punch.RecordType {
+		case domainModel.RecordTypeCheckIn:
+			if openIn != nil {
+				intervals = append(intervals, domainModel.WorkInterval{CheckIn: openIn})
+				unpaired++
+			}
+			openIn = &recordTime
+		case domainModel.RecordTypeCheckOut:
+			if openIn == nil {
+				intervals = append(intervals, domainModel.WorkInterval{CheckOut: &recordTime})
+				unpaired++
+				continue
+			}
+			intervals = append(intervals, domainModel.WorkInterval{
+				CheckIn:  openIn,
+				CheckOut: &recordTime,
+				Minutes:  int(recordTime.Sub(*openIn).Minutes()),
+			})
+			openIn = nil
+		}
+	}
+	if openIn != nil {
+		intervals = append(intervals, domainModel.WorkInterval{CheckIn: openIn})
+		unpaired++
+	}
+	return intervals, unpaired
+}
+
+// applyCorrection thay giờ vào/ra đã được duyệt vào danh sách chấm công (đã sắp xếp) của ca:
+//   - checkIn: bỏ các lần chấm công trước giờ vào mới và các check-in sai trước lần check-out đầu tiên
+//   - checkOut: bỏ các lần chấm công sau giờ ra mới và các check-out sai sau lần check-in cuối cùng
+func applyCorrection(punches []domainModel.AttendancePunch, checkIn, checkOut *time.Time) []domainModel.AttendancePunch {
+	result := make([]domainModel.AttendancePunch, 0, len(punches)+2)
+	if checkIn != nil {
+		result = append(result, domainModel.AttendancePunch{RecordTime: *checkIn, RecordType: domainModel.RecordTypeCheckIn})
+		beforeFirstCheckOut := true
+		for _, punch := range punches {
+			if !punch.RecordTime.After(*checkIn) {
+				continue
+			}
+			if beforeFirstCheckOut && punch.RecordType == domainModel.RecordTypeCheckIn {
+				continue
+			}
+			beforeFirstCheckOut = false
+			result = append(result, punch)
+		}
+	} else {
+		result = append(result, punches...)
+	}
+	if checkOut != nil {
+		kept := result[:0]
+		for _, punch := range result {
+			if punch.RecordTime.Before(*checkOut) {
+				kept = append(kept, punch)
+			}
+		}
+		for len(kept) > 0 && kept[len(kept)-1].RecordType == domainModel.RecordTypeCheckOut {
+			kept = kept[:len(kept)-1]
+		}
+		result = append(kept, domainModel.AttendancePunch{RecordTime: *checkOut, RecordType: domainModel.RecordTypeCheckOut})
+	}
+	return result
+}
+
+// buildCoreBounds dựng giờ lõi (core hours) của ca linh hoạt theo ngày bắt đầu ca
+func buildCoreBounds(shiftStart time.Time, coreStartOfDay, coreEndOfDay time.Time) (time.Time, time.Time) {
+	year, month, day := shiftStart.Date()
+	loc := shiftStart.Location()
+
+	coreStart := time.Date(year, month, day, coreStartOfDay.Hour(), coreStartOfDay.Minute(), coreStartOfDay.Second(), 0, loc)
+	// Giờ lõi nằm sau nửa đêm của ca qua đêm
+	if coreStart.Before(shiftStart) {
+		coreStart = coreStart.Add(24 * time.Hour)
+	}
+	coreEnd := time.Date(coreStart.Year(), coreStart.Month(), coreStart.Day(), coreEndOfDay.Hour(), coreEndOfDay.Minute(), coreEndOfDay.Second(), 0, loc)
+	if coreEnd.Before(coreStart) {
+		coreEnd = coreEnd.Add(24 * time.Hour)
+	}
+
+	return coreStart, coreEnd
+}
+
+// calculateScheduledMinutes tính thời gian làm việc theo lịch của ca (trừ thời gian nghỉ)
+func calculateScheduledMinutes(shiftStart, shiftEnd time.Time, breakDurationMinutes int) int {
+	scheduledMinutes := int(shiftEnd.Sub(shiftStart).Minutes())
+	if breakDurationMinutes > 0 {
+		scheduledMinutes -= breakDurationMinutes
+	}
+	if scheduledMinutes < 0 {
+		scheduledMinutes = 0
+	}
+	return scheduledMinutes
+}
+
+// calculateOvertimeMinutes tính thời gian tăng ca.
+// Nếu ca có overtime_after_minutes thì tăng ca là phần thời gian làm việc thực (đã trừ nghỉ)
+// vượt quá ngưỡng này, ngược lại là phần thời gian check-out sau giờ kết thúc ca.
+func calculateOvertimeMinutes(netWorkMinutes int, checkOutTime, shiftEnd time.Time, overtimeAfterMinutes int) int {
+	if overtimeAfterMinutes > 0 {
+		if netWorkMinutes > overtimeAfterMinutes {
+			return netWorkMinutes - overtimeAfterMinutes
+		}
+		return 0
+	}
+	if checkOutTime.After(shiftEnd) {
+		return int(checkOutTime.Sub(shiftEnd).Minutes())
+	}
+	return 0
+}
+
 // buildAttendanceNotes tạo ghi chú cho bản tổng hợp chấm công
-func buildAttendanceNotes(lateMinutes, earlyLeaveMinutes, totalWorkMinutes int, shift domainModel.ShiftTimeEmployee) string {
+func buildAttendanceNotes(lateMinutes, earlyLeaveMinutes, netWorkMinutes, scheduledMinutes, overtimeMinutes int) string {
 	var notes string
 
 	if lateMinutes > 0 {
@@ -266,14 +498,11 @@ func buildAttendanceNotes(lateMinutes, earlyLeaveMinutes, totalWorkMinutes int,
 	if earlyLeaveMinutes > 0 {
 		notes += "Early leave: " + strconv.Itoa(earlyLeaveMinutes) + " minutes. "
 	}
-
-	// Tính thời gian ca làm việc tiêu chuẩn
-	standardWorkMinutes := int(shift.EndTime.Sub(shift.StartTime).Minutes())
-	if standardWorkMinutes < 0 {
-		standardWorkMinutes += 24 * 60 // Ca đêm
+	if overtimeMinutes > 0 {
+		notes += "Overtime: " + strconv.Itoa(overtimeMinutes) + " minutes. "
 	}
 
-	notes += "Total work: " + strconv.Itoa(totalWorkMinutes) + "/" + strconv.Itoa(standardWorkMinutes) + " minutes."
+	notes += "Total work: " + strconv.Itoa(netWorkMinutes) + "/" + strconv.Itoa(scheduledMinutes) + " minutes."
 
 	return notes
 }
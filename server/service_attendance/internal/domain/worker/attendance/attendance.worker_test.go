@@ -0,0 +1,517 @@
+package attendance
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/model"
+	domainRepo "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/repository"
+)
+
+// ============================================
+// Fake repository / logger cho test tính tổng hợp chấm công
+// ============================================
+
+type fakeAttendanceRepo struct {
+	domainRepo.IAttendanceRepository
+	punches []domainModel.AttendancePunch
+}
+
+func (r *fakeAttendanceRepo) GetAttendancePunches(ctx context.Context, input *domainModel.GetAttendancePunchesInput) ([]domainModel.AttendancePunch, error) {
+	var out []domainModel.AttendancePunch
+	for _, punch := range r.punches {
+		if punch.RecordTime.Before(input.From) || punch.RecordTime.After(input.To) {
+			continue
+		}
+		out = append(out, punch)
+	}
+	return out, nil
+}
+
+type fakeCorrectionRepo struct {
+	domainRepo.ICorrectionRepository
+	correction *domainModel.AttendanceCorrection
+}
+
+func (r *fakeCorrectionRepo) GetApprovedCorrection(ctx context.Context, input *domainModel.GetApprovedCorrectionInput) (*domainModel.AttendanceCorrection, error) {
+	return r.correction, nil
+}
+
+type fakeUserRepo struct {
+	domainRepo.IUserRepository
+}
+
+func (r *fakeUserRepo) GetEmployeeDayOff(ctx context.Context, input *domainModel.GetEmployeeDayOffInput) (*domainModel.EmployeeDayOff, error) {
+	return nil, nil
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Info(msg string, fields ...interface{})  {}
+func (nopLogger) Error(msg string, fields ...interface{}) {}
+func (nopLogger) Warn(msg string, fields ...interface{})  {}
+func (nopLogger) Panic(msg string, fields ...interface{}) {}
+func (nopLogger) Fatal(msg string, fields ...interface{}) {}
+
+func newTestWorker(punches []domainModel.AttendancePunch, correction *domainModel.AttendanceCorrection) *AttendanceServiceWorker {
+	return &AttendanceServiceWorker{
+		logger:         nopLogger{},
+		attendanceRepo: &fakeAttendanceRepo{punches: punches},
+		userRepo:       &fakeUserRepo{},
+		correctionRepo: &fakeCorrectionRepo{correction: correction},
+	}
+}
+
+// at trả về thời điểm trong ngày 2025-11-24 (UTC), dayOffset để dựng ca qua đêm
+func at(dayOffset, hour, minute int) time.Time {
+	return time.Date(2025, 11, 24+dayOffset, hour, minute, 0, 0, time.UTC)
+}
+
+func timeOfDay(hour, minute int) time.Time {
+	return time.Date(0, 1, 1, hour, minute, 0, 0, time.UTC)
+}
+
+func timeOfDayPtr(hour, minute int) *time.Time {
+	t := timeOfDay(hour, minute)
+	return &t
+}
+
+func punch(t time.Time, recordType int) domainModel.AttendancePunch {
+	return domainModel.AttendancePunch{RecordTime: t, RecordType: recordType}
+}
+
+// ============================================
+// Test tăng ca, đi muộn (thời gian ân hạn) và về sớm
+// ============================================
+
+func TestCalculateOvertimeMinutes(t *testing.T) {
+	cases := []struct {
+		name           string
+		netWorkMinutes int
+		checkOut       time.Time
+		shiftEnd       time.Time
+		overtimeAfter  int
+		want           int
+	}{
+		{"checkout after shift end", 480, at(0, 18, 30), at(0, 17, 0), 0, 90},
+		{"checkout before shift end", 420, at(0, 16, 0), at(0, 17, 0), 0, 0},
+		{"checkout exactly at shift end", 480, at(0, 17, 0), at(0, 17, 0), 0, 0},
+		{"net work above threshold", 540, at(0, 18, 0), at(0, 17, 0), 480, 60},
+		{"net work below threshold ignores late checkout", 450, at(0, 19, 0), at(0, 17, 0), 480, 0},
+		{"overnight checkout after shift end", 480, at(1, 6, 45), at(1, 6, 0), 0, 45},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := calculateOvertimeMinutes(tc.netWorkMinutes, tc.checkOut, tc.shiftEnd, tc.overtimeAfter); got != tc.want {
+				t.Fatalf("calculateOvertimeMinutes() = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCalculateDailySummaryLateEarlyOvertime(t *testing.T) {
+	dayShift := domainModel.ShiftTimeEmployee{
+		ShiftID:               uuid.New(),
+		StartTime:             timeOfDay(8, 0),
+		EndTime:               timeOfDay(17, 0),
+		GracePeriodMinutes:    10,
+		EarlyDepartureMinutes: 5,
+		BreakDurationMinutes:  60,
+	}
+	nightShift := dayShift
+	nightShift.StartTime = timeOfDay(22, 0)
+	nightShift.EndTime = timeOfDay(6, 0)
+	overtimeAfterShift := dayShift
+	overtimeAfterShift.OvertimeAfterMinutes = 480
+
+	cases := []struct {
+		name         string
+		shift        domainModel.ShiftTimeEmployee
+		checkIn      time.Time
+		checkOut     time.Time
+		wantWorkDate time.Time
+		wantStatus   int
+		wantLate     int
+		wantEarly    int
+		wantOvertime int
+		wantNet      int
+	}{
+		{"late within grace period", dayShift, at(0, 8, 9), at(0, 17, 0), at(0, 0, 0), domainModel.StatusPresent, 0, 0, 0, 471},
+		{"late beyond grace period", dayShift, at(0, 8, 25), at(0, 17, 0), at(0, 0, 0), domainModel.StatusLate, 15, 0, 0, 455},
+		{"early leave beyond allowance", dayShift, at(0, 8, 0), at(0, 16, 50), at(0, 0, 0), domainModel.StatusEarlyLeave, 0, 5, 0, 470},
+		{"late and early leave", dayShift, at(0, 8, 30), at(0, 16, 30), at(0, 0, 0), domainModel.StatusLateAndEarlyLeave, 20, 25, 0, 420},
+		{"overtime after shift end", dayShift, at(0, 8, 0), at(0, 18, 30), at(0, 0, 0), domainModel.StatusPresent, 0, 0, 90, 570},
+		{"overtime after threshold", overtimeAfterShift, at(0, 8, 0), at(0, 18, 0), at(0, 0, 0), domainModel.StatusPresent, 0, 0, 60, 540},
+		{"overnight shift", nightShift, at(0, 22, 5), at(1, 6, 30), at(0, 0, 0), domainModel.StatusPresent, 0, 0, 30, 445},
+		{"overnight shift late", nightShift, at(0, 22, 40), at(1, 6, 0), at(0, 0, 0), domainModel.StatusLate, 30, 0, 0, 380},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := newTestWorker([]domainModel.AttendancePunch{
+				punch(tc.checkIn, domainModel.RecordTypeCheckIn),
+				punch(tc.checkOut, domainModel.RecordTypeCheckOut),
+			}, nil)
+			got, err := w.calculateDailySummaryForCheckout(context.Background(), uuid.New(), uuid.New(), tc.checkOut, tc.shift)
+			if err != nil {
+				t.Fatalf("calculateDailySummaryForCheckout() error: %v", err)
+			}
+			if !got.WorkDate.Equal(tc.wantWorkDate) {
+				t.Errorf("WorkDate = %v, want %v", got.WorkDate, tc.wantWorkDate)
+			}
+			if got.AttendanceStatus != tc.wantStatus {
+				t.Errorf("AttendanceStatus = %d, want %d", got.AttendanceStatus, tc.wantStatus)
+			}
+			if got.LateMinutes != tc.wantLate {
+				t.Errorf("LateMinutes = %d, want %d", got.LateMinutes, tc.wantLate)
+			}
+			if got.EarlyLeaveMinutes != tc.wantEarly {
+				t.Errorf("EarlyLeaveMinutes = %d, want %d", got.EarlyLeaveMinutes, tc.wantEarly)
+			}
+			if got.OvertimeMinutes != tc.wantOvertime {
+				t.Errorf("OvertimeMinutes = %d, want %d", got.OvertimeMinutes, tc.wantOvertime)
+			}
+			if got.NetWorkMinutes != tc.wantNet {
+				t.Errorf("NetWorkMinutes = %d, want %d", got.NetWorkMinutes, tc.wantNet)
+			}
+		})
+	}
+}
+
+// ============================================
+// Test giờ lõi của ca linh hoạt (qua nửa đêm)
+// ============================================
+
+func TestBuildCoreBounds(t *testing.T) {
+	cases := []struct {
+		name       string
+		shiftStart time.Time
+		coreStart  time.Time
+		coreEnd    time.Time
+		wantStart  time.Time
+		wantEnd    time.Time
+	}{
+		{"day shift", at(0, 7, 0), timeOfDay(10, 0), timeOfDay(15, 0), at(0, 10, 0), at(0, 15, 0)},
+		{"overnight shift, core before midnight", at(0, 20, 0), timeOfDay(21, 0), timeOfDay(23, 30), at(0, 21, 0), at(0, 23, 30)},
+		{"overnight shift, core across midnight", at(0, 20, 0), timeOfDay(23, 0), timeOfDay(3, 0), at(0, 23, 0), at(1, 3, 0)},
+		{"overnight shift, core after midnight", at(0, 20, 0), timeOfDay(1, 0), timeOfDay(5, 0), at(1, 1, 0), at(1, 5, 0)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotStart, gotEnd := buildCoreBounds(tc.shiftStart, tc.coreStart, tc.coreEnd)
+			if !gotStart.Equal(tc.wantStart) || !gotEnd.Equal(tc.wantEnd) {
+				t.Fatalf("buildCoreBounds() = (%v, %v), want (%v, %v)", gotStart, gotEnd, tc.wantStart, tc.wantEnd)
+			}
+		})
+	}
+}
+
+// Khoảng chấm công của ca dùng để giới hạn giờ đề xuất của yêu cầu điều chỉnh
+func TestPunchWindow(t *testing.T) {
+	w := &AttendanceServiceWorker{}
+	cases := []struct {
+		name     string
+		shift    domainModel.ShiftTimeEmployee
+		wantFrom time.Time
+		wantTo   time.Time
+	}{
+		{"day shift", domainModel.ShiftTimeEmployee{StartTime: timeOfDay(8, 0), EndTime: timeOfDay(17, 0)}, at(0, 7, 0), at(0, 23, 0)},
+		{"overnight shift", domainModel.ShiftTimeEmployee{StartTime: timeOfDay(22, 0), EndTime: timeOfDay(6, 0)}, at(0, 21, 0), at(1, 12, 0)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotFrom, gotTo := w.PunchWindow(at(0, 0, 0), tc.shift)
+			if !gotFrom.Equal(tc.wantFrom) || !gotTo.Equal(tc.wantTo) {
+				t.Fatalf("PunchWindow() = (%v, %v), want (%v, %v)", gotFrom, gotTo, tc.wantFrom, tc.wantTo)
+			}
+		})
+	}
+}
+
+func TestCalculateDailySummaryOvernightFlexibleShift(t *testing.T) {
+	shift := domainModel.ShiftTimeEmployee{
+		ShiftID:             uuid.New(),
+		StartTime:           timeOfDay(20, 0),
+		EndTime:             timeOfDay(8, 0),
+		IsFlexible:          true,
+		CoreStartTime:       timeOfDayPtr(23, 0),
+		CoreEndTime:         timeOfDayPtr(3, 0),
+		RequiredWorkMinutes: 480,
+	}
+	cases := []struct {
+		name         string
+		checkIn      time.Time
+		checkOut     time.Time
+		wantStatus   int
+		wantLate     int
+		wantEarly    int
+		wantOvertime int
+	}{
+		{"late to core hours, enough minutes", at(0, 23, 10), at(1, 7, 30), domainModel.StatusLate, 10, 0, 20},
+		{"within core hours, enough minutes", at(0, 21, 0), at(1, 5, 0), domainModel.StatusPresent, 0, 0, 0},
+		{"left before core end", at(0, 23, 0), at(1, 2, 0), domainModel.StatusInsufficientHours, 0, 60, 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := newTestWorker([]domainModel.AttendancePunch{
+				punch(tc.checkIn, domainModel.RecordTypeCheckIn),
+				punch(tc.checkOut, domainModel.RecordTypeCheckOut),
+			}, nil)
+			got, err := w.calculateDailySummaryForCheckout(context.Background(), uuid.New(), uuid.New(), tc.checkOut, shift)
+			if err != nil {
+				t.Fatalf("calculateDailySummaryForCheckout() error: %v", err)
+			}
+			if !got.WorkDate.Equal(at(0, 0, 0)) {
+				t.Errorf("WorkDate = %v, want %v", got.WorkDate, at(0, 0, 0))
+			}
+			if got.AttendanceStatus != tc.wantStatus {
+				t.Errorf("AttendanceStatus = %d, want %d", got.AttendanceStatus, tc.wantStatus)
+			}
+			if got.LateMinutes != tc.wantLate || got.EarlyLeaveMinutes != tc.wantEarly {
+				t.Errorf("late/early = %d/%d, want %d/%d", got.LateMinutes, got.EarlyLeaveMinutes, tc.wantLate, tc.wantEarly)
+			}
+			if got.OvertimeMinutes != tc.wantOvertime {
+				t.Errorf("OvertimeMinutes = %d, want %d", got.OvertimeMinutes, tc.wantOvertime)
+			}
+		})
+	}
+}
+
+// ============================================
+// Test ghép cặp check-in/check-out
+// ============================================
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestPairPunches(t *testing.T) {
+	in, out := domainModel.RecordTypeCheckIn, domainModel.RecordTypeCheckOut
+	cases := []struct {
+		name         string
+		punches      []domainModel.AttendancePunch
+		want         []domainModel.WorkInterval
+		wantUnpaired int
+	}{
+		{"no punches", nil, nil, 0},
+		{
+			"single pair",
+			[]domainModel.AttendancePunch{punch(at(0, 8, 0), in), punch(at(0, 17, 0), out)},
+			[]domainModel.WorkInterval{{CheckIn: ptr(at(0, 8, 0)), CheckOut: ptr(at(0, 17, 0)), Minutes: 540}},
+			0,
+		},
+		{
+			"split shift",
+			[]domainModel.AttendancePunch{punch(at(0, 8, 0), in), punch(at(0, 12, 0), out), punch(at(0, 13, 0), in), punch(at(0, 17, 0), out)},
+			[]domainModel.WorkInterval{
+				{CheckIn: ptr(at(0, 8, 0)), CheckOut: ptr(at(0, 12, 0)), Minutes: 240},
+				{CheckIn: ptr(at(0, 13, 0)), CheckOut: ptr(at(0, 17, 0)), Minutes: 240},
+			},
+			0,
+		},
+		{
+			"overnight pair",
+			[]domainModel.AttendancePunch{punch(at(0, 22, 0), in), punch(at(1, 6, 0), out)},
+			[]domainModel.WorkInterval{{CheckIn: ptr(at(0, 22, 0)), CheckOut: ptr(at(1, 6, 0)), Minutes: 480}},
+			0,
+		},
+		{
+			"duplicate check-in from device is ignored",
+			[]domainModel.AttendancePunch{punch(at(0, 8, 0), in), punch(at(0, 8, 1), in), punch(at(0, 12, 0), out)},
+			[]domainModel.WorkInterval{{CheckIn: ptr(at(0, 8, 0)), CheckOut: ptr(at(0, 12, 0)), Minutes: 240}},
+			0,
+		},
+		{
+			"check-in without check-out before next check-in",
+			[]domainModel.AttendancePunch{punch(at(0, 8, 0), in), punch(at(0, 9, 0), in), punch(at(0, 12, 0), out)},
+			[]domainModel.WorkInterval{
+				{CheckIn: ptr(at(0, 8, 0))},
+				{CheckIn: ptr(at(0, 9, 0)), CheckOut: ptr(at(0, 12, 0)), Minutes: 180},
+			},
+			1,
+		},
+		{
+			"check-out without check-in",
+			[]domainModel.AttendancePunch{punch(at(0, 8, 0), out), punch(at(0, 9, 0), in), punch(at(0, 12, 0), out)},
+			[]domainModel.WorkInterval{
+				{CheckOut: ptr(at(0, 8, 0))},
+				{CheckIn: ptr(at(0, 9, 0)), CheckOut: ptr(at(0, 12, 0)), Minutes: 180},
+			},
+			1,
+		},
+		{
+			"trailing check-in",
+			[]domainModel.AttendancePunch{punch(at(0, 8, 0), in), punch(at(0, 12, 0), out), punch(at(0, 13, 0), in)},
+			[]domainModel.WorkInterval{
+				{CheckIn: ptr(at(0, 8, 0)), CheckOut: ptr(at(0, 12, 0)), Minutes: 240},
+				{CheckIn: ptr(at(0, 13, 0))},
+			},
+			1,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, unpaired := pairPunches(tc.punches)
+			if unpaired != tc.wantUnpaired {
+				t.Errorf("unpaired = %d, want %d", unpaired, tc.wantUnpaired)
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("len(intervals) = %d, want %d", len(got), len(tc.want))
+			}
+			for i := range got {
+				if !sameTime(got[i].CheckIn, tc.want[i].CheckIn) || !sameTime(got[i].CheckOut, tc.want[i].CheckOut) || got[i].Minutes != tc.want[i].Minutes {
+					t.Errorf("interval[%d] = %+v, want %+v", i, got[i], tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateDailySummaryUnpairedPunches(t *testing.T) {
+	shift := domainModel.ShiftTimeEmployee{
+		ShiftID:   uuid.New(),
+		StartTime: timeOfDay(8, 0),
+		EndTime:   timeOfDay(17, 0),
+	}
+
+	// Chỉ có check-out: không có cặp nào → vắng mặt, vẫn ghi nhận số lần chấm công lẻ
+	w := newTestWorker([]domainModel.AttendancePunch{punch(at(0, 17, 0), domainModel.RecordTypeCheckOut)}, nil)
+	got, err := w.calculateDailySummaryForCheckout(context.Background(), uuid.New(), uuid.New(), at(0, 17, 0), shift)
+	if err != nil {
+		t.Fatalf("calculateDailySummaryForCheckout() error: %v", err)
+	}
+	if got.AttendanceStatus != domainModel.StatusAbsent || got.UnpairedPunches != 1 {
+		t.Fatalf("status/unpaired = %d/%d, want %d/1", got.AttendanceStatus, got.UnpairedPunches, domainModel.StatusAbsent)
+	}
+
+	// Check-in bị thiếu check-out ở giữa ca: chỉ tính các cặp đầy đủ
+	w = newTestWorker([]domainModel.AttendancePunch{
+		punch(at(0, 8, 0), domainModel.RecordTypeCheckIn),
+		punch(at(0, 13, 0), domainModel.RecordTypeCheckIn),
+		punch(at(0, 17, 0), domainModel.RecordTypeCheckOut),
+	}, nil)
+	got, err = w.calculateDailySummaryForCheckout(context.Background(), uuid.New(), uuid.New(), at(0, 17, 0), shift)
+	if err != nil {
+		t.Fatalf("calculateDailySummaryForCheckout() error: %v", err)
+	}
+	if got.UnpairedPunches != 1 || got.TotalWorkMinutes != 240 || !got.ActualCheckIn.Equal(at(0, 13, 0)) {
+		t.Fatalf("unpaired/total/checkIn = %d/%d/%v, want 1/240/%v", got.UnpairedPunches, got.TotalWorkMinutes, got.ActualCheckIn, at(0, 13, 0))
+	}
+}
+
+// ============================================
+// Test áp dụng giờ vào/ra của yêu cầu điều chỉnh đã duyệt
+// ============================================
+
+func TestApplyCorrection(t *testing.T) {
+	in, out := domainModel.RecordTypeCheckIn, domainModel.RecordTypeCheckOut
+	cases := []struct {
+		name     string
+		punches  []domainModel.AttendancePunch
+		checkIn  *time.Time
+		checkOut *time.Time
+		want     []domainModel.AttendancePunch
+	}{
+		{
+			"no correction keeps punches",
+			[]domainModel.AttendancePunch{punch(at(0, 8, 30), in), punch(at(0, 17, 0), out)},
+			nil, nil,
+			[]domainModel.AttendancePunch{punch(at(0, 8, 30), in), punch(at(0, 17, 0), out)},
+		},
+		{
+			"check-in override",
+			[]domainModel.AttendancePunch{punch(at(0, 8, 30), in), punch(at(0, 17, 0), out)},
+			ptr(at(0, 8, 0)), nil,
+			[]domainModel.AttendancePunch{punch(at(0, 8, 0), in), punch(at(0, 17, 0), out)},
+		},
+		{
+			"check-in override drops wrong check-ins before first check-out",
+			[]domainModel.AttendancePunch{punch(at(0, 8, 10), in), punch(at(0, 8, 20), in), punch(at(0, 12, 0), out), punch(at(0, 13, 0), in), punch(at(0, 17, 0), out)},
+			ptr(at(0, 8, 0)), nil,
+			[]domainModel.AttendancePunch{punch(at(0, 8, 0), in), punch(at(0, 12, 0), out), punch(at(0, 13, 0), in), punch(at(0, 17, 0), out)},
+		},
+		{
+			"check-in override drops punches before new check-in",
+			[]domainModel.AttendancePunch{punch(at(0, 7, 0), in), punch(at(0, 7, 30), out), punch(at(0, 8, 30), in), punch(at(0, 17, 0), out)},
+			ptr(at(0, 8, 0)), nil,
+			[]domainModel.AttendancePunch{punch(at(0, 8, 0), in), punch(at(0, 17, 0), out)},
+		},
+		{
+			"check-out override replaces early check-out",
+			[]domainModel.AttendancePunch{punch(at(0, 8, 0), in), punch(at(0, 16, 0), out)},
+			nil, ptr(at(0, 17, 0)),
+			[]domainModel.AttendancePunch{punch(at(0, 8, 0), in), punch(at(0, 17, 0), out)},
+		},
+		{
+			"check-out override drops punches after new check-out",
+			[]domainModel.AttendancePunch{punch(at(0, 8, 0), in), punch(at(0, 12, 0), out), punch(at(0, 13, 0), in), punch(at(0, 18, 30), out)},
+			nil, ptr(at(0, 17, 0)),
+			[]domainModel.AttendancePunch{punch(at(0, 8, 0), in), punch(at(0, 12, 0), out), punch(at(0, 13, 0), in), punch(at(0, 17, 0), out)},
+		},
+		{
+			"both overrides on a day without punches",
+			nil,
+			ptr(at(0, 8, 0)), ptr(at(0, 17, 0)),
+			[]domainModel.AttendancePunch{punch(at(0, 8, 0), in), punch(at(0, 17, 0), out)},
+		},
+		{
+			"both overrides on overnight shift",
+			[]domainModel.AttendancePunch{punch(at(0, 22, 10), in), punch(at(1, 6, 0), out)},
+			ptr(at(0, 22, 0)), ptr(at(1, 6, 30)),
+			[]domainModel.AttendancePunch{punch(at(0, 22, 0), in), punch(at(1, 6, 30), out)},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := applyCorrection(tc.punches, tc.checkIn, tc.checkOut)
+			if len(got) != len(tc.want) {
+				t.Fatalf("applyCorrection() = %+v, want %+v", got, tc.want)
+			}
+			for i := range got {
+				if !got[i].RecordTime.Equal(tc.want[i].RecordTime) || got[i].RecordType != tc.want[i].RecordType {
+					t.Fatalf("applyCorrection()[%d] = %+v, want %+v", i, got[i], tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateDailySummaryWithCorrection(t *testing.T) {
+	shift := domainModel.ShiftTimeEmployee{
+		ShiftID:              uuid.New(),
+		StartTime:            timeOfDay(8, 0),
+		EndTime:              timeOfDay(17, 0),
+		GracePeriodMinutes:   5,
+		BreakDurationMinutes: 60,
+	}
+	// Nhân viên quên check-out, check-in bị ghi muộn; yêu cầu điều chỉnh đã duyệt sửa cả hai
+	correction := &domainModel.AttendanceCorrection{
+		RequestID:        uuid.New(),
+		ProposedCheckIn:  ptr(at(0, 8, 0)),
+		ProposedCheckOut: ptr(at(0, 17, 0)),
+	}
+	w := newTestWorker([]domainModel.AttendancePunch{punch(at(0, 8, 40), domainModel.RecordTypeCheckIn)}, correction)
+	shiftStart, shiftEnd := buildShiftBoundsForDate(at(0, 0, 0), shift.StartTime, shift.EndTime)
+	got, err := w.calculateDailySummary(context.Background(), uuid.New(), uuid.New(), shiftStart, shiftEnd, shiftEnd.Add(punchWindowAfterShift), shift)
+	if err != nil {
+		t.Fatalf("calculateDailySummary() error: %v", err)
+	}
+	if got.AttendanceStatus != domainModel.StatusPresent || got.LateMinutes != 0 || got.UnpairedPunches != 0 {
+		t.Fatalf("status/late/unpaired = %d/%d/%d, want %d/0/0", got.AttendanceStatus, got.LateMinutes, got.UnpairedPunches, domainModel.StatusPresent)
+	}
+	if !got.ActualCheckIn.Equal(at(0, 8, 0)) || !got.ActualCheckOut.Equal(at(0, 17, 0)) || got.NetWorkMinutes != 480 {
+		t.Fatalf("checkIn/checkOut/net = %v/%v/%d, want %v/%v/480", got.ActualCheckIn, got.ActualCheckOut, got.NetWorkMinutes, at(0, 8, 0), at(0, 17, 0))
+	}
+	if !strings.Contains(got.Notes, correction.RequestID.String()) {
+		t.Fatalf("Notes = %q, want correction request id", got.Notes)
+	}
+}
@@ -0,0 +1,156 @@
+package attendance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/constants"
+	domainConfig "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/config"
+	domainLogger "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/logger"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/model"
+	domainMq "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/mq"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/worker"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/global"
+)
+
+// ============================================
+// Kafka consumer for daily summary
+// ============================================
+
+const (
+	defaultSummaryConsumers      = 1
+	defaultSummaryRetryBackoffMs = 500
+	// Thời gian chờ tối đa giữa hai lần thử lại
+	maxSummaryRetryBackoff = 30 * time.Second
+	// Thời gian chờ trước khi lắng nghe lại topic khi reader bị lỗi
+	summaryConsumerRestartDelay = 5 * time.Second
+)
+
+type DailySummaryConsumerWorker struct {
+	config        domainConfig.SummaryPipelineSetting
+	logger        domainLogger.ILogger
+	reader        domainMq.IKafkaRead
+	writer        domainMq.IKafkaWrite
+	summaryWorker worker.IWorkerAttendanceServiceWorker
+}
+
+// RunDailySummaryConsumer chạy các consumer trong group lắng nghe sự kiện check-out.
+// Offset chỉ được commit sau khi bản tổng hợp đã ghi xong hoặc sự kiện đã chuyển sang dead-letter topic,
+// nên sự kiện chưa xử lý sẽ được đọc lại khi replica khởi động lại. Các consumer dừng khi ctx bị hủy.
+func (w *DailySummaryConsumerWorker) RunDailySummaryConsumer(ctx context.Context) error {
+	if !w.config.Enabled {
+		w.logger.Warn("summary pipeline is disabled, skipping daily summary consumer startup")
+		return nil
+	}
+	numConsumers := w.config.NumConsumers
+	if numConsumers <= 0 {
+		numConsumers = defaultSummaryConsumers
+	}
+	for i := 0; i < numConsumers; i++ {
+		global.WaitGroup.Add(1)
+		go func(consumerID int) {
+			defer global.WaitGroup.Done()
+			for {
+				err := w.reader.ReadListenTopicManual(ctx, constants.KAFKA_TOPIC_ATTENDANCE_CHECKOUT, func(message interface{}) error {
+					return w.handleMessage(ctx, message)
+				})
+				if ctx.Err() != nil {
+					w.logger.Info("daily summary consumer stopped", "consumer number", consumerID)
+					return
+				}
+				w.logger.Error("daily summary consumer stopped, restarting", "consumer number", consumerID, "error", err)
+				select {
+				case <-ctx.Done():
+					w.logger.Info("daily summary consumer stopped", "consumer number", consumerID)
+					return
+				case <-time.After(summaryConsumerRestartDelay):
+				}
+			}
+		}(i)
+	}
+	return nil
+}
+
+// handleMessage xử lý một sự kiện check-out, trả về lỗi khi đang shutdown hoặc không thể chuyển sự kiện sang dead-letter topic
+func (w *DailySummaryConsumerWorker) handleMessage(ctx context.Context, message interface{}) error {
+	value, ok := message.([]byte)
+	if !ok {
+		return w.sendToDeadLetter(ctx, "", nil, fmt.Errorf("unexpected message type %T", message), 0)
+	}
+	var event domainModel.DailySummaryCheckoutEvent
+	if err := json.Unmarshal(value, &event); err != nil {
+		return w.sendToDeadLetter(ctx, "", value, err, 0)
+	}
+
+	backoff := time.Duration(w.config.RetryBackoffMs) * time.Millisecond
+	if backoff <= 0 {
+		backoff = defaultSummaryRetryBackoffMs * time.Millisecond
+	}
+	attempts := w.config.MaxRetries + 1
+	if attempts < 1 {
+		attempts = 1
+	}
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		err = w.summaryWorker.ProcessDailySummaryJob(ctx, event.CompanyID, event.EmployeeID, event.RecordTime, event.Shift)
+		if err == nil {
+			return nil
+		}
+		w.logger.Warn("process daily summary event failed", "employeeID", event.EmployeeID, "recordTime", event.RecordTime, "attempt", attempt, "error", err)
+		if attempt < attempts {
+			// Dừng khi shutdown, không chuyển sang dead-letter, offset chưa commit nên sự kiện được đọc lại
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, maxSummaryRetryBackoff)
+		}
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return w.sendToDeadLetter(ctx, event.EmployeeID.String(), value, err, attempts)
+}
+
+// sendToDeadLetter chuyển sự kiện không xử lý được sang dead-letter topic để xử lý thủ công
+func (w *DailySummaryConsumerWorker) sendToDeadLetter(ctx context.Context, key string, value []byte, cause error, attempts int) error {
+	event := json.RawMessage(value)
+	if !json.Valid(value) {
+		// Giữ nguyên dữ liệu gốc dưới dạng chuỗi JSON
+		event, _ = json.Marshal(string(value))
+	}
+	deadLetter, err := json.Marshal(domainModel.DailySummaryDeadLetter{
+		Event:    event,
+		Error:    cause.Error(),
+		Attempts: attempts,
+		FailedAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	if err := w.writer.WriteMessageRequireAllAck(ctx, constants.KAFKA_TOPIC_ATTENDANCE_CHECKOUT_DLQ, key, deadLetter); err != nil {
+		return errors.Join(cause, err)
+	}
+	w.logger.Error("daily summary event moved to dead-letter topic", "key", key, "attempts", attempts, "error", cause)
+	return nil
+}
+
+func NewDailySummaryConsumerWorker(
+	config domainConfig.SummaryPipelineSetting,
+	logger domainLogger.ILogger,
+	reader domainMq.IKafkaRead,
+	writer domainMq.IKafkaWrite,
+	summaryWorker worker.IWorkerAttendanceServiceWorker,
+) worker.IWorkerDailySummaryConsumer {
+	return &DailySummaryConsumerWorker{
+		config:        config,
+		logger:        logger,
+		reader:        reader,
+		writer:        writer,
+		summaryWorker: summaryWorker,
+	}
+}
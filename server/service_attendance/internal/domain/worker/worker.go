@@ -1,6 +1,7 @@
 package worker
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -14,9 +15,11 @@ import (
 
 // For worker attendance service
 type IWorkerAttendanceServiceWorker interface {
-	RunDailySummaryWorker() error
-	AddJobToDailySummaryWorker(job *domainModel.AddDailySummariesInput)
-	AddJobToDailySummaryWorkerV2(companyID uuid.UUID, employeeID uuid.UUID, recordTime time.Time, matchedShift domainModel.ShiftTimeEmployee)
+	ProcessDailySummaryJob(ctx context.Context, companyID uuid.UUID, employeeID uuid.UUID, recordTime time.Time, matchedShift domainModel.ShiftTimeEmployee) error
+	ComputeDailySummary(ctx context.Context, companyID uuid.UUID, employeeID uuid.UUID, workDate time.Time, shift domainModel.ShiftTimeEmployee) (*domainModel.AddDailySummariesInput, error)
+	RecalculateDailySummary(ctx context.Context, companyID uuid.UUID, employeeID uuid.UUID, workDate time.Time, shift domainModel.ShiftTimeEmployee) (*domainModel.AddDailySummariesInput, error)
+	// Khoảng thời gian chấm công được ghép vào ca bắt đầu trong ngày workDate
+	PunchWindow(workDate time.Time, shift domainModel.ShiftTimeEmployee) (time.Time, time.Time)
 }
 
 var _vIWorkerAttendanceServiceWorker IWorkerAttendanceServiceWorker
@@ -35,3 +38,47 @@ func SetWorkerAttendanceServiceWorker(worker IWorkerAttendanceServiceWorker) err
 	_vIWorkerAttendanceServiceWorker = worker
 	return nil
 }
+
+// For absence scheduler
+type IWorkerAbsenceScheduler interface {
+	RunAbsenceScheduler(ctx context.Context) error
+}
+
+var _vIWorkerAbsenceScheduler IWorkerAbsenceScheduler
+
+func GetWorkerAbsenceScheduler() IWorkerAbsenceScheduler {
+	return _vIWorkerAbsenceScheduler
+}
+
+func SetWorkerAbsenceScheduler(worker IWorkerAbsenceScheduler) error {
+	if worker == nil {
+		return errors.New("worker absence scheduler is nil")
+	}
+	if _vIWorkerAbsenceScheduler != nil {
+		return errors.New("worker absence scheduler is already set")
+	}
+	_vIWorkerAbsenceScheduler = worker
+	return nil
+}
+
+// For daily summary kafka consumer
+type IWorkerDailySummaryConsumer interface {
+	RunDailySummaryConsumer(ctx context.Context) error
+}
+
+var _vIWorkerDailySummaryConsumer IWorkerDailySummaryConsumer
+
+func GetWorkerDailySummaryConsumer() IWorkerDailySummaryConsumer {
+	return _vIWorkerDailySummaryConsumer
+}
+
+func SetWorkerDailySummaryConsumer(worker IWorkerDailySummaryConsumer) error {
+	if worker == nil {
+		return errors.New("worker daily summary consumer is nil")
+	}
+	if _vIWorkerDailySummaryConsumer != nil {
+		return errors.New("worker daily summary consumer is already set")
+	}
+	_vIWorkerDailySummaryConsumer = worker
+	return nil
+}
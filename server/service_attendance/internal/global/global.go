@@ -1,6 +1,8 @@
 package global
 
 import (
+	"context"
+
 	domainConfig "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/config"
 	domainLogger "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/logger"
 	domainWorker "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/worker"
@@ -9,6 +11,7 @@ import (
 
 var (
 	WaitGroup               *sync.WaitGroup
+	ShutdownContext         context.Context // Bị hủy khi service nhận tín hiệu dừng (SIGINT/SIGTERM)
 	Logger                  domainLogger.ILogger
 	SettingServer           domainConfig.Setting
 	AttendanceServiceWorker domainWorker.IWorkerAttendanceServiceWorker
@@ -11,6 +11,29 @@ import (
 	"github.com/pgvector/pgvector-go"
 )
 
+type AttendanceCorrectionRequest struct {
+	RequestID         pgtype.UUID
+	CompanyID         pgtype.UUID
+	EmployeeID        pgtype.UUID
+	ShiftID           pgtype.UUID
+	WorkDate          pgtype.Date
+	ProposedCheckIn   pgtype.Timestamptz
+	ProposedCheckOut  pgtype.Timestamptz
+	Reason            string
+	OriginalCheckIn   pgtype.Timestamptz
+	OriginalCheckOut  pgtype.Timestamptz
+	OriginalStatus    pgtype.Int2
+	CorrectedCheckIn  pgtype.Timestamptz
+	CorrectedCheckOut pgtype.Timestamptz
+	CorrectedStatus   pgtype.Int2
+	Status            int16
+	ReviewedBy        pgtype.UUID
+	ReviewedAt        pgtype.Timestamptz
+	ReviewNote        pgtype.Text
+	CreatedAt         pgtype.Timestamptz
+	UpdatedAt         pgtype.Timestamptz
+}
+
 type AuditLog struct {
 	LogID        pgtype.UUID
 	UserID       pgtype.UUID
@@ -181,4 +204,7 @@ type WorkShift struct {
 	IsActive              pgtype.Bool
 	CreatedAt             pgtype.Timestamptz
 	UpdatedAt             pgtype.Timestamptz
+	CoreStartTime         pgtype.Time
+	CoreEndTime           pgtype.Time
+	RequiredWorkMinutes   pgtype.Int4
 }
@@ -55,6 +55,54 @@ func (q *Queries) DeleteUserSessionByID(ctx context.Context, sessionID pgtype.UU
 	return err
 }
 
+const getApprovedLeaveEmployeeByDate = `-- name: GetApprovedLeaveEmployeeByDate :one
+SELECT request_id, leave_type
+FROM leave_requests
+WHERE company_id = $1
+    AND employee_id = $2
+    AND status = 1
+    AND start_date <= $3
+    AND end_date >= $3
+LIMIT 1
+`
+
+type GetApprovedLeaveEmployeeByDateParams struct {
+	CompanyID  pgtype.UUID
+	EmployeeID pgtype.UUID
+	StartDate  pgtype.Date
+}
+
+type GetApprovedLeaveEmployeeByDateRow struct {
+	RequestID pgtype.UUID
+	LeaveType int16
+}
+
+func (q *Queries) GetApprovedLeaveEmployeeByDate(ctx context.Context, arg GetApprovedLeaveEmployeeByDateParams) (GetApprovedLeaveEmployeeByDateRow, error) {
+	row := q.db.QueryRow(ctx, getApprovedLeaveEmployeeByDate, arg.CompanyID, arg.EmployeeID, arg.StartDate)
+	var i GetApprovedLeaveEmployeeByDateRow
+	err := row.Scan(&i.RequestID, &i.LeaveType)
+	return i, err
+}
+
+const getCompanyHolidayByDate = `-- name: GetCompanyHolidayByDate :one
+SELECT name
+FROM company_holidays
+WHERE company_id = $1 AND holiday_date = $2
+LIMIT 1
+`
+
+type GetCompanyHolidayByDateParams struct {
+	CompanyID   pgtype.UUID
+	HolidayDate pgtype.Date
+}
+
+func (q *Queries) GetCompanyHolidayByDate(ctx context.Context, arg GetCompanyHolidayByDateParams) (string, error) {
+	row := q.db.QueryRow(ctx, getCompanyHolidayByDate, arg.CompanyID, arg.HolidayDate)
+	var name string
+	err := row.Scan(&name)
+	return name, err
+}
+
 const getCompanyIdUser = `-- name: GetCompanyIdUser :one
 SELECT company_id
 FROM employees
@@ -69,6 +117,199 @@ func (q *Queries) GetCompanyIdUser(ctx context.Context, employeeID pgtype.UUID)
 	return company_id, err
 }
 
+const getListActiveEmployeeShift = `-- name: GetListActiveEmployeeShift :many
+SELECT 
+    ws.company_id,
+    es.employee_id,
+    ws.shift_id,
+    ws.start_time,
+    ws.end_time,
+    ws.grace_period_minutes,
+    ws.early_departure_minutes,
+    ws.break_duration_minutes,
+    ws.overtime_after_minutes,
+    ws.is_flexible,
+    ws.core_start_time,
+    ws.core_end_time,
+    ws.required_work_minutes,
+    ws.work_days,
+    es.effective_from,
+    es.effective_to
+FROM 
+    employee_shifts es
+JOIN 
+    work_shifts ws ON es.shift_id = ws.shift_id
+WHERE 
+    es.is_active = TRUE
+    AND ws.is_active = TRUE
+    AND es.effective_from <= $1::date
+    AND (es.effective_to IS NULL OR es.effective_to >= $1::date)
+    AND (es.employee_id, es.shift_id, es.effective_from) > ($2::uuid, $3::uuid, $4::date)
+ORDER BY es.employee_id, es.shift_id, es.effective_from
+LIMIT $5
+`
+
+type GetListActiveEmployeeShiftParams struct {
+	WorkDate           pgtype.Date
+	AfterEmployeeID    pgtype.UUID
+	AfterShiftID       pgtype.UUID
+	AfterEffectiveFrom pgtype.Date
+	PageSize           int32
+}
+
+type GetListActiveEmployeeShiftRow struct {
+	CompanyID             pgtype.UUID
+	EmployeeID            pgtype.UUID
+	ShiftID               pgtype.UUID
+	StartTime             pgtype.Time
+	EndTime               pgtype.Time
+	GracePeriodMinutes    pgtype.Int4
+	EarlyDepartureMinutes pgtype.Int4
+	BreakDurationMinutes  pgtype.Int4
+	OvertimeAfterMinutes  pgtype.Int4
+	IsFlexible            pgtype.Bool
+	CoreStartTime         pgtype.Time
+	CoreEndTime           pgtype.Time
+	RequiredWorkMinutes   pgtype.Int4
+	WorkDays              []int32
+	EffectiveFrom         pgtype.Date
+	EffectiveTo           pgtype.Date
+}
+
+func (q *Queries) GetListActiveEmployeeShift(ctx context.Context, arg GetListActiveEmployeeShiftParams) ([]GetListActiveEmployeeShiftRow, error) {
+	rows, err := q.db.Query(ctx, getListActiveEmployeeShift,
+		arg.WorkDate,
+		arg.AfterEmployeeID,
+		arg.AfterShiftID,
+		arg.AfterEffectiveFrom,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetListActiveEmployeeShiftRow
+	for rows.Next() {
+		var i GetListActiveEmployeeShiftRow
+		if err := rows.Scan(
+			&i.CompanyID,
+			&i.EmployeeID,
+			&i.ShiftID,
+			&i.StartTime,
+			&i.EndTime,
+			&i.GracePeriodMinutes,
+			&i.EarlyDepartureMinutes,
+			&i.BreakDurationMinutes,
+			&i.OvertimeAfterMinutes,
+			&i.IsFlexible,
+			&i.CoreStartTime,
+			&i.CoreEndTime,
+			&i.RequiredWorkMinutes,
+			&i.WorkDays,
+			&i.EffectiveFrom,
+			&i.EffectiveTo,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getListActiveEmployeeShiftCompany = `-- name: GetListActiveEmployeeShiftCompany :many
+SELECT 
+    ws.company_id,
+    es.employee_id,
+    ws.shift_id,
+    ws.start_time,
+    ws.end_time,
+    ws.grace_period_minutes,
+    ws.early_departure_minutes,
+    ws.break_duration_minutes,
+    ws.overtime_after_minutes,
+    ws.is_flexible,
+    ws.core_start_time,
+    ws.core_end_time,
+    ws.required_work_minutes,
+    ws.work_days,
+    es.effective_from,
+    es.effective_to
+FROM 
+    employee_shifts es
+JOIN 
+    work_shifts ws ON es.shift_id = ws.shift_id
+WHERE 
+    ws.company_id = $1
+    AND es.is_active = TRUE
+    AND ws.is_active = TRUE
+    AND es.effective_from <= $2
+    AND (es.effective_to IS NULL OR es.effective_to >= $2)
+`
+
+type GetListActiveEmployeeShiftCompanyParams struct {
+	CompanyID     pgtype.UUID
+	EffectiveFrom pgtype.Date
+}
+
+type GetListActiveEmployeeShiftCompanyRow struct {
+	CompanyID             pgtype.UUID
+	EmployeeID            pgtype.UUID
+	ShiftID               pgtype.UUID
+	StartTime             pgtype.Time
+	EndTime               pgtype.Time
+	GracePeriodMinutes    pgtype.Int4
+	EarlyDepartureMinutes pgtype.Int4
+	BreakDurationMinutes  pgtype.Int4
+	OvertimeAfterMinutes  pgtype.Int4
+	IsFlexible            pgtype.Bool
+	CoreStartTime         pgtype.Time
+	CoreEndTime           pgtype.Time
+	RequiredWorkMinutes   pgtype.Int4
+	WorkDays              []int32
+	EffectiveFrom         pgtype.Date
+	EffectiveTo           pgtype.Date
+}
+
+func (q *Queries) GetListActiveEmployeeShiftCompany(ctx context.Context, arg GetListActiveEmployeeShiftCompanyParams) ([]GetListActiveEmployeeShiftCompanyRow, error) {
+	rows, err := q.db.Query(ctx, getListActiveEmployeeShiftCompany, arg.CompanyID, arg.EffectiveFrom)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetListActiveEmployeeShiftCompanyRow
+	for rows.Next() {
+		var i GetListActiveEmployeeShiftCompanyRow
+		if err := rows.Scan(
+			&i.CompanyID,
+			&i.EmployeeID,
+			&i.ShiftID,
+			&i.StartTime,
+			&i.EndTime,
+			&i.GracePeriodMinutes,
+			&i.EarlyDepartureMinutes,
+			&i.BreakDurationMinutes,
+			&i.OvertimeAfterMinutes,
+			&i.IsFlexible,
+			&i.CoreStartTime,
+			&i.CoreEndTime,
+			&i.RequiredWorkMinutes,
+			&i.WorkDays,
+			&i.EffectiveFrom,
+			&i.EffectiveTo,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getListTimeShiftEmployee = `-- name: GetListTimeShiftEmployee :many
 SELECT 
     ws.shift_id,
@@ -76,6 +317,12 @@ SELECT
     ws.end_time,
     ws.grace_period_minutes,
     ws.early_departure_minutes,
+    ws.break_duration_minutes,
+    ws.overtime_after_minutes,
+    ws.is_flexible,
+    ws.core_start_time,
+    ws.core_end_time,
+    ws.required_work_minutes,
     ws.work_days,
     es.effective_from,
     es.effective_to
@@ -101,6 +348,12 @@ type GetListTimeShiftEmployeeRow struct {
 	EndTime               pgtype.Time
 	GracePeriodMinutes    pgtype.Int4
 	EarlyDepartureMinutes pgtype.Int4
+	BreakDurationMinutes  pgtype.Int4
+	OvertimeAfterMinutes  pgtype.Int4
+	IsFlexible            pgtype.Bool
+	CoreStartTime         pgtype.Time
+	CoreEndTime           pgtype.Time
+	RequiredWorkMinutes   pgtype.Int4
 	WorkDays              []int32
 	EffectiveFrom         pgtype.Date
 	EffectiveTo           pgtype.Date
@@ -121,6 +374,12 @@ func (q *Queries) GetListTimeShiftEmployee(ctx context.Context, arg GetListTimeS
 			&i.EndTime,
 			&i.GracePeriodMinutes,
 			&i.EarlyDepartureMinutes,
+			&i.BreakDurationMinutes,
+			&i.OvertimeAfterMinutes,
+			&i.IsFlexible,
+			&i.CoreStartTime,
+			&i.CoreEndTime,
+			&i.RequiredWorkMinutes,
 			&i.WorkDays,
 			&i.EffectiveFrom,
 			&i.EffectiveTo,
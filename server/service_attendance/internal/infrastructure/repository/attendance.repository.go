@@ -50,6 +50,12 @@ package repository
  *		late_minutes INT,
  *		early_leave_minutes INT,
  *		total_work_minutes INT,
+ *		scheduled_minutes INT,
+ *		break_minutes INT,
+ *		overtime_minutes INT,
+ *		net_work_minutes INT,
+ *		work_intervals TEXT, -- JSON: [{"check_in": ..., "check_out": ..., "minutes": ...}]
+ *		unpaired_punches INT,
  *		notes TEXT,
  *		updated_at TIMESTAMP,
  *   	PRIMARY KEY ((company_id, summary_month), work_date, employee_id)
@@ -68,6 +74,12 @@ package repository
  *		late_minutes INT,
  *		early_leave_minutes INT,
  *		total_work_minutes INT,
+ *		scheduled_minutes INT,
+ *		break_minutes INT,
+ *		overtime_minutes INT,
+ *		net_work_minutes INT,
+ *		work_intervals TEXT, -- JSON: [{"check_in": ..., "check_out": ..., "minutes": ...}]
+ *		unpaired_punches INT,
  *		notes TEXT,
  *		updated_at TIMESTAMP,
  *		PRIMARY KEY ((company_id, employee_id, summary_month), work_date)
@@ -92,8 +104,10 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net"
+	"sort"
 	"time"
 
 	"github.com/gocql/gocql"
@@ -271,151 +285,56 @@ func (a *AttendanceRepository) GetAttendanceRecordNoShift(ctx context.Context, i
 	return output, nil
 }
 
-// GetFirstCheckIn implements repository.IAttendanceRepository.
-func (a *AttendanceRepository) GetFirstCheckIn(ctx context.Context, input *model.GetFirstCheckInInput) (*model.GetFirstCheckInOutput, error) {
-	// 1. Trích xuất giờ/phút/giây của ca từ ShiftTimeStart/ShiftTimeEnd
-	startHour, startMinute, startSecond := input.ShiftTimeStart.Hour(), input.ShiftTimeStart.Minute(), input.ShiftTimeStart.Second()
-	endHour, endMinute, endSecond := input.ShiftTimeEnd.Hour(), input.ShiftTimeEnd.Minute(), input.ShiftTimeEnd.Second()
-
-	// 2. Lấy ngày theo DateCheckOut
-	y, m, d := input.DateCheckOut.Date()
-	loc := input.DateCheckOut.Location()
-
-	var shiftStartActual, shiftEndActual time.Time
-
-	// Ca bình thường (End > Start về thời gian trong ngày)
-	if time.Date(0, 1, 1, endHour, endMinute, endSecond, 0, time.UTC).After(
-		time.Date(0, 1, 1, startHour, startMinute, startSecond, 0, time.UTC),
-	) {
-		shiftStartActual = time.Date(y, m, d, startHour, startMinute, startSecond, 0, loc)
-		shiftEndActual = time.Date(y, m, d, endHour, endMinute, endSecond, 0, loc)
-	} else {
-		// Ca qua đêm (End <= Start)
-		shiftEndActual = time.Date(y, m, d, endHour, endMinute, endSecond, 0, loc)
-		// Bắt đầu ca là ngày trước đó
-		shiftStartActual = time.Date(y, m, d, startHour, startMinute, startSecond, 0, loc).Add(-24 * time.Hour)
+// GetAttendancePunches implements repository.IAttendanceRepository.
+func (a *AttendanceRepository) GetAttendancePunches(ctx context.Context, input *model.GetAttendancePunchesInput) ([]model.AttendancePunch, error) {
+	// SELECT record_time, record_type, device_id FROM attendance_records_by_user
+	// WHERE company_id = ? AND employee_id = ? AND year_month = ?
+	//   AND record_time >= ? AND record_time <= ?;
+	sql_raw := `SELECT record_time, record_type, device_id
+		FROM attendance_records_by_user
+		WHERE company_id = ?
+		  AND employee_id = ?
+		  AND year_month = ?
+		  AND record_time >= ?
+		  AND record_time <= ?;`
+
+	// Khoảng thời gian có thể nằm trên 2 partition (ca qua đêm cuối tháng)
+	yearMonths := []string{input.From.Format("2006-01")}
+	if toYearMonth := input.To.Format("2006-01"); toYearMonth != yearMonths[0] {
+		yearMonths = append(yearMonths, toYearMonth)
 	}
 
-	// 3. Xác định khoảng query
-	earlyBuffer := time.Duration(1) * time.Hour // Có thể đến sớm hơn 1 tiếng
-	lateBuffer := time.Duration(2) * time.Hour  // Có thể đến trễ hơn 2 tiếng
-	startQuery := shiftStartActual.Add(-earlyBuffer)
-	endQuery := minTime(input.DateCheckOut, shiftEndActual.Add(lateBuffer))
-
-	// 4. Truy vấn Cassandra
-	iter := a.dbSession.Query(`
-        SELECT record_time, device_id, verification_method, verification_score,
-               location_coordinates, record_type
-        FROM attendance_records_by_user
-        WHERE company_id = ?
-          AND employee_id = ?
-          AND year_month = ?
-          AND record_time >= ?
-          AND record_time <= ?;
-        `,
-		marshalUuid(input.CompanyID),
-		marshalUuid(input.EmployeeID),
-		input.YearMonth,
-		startQuery,
-		endQuery,
-	).WithContext(ctx).Iter()
-
-	var (
-		recordTime          time.Time
-		deviceID            gocql.UUID
-		verificationMethod  string
-		verificationScore   float32
-		locationCoordinates string
-		recordType          int
-
-		bestCheckIn *model.GetFirstCheckInOutput
-		bestDelta   time.Duration // Khoảng cách tối ưu hiện tại
-		found       bool
-	)
-
-	for iter.Scan(
-		&recordTime,
-		&deviceID,
-		&verificationMethod,
-		&verificationScore,
-		&locationCoordinates,
-		&recordType,
-	) {
-		if recordType != 0 {
-			continue
+	var punches []model.AttendancePunch
+	for _, yearMonth := range yearMonths {
+		iter := a.dbSession.Query(sql_raw,
+			marshalUuid(input.CompanyID),
+			marshalUuid(input.EmployeeID),
+			yearMonth,
+			input.From,
+			input.To,
+		).WithContext(ctx).Iter()
+		var (
+			recordTime time.Time
+			recordType int
+			deviceID   gocql.UUID
+		)
+		for iter.Scan(&recordTime, &recordType, &deviceID) {
+			punches = append(punches, model.AttendancePunch{
+				RecordTime: recordTime,
+				RecordType: recordType,
+				DeviceID:   unmarshalUuid(deviceID),
+			})
 		}
-
-		// 5. Tính delta so với shiftStartActual
-		delta := recordTime.Sub(shiftStartActual)
-
-		// Ưu tiên delta >= 0 (sau hoặc bằng giờ bắt đầu); nếu chưa có, chấp nhận delta âm (đến sớm)
-		if !found {
-			bestCheckIn = &model.GetFirstCheckInOutput{
-				RecordTime:          recordTime,
-				DeviceID:            uuid.MustParse(deviceID.String()),
-				VerificationMethod:  verificationMethod,
-				VerificationScore:   float64(verificationScore),
-				LocationCoordinates: locationCoordinates,
-			}
-			bestDelta = delta
-			found = true
-			continue
+		if err := iter.Close(); err != nil {
+			return nil, err
 		}
-
-		// Logic chọn tối ưu:
-		// 1. Nếu bestDelta < 0 (chỉ có bản ghi trước giờ bắt đầu)
-		//    và delta >= 0 → chọn ngay (có bản ghi đúng hoặc trễ nhẹ).
-		// 2. Nếu cả hai cùng >= 0 → chọn bản ghi có delta nhỏ hơn (trễ ít hơn).
-		// 3. Nếu cả hai cùng < 0 → chọn bản ghi delta âm "lớn hơn" (gần giờ bắt đầu hơn).
-		switch {
-		case bestDelta < 0 && delta >= 0:
-			// Bản ghi mới nằm sau giờ bắt đầu → ưu tiên hơn
-			bestCheckIn = &model.GetFirstCheckInOutput{
-				RecordTime:          recordTime,
-				DeviceID:            uuid.MustParse(deviceID.String()),
-				VerificationMethod:  verificationMethod,
-				VerificationScore:   float64(verificationScore),
-				LocationCoordinates: locationCoordinates,
-			}
-			bestDelta = delta
-		case bestDelta >= 0 && delta >= 0:
-			if delta < bestDelta {
-				bestCheckIn = &model.GetFirstCheckInOutput{
-					RecordTime:          recordTime,
-					DeviceID:            uuid.MustParse(deviceID.String()),
-					VerificationMethod:  verificationMethod,
-					VerificationScore:   float64(verificationScore),
-					LocationCoordinates: locationCoordinates,
-				}
-				bestDelta = delta
-			}
-		case bestDelta < 0 && delta < 0:
-			// Cả hai đến sớm; delta âm càng "lớn" (ít âm hơn) thì gần hơn
-			if delta > bestDelta {
-				bestCheckIn = &model.GetFirstCheckInOutput{
-					RecordTime:          recordTime,
-					DeviceID:            uuid.MustParse(deviceID.String()),
-					VerificationMethod:  verificationMethod,
-					VerificationScore:   float64(verificationScore),
-					LocationCoordinates: locationCoordinates,
-				}
-				bestDelta = delta
-			}
-		}
-	}
-
-	if err := iter.Close(); err != nil {
-		return nil, err
 	}
 
-	return bestCheckIn, nil
-}
-
-func minTime(time1, time2 time.Time) time.Time {
-	if time1.Before(time2) {
-		return time1
-	}
-	return time2
+	// Bảng lưu theo record_time DESC, sắp xếp lại theo thứ tự thời gian
+	sort.Slice(punches, func(i, j int) bool {
+		return punches[i].RecordTime.Before(punches[j].RecordTime)
+	})
+	return punches, nil
 }
 
 // DeleteDailySummariesCompany implements repository.IAttendanceRepository.
@@ -678,7 +597,9 @@ func (a *AttendanceRepository) GetDailySummarieCompany(ctx context.Context, inpu
 	// WHERE company_id = uuid_company AND summary_month = '2023-10' AND work_date = '2023-10-25';
 	sql_raw := `SELECT company_id, summary_month, work_date, employee_id,
 		shift_id, actual_check_in, actual_check_out, attendance_status,
-		late_minutes, early_leave_minutes, total_work_minutes, notes, updated_at
+		late_minutes, early_leave_minutes, total_work_minutes,
+		scheduled_minutes, break_minutes, overtime_minutes, net_work_minutes,
+		work_intervals, unpaired_punches, notes, updated_at
 		FROM daily_summaries
 		WHERE company_id = ? AND summary_month = ? AND work_date = ?;`
 	var iter *gocql.Iter
@@ -705,6 +626,7 @@ func (a *AttendanceRepository) GetDailySummarieCompany(ctx context.Context, inpu
 		gocqlUUIDCompanyID := gocql.UUID{}
 		gocqlUUIDEmployeeID := gocql.UUID{}
 		gocqlUUIDShiftID := gocql.UUID{}
+		workIntervals := ""
 		if !iter.Scan(
 			&gocqlUUIDCompanyID,
 			&r.SummaryMonth,
@@ -717,6 +639,12 @@ func (a *AttendanceRepository) GetDailySummarieCompany(ctx context.Context, inpu
 			&r.LateMinutes,
 			&r.EarlyLeaveMinutes,
 			&r.TotalWorkMinutes,
+			&r.ScheduledMinutes,
+			&r.BreakMinutes,
+			&r.OvertimeMinutes,
+			&r.NetWorkMinutes,
+			&workIntervals,
+			&r.UnpairedPunches,
 			&r.Notes,
 			&r.UpdatedAt,
 		) {
@@ -726,6 +654,7 @@ func (a *AttendanceRepository) GetDailySummarieCompany(ctx context.Context, inpu
 		r.CompanyId = uuid.UUID(gocqlUUIDCompanyID)
 		r.EmployeeId = uuid.UUID(gocqlUUIDEmployeeID)
 		r.ShiftId = uuid.UUID(gocqlUUIDShiftID)
+		r.WorkIntervals = unmarshalWorkIntervals(workIntervals)
 		//
 		listDailySummaries = append(listDailySummaries, r)
 	}
@@ -743,7 +672,9 @@ func (a *AttendanceRepository) GetDailySummarieCompanyForEmployee(ctx context.Co
 	// WHERE company_id = uuid_company AND employee_id = uuid_employee AND summary_month = '2023-10';
 	sql_raw := `SELECT company_id, summary_month, work_date, employee_id,
 		shift_id, actual_check_in, actual_check_out, attendance_status,
-		late_minutes, early_leave_minutes, total_work_minutes, notes, updated_at
+		late_minutes, early_leave_minutes, total_work_minutes,
+		scheduled_minutes, break_minutes, overtime_minutes, net_work_minutes,
+		work_intervals, unpaired_punches, notes, updated_at
 		FROM daily_summaries_by_user
 		WHERE company_id = ? AND summary_month = ? AND employee_id = ?;`
 	var iter *gocql.Iter
@@ -770,6 +701,7 @@ func (a *AttendanceRepository) GetDailySummarieCompanyForEmployee(ctx context.Co
 		gocqlUUIDCompanyID := gocql.UUID{}
 		gocqlUUIDEmployeeID := gocql.UUID{}
 		gocqlUUIDShiftID := gocql.UUID{}
+		workIntervals := ""
 		if !iter.Scan(
 			&gocqlUUIDCompanyID,
 			&r.SummaryMonth,
@@ -782,6 +714,12 @@ func (a *AttendanceRepository) GetDailySummarieCompanyForEmployee(ctx context.Co
 			&r.LateMinutes,
 			&r.EarlyLeaveMinutes,
 			&r.TotalWorkMinutes,
+			&r.ScheduledMinutes,
+			&r.BreakMinutes,
+			&r.OvertimeMinutes,
+			&r.NetWorkMinutes,
+			&workIntervals,
+			&r.UnpairedPunches,
 			&r.Notes,
 			&r.UpdatedAt,
 		) {
@@ -791,6 +729,7 @@ func (a *AttendanceRepository) GetDailySummarieCompanyForEmployee(ctx context.Co
 		r.CompanyId = uuid.UUID(gocqlUUIDCompanyID)
 		r.EmployeeId = uuid.UUID(gocqlUUIDEmployeeID)
 		r.ShiftId = uuid.UUID(gocqlUUIDShiftID)
+		r.WorkIntervals = unmarshalWorkIntervals(workIntervals)
 		//
 		listDailySummaries = append(listDailySummaries, r)
 	}
@@ -802,6 +741,87 @@ func (a *AttendanceRepository) GetDailySummarieCompanyForEmployee(ctx context.Co
 	return output, nil
 }
 
+// ExistsDailySummaryEmployee implements repository.IAttendanceRepository.
+func (a *AttendanceRepository) ExistsDailySummaryEmployee(ctx context.Context, input *model.ExistsDailySummaryEmployeeInput) (bool, error) {
+	// SELECT work_date FROM daily_summaries_by_user
+	// WHERE company_id = uuid_company AND employee_id = uuid_employee AND summary_month = '2023-10' AND work_date = '2023-10-25';
+	sql_raw := `SELECT work_date FROM daily_summaries_by_user
+		WHERE company_id = ? AND employee_id = ? AND summary_month = ? AND work_date = ?
+		LIMIT 1;`
+	var workDate time.Time
+	err := a.dbSession.Query(sql_raw,
+		marshalUuid(input.CompanyID),
+		marshalUuid(input.EmployeeID),
+		input.SummaryMonth,
+		input.WorkDate,
+	).WithContext(ctx).Scan(&workDate)
+	if err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// GetDailySummaryEmployee implements repository.IAttendanceRepository.
+// Trả về nil nếu ngày làm việc chưa có bản tổng hợp.
+func (a *AttendanceRepository) GetDailySummaryEmployee(ctx context.Context, input *model.GetDailySummaryEmployeeInput) (*model.DailySummariesEmployeeInfo, error) {
+	// SELECT * FROM daily_summaries_by_user
+	// WHERE company_id = uuid_company AND employee_id = uuid_employee AND summary_month = '2023-10' AND work_date = '2023-10-25';
+	sql_raw := `SELECT company_id, summary_month, work_date, employee_id,
+		shift_id, actual_check_in, actual_check_out, attendance_status,
+		late_minutes, early_leave_minutes, total_work_minutes,
+		scheduled_minutes, break_minutes, overtime_minutes, net_work_minutes,
+		work_intervals, unpaired_punches, notes, updated_at
+		FROM daily_summaries_by_user
+		WHERE company_id = ? AND employee_id = ? AND summary_month = ? AND work_date = ?
+		LIMIT 1;`
+	var r model.DailySummariesEmployeeInfo
+	gocqlUUIDCompanyID := gocql.UUID{}
+	gocqlUUIDEmployeeID := gocql.UUID{}
+	gocqlUUIDShiftID := gocql.UUID{}
+	workIntervals := ""
+	err := a.dbSession.Query(sql_raw,
+		marshalUuid(input.CompanyID),
+		marshalUuid(input.EmployeeID),
+		input.WorkDate.Format("2006-01"),
+		input.WorkDate,
+	).WithContext(ctx).Scan(
+		&gocqlUUIDCompanyID,
+		&r.SummaryMonth,
+		&r.WorkDate,
+		&gocqlUUIDEmployeeID,
+		&gocqlUUIDShiftID,
+		&r.ActualCheckIn,
+		&r.ActualCheckOut,
+		&r.AttendanceStatus,
+		&r.LateMinutes,
+		&r.EarlyLeaveMinutes,
+		&r.TotalWorkMinutes,
+		&r.ScheduledMinutes,
+		&r.BreakMinutes,
+		&r.OvertimeMinutes,
+		&r.NetWorkMinutes,
+		&workIntervals,
+		&r.UnpairedPunches,
+		&r.Notes,
+		&r.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	// Unmarshal
+	r.CompanyId = uuid.UUID(gocqlUUIDCompanyID)
+	r.EmployeeId = uuid.UUID(gocqlUUIDEmployeeID)
+	r.ShiftId = uuid.UUID(gocqlUUIDShiftID)
+	r.WorkIntervals = unmarshalWorkIntervals(workIntervals)
+	return &r, nil
+}
+
 // UpdateDailySummariesEmployee implements repository.IAttendanceRepository.
 func (a *AttendanceRepository) UpdateDailySummariesEmployee(ctx context.Context, input *model.UpdateDailySummariesEmployeeInput) error {
 	// BEGIN BATCH
@@ -928,21 +948,25 @@ func (a *AttendanceRepository) AddDailySummaries(ctx context.Context, input *mod
 	//     INSERT INTO daily_summaries (
 	//         company_id, summary_month, work_date, employee_id,
 	//         shift_id, actual_check_in, actual_check_out, attendance_status,
-	//         late_minutes, early_leave_minutes, total_work_minutes, notes, updated_at
+	//         late_minutes, early_leave_minutes, total_work_minutes,
+	//         scheduled_minutes, break_minutes, overtime_minutes, net_work_minutes,
+	//         work_intervals, unpaired_punches, notes, updated_at
 	//     ) VALUES (
 	//         uuid_company, '2023-10', '2023-10-25', uuid_employee,
 	//         uuid_shift, '2023-10-25 08:00:00', '2023-10-25 17:30:00', 1,
-	//         15, 0, 480, 'Đi muộn do kẹt xe', toTimestamp(now())
+	//         15, 0, 570, 540, 60, 30, 510, '[...]', 0, 'Đi muộn do kẹt xe', toTimestamp(now())
 	//     );
 	//	-- 2. Cập nhật bảng User
 	//	INSERT INTO daily_summaries_by_user (
 	//	    company_id, employee_id, summary_month, work_date,
 	//	    shift_id, actual_check_in, actual_check_out, attendance_status,
-	//	    late_minutes, early_leave_minutes, total_work_minutes, notes, updated_at
+	//	    late_minutes, early_leave_minutes, total_work_minutes,
+	//	    scheduled_minutes, break_minutes, overtime_minutes, net_work_minutes,
+	//	    work_intervals, unpaired_punches, notes, updated_at
 	//	) VALUES (
 	//	    uuid_company, uuid_employee, '2023-10', '2023-10-25',
 	//	    uuid_shift, '2023-10-25 08:00:00', '2023-10-25 17:30:00', 1,
-	//	    15, 0, 480, 'Đi muộn do kẹt xe', toTimestamp(now())
+	//	    15, 0, 570, 540, 60, 30, 510, '[...]', 0, 'Đi muộn do kẹt xe', toTimestamp(now())
 	//	);
 	//
 	// APPLY BATCH;
@@ -950,23 +974,32 @@ func (a *AttendanceRepository) AddDailySummaries(ctx context.Context, input *mod
 	INSERT INTO daily_summaries (
 		company_id, summary_month, work_date, employee_id,
 		shift_id, actual_check_in, actual_check_out, attendance_status,
-		late_minutes, early_leave_minutes, total_work_minutes, notes, updated_at
+		late_minutes, early_leave_minutes, total_work_minutes,
+		scheduled_minutes, break_minutes, overtime_minutes, net_work_minutes,
+		work_intervals, unpaired_punches, notes, updated_at
 	) VALUES (
 		?, ?, ?, ?,
 		?, ?, ?, ?,
-		?, ?, ?, ?, ?
+		?, ?, ?,
+		?, ?, ?, ?,
+		?, ?, ?, ?
 	);
 	INSERT INTO daily_summaries_by_user (
 		company_id, employee_id, summary_month, work_date,
 		shift_id, actual_check_in, actual_check_out, attendance_status,
-		late_minutes, early_leave_minutes, total_work_minutes, notes, updated_at
+		late_minutes, early_leave_minutes, total_work_minutes,
+		scheduled_minutes, break_minutes, overtime_minutes, net_work_minutes,
+		work_intervals, unpaired_punches, notes, updated_at
 	) VALUES (
 		?, ?, ?, ?,
 		?, ?, ?, ?,
-		?, ?, ?, ?, ?
+		?, ?, ?,
+		?, ?, ?, ?,
+		?, ?, ?, ?
 	);
 	APPLY BATCH;`
 	updatedAt := time.Now()
+	workIntervals := marshalWorkIntervals(input.WorkIntervals)
 	err := a.dbSession.Query(sql_raw,
 		marshalUuid(input.CompanyID),
 		input.SummaryMonth,
@@ -979,6 +1012,12 @@ func (a *AttendanceRepository) AddDailySummaries(ctx context.Context, input *mod
 		input.LateMinutes,
 		input.EarlyLeaveMinutes,
 		input.TotalWorkMinutes,
+		input.ScheduledMinutes,
+		input.BreakMinutes,
+		input.OvertimeMinutes,
+		input.NetWorkMinutes,
+		workIntervals,
+		input.UnpairedPunches,
 		input.Notes,
 		updatedAt,
 		// second insert
@@ -993,6 +1032,12 @@ func (a *AttendanceRepository) AddDailySummaries(ctx context.Context, input *mod
 		input.LateMinutes,
 		input.EarlyLeaveMinutes,
 		input.TotalWorkMinutes,
+		input.ScheduledMinutes,
+		input.BreakMinutes,
+		input.OvertimeMinutes,
+		input.NetWorkMinutes,
+		workIntervals,
+		input.UnpairedPunches,
 		input.Notes,
 		updatedAt,
 	).WithContext(ctx).Exec()
@@ -1236,6 +1281,28 @@ func NewAttendanceRepository(dbSession *gocql.Session) domainRepo.IAttendanceRep
 // ===========================================
 
 // UUID marshaling/unmarshaling
+func marshalWorkIntervals(intervals []model.WorkInterval) string {
+	if len(intervals) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(intervals)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func unmarshalWorkIntervals(data string) []model.WorkInterval {
+	if data == "" {
+		return nil
+	}
+	var intervals []model.WorkInterval
+	if err := json.Unmarshal([]byte(data), &intervals); err != nil {
+		return nil
+	}
+	return intervals
+}
+
 func marshalUuid(id uuid.UUID) gocql.UUID {
 	return gocql.UUID(id)
 }
@@ -37,13 +37,13 @@ func (a *AuditRepository) AddAuditLog(ctx context.Context, log *model.AuditLog)
 		?, ?, ?, ?
 	);`
 	return a.dbSession.Query(sql_raw,
-		log.CompanyID,
+		marshalUuid(log.CompanyID),
 		log.YearMonth,
-		log.ActorID,
+		marshalUuid(log.ActorID),
 		log.ActionCategory,
 		log.ActionName,
 		log.ResourceType,
-		log.ResourceID,
+		log.ResourceID.String(),
 		log.Details,
 		log.IP_Address,
 		log.UserAgent,
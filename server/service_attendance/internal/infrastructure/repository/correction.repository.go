@@ -0,0 +1,278 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/model"
+	domainRepo "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/repository"
+	db "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/infrastructure/gen"
+)
+
+// ============================================
+// Correction repository impl
+// ============================================
+type CorrectionRepository struct {
+	q db.Queries
+}
+
+// CreateCorrectionRequest implements repository.ICorrectionRepository.
+func (c *CorrectionRepository) CreateCorrectionRequest(ctx context.Context, input *domainModel.CreateCorrectionRequestInput) (uuid.UUID, error) {
+	shiftID := pgtype.UUID{}
+	if input.ShiftID != uuid.Nil {
+		shiftID = pgtype.UUID{Valid: true, Bytes: input.ShiftID}
+	}
+	id, err := c.q.CreateCorrectionRequest(
+		ctx,
+		db.CreateCorrectionRequestParams{
+			CompanyID:        pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+			EmployeeID:       pgtype.UUID{Valid: true, Bytes: input.EmployeeID},
+			ShiftID:          shiftID,
+			WorkDate:         pgtype.Date{Valid: true, Time: input.WorkDate},
+			ProposedCheckIn:  toPgTimestamptz(input.ProposedCheckIn),
+			ProposedCheckOut: toPgTimestamptz(input.ProposedCheckOut),
+			Reason:           input.Reason,
+			OriginalCheckIn:  toPgTimestamptz(input.OriginalCheckIn),
+			OriginalCheckOut: toPgTimestamptz(input.OriginalCheckOut),
+			OriginalStatus:   toPgInt2(input.OriginalStatus),
+		},
+	)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return id.Bytes, nil
+}
+
+// CountPendingCorrectionRequests implements repository.ICorrectionRepository.
+func (c *CorrectionRepository) CountPendingCorrectionRequests(ctx context.Context, input *domainModel.CountPendingCorrectionRequestsInput) (int64, error) {
+	return c.q.CountPendingCorrectionRequestsByDate(
+		ctx,
+		db.CountPendingCorrectionRequestsByDateParams{
+			EmployeeID: pgtype.UUID{Valid: true, Bytes: input.EmployeeID},
+			WorkDate:   pgtype.Date{Valid: true, Time: input.WorkDate},
+		},
+	)
+}
+
+// GetCorrectionRequest implements repository.ICorrectionRepository.
+func (c *CorrectionRepository) GetCorrectionRequest(ctx context.Context, input *domainModel.GetCorrectionRequestInput) (*domainModel.AttendanceCorrection, error) {
+	r, err := c.q.GetCorrectionRequestByID(
+		ctx,
+		db.GetCorrectionRequestByIDParams{
+			RequestID: pgtype.UUID{Valid: true, Bytes: input.RequestID},
+			CompanyID: pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+		},
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	result := toAttendanceCorrection(r)
+	return &result, nil
+}
+
+// GetApprovedCorrection implements repository.ICorrectionRepository.
+// Trả về nil nếu ngày làm việc không có yêu cầu điều chỉnh nào được duyệt.
+func (c *CorrectionRepository) GetApprovedCorrection(ctx context.Context, input *domainModel.GetApprovedCorrectionInput) (*domainModel.AttendanceCorrection, error) {
+	r, err := c.q.GetApprovedCorrectionByDate(
+		ctx,
+		db.GetApprovedCorrectionByDateParams{
+			CompanyID:  pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+			EmployeeID: pgtype.UUID{Valid: true, Bytes: input.EmployeeID},
+			WorkDate:   pgtype.Date{Valid: true, Time: input.WorkDate},
+		},
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	result := toAttendanceCorrection(r)
+	return &result, nil
+}
+
+// ListCorrectionRequestsByEmployee implements repository.ICorrectionRepository.
+func (c *CorrectionRepository) ListCorrectionRequestsByEmployee(ctx context.Context, input *domainModel.ListCorrectionRequestsByEmployeeInput) ([]domainModel.AttendanceCorrection, error) {
+	reps, err := c.q.ListCorrectionRequestsByEmployee(
+		ctx,
+		db.ListCorrectionRequestsByEmployeeParams{
+			EmployeeID: pgtype.UUID{Valid: true, Bytes: input.EmployeeID},
+			Limit:      input.Limit,
+			Offset:     input.Offset,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return []domainModel.AttendanceCorrection{}, nil
+		}
+		return nil, err
+	}
+	result := make([]domainModel.AttendanceCorrection, len(reps))
+	for i, r := range reps {
+		result[i] = toAttendanceCorrection(r)
+	}
+	return result, nil
+}
+
+// ListPendingCorrectionRequests implements repository.ICorrectionRepository.
+func (c *CorrectionRepository) ListPendingCorrectionRequests(ctx context.Context, input *domainModel.ListPendingCorrectionRequestsInput) ([]domainModel.AttendanceCorrection, error) {
+	reps, err := c.q.ListPendingCorrectionRequestsByCompany(
+		ctx,
+		db.ListPendingCorrectionRequestsByCompanyParams{
+			CompanyID: pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+			Limit:     input.Limit,
+			Offset:    input.Offset,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return []domainModel.AttendanceCorrection{}, nil
+		}
+		return nil, err
+	}
+	result := make([]domainModel.AttendanceCorrection, len(reps))
+	for i, r := range reps {
+		result[i] = toAttendanceCorrection(r)
+	}
+	return result, nil
+}
+
+// ApproveCorrectionRequest implements repository.ICorrectionRepository.
+// Trả về false nếu yêu cầu không còn ở trạng thái chờ duyệt.
+func (c *CorrectionRepository) ApproveCorrectionRequest(ctx context.Context, input *domainModel.ReviewCorrectionRequestInput) (bool, error) {
+	rows, err := c.q.ApproveCorrectionRequest(
+		ctx,
+		db.ApproveCorrectionRequestParams{
+			RequestID:  pgtype.UUID{Valid: true, Bytes: input.RequestID},
+			CompanyID:  pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+			ReviewedBy: pgtype.UUID{Valid: true, Bytes: input.ReviewedBy},
+			ReviewNote: pgtype.Text{Valid: input.ReviewNote != "", String: input.ReviewNote},
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// RejectCorrectionRequest implements repository.ICorrectionRepository.
+func (c *CorrectionRepository) RejectCorrectionRequest(ctx context.Context, input *domainModel.ReviewCorrectionRequestInput) (bool, error) {
+	rows, err := c.q.RejectCorrectionRequest(
+		ctx,
+		db.RejectCorrectionRequestParams{
+			RequestID:  pgtype.UUID{Valid: true, Bytes: input.RequestID},
+			CompanyID:  pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+			ReviewedBy: pgtype.UUID{Valid: true, Bytes: input.ReviewedBy},
+			ReviewNote: pgtype.Text{Valid: input.ReviewNote != "", String: input.ReviewNote},
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// RevertCorrectionApproval implements repository.ICorrectionRepository.
+// Trả về false nếu yêu cầu không còn ở trạng thái đã duyệt.
+func (c *CorrectionRepository) RevertCorrectionApproval(ctx context.Context, input *domainModel.GetCorrectionRequestInput) (bool, error) {
+	rows, err := c.q.RevertCorrectionApproval(
+		ctx,
+		db.RevertCorrectionApprovalParams{
+			RequestID: pgtype.UUID{Valid: true, Bytes: input.RequestID},
+			CompanyID: pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// UpdateCorrectionResult implements repository.ICorrectionRepository.
+func (c *CorrectionRepository) UpdateCorrectionResult(ctx context.Context, input *domainModel.UpdateCorrectionResultInput) error {
+	correctedStatus := input.CorrectedStatus
+	return c.q.UpdateCorrectionResult(
+		ctx,
+		db.UpdateCorrectionResultParams{
+			RequestID:         pgtype.UUID{Valid: true, Bytes: input.RequestID},
+			CorrectedCheckIn:  toPgTimestamptz(input.CorrectedCheckIn),
+			CorrectedCheckOut: toPgTimestamptz(input.CorrectedCheckOut),
+			CorrectedStatus:   toPgInt2(&correctedStatus),
+		},
+	)
+}
+
+// New instance correction repository and impl ICorrectionRepository
+func NewCorrectionRepository(conn *pgxpool.Pool) domainRepo.ICorrectionRepository {
+	return &CorrectionRepository{
+		q: *db.New(conn),
+	}
+}
+
+// toAttendanceCorrection mapping bản ghi yêu cầu điều chỉnh sang domain model
+func toAttendanceCorrection(r db.AttendanceCorrectionRequest) domainModel.AttendanceCorrection {
+	result := domainModel.AttendanceCorrection{
+		RequestID:         r.RequestID.Bytes,
+		CompanyID:         r.CompanyID.Bytes,
+		EmployeeID:        r.EmployeeID.Bytes,
+		ShiftID:           r.ShiftID.Bytes,
+		WorkDate:          r.WorkDate.Time,
+		ProposedCheckIn:   fromPgTimestamptz(r.ProposedCheckIn),
+		ProposedCheckOut:  fromPgTimestamptz(r.ProposedCheckOut),
+		Reason:            r.Reason,
+		OriginalCheckIn:   fromPgTimestamptz(r.OriginalCheckIn),
+		OriginalCheckOut:  fromPgTimestamptz(r.OriginalCheckOut),
+		OriginalStatus:    fromPgInt2(r.OriginalStatus),
+		CorrectedCheckIn:  fromPgTimestamptz(r.CorrectedCheckIn),
+		CorrectedCheckOut: fromPgTimestamptz(r.CorrectedCheckOut),
+		CorrectedStatus:   fromPgInt2(r.CorrectedStatus),
+		Status:            int(r.Status),
+		ReviewedAt:        fromPgTimestamptz(r.ReviewedAt),
+		ReviewNote:        r.ReviewNote.String,
+		CreatedAt:         r.CreatedAt.Time,
+		UpdatedAt:         r.UpdatedAt.Time,
+	}
+	if r.ReviewedBy.Valid {
+		reviewedBy := uuid.UUID(r.ReviewedBy.Bytes)
+		result.ReviewedBy = &reviewedBy
+	}
+	return result
+}
+
+func toPgTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil || t.IsZero() {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Valid: true, Time: *t}
+}
+
+func fromPgTimestamptz(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time
+	return &v
+}
+
+func toPgInt2(v *int) pgtype.Int2 {
+	if v == nil {
+		return pgtype.Int2{}
+	}
+	return pgtype.Int2{Valid: true, Int16: int16(*v)}
+}
+
+func fromPgInt2(v pgtype.Int2) *int {
+	if !v.Valid {
+		return nil
+	}
+	i := int(v.Int16)
+	return &i
+}
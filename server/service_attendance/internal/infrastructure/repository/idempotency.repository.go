@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/model"
+	domainRepository "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/repository"
+)
+
+/**
+ * Struct impl IIdempotencyRepository
+ */
+type IdempotencyRepository struct {
+	dbSession *gocql.Session
+}
+
+// ClaimIdempotencyKey implements repository.IIdempotencyRepository.
+func (r *IdempotencyRepository) ClaimIdempotencyKey(ctx context.Context, input *model.ClaimIdempotencyKeyInput) (bool, *model.AttendanceIdempotencyRecord, error) {
+	sql_raw := `INSERT INTO attendance_idempotency_keys (
+		company_id, idempotency_key, employee_id, record_time, record_type, status, created_at
+		) VALUES (?, ?, ?, ?, ?, ?, toTimestamp(now()))
+		IF NOT EXISTS USING TTL ?;`
+	existing := map[string]interface{}{}
+	applied, err := r.dbSession.Query(sql_raw,
+		marshalUuid(input.CompanyID),
+		input.IdempotencyKey,
+		marshalUuid(input.EmployeeID),
+		input.RecordTime,
+		-1,
+		model.IdempotencyStatusPending,
+		input.TTLSeconds,
+	).SerialConsistency(gocql.LocalSerial).WithContext(ctx).MapScanCAS(existing)
+	if err != nil {
+		return false, nil, err
+	}
+	if applied {
+		return true, nil, nil
+	}
+	record := &model.AttendanceIdempotencyRecord{
+		CompanyID:      input.CompanyID,
+		IdempotencyKey: input.IdempotencyKey,
+	}
+	if v, ok := existing["employee_id"].(gocql.UUID); ok {
+		record.EmployeeID = unmarshalUuid(v)
+	}
+	if v, ok := existing["record_time"].(time.Time); ok {
+		record.RecordTime = v
+	}
+	if v, ok := existing["record_type"].(int); ok {
+		record.RecordType = v
+	}
+	if v, ok := existing["status"].(string); ok {
+		record.Status = v
+	}
+	return false, record, nil
+}
+
+// CompleteIdempotencyKey implements repository.IIdempotencyRepository.
+func (r *IdempotencyRepository) CompleteIdempotencyKey(ctx context.Context, input *model.CompleteIdempotencyKeyInput) error {
+	sql_raw := `UPDATE attendance_idempotency_keys USING TTL ?
+		SET record_type = ?, status = ?
+		WHERE company_id = ? AND idempotency_key = ?
+		IF status = ?;`
+	_, err := r.dbSession.Query(sql_raw,
+		input.TTLSeconds,
+		input.RecordType,
+		model.IdempotencyStatusCompleted,
+		marshalUuid(input.CompanyID),
+		input.IdempotencyKey,
+		model.IdempotencyStatusPending,
+	).SerialConsistency(gocql.LocalSerial).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	return err
+}
+
+// ReleaseIdempotencyKey implements repository.IIdempotencyRepository.
+func (r *IdempotencyRepository) ReleaseIdempotencyKey(ctx context.Context, input *model.ReleaseIdempotencyKeyInput) error {
+	sql_raw := `DELETE FROM attendance_idempotency_keys
+		WHERE company_id = ? AND idempotency_key = ?
+		IF status = ?;`
+	_, err := r.dbSession.Query(sql_raw,
+		marshalUuid(input.CompanyID),
+		input.IdempotencyKey,
+		model.IdempotencyStatusPending,
+	).SerialConsistency(gocql.LocalSerial).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	return err
+}
+
+/**
+ * New IdempotencyRepository
+ */
+func NewIdempotencyRepository(session *gocql.Session) domainRepository.IIdempotencyRepository {
+	return &IdempotencyRepository{
+		dbSession: session,
+	}
+}
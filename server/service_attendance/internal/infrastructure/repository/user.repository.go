@@ -45,8 +45,9 @@ func (u *UserRepository) GetListTimeShiftEmployee(ctx context.Context, input *do
 		}
 
 		// Convert pgtype.Time to time.Time (using date 0000-01-01 as base)
-		startTime := time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(r.StartTime.Microseconds) * time.Microsecond)
-		endTime := time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(r.EndTime.Microseconds) * time.Microsecond)
+		startTime := pgTimeToTimeOfDay(r.StartTime)
+		endTime := pgTimeToTimeOfDay(r.EndTime)
+		coreStartTime, coreEndTime := pgCoreHoursToTimeOfDay(r.CoreStartTime, r.CoreEndTime)
 
 		result[i] = domainModel.ShiftTimeEmployee{
 			ShiftID:               r.ShiftID.Bytes,
@@ -54,6 +55,12 @@ func (u *UserRepository) GetListTimeShiftEmployee(ctx context.Context, input *do
 			EndTime:               endTime,
 			GracePeriodMinutes:    int(r.GracePeriodMinutes.Int32),
 			EarlyDepartureMinutes: int(r.EarlyDepartureMinutes.Int32),
+			BreakDurationMinutes:  int(r.BreakDurationMinutes.Int32),
+			OvertimeAfterMinutes:  int(r.OvertimeAfterMinutes.Int32),
+			IsFlexible:            r.IsFlexible.Valid && r.IsFlexible.Bool,
+			CoreStartTime:         coreStartTime,
+			CoreEndTime:           coreEndTime,
+			RequiredWorkMinutes:   int(r.RequiredWorkMinutes.Int32),
 			WorkDays:              r.WorkDays,
 			EffectiveFrom:         r.EffectiveFrom.Time,
 			EffectiveTo:           effectiveTo,
@@ -62,6 +69,84 @@ func (u *UserRepository) GetListTimeShiftEmployee(ctx context.Context, input *do
 	return result, nil
 }
 
+// GetListActiveEmployeeShift implements repository.IUserRepository.
+func (u *UserRepository) GetListActiveEmployeeShift(ctx context.Context, input *domainModel.GetListActiveEmployeeShiftInput) ([]domainModel.EmployeeShiftAssignment, error) {
+	reps, err := u.q.GetListActiveEmployeeShift(
+		ctx,
+		db.GetListActiveEmployeeShiftParams{
+			WorkDate:           pgtype.Date{Valid: true, Time: input.Date},
+			AfterEmployeeID:    pgtype.UUID{Valid: true, Bytes: input.AfterEmployeeID},
+			AfterShiftID:       pgtype.UUID{Valid: true, Bytes: input.AfterShiftID},
+			AfterEffectiveFrom: pgtype.Date{Valid: true, Time: input.AfterEffectiveFrom},
+			PageSize:           int32(input.Limit),
+		},
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return []domainModel.EmployeeShiftAssignment{}, nil
+		}
+		return nil, err
+	}
+	return toEmployeeShiftAssignments(reps), nil
+}
+
+// GetListActiveEmployeeShiftCompany implements repository.IUserRepository.
+func (u *UserRepository) GetListActiveEmployeeShiftCompany(ctx context.Context, input *domainModel.GetListActiveEmployeeShiftCompanyInput) ([]domainModel.EmployeeShiftAssignment, error) {
+	reps, err := u.q.GetListActiveEmployeeShiftCompany(
+		ctx,
+		db.GetListActiveEmployeeShiftCompanyParams{
+			CompanyID:     pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+			EffectiveFrom: pgtype.Date{Valid: true, Time: input.Date},
+		},
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return []domainModel.EmployeeShiftAssignment{}, nil
+		}
+		return nil, err
+	}
+	rows := make([]db.GetListActiveEmployeeShiftRow, len(reps))
+	for i, r := range reps {
+		rows[i] = db.GetListActiveEmployeeShiftRow(r)
+	}
+	return toEmployeeShiftAssignments(rows), nil
+}
+
+// toEmployeeShiftAssignments mapping các phân ca còn hiệu lực sang domain model
+func toEmployeeShiftAssignments(reps []db.GetListActiveEmployeeShiftRow) []domainModel.EmployeeShiftAssignment {
+	result := make([]domainModel.EmployeeShiftAssignment, len(reps))
+	for i, r := range reps {
+		var effectiveTo *time.Time
+		if r.EffectiveTo.Valid {
+			t := r.EffectiveTo.Time
+			effectiveTo = &t
+		}
+		coreStartTime, coreEndTime := pgCoreHoursToTimeOfDay(r.CoreStartTime, r.CoreEndTime)
+
+		result[i] = domainModel.EmployeeShiftAssignment{
+			CompanyID:  r.CompanyID.Bytes,
+			EmployeeID: r.EmployeeID.Bytes,
+			Shift: domainModel.ShiftTimeEmployee{
+				ShiftID:               r.ShiftID.Bytes,
+				StartTime:             pgTimeToTimeOfDay(r.StartTime),
+				EndTime:               pgTimeToTimeOfDay(r.EndTime),
+				GracePeriodMinutes:    int(r.GracePeriodMinutes.Int32),
+				EarlyDepartureMinutes: int(r.EarlyDepartureMinutes.Int32),
+				BreakDurationMinutes:  int(r.BreakDurationMinutes.Int32),
+				OvertimeAfterMinutes:  int(r.OvertimeAfterMinutes.Int32),
+				IsFlexible:            r.IsFlexible.Valid && r.IsFlexible.Bool,
+				CoreStartTime:         coreStartTime,
+				CoreEndTime:           coreEndTime,
+				RequiredWorkMinutes:   int(r.RequiredWorkMinutes.Int32),
+				WorkDays:              r.WorkDays,
+				EffectiveFrom:         r.EffectiveFrom.Time,
+				EffectiveTo:           effectiveTo,
+			},
+		}
+	}
+	return result
+}
+
 // UserIsManagerCompany implements repository.IUserRepository.
 func (u *UserRepository) UserIsManagerCompany(ctx context.Context, input *domainModel.UserIsManagerCompanyInput) (bool, error) {
 	_, err := u.q.CheckUserIsManagementInCompany(
@@ -112,9 +197,64 @@ func (u *UserRepository) UserIsEmployeeInCompany(ctx context.Context, input *dom
 	return true, nil
 }
 
+// GetEmployeeDayOff implements repository.IUserRepository.
+// Ngày nghỉ lễ được ưu tiên hơn nghỉ phép, trả về nil nếu là ngày làm việc bình thường.
+func (u *UserRepository) GetEmployeeDayOff(ctx context.Context, input *domainModel.GetEmployeeDayOffInput) (*domainModel.EmployeeDayOff, error) {
+	workDate := pgtype.Date{Valid: true, Time: input.WorkDate}
+	name, err := u.q.GetCompanyHolidayByDate(
+		ctx,
+		db.GetCompanyHolidayByDateParams{
+			CompanyID:   pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+			HolidayDate: workDate,
+		},
+	)
+	if err == nil {
+		return &domainModel.EmployeeDayOff{
+			Status: domainModel.StatusHoliday,
+			Name:   name,
+		}, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+	leave, err := u.q.GetApprovedLeaveEmployeeByDate(
+		ctx,
+		db.GetApprovedLeaveEmployeeByDateParams{
+			CompanyID:  pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+			EmployeeID: pgtype.UUID{Valid: true, Bytes: input.EmployeeID},
+			StartDate:  workDate,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &domainModel.EmployeeDayOff{
+		Status:    domainModel.StatusOnLeave,
+		LeaveType: int(leave.LeaveType),
+	}, nil
+}
+
 // New instance user repository and impl IUserRepository
 func NewUserRepository(conn *pgxpool.Pool) domainRepo.IUserRepository {
 	return &UserRepository{
 		q: *db.New(conn),
 	}
 }
+
+// pgTimeToTimeOfDay chuyển pgtype.Time sang time.Time (lấy ngày 0000-01-01 làm gốc)
+func pgTimeToTimeOfDay(t pgtype.Time) time.Time {
+	return time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(t.Microseconds) * time.Microsecond)
+}
+
+// pgCoreHoursToTimeOfDay chuyển giờ lõi của ca linh hoạt, nil nếu ca không cấu hình giờ lõi
+func pgCoreHoursToTimeOfDay(coreStart, coreEnd pgtype.Time) (*time.Time, *time.Time) {
+	if !coreStart.Valid || !coreEnd.Valid {
+		return nil, nil
+	}
+	cs := pgTimeToTimeOfDay(coreStart)
+	ce := pgTimeToTimeOfDay(coreEnd)
+	return &cs, &ce
+}
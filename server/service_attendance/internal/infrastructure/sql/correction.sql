@@ -0,0 +1,99 @@
+-- name: CreateCorrectionRequest :one
+INSERT INTO attendance_correction_requests (
+    company_id,
+    employee_id,
+    shift_id,
+    work_date,
+    proposed_check_in,
+    proposed_check_out,
+    reason,
+    original_check_in,
+    original_check_out,
+    original_status
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING request_id;
+
+-- name: CountPendingCorrectionRequestsByDate :one
+SELECT COUNT(1)
+FROM attendance_correction_requests
+WHERE employee_id = $1 AND work_date = $2 AND status = 0;
+
+-- name: GetCorrectionRequestByID :one
+SELECT request_id, company_id, employee_id, shift_id, work_date,
+    proposed_check_in, proposed_check_out, reason,
+    original_check_in, original_check_out, original_status,
+    corrected_check_in, corrected_check_out, corrected_status,
+    status, reviewed_by, reviewed_at, review_note, created_at, updated_at
+FROM attendance_correction_requests
+WHERE request_id = $1 AND company_id = $2
+LIMIT 1;
+
+-- name: GetApprovedCorrectionByDate :one
+-- Latest approved correction of the work day, applied when the summary is (re)computed
+SELECT request_id, company_id, employee_id, shift_id, work_date,
+    proposed_check_in, proposed_check_out, reason,
+    original_check_in, original_check_out, original_status,
+    corrected_check_in, corrected_check_out, corrected_status,
+    status, reviewed_by, reviewed_at, review_note, created_at, updated_at
+FROM attendance_correction_requests
+WHERE company_id = $1 AND employee_id = $2 AND work_date = $3 AND status = 1
+ORDER BY reviewed_at DESC
+LIMIT 1;
+
+-- name: ListCorrectionRequestsByEmployee :many
+SELECT request_id, company_id, employee_id, shift_id, work_date,
+    proposed_check_in, proposed_check_out, reason,
+    original_check_in, original_check_out, original_status,
+    corrected_check_in, corrected_check_out, corrected_status,
+    status, reviewed_by, reviewed_at, review_note, created_at, updated_at
+FROM attendance_correction_requests
+WHERE employee_id = $1
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3;
+
+-- name: ListPendingCorrectionRequestsByCompany :many
+SELECT request_id, company_id, employee_id, shift_id, work_date,
+    proposed_check_in, proposed_check_out, reason,
+    original_check_in, original_check_out, original_status,
+    corrected_check_in, corrected_check_out, corrected_status,
+    status, reviewed_by, reviewed_at, review_note, created_at, updated_at
+FROM attendance_correction_requests
+WHERE company_id = $1 AND status = 0
+ORDER BY created_at ASC
+LIMIT $2 OFFSET $3;
+
+-- name: ApproveCorrectionRequest :execrows
+UPDATE attendance_correction_requests
+SET status = 1,
+    reviewed_by = $3,
+    reviewed_at = CURRENT_TIMESTAMP,
+    review_note = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE request_id = $1 AND company_id = $2 AND status = 0;
+
+-- name: RejectCorrectionRequest :execrows
+UPDATE attendance_correction_requests
+SET status = 2,
+    reviewed_by = $3,
+    reviewed_at = CURRENT_TIMESTAMP,
+    review_note = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE request_id = $1 AND company_id = $2 AND status = 0;
+
+-- name: RevertCorrectionApproval :execrows
+-- Đưa yêu cầu về trạng thái chờ duyệt khi không tính lại được bản tổng hợp sau khi duyệt
+UPDATE attendance_correction_requests
+SET status = 0,
+    reviewed_by = NULL,
+    reviewed_at = NULL,
+    review_note = NULL,
+    updated_at = CURRENT_TIMESTAMP
+WHERE request_id = $1 AND company_id = $2 AND status = 1;
+
+-- name: UpdateCorrectionResult :exec
+UPDATE attendance_correction_requests
+SET corrected_check_in = $2,
+    corrected_check_out = $3,
+    corrected_status = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE request_id = $1;
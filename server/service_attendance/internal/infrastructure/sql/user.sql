@@ -5,6 +5,12 @@ SELECT
     ws.end_time,
     ws.grace_period_minutes,
     ws.early_departure_minutes,
+    ws.break_duration_minutes,
+    ws.overtime_after_minutes,
+    ws.is_flexible,
+    ws.core_start_time,
+    ws.core_end_time,
+    ws.required_work_minutes,
     ws.work_days,
     es.effective_from,
     es.effective_to
@@ -18,6 +24,82 @@ WHERE
     AND es.is_active = TRUE
     AND ws.is_active = TRUE;
 
+-- name: GetListActiveEmployeeShift :many
+SELECT 
+    ws.company_id,
+    es.employee_id,
+    ws.shift_id,
+    ws.start_time,
+    ws.end_time,
+    ws.grace_period_minutes,
+    ws.early_departure_minutes,
+    ws.break_duration_minutes,
+    ws.overtime_after_minutes,
+    ws.is_flexible,
+    ws.core_start_time,
+    ws.core_end_time,
+    ws.required_work_minutes,
+    ws.work_days,
+    es.effective_from,
+    es.effective_to
+FROM 
+    employee_shifts es
+JOIN 
+    work_shifts ws ON es.shift_id = ws.shift_id
+WHERE 
+    es.is_active = TRUE
+    AND ws.is_active = TRUE
+    AND es.effective_from <= sqlc.arg(work_date)::date
+    AND (es.effective_to IS NULL OR es.effective_to >= sqlc.arg(work_date)::date)
+    AND (es.employee_id, es.shift_id, es.effective_from) > (sqlc.arg(after_employee_id)::uuid, sqlc.arg(after_shift_id)::uuid, sqlc.arg(after_effective_from)::date)
+ORDER BY es.employee_id, es.shift_id, es.effective_from
+LIMIT sqlc.arg(page_size);
+
+-- name: GetListActiveEmployeeShiftCompany :many
+SELECT 
+    ws.company_id,
+    es.employee_id,
+    ws.shift_id,
+    ws.start_time,
+    ws.end_time,
+    ws.grace_period_minutes,
+    ws.early_departure_minutes,
+    ws.break_duration_minutes,
+    ws.overtime_after_minutes,
+    ws.is_flexible,
+    ws.core_start_time,
+    ws.core_end_time,
+    ws.required_work_minutes,
+    ws.work_days,
+    es.effective_from,
+    es.effective_to
+FROM 
+    employee_shifts es
+JOIN 
+    work_shifts ws ON es.shift_id = ws.shift_id
+WHERE 
+    ws.company_id = $1
+    AND es.is_active = TRUE
+    AND ws.is_active = TRUE
+    AND es.effective_from <= $2
+    AND (es.effective_to IS NULL OR es.effective_to >= $2);
+
+-- name: GetCompanyHolidayByDate :one
+SELECT name
+FROM company_holidays
+WHERE company_id = $1 AND holiday_date = $2
+LIMIT 1;
+
+-- name: GetApprovedLeaveEmployeeByDate :one
+SELECT request_id, leave_type
+FROM leave_requests
+WHERE company_id = $1
+    AND employee_id = $2
+    AND status = 1
+    AND start_date <= $3
+    AND end_date >= $3
+LIMIT 1;
+
 -- name: GetCompanyIdUser :one
 SELECT company_id
 FROM employees
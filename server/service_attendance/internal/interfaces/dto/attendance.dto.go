@@ -43,4 +43,5 @@ type AddAttendanceRequest struct {
 	VerificationScore   float64 `json:"verification_score" validate:"required,gte=0,lte=1"`
 	FaceImageURL        string  `json:"face_image_url" validate:"required"`
 	LocationCoordinates string  `json:"location_coordinates" validate:"required"`
+	IdempotencyKey      string  `json:"idempotency_key" validate:"omitempty,max=128"` // Optional client-supplied event id
 }
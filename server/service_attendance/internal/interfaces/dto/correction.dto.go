@@ -0,0 +1,28 @@
+package dto
+
+// ============================================
+// Correction DTOs
+// ============================================
+type CreateCorrectionRequest struct {
+	WorkDate         string `json:"work_date" validate:"required,len=10"`         // Format: YYYY-MM-DD
+	ProposedCheckIn  int64  `json:"proposed_check_in" validate:"omitempty,gt=0"`  // Unix timestamp in seconds
+	ProposedCheckOut int64  `json:"proposed_check_out" validate:"omitempty,gt=0"` // Unix timestamp in seconds
+	Reason           string `json:"reason" validate:"required,max=1000"`
+}
+
+type ListCorrectionRequestsEmployeeRequest struct {
+	Limit  int `json:"limit" validate:"omitempty,gte=0,lte=100"`
+	Offset int `json:"offset" validate:"omitempty,gte=0"`
+}
+
+type ListPendingCorrectionRequestsRequest struct {
+	CompanyID string `json:"company_id" validate:"required"`
+	Limit     int    `json:"limit" validate:"omitempty,gte=0,lte=100"`
+	Offset    int    `json:"offset" validate:"omitempty,gte=0"`
+}
+
+type ReviewCorrectionRequest struct {
+	CompanyID  string `json:"company_id" validate:"required"`
+	RequestID  string `json:"request_id" validate:"required"`
+	ReviewNote string `json:"review_note" validate:"omitempty,max=1000"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+// ============================================
+// Recompute DTOs
+// ============================================
+type RecomputeDailySummariesRequest struct {
+	CompanyID   string   `json:"company_id" validate:"required"`
+	Month       string   `json:"month" validate:"omitempty,len=7"`      // Format: YYYY-MM, thay cho from_date/to_date
+	FromDate    string   `json:"from_date" validate:"omitempty,len=10"` // Format: YYYY-MM-DD
+	ToDate      string   `json:"to_date" validate:"omitempty,len=10"`   // Format: YYYY-MM-DD
+	EmployeeIDs []string `json:"employee_ids" validate:"omitempty,max=1000"`
+	DryRun      bool     `json:"dry_run"`
+}
+
+type GetRecomputeJobRequest struct {
+	CompanyID string `json:"company_id" validate:"required"`
+	JobID     string `json:"job_id" validate:"required"`
+}
@@ -0,0 +1,290 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/model"
+	pb "github.com/youknow2509/cio_verify_face/server/service_attendance/proto"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// Số worker xử lý song song của một batch stream
+	batchStreamWorkers = 20
+	// Số item chờ tối đa của mỗi worker
+	batchStreamWorkerBuffer = 16
+	// Số item lỗi tối đa liệt kê trong phản hồi của batch client-streaming cũ
+	legacyBatchMaxErrors = 20
+)
+
+// Lỗi client không phải lỗi dữ liệu, client có thể gửi lại item
+var retryableBatchErrors = map[string]bool{
+	"RequestInProgress": true,
+}
+
+// batchItem item của batch stream đã mapping sang application model
+type batchItem struct {
+	seq    int64
+	model  *applicationModel.AddAttendanceModel
+	reason string // khác rỗng nếu item không hợp lệ, trả về REJECTED ngay
+}
+
+func (s *AttendanceGRPCServer) AddBatchAttendanceStream(stream pb.AttendanceService_AddBatchAttendanceStreamServer) error {
+	return s.runBatchAttendanceStream(
+		stream.Context(),
+		func() (*batchItem, error) {
+			req, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			item := &batchItem{seq: req.GetSeq()}
+			item.model, item.reason = toAddAttendanceModel(req.GetAttendance())
+			return item, nil
+		},
+		stream.Send,
+	)
+}
+
+func (s *AttendanceGRPCServer) ServiceAddBatchAttendanceStream(stream pb.AttendanceService_ServiceAddBatchAttendanceStreamServer) error {
+	return s.runBatchAttendanceStream(
+		stream.Context(),
+		func() (*batchItem, error) {
+			req, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			item := &batchItem{seq: req.GetSeq()}
+			item.model, item.reason = toServiceAddAttendanceModel(req.GetAttendance())
+			return item, nil
+		},
+		stream.Send,
+	)
+}
+
+// runBatchAttendanceStream xử lý batch stream hai chiều:
+//   - item của cùng một nhân viên đi vào cùng một worker để giữ thứ tự check-in/check-out
+//   - mỗi item có một kết quả riêng, lỗi của một item không làm dừng stream
+//   - chỉ một goroutine gửi kết quả vì grpc.ServerStream không an toàn khi Send đồng thời
+func (s *AttendanceGRPCServer) runBatchAttendanceStream(
+	ctx context.Context,
+	recv func() (*batchItem, error),
+	send func(*pb.AddBatchAttendanceResult) error,
+) error {
+	results := make(chan *pb.AddBatchAttendanceResult, batchStreamWorkers)
+	sendErrChan := make(chan error, 1)
+	sendDone := make(chan struct{})
+	go func() {
+		defer close(sendDone)
+		for result := range results {
+			if err := send(result); err != nil {
+				sendErrChan <- err
+				// Client đã ngắt kết nối, bỏ các kết quả còn lại
+				for range results {
+				}
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	shards := make([]chan *batchItem, batchStreamWorkers)
+	for i := range shards {
+		shards[i] = make(chan *batchItem, batchStreamWorkerBuffer)
+		wg.Add(1)
+		go func(items <-chan *batchItem) {
+			defer wg.Done()
+			for item := range items {
+				results <- s.processBatchItem(ctx, item)
+			}
+		}(shards[i])
+	}
+
+	var recvErr error
+	for {
+		item, err := recv()
+		if err == io.EOF {
+			break // Client đã gửi xong
+		}
+		if err != nil {
+			recvErr = err
+			break
+		}
+		shards[batchShardOf(item, len(shards))] <- item
+	}
+	// Đợi tất cả item đã nhận được xử lý và gửi kết quả
+	for _, shard := range shards {
+		close(shard)
+	}
+	wg.Wait()
+	close(results)
+	<-sendDone
+	select {
+	case err := <-sendErrChan:
+		return err
+	default:
+		return recvErr
+	}
+}
+
+// processBatchItem ghi nhận một item và trả về kết quả tương ứng
+func (s *AttendanceGRPCServer) processBatchItem(ctx context.Context, item *batchItem) *pb.AddBatchAttendanceResult {
+	if item.reason != "" {
+		return &pb.AddBatchAttendanceResult{
+			Seq:    item.seq,
+			Status: pb.BatchItemStatus_BATCH_ITEM_STATUS_REJECTED,
+			Reason: item.reason,
+		}
+	}
+	result, appErr := s.attendanceService.AddAttendance(ctx, item.model)
+	if appErr != nil {
+		status := pb.BatchItemStatus_BATCH_ITEM_STATUS_REJECTED
+		reason := appErr.ErrorClient
+		if appErr.ErrorSystem != nil {
+			status = pb.BatchItemStatus_BATCH_ITEM_STATUS_FAILED
+			reason = "InternalError"
+		} else if retryableBatchErrors[appErr.ErrorClient] {
+			status = pb.BatchItemStatus_BATCH_ITEM_STATUS_FAILED
+		}
+		return &pb.AddBatchAttendanceResult{
+			Seq:    item.seq,
+			Status: status,
+			Reason: reason,
+		}
+	}
+	status := pb.BatchItemStatus_BATCH_ITEM_STATUS_ACCEPTED
+	if result.Replayed {
+		status = pb.BatchItemStatus_BATCH_ITEM_STATUS_DUPLICATE
+	}
+	return &pb.AddBatchAttendanceResult{
+		Seq:        item.seq,
+		Status:     status,
+		RecordType: int32(result.RecordType),
+	}
+}
+
+// legacyBatchSummary gom kết quả từng item cho batch client-streaming cũ, chỉ được gọi từ goroutine gửi kết quả
+type legacyBatchSummary struct {
+	added      int
+	duplicated int
+	failures   []string // seq:reason của item bị từ chối hoặc lỗi, seq là thứ tự item trong stream (bắt đầu từ 1)
+	failed     int
+}
+
+func (b *legacyBatchSummary) add(result *pb.AddBatchAttendanceResult) error {
+	switch result.GetStatus() {
+	case pb.BatchItemStatus_BATCH_ITEM_STATUS_ACCEPTED:
+		b.added++
+	case pb.BatchItemStatus_BATCH_ITEM_STATUS_DUPLICATE:
+		b.duplicated++
+	default:
+		b.failed++
+		if len(b.failures) < legacyBatchMaxErrors {
+			b.failures = append(b.failures, fmt.Sprintf("%d:%s", result.GetSeq(), result.GetReason()))
+		}
+	}
+	return nil
+}
+
+// output trả về mã 400 nếu có item không ghi nhận được, message liệt kê các item lỗi để client gửi lại
+func (b *legacyBatchSummary) output() *pb.AddAttendanceOutput {
+	message := fmt.Sprintf("%d attendance records added successfully, %d already recorded", b.added, b.duplicated)
+	if b.failed == 0 {
+		return &pb.AddAttendanceOutput{
+			Message:    message,
+			StatusCode: int32(codes.OK),
+		}
+	}
+	return &pb.AddAttendanceOutput{
+		Message:    fmt.Sprintf("%s, %d failed: %s", message, b.failed, strings.Join(b.failures, ", ")),
+		StatusCode: int32(codes.Code(400)),
+	}
+}
+
+// batchShardOf chọn worker theo nhân viên
+func batchShardOf(item *batchItem, shards int) int {
+	if item.model == nil {
+		return 0
+	}
+	h := fnv.New32a()
+	_, _ = h.Write(item.model.EmployeeID[:])
+	return int(h.Sum32() % uint32(shards))
+}
+
+// toAddAttendanceModel mapping item của user/device, trả về lý do nếu item không hợp lệ
+func toAddAttendanceModel(req *pb.AddAttendanceInput) (*applicationModel.AddAttendanceModel, string) {
+	if req == nil {
+		return nil, "InvalidItem"
+	}
+	requestModel, reason := toAddAttendanceModelBase(req.GetCompanyId(), req.GetEmployeeId(), req.GetDeviceId(), req.GetRecordTime())
+	if reason != "" {
+		return nil, reason
+	}
+	session, err := toSessionReq(req.GetSession())
+	if err != nil {
+		return nil, "InvalidSession"
+	}
+	requestModel.VerificationMethod = req.GetVerificationMethod()
+	requestModel.VerificationScore = req.GetVerificationScore()
+	requestModel.FaceImageURL = req.GetFaceImageUrl()
+	requestModel.LocationCoordinates = req.GetLocationCoordinates()
+	requestModel.IdempotencyKey = req.GetIdempotencyKey()
+	requestModel.Session = session
+	return requestModel, ""
+}
+
+// toServiceAddAttendanceModel mapping item của service nội bộ, trả về lý do nếu item không hợp lệ
+func toServiceAddAttendanceModel(req *pb.ServiceAddBatchAttendanceInput) (*applicationModel.AddAttendanceModel, string) {
+	if req == nil {
+		return nil, "InvalidItem"
+	}
+	requestModel, reason := toAddAttendanceModelBase(req.GetCompanyId(), req.GetEmployeeId(), req.GetDeviceId(), req.GetRecordTime())
+	if reason != "" {
+		return nil, reason
+	}
+	if req.GetSession().GetServiceId() == "" {
+		return nil, "InvalidSession"
+	}
+	requestModel.VerificationMethod = req.GetVerificationMethod()
+	requestModel.VerificationScore = req.GetVerificationScore()
+	requestModel.FaceImageURL = req.GetFaceImageUrl()
+	requestModel.LocationCoordinates = req.GetLocationCoordinates()
+	requestModel.IdempotencyKey = req.GetIdempotencyKey()
+	requestModel.ServiceSession = &applicationModel.ServiceSession{
+		ServiceName: req.GetSession().GetServiceName(),
+		ServiceId:   req.GetSession().GetServiceId(),
+		ClientIp:    req.GetSession().GetClientIp(),
+		ClientAgent: req.GetSession().GetClientAgent(),
+	}
+	return requestModel, ""
+}
+
+func toAddAttendanceModelBase(companyId, employeeId, deviceId string, recordTime int64) (*applicationModel.AddAttendanceModel, string) {
+	companyID, err := uuid.Parse(companyId)
+	if err != nil {
+		return nil, "InvalidCompanyID"
+	}
+	employeeID, err := uuid.Parse(employeeId)
+	if err != nil {
+		return nil, "InvalidEmployeeID"
+	}
+	deviceID, err := uuid.Parse(deviceId)
+	if err != nil {
+		return nil, "InvalidDeviceID"
+	}
+	if recordTime <= 0 {
+		return nil, "InvalidRecordTime"
+	}
+	return &applicationModel.AddAttendanceModel{
+		CompanyID:  companyID,
+		EmployeeID: employeeID,
+		DeviceID:   deviceID,
+		RecordTime: time.Unix(recordTime, 0),
+	}, ""
+}
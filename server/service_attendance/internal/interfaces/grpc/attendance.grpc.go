@@ -2,8 +2,6 @@ package grpc
 
 import (
 	"context"
-	"io"
-	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,12 +16,16 @@ import (
 type AttendanceGRPCServer struct {
 	pb.UnimplementedAttendanceServiceServer
 	attendanceService service.IAttendanceService
+	correctionService service.ICorrectionService
+	recomputeService  service.IRecomputeService
 }
 
 func NewAttendanceGRPCServer() *AttendanceGRPCServer {
 	attendanceService := service.GetAttendanceService()
 	return &AttendanceGRPCServer{
 		attendanceService: attendanceService,
+		correctionService: service.GetCorrectionService(),
+		recomputeService:  service.GetRecomputeService(),
 	}
 }
 
@@ -138,6 +140,7 @@ func (s *AttendanceGRPCServer) AddAttendance(ctx context.Context, req *pb.AddAtt
 		VerificationScore:   req.GetVerificationScore(),
 		FaceImageURL:        req.GetFaceImageUrl(),
 		LocationCoordinates: req.GetLocationCoordinates(),
+		IdempotencyKey:      req.GetIdempotencyKey(),
 		Session: &applicationModel.SessionReq{
 			SessionId:   uuid.MustParse(req.Session.GetSessionId()),
 			UserId:      uuid.MustParse(req.Session.GetUserId()),
@@ -147,17 +150,14 @@ func (s *AttendanceGRPCServer) AddAttendance(ctx context.Context, req *pb.AddAtt
 		},
 	}
 	// Call service
-	appErr := s.attendanceService.AddAttendance(ctx, requestModel)
+	result, appErr := s.attendanceService.AddAttendance(ctx, requestModel)
 	if appErr != nil {
 		if appErr.ErrorSystem != nil {
 			return nil, status.Errorf(codes.Code(401), "System is busy, please try again later")
 		}
 		return nil, status.Errorf(codes.Code(400), "%s", appErr.ErrorClient)
 	}
-	return &pb.AddAttendanceOutput{
-		Message:    "Attendance added successfully",
-		StatusCode: int32(codes.OK),
-	}, nil
+	return toPbAddAttendanceOutput(result), nil
 }
 
 func (s *AttendanceGRPCServer) GetAttendanceRecords(ctx context.Context, input *pb.GetAttendanceRecordsInput) (*pb.GetAttendanceRecordsOutput, error) {
@@ -308,6 +308,12 @@ func (s *AttendanceGRPCServer) GetDailyAttendanceSummary(ctx context.Context, in
 			LateMinutes:       int32(record.LateMinutes),
 			EarlyLeaveMinutes: int32(record.EarlyLeaveMinutes),
 			TotalWorkMinutes:  int32(record.TotalWorkMinutes),
+			ScheduledMinutes:  int32(record.ScheduledMinutes),
+			BreakMinutes:      int32(record.BreakMinutes),
+			OvertimeMinutes:   int32(record.OvertimeMinutes),
+			NetWorkMinutes:    int32(record.NetWorkMinutes),
+			WorkIntervals:     toPbWorkIntervals(record.WorkIntervals),
+			UnpairedPunches:   int32(record.UnpairedPunches),
 			Notes:             record.Notes,
 			UpdatedAt:         record.UpdatedAt.Unix(),
 		})
@@ -359,6 +365,12 @@ func (s *AttendanceGRPCServer) GetDailyAttendanceSummaryEmployee(ctx context.Con
 			LateMinutes:       int32(record.LateMinutes),
 			EarlyLeaveMinutes: int32(record.EarlyLeaveMinutes),
 			TotalWorkMinutes:  int32(record.TotalWorkMinutes),
+			ScheduledMinutes:  int32(record.ScheduledMinutes),
+			BreakMinutes:      int32(record.BreakMinutes),
+			OvertimeMinutes:   int32(record.OvertimeMinutes),
+			NetWorkMinutes:    int32(record.NetWorkMinutes),
+			WorkIntervals:     toPbWorkIntervals(record.WorkIntervals),
+			UnpairedPunches:   int32(record.UnpairedPunches),
 			Notes:             record.Notes,
 			UpdatedAt:         record.UpdatedAt.Unix(),
 		})
@@ -366,190 +378,83 @@ func (s *AttendanceGRPCServer) GetDailyAttendanceSummaryEmployee(ctx context.Con
 	return output, nil
 }
 
+// AddBatchAttendance client-streaming cũ, xử lý giống AddBatchAttendanceStream và trả về tổng kết của các item.
+// Deprecated: dùng AddBatchAttendanceStream để nhận kết quả của từng item.
 func (s *AttendanceGRPCServer) AddBatchAttendance(stream pb.AttendanceService_AddBatchAttendanceServer) error {
-	ctx := stream.Context()
-	sem := make(chan struct{}, 20)
-	var wg sync.WaitGroup
-	var mu sync.Mutex
-	errChan := make(chan error, 1)
-	for {
-		select {
-		case err := <-errChan:
-			return err
-		default:
-		}
-		//
-		req, err := stream.Recv()
-		if err == io.EOF {
-			break // Client đã gửi xong
-		}
-		if err != nil {
-			return err
-		}
-		wg.Add(1)
-		sem <- struct{}{} // Acquire token (block nếu đã đạt giới hạn 20)
-		go func(req *pb.AddAttendanceInput) {
-			defer wg.Done()
-			defer func() { <-sem }()
-			companyID, _ := uuid.Parse(req.GetCompanyId())
-			employeeID, _ := uuid.Parse(req.GetEmployeeId())
-			deviceID, _ := uuid.Parse(req.GetDeviceId())
-			sessionID, _ := uuid.Parse(req.Session.GetSessionId())
-			userID, _ := uuid.Parse(req.Session.GetUserId())
-			sessionCompanyID, _ := uuid.Parse(req.Session.GetCompanyId())
-			// Mapping data request to service
-			requestModel := &applicationModel.AddAttendanceModel{
-				CompanyID:           companyID,
-				EmployeeID:          employeeID,
-				DeviceID:            deviceID,
-				RecordTime:          time.Unix(req.GetRecordTime(), 0),
-				VerificationMethod:  req.GetVerificationMethod(),
-				VerificationScore:   req.GetVerificationScore(),
-				FaceImageURL:        req.GetFaceImageUrl(),
-				LocationCoordinates: req.GetLocationCoordinates(),
-				Session: &applicationModel.SessionReq{
-					SessionId:   sessionID,
-					UserId:      userID,
-					CompanyId:   sessionCompanyID,
-					ClientIp:    req.Session.GetClientIp(),
-					ClientAgent: req.Session.GetClientAgent(),
-				},
-			}
-			// Call service
-			appErr := s.attendanceService.AddAttendance(ctx, requestModel)
-			// Critical section: Gửi phản hồi hoặc lỗi
-			mu.Lock()
-			defer mu.Unlock()
-			// Nếu đã có lỗi hệ thống xảy ra ở goroutine khác, không gửi thêm gì cả
-			if len(errChan) > 0 {
-				return
-			}
-			if appErr != nil {
-				var grpcErr error
-				if appErr.ErrorSystem != nil {
-					grpcErr = status.Errorf(codes.Code(401), "System is busy, please try again later")
-				} else {
-					grpcErr = status.Errorf(codes.Code(400), "%s", appErr.ErrorClient)
-				}
-				// Đẩy lỗi vào channel để main loop biết và dừng lại
-				select {
-				case errChan <- grpcErr:
-				default:
-				}
-				return
-			}
-			// Send response back to client
-			if err := stream.SendMsg(&pb.AddAttendanceOutput{
-				Message:    "Attendance added successfully",
-				StatusCode: int32(codes.OK),
-			}); err != nil {
-				// Nếu gửi thất bại (ví dụ client ngắt kết nối)
-				select {
-				case errChan <- err:
-				default:
-				}
+	var seq int64
+	summary := &legacyBatchSummary{}
+	err := s.runBatchAttendanceStream(
+		stream.Context(),
+		func() (*batchItem, error) {
+			req, err := stream.Recv()
+			if err != nil {
+				return nil, err
 			}
-		}(req)
-	}
-	// Đợi tất cả các request đang chạy hoàn tất
-	wg.Wait()
-	// Kiểm tra lần cuối xem có lỗi nào không
-	select {
-	case err := <-errChan:
+			seq++
+			item := &batchItem{seq: seq}
+			item.model, item.reason = toAddAttendanceModel(req)
+			return item, nil
+		},
+		summary.add,
+	)
+	if err != nil {
 		return err
-	default:
-		return nil
 	}
+	// Client-streaming chỉ được trả về một phản hồi duy nhất
+	return stream.SendAndClose(summary.output())
 }
 
+// ServiceAddBatchAttendance client-streaming cũ của service nội bộ, xử lý giống ServiceAddBatchAttendanceStream.
+// Deprecated: dùng ServiceAddBatchAttendanceStream để nhận kết quả của từng item.
 func (s *AttendanceGRPCServer) ServiceAddBatchAttendance(stream pb.AttendanceService_ServiceAddBatchAttendanceServer) error {
-	ctx := stream.Context()
-	sem := make(chan struct{}, 20)
-	var wg sync.WaitGroup
-	var mu sync.Mutex
-	errChan := make(chan error, 1)
-	for {
-		select {
-		case err := <-errChan:
-			return err
-		default:
-		}
-		//
-		req, err := stream.Recv()
-		if err == io.EOF {
-			break // Client đã gửi xong
-		}
-		if err != nil {
-			return err
-		}
-		wg.Add(1)
-		sem <- struct{}{} // Acquire token (block nếu đã đạt giới hạn 20)
-		go func(req *pb.ServiceAddBatchAttendanceInput) {
-			defer wg.Done()
-			defer func() { <-sem }()
-			companyID, _ := uuid.Parse(req.GetCompanyId())
-			employeeID, _ := uuid.Parse(req.GetEmployeeId())
-			deviceID, _ := uuid.Parse(req.GetDeviceId())
-			// Mapping data request to service
-			requestModel := &applicationModel.AddAttendanceModel{
-				CompanyID:           companyID,
-				EmployeeID:          employeeID,
-				DeviceID:            deviceID,
-				RecordTime:          time.Unix(req.GetRecordTime(), 0),
-				VerificationMethod:  req.GetVerificationMethod(),
-				VerificationScore:   req.GetVerificationScore(),
-				FaceImageURL:        req.GetFaceImageUrl(),
-				LocationCoordinates: req.GetLocationCoordinates(),
-				ServiceSession: &applicationModel.ServiceSession{
-					ServiceName: req.GetSession().GetServiceName(),
-					ServiceId:   req.GetSession().GetServiceId(),
-					ClientIp:    req.GetSession().GetClientIp(),
-					ClientAgent: req.GetSession().GetClientAgent(),
-				},
-			}
-			// Call service
-			appErr := s.attendanceService.AddAttendance(ctx, requestModel)
-			// Critical section: Gửi phản hồi hoặc lỗi
-			mu.Lock()
-			defer mu.Unlock()
-			// Nếu đã có lỗi hệ thống xảy ra ở goroutine khác, không gửi thêm gì cả
-			if len(errChan) > 0 {
-				return
+	var seq int64
+	summary := &legacyBatchSummary{}
+	err := s.runBatchAttendanceStream(
+		stream.Context(),
+		func() (*batchItem, error) {
+			req, err := stream.Recv()
+			if err != nil {
+				return nil, err
 			}
-			if appErr != nil {
-				var grpcErr error
-				if appErr.ErrorSystem != nil {
-					grpcErr = status.Errorf(codes.Code(401), "System is busy, please try again later")
-				} else {
-					grpcErr = status.Errorf(codes.Code(400), "%s", appErr.ErrorClient)
-				}
-				// Đẩy lỗi vào channel để main loop biết và dừng lại
-				select {
-				case errChan <- grpcErr:
-				default:
-				}
-				return
-			}
-			// Send response back to client
-			if err := stream.SendMsg(&pb.AddAttendanceOutput{
-				Message:    "Attendance added successfully",
-				StatusCode: int32(codes.OK),
-			}); err != nil {
-				// Nếu gửi thất bại (ví dụ client ngắt kết nối)
-				select {
-				case errChan <- err:
-				default:
-				}
-			}
-		}(req)
-	}
-	// Đợi tất cả các request đang chạy hoàn tất
-	wg.Wait()
-	// Kiểm tra lần cuối xem có lỗi nào không
-	select {
-	case err := <-errChan:
+			seq++
+			item := &batchItem{seq: seq}
+			item.model, item.reason = toServiceAddAttendanceModel(req)
+			return item, nil
+		},
+		summary.add,
+	)
+	if err != nil {
 		return err
-	default:
-		return nil
 	}
+	return stream.SendAndClose(summary.output())
+}
+
+// toPbAddAttendanceOutput mapping kết quả chấm công sang protobuf, lần gửi lại theo idempotency key được đánh dấu replayed
+func toPbAddAttendanceOutput(result *applicationModel.AddAttendanceResultModel) *pb.AddAttendanceOutput {
+	message := "Attendance added successfully"
+	if result.Replayed {
+		message = "Attendance already recorded"
+	}
+	return &pb.AddAttendanceOutput{
+		Message:    message,
+		StatusCode: int32(codes.OK),
+		RecordType: int32(result.RecordType),
+		Replayed:   result.Replayed,
+	}
+}
+
+// toPbWorkIntervals mapping khoảng làm việc sang protobuf, đầu thiếu của khoảng không ghép cặp được là 0
+func toPbWorkIntervals(intervals []applicationModel.WorkInterval) []*pb.WorkInterval {
+	result := make([]*pb.WorkInterval, 0, len(intervals))
+	for _, interval := range intervals {
+		item := &pb.WorkInterval{Minutes: int32(interval.Minutes)}
+		if interval.CheckIn != nil {
+			item.CheckIn = interval.CheckIn.Unix()
+		}
+		if interval.CheckOut != nil {
+			item.CheckOut = interval.CheckOut.Unix()
+		}
+		result = append(result, item)
+	}
+	return result
 }
@@ -0,0 +1,206 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/model"
+	pb "github.com/youknow2509/cio_verify_face/server/service_attendance/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *AttendanceGRPCServer) CreateCorrectionRequest(ctx context.Context, req *pb.CreateCorrectionRequestInput) (*pb.CreateCorrectionRequestOutput, error) {
+	session, err := toSessionReq(req.GetSession())
+	if err != nil {
+		return nil, status.Errorf(codes.Code(400), "Invalid session")
+	}
+	companyID, err := uuid.Parse(req.GetCompanyId())
+	if err != nil {
+		return nil, status.Errorf(codes.Code(400), "Invalid company_id")
+	}
+	employeeID, err := uuid.Parse(req.GetEmployeeId())
+	if err != nil {
+		return nil, status.Errorf(codes.Code(400), "Invalid employee_id")
+	}
+	workDate, err := time.ParseInLocation("2006-01-02", req.GetWorkDate(), time.Local)
+	if err != nil {
+		return nil, status.Errorf(codes.Code(400), "Invalid work_date")
+	}
+	requestModel := &applicationModel.CreateCorrectionRequestModel{
+		Session: session,
+		//
+		CompanyID:        companyID,
+		EmployeeID:       employeeID,
+		WorkDate:         workDate,
+		ProposedCheckIn:  unixToTimePtr(req.GetProposedCheckIn()),
+		ProposedCheckOut: unixToTimePtr(req.GetProposedCheckOut()),
+		Reason:           req.GetReason(),
+	}
+	result, errApp := s.correctionService.CreateCorrectionRequest(ctx, requestModel)
+	if errApp != nil {
+		if errApp.ErrorSystem != nil {
+			return nil, status.Errorf(codes.Code(500), "System is busy, please try again later")
+		}
+		return nil, status.Errorf(codes.Code(400), "%s", errApp.ErrorClient)
+	}
+	return &pb.CreateCorrectionRequestOutput{
+		RequestId: result.RequestID.String(),
+	}, nil
+}
+
+func (s *AttendanceGRPCServer) ApproveCorrectionRequest(ctx context.Context, req *pb.ReviewCorrectionRequestInput) (*pb.ApproveCorrectionRequestOutput, error) {
+	requestModel, err := toReviewCorrectionRequestModel(req)
+	if err != nil {
+		return nil, err
+	}
+	result, errApp := s.correctionService.ApproveCorrectionRequest(ctx, requestModel)
+	if errApp != nil {
+		if errApp.ErrorSystem != nil {
+			return nil, status.Errorf(codes.Code(500), "System is busy, please try again later")
+		}
+		return nil, status.Errorf(codes.Code(400), "%s", errApp.ErrorClient)
+	}
+	return &pb.ApproveCorrectionRequestOutput{
+		RequestId:         result.RequestID.String(),
+		CorrectedCheckIn:  timePtrToUnix(result.CorrectedCheckIn),
+		CorrectedCheckOut: timePtrToUnix(result.CorrectedCheckOut),
+		CorrectedStatus:   int32(result.CorrectedStatus),
+	}, nil
+}
+
+func (s *AttendanceGRPCServer) RejectCorrectionRequest(ctx context.Context, req *pb.ReviewCorrectionRequestInput) (*emptypb.Empty, error) {
+	requestModel, err := toReviewCorrectionRequestModel(req)
+	if err != nil {
+		return nil, err
+	}
+	if errApp := s.correctionService.RejectCorrectionRequest(ctx, requestModel); errApp != nil {
+		if errApp.ErrorSystem != nil {
+			return nil, status.Errorf(codes.Code(500), "System is busy, please try again later")
+		}
+		return nil, status.Errorf(codes.Code(400), "%s", errApp.ErrorClient)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AttendanceGRPCServer) ListPendingCorrectionRequests(ctx context.Context, req *pb.ListPendingCorrectionRequestsInput) (*pb.ListCorrectionRequestsOutput, error) {
+	session, err := toSessionReq(req.GetSession())
+	if err != nil {
+		return nil, status.Errorf(codes.Code(400), "Invalid session")
+	}
+	companyID, err := uuid.Parse(req.GetCompanyId())
+	if err != nil {
+		return nil, status.Errorf(codes.Code(400), "Invalid company_id")
+	}
+	result, errApp := s.correctionService.ListPendingCorrectionRequests(ctx, &applicationModel.ListPendingCorrectionRequestsModel{
+		Session: session,
+		//
+		CompanyID: companyID,
+		Limit:     int(req.GetLimit()),
+		Offset:    int(req.GetOffset()),
+	})
+	if errApp != nil {
+		if errApp.ErrorSystem != nil {
+			return nil, status.Errorf(codes.Code(500), "System is busy, please try again later")
+		}
+		return nil, status.Errorf(codes.Code(400), "%s", errApp.ErrorClient)
+	}
+	records := make([]*pb.CorrectionRequestInfo, 0, len(result.Records))
+	for _, r := range result.Records {
+		item := &pb.CorrectionRequestInfo{
+			RequestId:        r.RequestID.String(),
+			CompanyId:        r.CompanyID.String(),
+			EmployeeId:       r.EmployeeID.String(),
+			ShiftId:          r.ShiftID.String(),
+			WorkDate:         r.WorkDate.Format("2006-01-02"),
+			ProposedCheckIn:  timePtrToUnix(r.ProposedCheckIn),
+			ProposedCheckOut: timePtrToUnix(r.ProposedCheckOut),
+			Reason:           r.Reason,
+			OriginalCheckIn:  timePtrToUnix(r.OriginalCheckIn),
+			OriginalCheckOut: timePtrToUnix(r.OriginalCheckOut),
+			OriginalStatus:   -1,
+			Status:           int32(r.Status),
+			ReviewedAt:       timePtrToUnix(r.ReviewedAt),
+			ReviewNote:       r.ReviewNote,
+			CreatedAt:        r.CreatedAt.Unix(),
+		}
+		if r.OriginalStatus != nil {
+			item.OriginalStatus = int32(*r.OriginalStatus)
+		}
+		if r.ReviewedBy != nil {
+			item.ReviewedBy = r.ReviewedBy.String()
+		}
+		records = append(records, item)
+	}
+	return &pb.ListCorrectionRequestsOutput{
+		Records: records,
+		Limit:   int32(result.Limit),
+		Offset:  int32(result.Offset),
+	}, nil
+}
+
+// toReviewCorrectionRequestModel mapping request duyệt/từ chối sang application model
+func toReviewCorrectionRequestModel(req *pb.ReviewCorrectionRequestInput) (*applicationModel.ReviewCorrectionRequestModel, error) {
+	session, err := toSessionReq(req.GetSession())
+	if err != nil {
+		return nil, status.Errorf(codes.Code(400), "Invalid session")
+	}
+	companyID, err := uuid.Parse(req.GetCompanyId())
+	if err != nil {
+		return nil, status.Errorf(codes.Code(400), "Invalid company_id")
+	}
+	requestID, err := uuid.Parse(req.GetRequestId())
+	if err != nil {
+		return nil, status.Errorf(codes.Code(400), "Invalid request_id")
+	}
+	return &applicationModel.ReviewCorrectionRequestModel{
+		Session: session,
+		//
+		CompanyID:  companyID,
+		RequestID:  requestID,
+		ReviewNote: req.GetReviewNote(),
+	}, nil
+}
+
+// toSessionReq mapping session protobuf sang application model
+func toSessionReq(session *pb.SessionInfo) (*applicationModel.SessionReq, error) {
+	userID, err := uuid.Parse(session.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	sessionID, err := uuid.Parse(session.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+	var companyID uuid.UUID
+	if session.GetCompanyId() != "" {
+		if companyID, err = uuid.Parse(session.GetCompanyId()); err != nil {
+			return nil, err
+		}
+	}
+	return &applicationModel.SessionReq{
+		SessionId:   sessionID,
+		UserId:      userID,
+		Role:        int(session.GetRole()),
+		CompanyId:   companyID,
+		ClientIp:    session.GetClientIp(),
+		ClientAgent: session.GetClientAgent(),
+	}, nil
+}
+
+func unixToTimePtr(v int64) *time.Time {
+	if v == 0 {
+		return nil
+	}
+	t := time.Unix(v, 0)
+	return &t
+}
+
+func timePtrToUnix(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
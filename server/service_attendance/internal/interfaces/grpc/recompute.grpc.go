@@ -0,0 +1,128 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/model"
+	pb "github.com/youknow2509/cio_verify_face/server/service_attendance/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *AttendanceGRPCServer) RecomputeDailySummaries(ctx context.Context, req *pb.RecomputeDailySummariesInput) (*pb.RecomputeJobOutput, error) {
+	session, err := toSessionReq(req.GetSession())
+	if err != nil {
+		return nil, status.Errorf(codes.Code(400), "Invalid session")
+	}
+	companyID, err := uuid.Parse(req.GetCompanyId())
+	if err != nil {
+		return nil, status.Errorf(codes.Code(400), "Invalid company_id")
+	}
+	requestModel := &applicationModel.RecomputeDailySummariesModel{
+		Session: session,
+		//
+		CompanyID: companyID,
+		DryRun:    req.GetDryRun(),
+		Immediate: req.GetImmediate(),
+	}
+	for _, v := range []struct {
+		layout string
+		value  string
+		target *time.Time
+	}{
+		{"2006-01", req.GetMonth(), &requestModel.Month},
+		{"2006-01-02", req.GetFromDate(), &requestModel.FromDate},
+		{"2006-01-02", req.GetToDate(), &requestModel.ToDate},
+	} {
+		if v.value == "" {
+			continue
+		}
+		if *v.target, err = time.ParseInLocation(v.layout, v.value, time.Local); err != nil {
+			return nil, status.Errorf(codes.Code(400), "Invalid date range")
+		}
+	}
+	for _, employeeId := range req.GetEmployeeIds() {
+		employeeID, err := uuid.Parse(employeeId)
+		if err != nil {
+			return nil, status.Errorf(codes.Code(400), "Invalid employee_ids")
+		}
+		requestModel.EmployeeIDs = append(requestModel.EmployeeIDs, employeeID)
+	}
+	result, errApp := s.recomputeService.RecomputeDailySummaries(ctx, requestModel)
+	if errApp != nil {
+		if errApp.ErrorSystem != nil {
+			return nil, status.Errorf(codes.Code(500), "System is busy, please try again later")
+		}
+		return nil, status.Errorf(codes.Code(400), "%s", errApp.ErrorClient)
+	}
+	return toPbRecomputeJobOutput(result), nil
+}
+
+func (s *AttendanceGRPCServer) GetRecomputeJob(ctx context.Context, req *pb.GetRecomputeJobInput) (*pb.RecomputeJobOutput, error) {
+	session, err := toSessionReq(req.GetSession())
+	if err != nil {
+		return nil, status.Errorf(codes.Code(400), "Invalid session")
+	}
+	companyID, err := uuid.Parse(req.GetCompanyId())
+	if err != nil {
+		return nil, status.Errorf(codes.Code(400), "Invalid company_id")
+	}
+	jobID, err := uuid.Parse(req.GetJobId())
+	if err != nil {
+		return nil, status.Errorf(codes.Code(400), "Invalid job_id")
+	}
+	result, errApp := s.recomputeService.GetRecomputeJob(ctx, &applicationModel.GetRecomputeJobModel{
+		Session: session,
+		//
+		CompanyID: companyID,
+		JobID:     jobID,
+	})
+	if errApp != nil {
+		if errApp.ErrorSystem != nil {
+			return nil, status.Errorf(codes.Code(500), "System is busy, please try again later")
+		}
+		return nil, status.Errorf(codes.Code(400), "%s", errApp.ErrorClient)
+	}
+	return toPbRecomputeJobOutput(result), nil
+}
+
+func toPbRecomputeJobOutput(job *applicationModel.RecomputeJobModel) *pb.RecomputeJobOutput {
+	output := &pb.RecomputeJobOutput{
+		JobId:          job.JobID.String(),
+		CompanyId:      job.CompanyID.String(),
+		Status:         job.Status,
+		DryRun:         job.DryRun,
+		FromDate:       job.FromDate.Format("2006-01-02"),
+		ToDate:         job.ToDate.Format("2006-01-02"),
+		Total:          int32(job.Total),
+		Processed:      int32(job.Processed),
+		Changed:        int32(job.Changed),
+		Failed:         int32(job.Failed),
+		DiffsTruncated: job.DiffsTruncated,
+		Error:          job.Error,
+		CreatedBy:      job.CreatedBy.String(),
+		CreatedAt:      job.CreatedAt.Unix(),
+		FinishedAt:     timePtrToUnix(job.FinishedAt),
+	}
+	for _, employeeID := range job.EmployeeIDs {
+		output.EmployeeIds = append(output.EmployeeIds, employeeID.String())
+	}
+	for _, diff := range job.Diffs {
+		pbDiff := &pb.RecomputeDiff{
+			EmployeeId: diff.EmployeeID.String(),
+			WorkDate:   diff.WorkDate.Format("2006-01-02"),
+			Missing:    diff.Missing,
+		}
+		for _, change := range diff.Changes {
+			pbDiff.Changes = append(pbDiff.Changes, &pb.RecomputeFieldChange{
+				Field:  change.Field,
+				Before: change.Before,
+				After:  change.After,
+			})
+		}
+		output.Diffs = append(output.Diffs, pbDiff)
+	}
+	return output
+}
@@ -479,7 +479,7 @@ func (a *AttendanceHandler) AddAttendance(c *gin.Context) {
 	}
 	recordTime := time.Unix(req.RecordTime, 0)
 	// Call application service
-	errApplication := applicationService.GetAttendanceService().AddAttendance(
+	result, errApplication := applicationService.GetAttendanceService().AddAttendance(
 		c,
 		&applicationModel.AddAttendanceModel{
 			Session: &sessionReq,
@@ -492,6 +492,7 @@ func (a *AttendanceHandler) AddAttendance(c *gin.Context) {
 			VerificationScore:   req.VerificationScore,
 			FaceImageURL:        req.FaceImageURL,
 			LocationCoordinates: req.LocationCoordinates,
+			IdempotencyKey:      req.IdempotencyKey,
 		},
 	)
 	if errApplication != nil {
@@ -503,6 +504,10 @@ func (a *AttendanceHandler) AddAttendance(c *gin.Context) {
 		return
 	}
 	// Return response
+	if result.Replayed {
+		response.SuccessResponse(c, 200, "Attendance record already added")
+		return
+	}
 	response.SuccessResponse(c, 200, "Attendance record added successfully")
 }
 
@@ -0,0 +1,328 @@
+package handler
+
+import (
+	"strings"
+	"time"
+
+	gin "github.com/gin-gonic/gin"
+	validator "github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/model"
+	applicationService "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/service"
+	constants "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/constants"
+	dto "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/interfaces/dto"
+	response "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/interfaces/response"
+	contextShared "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/shared/utils/context"
+	uuidShared "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/shared/utils/uuid"
+)
+
+// ============================================
+// Correction handler
+// ============================================
+type iCorrectionHandler interface {
+	CreateCorrectionRequest(c *gin.Context)
+	ListCorrectionRequestsEmployee(c *gin.Context)
+	ListPendingCorrectionRequests(c *gin.Context)
+	ApproveCorrectionRequest(c *gin.Context)
+	RejectCorrectionRequest(c *gin.Context)
+}
+
+// ============================================================
+// Correction handler struct deployment interface
+// ============================================================
+type CorrectionHandler struct{}
+
+// CreateCorrectionRequest implements iCorrectionHandler.
+// @Summary      Create attendance correction request
+// @Description  Employee proposes check-in and/or check-out of a work day for manager approval
+// @Tags         Attendance Correction
+// @Accept       json
+// @Produce      json
+// @Param 	  	 Authorization header string true "With the bearer started"
+// @Param        request   body dto.CreateCorrectionRequest  true  "Request body create correction request"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/attendance/corrections [post]
+func (h *CorrectionHandler) CreateCorrectionRequest(c *gin.Context) {
+	var req *dto.CreateCorrectionRequest
+	if !bindAndValidate(c, &req) {
+		return
+	}
+	sessionReq, ok := getSessionReq(c)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	// Parse data request
+	workDate, err := time.ParseInLocation("2006-01-02", req.WorkDate, time.Local)
+	if err != nil {
+		response.BadRequestResponse(c, response.ErrCodeParamInvalid, "Invalid work_date format, expected YYYY-MM-DD")
+		return
+	}
+	var proposedCheckIn, proposedCheckOut *time.Time
+	if req.ProposedCheckIn != 0 {
+		t := time.Unix(req.ProposedCheckIn, 0)
+		proposedCheckIn = &t
+	}
+	if req.ProposedCheckOut != 0 {
+		t := time.Unix(req.ProposedCheckOut, 0)
+		proposedCheckOut = &t
+	}
+	// Call application service
+	result, errApplication := applicationService.GetCorrectionService().CreateCorrectionRequest(
+		c,
+		&applicationModel.CreateCorrectionRequestModel{
+			Session: &sessionReq,
+			//
+			CompanyID:        sessionReq.CompanyId,
+			EmployeeID:       sessionReq.UserId,
+			WorkDate:         workDate,
+			ProposedCheckIn:  proposedCheckIn,
+			ProposedCheckOut: proposedCheckOut,
+			Reason:           req.Reason,
+		},
+	)
+	if errApplication != nil {
+		if errApplication.ErrorSystem != nil {
+			response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Server temporary busy, please try again later")
+			return
+		}
+		response.BadRequestResponse(c, 400, errApplication.ErrorClient)
+		return
+	}
+	// Return response
+	response.SuccessResponse(c, 200, result)
+}
+
+// ListCorrectionRequestsEmployee implements iCorrectionHandler.
+// @Summary      List correction requests of current employee
+// @Description  List correction requests of current employee, newest first
+// @Tags         Attendance Correction
+// @Accept       json
+// @Produce      json
+// @Param 	  	 Authorization header string true "With the bearer started"
+// @Param        request   body dto.ListCorrectionRequestsEmployeeRequest  true  "Request body list correction requests"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/attendance/corrections/employee [post]
+func (h *CorrectionHandler) ListCorrectionRequestsEmployee(c *gin.Context) {
+	var req *dto.ListCorrectionRequestsEmployeeRequest
+	if !bindAndValidate(c, &req) {
+		return
+	}
+	sessionReq, ok := getSessionReq(c)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	// Call application service
+	result, errApplication := applicationService.GetCorrectionService().ListCorrectionRequestsEmployee(
+		c,
+		&applicationModel.ListCorrectionRequestsEmployeeModel{
+			Session: &sessionReq,
+			//
+			CompanyID:  sessionReq.CompanyId,
+			EmployeeID: sessionReq.UserId,
+			Limit:      req.Limit,
+			Offset:     req.Offset,
+		},
+	)
+	if errApplication != nil {
+		if errApplication.ErrorSystem != nil {
+			response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Server temporary busy, please try again later")
+			return
+		}
+		response.BadRequestResponse(c, 400, errApplication.ErrorClient)
+		return
+	}
+	// Return response
+	response.SuccessResponse(c, 200, result)
+}
+
+// ListPendingCorrectionRequests implements iCorrectionHandler.
+// @Summary      List pending correction requests of company
+// @Description  List pending correction requests of company, oldest first
+// @Tags         Attendance Correction
+// @Accept       json
+// @Produce      json
+// @Param 	  	 Authorization header string true "With the bearer started"
+// @Param        request   body dto.ListPendingCorrectionRequestsRequest  true  "Request body list pending correction requests"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/attendance/corrections/pending [post]
+func (h *CorrectionHandler) ListPendingCorrectionRequests(c *gin.Context) {
+	var req *dto.ListPendingCorrectionRequestsRequest
+	if !bindAndValidate(c, &req) {
+		return
+	}
+	sessionReq, ok := getSessionReq(c)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	// Parse data request
+	companyIdReq, err := uuidShared.ParseUUID(req.CompanyID)
+	if err != nil {
+		response.BadRequestResponse(c, response.ErrCodeParamInvalid, "Invalid company_id")
+		return
+	}
+	// Call application service
+	result, errApplication := applicationService.GetCorrectionService().ListPendingCorrectionRequests(
+		c,
+		&applicationModel.ListPendingCorrectionRequestsModel{
+			Session: &sessionReq,
+			//
+			CompanyID: companyIdReq,
+			Limit:     req.Limit,
+			Offset:    req.Offset,
+		},
+	)
+	if errApplication != nil {
+		if errApplication.ErrorSystem != nil {
+			response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Server temporary busy, please try again later")
+			return
+		}
+		response.BadRequestResponse(c, 400, errApplication.ErrorClient)
+		return
+	}
+	// Return response
+	response.SuccessResponse(c, 200, result)
+}
+
+// ApproveCorrectionRequest implements iCorrectionHandler.
+// @Summary      Approve correction request
+// @Description  Approve correction request and recompute the daily summary of the work date
+// @Tags         Attendance Correction
+// @Accept       json
+// @Produce      json
+// @Param 	  	 Authorization header string true "With the bearer started"
+// @Param        request   body dto.ReviewCorrectionRequest  true  "Request body approve correction request"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/attendance/corrections/approve [post]
+func (h *CorrectionHandler) ApproveCorrectionRequest(c *gin.Context) {
+	reviewReq, ok := parseReviewCorrectionRequest(c)
+	if !ok {
+		return
+	}
+	// Call application service
+	result, errApplication := applicationService.GetCorrectionService().ApproveCorrectionRequest(c, reviewReq)
+	if errApplication != nil {
+		if errApplication.ErrorSystem != nil {
+			response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Server temporary busy, please try again later")
+			return
+		}
+		response.BadRequestResponse(c, 400, errApplication.ErrorClient)
+		return
+	}
+	// Return response
+	response.SuccessResponse(c, 200, result)
+}
+
+// RejectCorrectionRequest implements iCorrectionHandler.
+// @Summary      Reject correction request
+// @Description  Reject correction request
+// @Tags         Attendance Correction
+// @Accept       json
+// @Produce      json
+// @Param 	  	 Authorization header string true "With the bearer started"
+// @Param        request   body dto.ReviewCorrectionRequest  true  "Request body reject correction request"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/attendance/corrections/reject [post]
+func (h *CorrectionHandler) RejectCorrectionRequest(c *gin.Context) {
+	reviewReq, ok := parseReviewCorrectionRequest(c)
+	if !ok {
+		return
+	}
+	// Call application service
+	if errApplication := applicationService.GetCorrectionService().RejectCorrectionRequest(c, reviewReq); errApplication != nil {
+		if errApplication.ErrorSystem != nil {
+			response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Server temporary busy, please try again later")
+			return
+		}
+		response.BadRequestResponse(c, 400, errApplication.ErrorClient)
+		return
+	}
+	// Return response
+	response.SuccessResponse(c, 200, "Correction request rejected successfully")
+}
+
+// NewCorrectionHandler creates a new instance of CorrectionHandler
+func NewCorrectionHandler() iCorrectionHandler {
+	return &CorrectionHandler{}
+}
+
+// parseReviewCorrectionRequest parse request body duyệt/từ chối yêu cầu điều chỉnh
+func parseReviewCorrectionRequest(c *gin.Context) (*applicationModel.ReviewCorrectionRequestModel, bool) {
+	var req *dto.ReviewCorrectionRequest
+	if !bindAndValidate(c, &req) {
+		return nil, false
+	}
+	sessionReq, ok := getSessionReq(c)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return nil, false
+	}
+	companyIdReq, err := uuidShared.ParseUUID(req.CompanyID)
+	if err != nil {
+		response.BadRequestResponse(c, response.ErrCodeParamInvalid, "Invalid company_id")
+		return nil, false
+	}
+	requestIdReq, err := uuidShared.ParseUUID(req.RequestID)
+	if err != nil {
+		response.BadRequestResponse(c, response.ErrCodeParamInvalid, "Invalid request_id")
+		return nil, false
+	}
+	return &applicationModel.ReviewCorrectionRequestModel{
+		Session: &sessionReq,
+		//
+		CompanyID:  companyIdReq,
+		RequestID:  requestIdReq,
+		ReviewNote: req.ReviewNote,
+	}, true
+}
+
+// bindAndValidate bind json body và validate, tự trả response lỗi nếu không hợp lệ
+func bindAndValidate[T any](c *gin.Context, req **T) bool {
+	if err := c.ShouldBindJSON(req); err != nil || *req == nil {
+		response.ErrorResponse(c, 400, "Invalid request body")
+		return false
+	}
+	validate := c.MustGet(constants.MIDDLEWARE_VALIDATE_SERVICE_NAME).(*validator.Validate)
+	if err := validate.Struct(*req); err != nil {
+		var fieldErrors []string
+		for _, fieldError := range err.(validator.ValidationErrors) {
+			fieldErrors = append(fieldErrors, fieldError.Field())
+		}
+		response.BadRequestResponse(
+			c,
+			response.ErrCodeParamInvalid,
+			"Invalid request parameters: "+strings.Join(fieldErrors, ", "),
+		)
+		return false
+	}
+	return true
+}
+
+// getSessionReq dựng session request từ context đã qua middleware xác thực
+func getSessionReq(c *gin.Context) (applicationModel.SessionReq, bool) {
+	userId, sessionId, userRole, companyId, ok := contextShared.GetSessionFromContext(c)
+	if !ok {
+		return applicationModel.SessionReq{}, false
+	}
+	userUuid, _ := uuidShared.ParseUUID(userId)
+	sessionUuid, _ := uuidShared.ParseUUID(sessionId)
+	var companyUuid uuid.UUID
+	if companyId != "" {
+		companyUuid, _ = uuidShared.ParseUUID(companyId)
+	}
+	return applicationModel.SessionReq{
+		UserId:      userUuid,
+		SessionId:   sessionUuid,
+		Role:        userRole,
+		CompanyId:   companyUuid,
+		ClientIp:    c.ClientIP(),
+		ClientAgent: c.Request.UserAgent(),
+	}, true
+}
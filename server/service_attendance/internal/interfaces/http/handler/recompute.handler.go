@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"time"
+
+	gin "github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/model"
+	applicationService "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/application/service"
+	dto "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/interfaces/dto"
+	response "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/interfaces/response"
+	uuidShared "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/shared/utils/uuid"
+)
+
+// ============================================
+// Recompute handler
+// ============================================
+type iRecomputeHandler interface {
+	RecomputeDailySummaries(c *gin.Context)
+	GetRecomputeJob(c *gin.Context)
+}
+
+// ============================================================
+// Recompute handler struct deployment interface
+// ============================================================
+type RecomputeHandler struct{}
+
+// RecomputeDailySummaries implements iRecomputeHandler.
+// @Summary      Recompute daily summaries
+// @Description  Start a background job recomputing daily summaries of a company from raw attendance records, for a month or a date range and an optional employee list. Dry-run only reports the differences.
+// @Tags         Attendance Summary
+// @Accept       json
+// @Produce      json
+// @Param 	  	 Authorization header string true "With the bearer started"
+// @Param        request   body dto.RecomputeDailySummariesRequest  true  "Request body recompute daily summaries"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/attendance/summaries/recompute [post]
+func (h *RecomputeHandler) RecomputeDailySummaries(c *gin.Context) {
+	var req *dto.RecomputeDailySummariesRequest
+	if !bindAndValidate(c, &req) {
+		return
+	}
+	sessionReq, ok := getSessionReq(c)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	// Parse data request
+	companyIdReq, err := uuidShared.ParseUUID(req.CompanyID)
+	if err != nil {
+		response.BadRequestResponse(c, response.ErrCodeParamInvalid, "Invalid company_id")
+		return
+	}
+	month, fromDate, toDate, ok := parseRecomputeDateRange(req.Month, req.FromDate, req.ToDate)
+	if !ok {
+		response.BadRequestResponse(c, response.ErrCodeParamInvalid, "Invalid date range, expected month YYYY-MM or from_date/to_date YYYY-MM-DD")
+		return
+	}
+	employeeIDs := make([]uuid.UUID, 0, len(req.EmployeeIDs))
+	for _, employeeId := range req.EmployeeIDs {
+		employeeUuid, err := uuidShared.ParseUUID(employeeId)
+		if err != nil {
+			response.BadRequestResponse(c, response.ErrCodeParamInvalid, "Invalid employee_ids")
+			return
+		}
+		employeeIDs = append(employeeIDs, employeeUuid)
+	}
+	// Call application service
+	result, errApplication := applicationService.GetRecomputeService().RecomputeDailySummaries(
+		c,
+		&applicationModel.RecomputeDailySummariesModel{
+			Session: &sessionReq,
+			//
+			CompanyID:   companyIdReq,
+			Month:       month,
+			FromDate:    fromDate,
+			ToDate:      toDate,
+			EmployeeIDs: employeeIDs,
+			DryRun:      req.DryRun,
+		},
+	)
+	if errApplication != nil {
+		if errApplication.ErrorSystem != nil {
+			response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Server temporary busy, please try again later")
+			return
+		}
+		response.BadRequestResponse(c, 400, errApplication.ErrorClient)
+		return
+	}
+	// Return response
+	response.SuccessResponse(c, 200, result)
+}
+
+// GetRecomputeJob implements iRecomputeHandler.
+// @Summary      Get recompute job
+// @Description  Get status, progress and differences of a daily summary recompute job
+// @Tags         Attendance Summary
+// @Accept       json
+// @Produce      json
+// @Param 	  	 Authorization header string true "With the bearer started"
+// @Param        request   body dto.GetRecomputeJobRequest  true  "Request body get recompute job"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/attendance/summaries/recompute/job [post]
+func (h *RecomputeHandler) GetRecomputeJob(c *gin.Context) {
+	var req *dto.GetRecomputeJobRequest
+	if !bindAndValidate(c, &req) {
+		return
+	}
+	sessionReq, ok := getSessionReq(c)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	// Parse data request
+	companyIdReq, err := uuidShared.ParseUUID(req.CompanyID)
+	if err != nil {
+		response.BadRequestResponse(c, response.ErrCodeParamInvalid, "Invalid company_id")
+		return
+	}
+	jobIdReq, err := uuidShared.ParseUUID(req.JobID)
+	if err != nil {
+		response.BadRequestResponse(c, response.ErrCodeParamInvalid, "Invalid job_id")
+		return
+	}
+	// Call application service
+	result, errApplication := applicationService.GetRecomputeService().GetRecomputeJob(
+		c,
+		&applicationModel.GetRecomputeJobModel{
+			Session: &sessionReq,
+			//
+			CompanyID: companyIdReq,
+			JobID:     jobIdReq,
+		},
+	)
+	if errApplication != nil {
+		if errApplication.ErrorSystem != nil {
+			response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Server temporary busy, please try again later")
+			return
+		}
+		response.BadRequestResponse(c, 400, errApplication.ErrorClient)
+		return
+	}
+	// Return response
+	response.SuccessResponse(c, 200, result)
+}
+
+// NewRecomputeHandler creates a new instance of RecomputeHandler
+func NewRecomputeHandler() iRecomputeHandler {
+	return &RecomputeHandler{}
+}
+
+// parseRecomputeDateRange parse month (YYYY-MM) hoặc from_date/to_date (YYYY-MM-DD), giá trị rỗng trả về zero
+func parseRecomputeDateRange(month, fromDate, toDate string) (time.Time, time.Time, time.Time, bool) {
+	var result [3]time.Time
+	for i, v := range []struct{ layout, value string }{
+		{"2006-01", month},
+		{"2006-01-02", fromDate},
+		{"2006-01-02", toDate},
+	} {
+		if v.value == "" {
+			continue
+		}
+		t, err := time.ParseInLocation(v.layout, v.value, time.Local)
+		if err != nil {
+			return time.Time{}, time.Time{}, time.Time{}, false
+		}
+		result[i] = t
+	}
+	return result[0], result[1], result[2], true
+}
@@ -23,6 +23,16 @@ func (r *AttendanceRouter) Deploy(g *gin.RouterGroup) {
 		v1Admin.POST("/records", httpHandler.NewAttendanceHandler().GetAttendanceRecords)
 		// Get daily attendance summary for company
 		v1Admin.POST("/records/summary/daily", httpHandler.NewAttendanceHandler().GetDailyAttendanceSummary)
+		// List pending correction requests for company
+		v1Admin.POST("/corrections/pending", httpHandler.NewCorrectionHandler().ListPendingCorrectionRequests)
+		// Approve correction request
+		v1Admin.POST("/corrections/approve", httpHandler.NewCorrectionHandler().ApproveCorrectionRequest)
+		// Reject correction request
+		v1Admin.POST("/corrections/reject", httpHandler.NewCorrectionHandler().RejectCorrectionRequest)
+		// Recompute daily summaries in background
+		v1Admin.POST("/summaries/recompute", httpHandler.NewRecomputeHandler().RecomputeDailySummaries)
+		// Get recompute job progress
+		v1Admin.POST("/summaries/recompute/job", httpHandler.NewRecomputeHandler().GetRecomputeJob)
 	}
 	//
 	v1User := g.Group("/v1/attendance")
@@ -32,5 +42,9 @@ func (r *AttendanceRouter) Deploy(g *gin.RouterGroup) {
 		v1User.POST("/records/employee", httpHandler.NewAttendanceHandler().GetAttendanceRecordsEmployee)
 		// Get daily attendance summary for employee
 		v1User.POST("/records/employee/summary/daily", httpHandler.NewAttendanceHandler().GetDailyAttendanceSummaryEmployee)
+		// Create correction request for employee
+		v1User.POST("/corrections", httpHandler.NewCorrectionHandler().CreateCorrectionRequest)
+		// List correction requests for employee
+		v1User.POST("/corrections/employee", httpHandler.NewCorrectionHandler().ListCorrectionRequestsEmployee)
 	}
 }
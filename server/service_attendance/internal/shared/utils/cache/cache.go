@@ -158,3 +158,18 @@ func GetKeyDailyAttendanceSummary(companyIdHash string, summaryMonth string, wor
 		return fmt.Sprintf("company:attendance:summary:%s:%s:%d:%d:%s", companyIdHash, summaryMonth, workDate, pageSize, string(pageStage))
 	}
 }
+
+// Key idempotency key of attendance ingestion per company
+func GetKeyAttendanceIdempotency(companyIdHash string, idempotencyKeyHash string) string {
+	return fmt.Sprintf("attendance:idempotency:%s:%s", companyIdHash, idempotencyKeyHash)
+}
+
+// Key daily summary recompute job
+func GetKeyRecomputeJob(jobId string) string {
+	return fmt.Sprintf("attendance:recompute:job:%s", jobId)
+}
+
+// Key lock running recompute job per company
+func GetKeyRecomputeJobLock(companyIdHash string) string {
+	return fmt.Sprintf("attendance:recompute:lock:%s", companyIdHash)
+}
@@ -13,5 +13,21 @@ func initApplication() error {
 	); err != nil {
 		return err
 	}
+	// init CorrectionService
+	correctionService, err := applicationServiceImpl.NewCorrectionService()
+	if err != nil {
+		return err
+	}
+	if err := applicationService.SetCorrectionService(correctionService); err != nil {
+		return err
+	}
+	// init RecomputeService
+	recomputeService, err := applicationServiceImpl.NewRecomputeService()
+	if err != nil {
+		return err
+	}
+	if err := applicationService.SetRecomputeService(recomputeService); err != nil {
+		return err
+	}
 	return nil
 }
@@ -3,9 +3,11 @@ package start
 import (
 	domainCache "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/cache"
 	domainConfig "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/config"
+	domainMq "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/mq"
 	domainToken "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/token"
 	infraCache "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/infrastructure/cache"
 	infraConn "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/infrastructure/conn"
+	infraMq "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/infrastructure/mq"
 	infraToken "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/infrastructure/token"
 )
 
@@ -26,6 +28,10 @@ func initConnectionToInfrastructure(setting *domainConfig.Setting) error {
 	if err := initConnectionScyllaDB(&setting.ScyllaDb); err != nil {
 		return err
 	}
+	// initialize kafka for summary pipeline
+	if err := initKafka(setting); err != nil {
+		return err
+	}
 	// initialize token service
 	_tokenService = infraToken.NewTokenService(
 		grpcClient,
@@ -64,3 +70,16 @@ func initConnectionPostgreSQL(setting *domainConfig.PostgresSetting) error {
 	}
 	return nil
 }
+
+// initKafka khởi tạo kafka writer/reader, chỉ dùng khi bật summary pipeline
+func initKafka(setting *domainConfig.Setting) error {
+	if !setting.SummaryPipeline.Enabled {
+		return nil
+	}
+	if err := infraConn.InitializeKafkaSecurity(&setting.Kafka); err != nil {
+		return err
+	}
+	domainMq.InitKafkaWriteService(infraMq.NewKafkaWriterService(&setting.Kafka))
+	domainMq.InitKafkaReadService(infraMq.NewKafkaReaderService(&setting.Kafka))
+	return nil
+}
@@ -40,12 +40,24 @@ func initDomain() error {
 	); err != nil {
 		return err
 	}
+	// init IIdempotencyRepository
+	if err := domainRepository.SetIdempotencyRepository(
+		infraRepository.NewIdempotencyRepository(cql),
+	); err != nil {
+		return err
+	}
 	// init IUserRepository
 	if err := domainRepository.SetUserRepository(
 		infraRepository.NewUserRepository(postgres),
 	); err != nil {
 		return err
 	}
+	// init ICorrectionRepository
+	if err := domainRepository.SetCorrectionRepository(
+		infraRepository.NewCorrectionRepository(postgres),
+	); err != nil {
+		return err
+	}
 
 	// v.v
 	return nil
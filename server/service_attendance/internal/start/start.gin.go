@@ -1,7 +1,10 @@
 package start
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/gin-contrib/cors"
@@ -15,6 +18,9 @@ import (
 	httpRouter "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/interfaces/http/router"
 )
 
+// Thời gian chờ các request đang xử lý khi dừng http server
+const httpShutdownTimeout = 10 * time.Second
+
 func initGinRouter(setting *domainConfig.ServerSetting) error {
 	var ginEngine *gin.Engine
 	// Set Gin mode
@@ -36,14 +42,27 @@ func initGinRouter(setting *domainConfig.ServerSetting) error {
 		return err
 	}
 	// Start Gin server
+	server := &http.Server{
+		Addr:    portGin,
+		Handler: ginEngine,
+	}
 	global.WaitGroup.Add(1)
 	go func() {
 		defer global.WaitGroup.Done()
-		err := ginEngine.Run(portGin)
-		if err != nil {
+		err := server.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
 			global.Logger.Error(err.Error())
 		}
 	}()
+	// Graceful shutdown khi nhận tín hiệu dừng
+	go func() {
+		<-global.ShutdownContext.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), httpShutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			global.Logger.Error("http server shutdown", "error", err)
+		}
+	}()
 
 	return nil
 }
@@ -36,6 +36,10 @@ func StartService() error {
 	if err := initDomain(); err != nil {
 		return err
 	}
+	// Initialize worker
+	if err := initWorker(setting); err != nil {
+		return err
+	}
 	// Initialize application
 	if err := initApplication(); err != nil {
 		return err
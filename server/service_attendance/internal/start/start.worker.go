@@ -1,14 +1,32 @@
 package start
 
 import (
+	domainCache "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/cache"
 	domainConfig "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/config"
 	domainLogger "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/logger"
+	domainMq "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/mq"
 	domainRepo "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/repository"
 	domainWorker "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/worker"
 	domainWorkerAttendance "github.com/youknow2509/cio_verify_face/server/service_attendance/internal/domain/worker/attendance"
 	"github.com/youknow2509/cio_verify_face/server/service_attendance/internal/global"
 )
 
+// ============================================
+// Start workers
+// ============================================
+func initWorker(setting *domainConfig.Setting) error {
+	if err := InitAttendanceServiceWorker(&setting.WorkerAttendance); err != nil {
+		return err
+	}
+	if err := InitAbsenceSchedulerWorker(&setting.AbsenceScheduler); err != nil {
+		return err
+	}
+	if err := InitDailySummaryConsumerWorker(&setting.SummaryPipeline); err != nil {
+		return err
+	}
+	return nil
+}
+
 // ============================================
 // Start Attendance service worker
 // ============================================
@@ -17,9 +35,59 @@ func InitAttendanceServiceWorker(config *domainConfig.WorkerAttendanceSetting) e
 		*config,
 		domainLogger.GetLogger(),
 		domainRepo.GetAttendanceRepository(),
+		domainRepo.GetUserRepository(),
+		domainRepo.GetCorrectionRepository(),
 	)
 	_ = domainWorker.SetWorkerAttendanceServiceWorker(worker)
 	global.AttendanceServiceWorker = worker
-	worker.RunDailySummaryWorker()
 	return nil
 }
+
+// ============================================
+// Start absence scheduler worker
+// ============================================
+func InitAbsenceSchedulerWorker(config *domainConfig.AbsenceSchedulerSetting) error {
+	distributedCache, err := domainCache.GetDistributedCache()
+	if err != nil {
+		return err
+	}
+	worker := domainWorkerAttendance.NewAbsenceSchedulerWorker(
+		*config,
+		domainLogger.GetLogger(),
+		domainRepo.GetAttendanceRepository(),
+		domainRepo.GetUserRepository(),
+		distributedCache,
+	)
+	if err := domainWorker.SetWorkerAbsenceScheduler(worker); err != nil {
+		return err
+	}
+	return worker.RunAbsenceScheduler(global.ShutdownContext)
+}
+
+// ============================================
+// Start daily summary kafka consumer worker
+// ============================================
+func InitDailySummaryConsumerWorker(config *domainConfig.SummaryPipelineSetting) error {
+	if !config.Enabled {
+		return nil
+	}
+	reader, err := domainMq.GetKafkaReadService()
+	if err != nil {
+		return err
+	}
+	writer, err := domainMq.GetKafkaWriteService()
+	if err != nil {
+		return err
+	}
+	worker := domainWorkerAttendance.NewDailySummaryConsumerWorker(
+		*config,
+		domainLogger.GetLogger(),
+		reader,
+		writer,
+		global.AttendanceServiceWorker,
+	)
+	if err := domainWorker.SetWorkerDailySummaryConsumer(worker); err != nil {
+		return err
+	}
+	return worker.RunDailySummaryConsumer(global.ShutdownContext)
+}
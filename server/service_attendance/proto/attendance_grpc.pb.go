@@ -24,12 +24,20 @@ const (
 	AttendanceService_AddAttendance_FullMethodName                     = "/attendance.AttendanceService/AddAttendance"
 	AttendanceService_AddBatchAttendance_FullMethodName                = "/attendance.AttendanceService/AddBatchAttendance"
 	AttendanceService_ServiceAddBatchAttendance_FullMethodName         = "/attendance.AttendanceService/ServiceAddBatchAttendance"
+	AttendanceService_AddBatchAttendanceStream_FullMethodName          = "/attendance.AttendanceService/AddBatchAttendanceStream"
+	AttendanceService_ServiceAddBatchAttendanceStream_FullMethodName   = "/attendance.AttendanceService/ServiceAddBatchAttendanceStream"
 	AttendanceService_GetAttendanceRecords_FullMethodName              = "/attendance.AttendanceService/GetAttendanceRecords"
 	AttendanceService_GetAttendanceRecordsEmployee_FullMethodName      = "/attendance.AttendanceService/GetAttendanceRecordsEmployee"
 	AttendanceService_GetDailyAttendanceSummary_FullMethodName         = "/attendance.AttendanceService/GetDailyAttendanceSummary"
 	AttendanceService_GetDailyAttendanceSummaryEmployee_FullMethodName = "/attendance.AttendanceService/GetDailyAttendanceSummaryEmployee"
 	AttendanceService_DeleteAttendanceRecords_FullMethodName           = "/attendance.AttendanceService/DeleteAttendanceRecords"
 	AttendanceService_DeleteDailyAttendanceSummary_FullMethodName      = "/attendance.AttendanceService/DeleteDailyAttendanceSummary"
+	AttendanceService_CreateCorrectionRequest_FullMethodName           = "/attendance.AttendanceService/CreateCorrectionRequest"
+	AttendanceService_ApproveCorrectionRequest_FullMethodName          = "/attendance.AttendanceService/ApproveCorrectionRequest"
+	AttendanceService_RejectCorrectionRequest_FullMethodName           = "/attendance.AttendanceService/RejectCorrectionRequest"
+	AttendanceService_ListPendingCorrectionRequests_FullMethodName     = "/attendance.AttendanceService/ListPendingCorrectionRequests"
+	AttendanceService_RecomputeDailySummaries_FullMethodName           = "/attendance.AttendanceService/RecomputeDailySummaries"
+	AttendanceService_GetRecomputeJob_FullMethodName                   = "/attendance.AttendanceService/GetRecomputeJob"
 )
 
 // AttendanceServiceClient is the client API for AttendanceService service.
@@ -42,8 +50,15 @@ type AttendanceServiceClient interface {
 	HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Methods Add attendance record
 	AddAttendance(ctx context.Context, in *AddAttendanceInput, opts ...grpc.CallOption) (*AddAttendanceOutput, error)
+	// Deprecated: Do not use.
+	// Legacy client-streaming batch, new callers use AddBatchAttendanceStream / ServiceAddBatchAttendanceStream for per-item results.
+	// Items are processed like the bidirectional RPCs; the single response summarizes failed items as "seq:reason", seq starting at 1
 	AddBatchAttendance(ctx context.Context, opts ...grpc.CallOption) (AttendanceService_AddBatchAttendanceClient, error)
+	// Deprecated: Do not use.
 	ServiceAddBatchAttendance(ctx context.Context, opts ...grpc.CallOption) (AttendanceService_ServiceAddBatchAttendanceClient, error)
+	// Bidirectional batch: one result per item, correlated by seq; the stream survives item failures
+	AddBatchAttendanceStream(ctx context.Context, opts ...grpc.CallOption) (AttendanceService_AddBatchAttendanceStreamClient, error)
+	ServiceAddBatchAttendanceStream(ctx context.Context, opts ...grpc.CallOption) (AttendanceService_ServiceAddBatchAttendanceStreamClient, error)
 	// Methods Get attendance records
 	GetAttendanceRecords(ctx context.Context, in *GetAttendanceRecordsInput, opts ...grpc.CallOption) (*GetAttendanceRecordsOutput, error)
 	GetAttendanceRecordsEmployee(ctx context.Context, in *GetAttendanceRecordsEmployeeInput, opts ...grpc.CallOption) (*GetAttendanceRecordsOutput, error)
@@ -52,6 +67,14 @@ type AttendanceServiceClient interface {
 	// Methods Delete attendance records
 	DeleteAttendanceRecords(ctx context.Context, in *DeleteAttendanceRecordsInput, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteDailyAttendanceSummary(ctx context.Context, in *DeleteAttendanceRecordsInput, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Methods Attendance correction requests
+	CreateCorrectionRequest(ctx context.Context, in *CreateCorrectionRequestInput, opts ...grpc.CallOption) (*CreateCorrectionRequestOutput, error)
+	ApproveCorrectionRequest(ctx context.Context, in *ReviewCorrectionRequestInput, opts ...grpc.CallOption) (*ApproveCorrectionRequestOutput, error)
+	RejectCorrectionRequest(ctx context.Context, in *ReviewCorrectionRequestInput, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListPendingCorrectionRequests(ctx context.Context, in *ListPendingCorrectionRequestsInput, opts ...grpc.CallOption) (*ListCorrectionRequestsOutput, error)
+	// Methods Daily summary recompute
+	RecomputeDailySummaries(ctx context.Context, in *RecomputeDailySummariesInput, opts ...grpc.CallOption) (*RecomputeJobOutput, error)
+	GetRecomputeJob(ctx context.Context, in *GetRecomputeJobInput, opts ...grpc.CallOption) (*RecomputeJobOutput, error)
 }
 
 type attendanceServiceClient struct {
@@ -82,6 +105,7 @@ func (c *attendanceServiceClient) AddAttendance(ctx context.Context, in *AddAtte
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *attendanceServiceClient) AddBatchAttendance(ctx context.Context, opts ...grpc.CallOption) (AttendanceService_AddBatchAttendanceClient, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &AttendanceService_ServiceDesc.Streams[0], AttendanceService_AddBatchAttendance_FullMethodName, cOpts...)
@@ -117,6 +141,7 @@ func (x *attendanceServiceAddBatchAttendanceClient) CloseAndRecv() (*AddAttendan
 	return m, nil
 }
 
+// Deprecated: Do not use.
 func (c *attendanceServiceClient) ServiceAddBatchAttendance(ctx context.Context, opts ...grpc.CallOption) (AttendanceService_ServiceAddBatchAttendanceClient, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &AttendanceService_ServiceDesc.Streams[1], AttendanceService_ServiceAddBatchAttendance_FullMethodName, cOpts...)
@@ -152,6 +177,70 @@ func (x *attendanceServiceServiceAddBatchAttendanceClient) CloseAndRecv() (*AddA
 	return m, nil
 }
 
+func (c *attendanceServiceClient) AddBatchAttendanceStream(ctx context.Context, opts ...grpc.CallOption) (AttendanceService_AddBatchAttendanceStreamClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AttendanceService_ServiceDesc.Streams[2], AttendanceService_AddBatchAttendanceStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &attendanceServiceAddBatchAttendanceStreamClient{ClientStream: stream}
+	return x, nil
+}
+
+type AttendanceService_AddBatchAttendanceStreamClient interface {
+	Send(*AddBatchAttendanceItem) error
+	Recv() (*AddBatchAttendanceResult, error)
+	grpc.ClientStream
+}
+
+type attendanceServiceAddBatchAttendanceStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *attendanceServiceAddBatchAttendanceStreamClient) Send(m *AddBatchAttendanceItem) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *attendanceServiceAddBatchAttendanceStreamClient) Recv() (*AddBatchAttendanceResult, error) {
+	m := new(AddBatchAttendanceResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *attendanceServiceClient) ServiceAddBatchAttendanceStream(ctx context.Context, opts ...grpc.CallOption) (AttendanceService_ServiceAddBatchAttendanceStreamClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AttendanceService_ServiceDesc.Streams[3], AttendanceService_ServiceAddBatchAttendanceStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &attendanceServiceServiceAddBatchAttendanceStreamClient{ClientStream: stream}
+	return x, nil
+}
+
+type AttendanceService_ServiceAddBatchAttendanceStreamClient interface {
+	Send(*ServiceAddBatchAttendanceItem) error
+	Recv() (*AddBatchAttendanceResult, error)
+	grpc.ClientStream
+}
+
+type attendanceServiceServiceAddBatchAttendanceStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *attendanceServiceServiceAddBatchAttendanceStreamClient) Send(m *ServiceAddBatchAttendanceItem) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *attendanceServiceServiceAddBatchAttendanceStreamClient) Recv() (*AddBatchAttendanceResult, error) {
+	m := new(AddBatchAttendanceResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *attendanceServiceClient) GetAttendanceRecords(ctx context.Context, in *GetAttendanceRecordsInput, opts ...grpc.CallOption) (*GetAttendanceRecordsOutput, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAttendanceRecordsOutput)
@@ -212,6 +301,66 @@ func (c *attendanceServiceClient) DeleteDailyAttendanceSummary(ctx context.Conte
 	return out, nil
 }
 
+func (c *attendanceServiceClient) CreateCorrectionRequest(ctx context.Context, in *CreateCorrectionRequestInput, opts ...grpc.CallOption) (*CreateCorrectionRequestOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCorrectionRequestOutput)
+	err := c.cc.Invoke(ctx, AttendanceService_CreateCorrectionRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) ApproveCorrectionRequest(ctx context.Context, in *ReviewCorrectionRequestInput, opts ...grpc.CallOption) (*ApproveCorrectionRequestOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveCorrectionRequestOutput)
+	err := c.cc.Invoke(ctx, AttendanceService_ApproveCorrectionRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) RejectCorrectionRequest(ctx context.Context, in *ReviewCorrectionRequestInput, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AttendanceService_RejectCorrectionRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) ListPendingCorrectionRequests(ctx context.Context, in *ListPendingCorrectionRequestsInput, opts ...grpc.CallOption) (*ListCorrectionRequestsOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCorrectionRequestsOutput)
+	err := c.cc.Invoke(ctx, AttendanceService_ListPendingCorrectionRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) RecomputeDailySummaries(ctx context.Context, in *RecomputeDailySummariesInput, opts ...grpc.CallOption) (*RecomputeJobOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecomputeJobOutput)
+	err := c.cc.Invoke(ctx, AttendanceService_RecomputeDailySummaries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) GetRecomputeJob(ctx context.Context, in *GetRecomputeJobInput, opts ...grpc.CallOption) (*RecomputeJobOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecomputeJobOutput)
+	err := c.cc.Invoke(ctx, AttendanceService_GetRecomputeJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AttendanceServiceServer is the server API for AttendanceService service.
 // All implementations must embed UnimplementedAttendanceServiceServer
 // for forward compatibility
@@ -222,8 +371,15 @@ type AttendanceServiceServer interface {
 	HealthCheck(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// Methods Add attendance record
 	AddAttendance(context.Context, *AddAttendanceInput) (*AddAttendanceOutput, error)
+	// Deprecated: Do not use.
+	// Legacy client-streaming batch, new callers use AddBatchAttendanceStream / ServiceAddBatchAttendanceStream for per-item results.
+	// Items are processed like the bidirectional RPCs; the single response summarizes failed items as "seq:reason", seq starting at 1
 	AddBatchAttendance(AttendanceService_AddBatchAttendanceServer) error
+	// Deprecated: Do not use.
 	ServiceAddBatchAttendance(AttendanceService_ServiceAddBatchAttendanceServer) error
+	// Bidirectional batch: one result per item, correlated by seq; the stream survives item failures
+	AddBatchAttendanceStream(AttendanceService_AddBatchAttendanceStreamServer) error
+	ServiceAddBatchAttendanceStream(AttendanceService_ServiceAddBatchAttendanceStreamServer) error
 	// Methods Get attendance records
 	GetAttendanceRecords(context.Context, *GetAttendanceRecordsInput) (*GetAttendanceRecordsOutput, error)
 	GetAttendanceRecordsEmployee(context.Context, *GetAttendanceRecordsEmployeeInput) (*GetAttendanceRecordsOutput, error)
@@ -232,6 +388,14 @@ type AttendanceServiceServer interface {
 	// Methods Delete attendance records
 	DeleteAttendanceRecords(context.Context, *DeleteAttendanceRecordsInput) (*emptypb.Empty, error)
 	DeleteDailyAttendanceSummary(context.Context, *DeleteAttendanceRecordsInput) (*emptypb.Empty, error)
+	// Methods Attendance correction requests
+	CreateCorrectionRequest(context.Context, *CreateCorrectionRequestInput) (*CreateCorrectionRequestOutput, error)
+	ApproveCorrectionRequest(context.Context, *ReviewCorrectionRequestInput) (*ApproveCorrectionRequestOutput, error)
+	RejectCorrectionRequest(context.Context, *ReviewCorrectionRequestInput) (*emptypb.Empty, error)
+	ListPendingCorrectionRequests(context.Context, *ListPendingCorrectionRequestsInput) (*ListCorrectionRequestsOutput, error)
+	// Methods Daily summary recompute
+	RecomputeDailySummaries(context.Context, *RecomputeDailySummariesInput) (*RecomputeJobOutput, error)
+	GetRecomputeJob(context.Context, *GetRecomputeJobInput) (*RecomputeJobOutput, error)
 	mustEmbedUnimplementedAttendanceServiceServer()
 }
 
@@ -251,6 +415,12 @@ func (UnimplementedAttendanceServiceServer) AddBatchAttendance(AttendanceService
 func (UnimplementedAttendanceServiceServer) ServiceAddBatchAttendance(AttendanceService_ServiceAddBatchAttendanceServer) error {
 	return status.Errorf(codes.Unimplemented, "method ServiceAddBatchAttendance not implemented")
 }
+func (UnimplementedAttendanceServiceServer) AddBatchAttendanceStream(AttendanceService_AddBatchAttendanceStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method AddBatchAttendanceStream not implemented")
+}
+func (UnimplementedAttendanceServiceServer) ServiceAddBatchAttendanceStream(AttendanceService_ServiceAddBatchAttendanceStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ServiceAddBatchAttendanceStream not implemented")
+}
 func (UnimplementedAttendanceServiceServer) GetAttendanceRecords(context.Context, *GetAttendanceRecordsInput) (*GetAttendanceRecordsOutput, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAttendanceRecords not implemented")
 }
@@ -269,6 +439,24 @@ func (UnimplementedAttendanceServiceServer) DeleteAttendanceRecords(context.Cont
 func (UnimplementedAttendanceServiceServer) DeleteDailyAttendanceSummary(context.Context, *DeleteAttendanceRecordsInput) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDailyAttendanceSummary not implemented")
 }
+func (UnimplementedAttendanceServiceServer) CreateCorrectionRequest(context.Context, *CreateCorrectionRequestInput) (*CreateCorrectionRequestOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCorrectionRequest not implemented")
+}
+func (UnimplementedAttendanceServiceServer) ApproveCorrectionRequest(context.Context, *ReviewCorrectionRequestInput) (*ApproveCorrectionRequestOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveCorrectionRequest not implemented")
+}
+func (UnimplementedAttendanceServiceServer) RejectCorrectionRequest(context.Context, *ReviewCorrectionRequestInput) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectCorrectionRequest not implemented")
+}
+func (UnimplementedAttendanceServiceServer) ListPendingCorrectionRequests(context.Context, *ListPendingCorrectionRequestsInput) (*ListCorrectionRequestsOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingCorrectionRequests not implemented")
+}
+func (UnimplementedAttendanceServiceServer) RecomputeDailySummaries(context.Context, *RecomputeDailySummariesInput) (*RecomputeJobOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecomputeDailySummaries not implemented")
+}
+func (UnimplementedAttendanceServiceServer) GetRecomputeJob(context.Context, *GetRecomputeJobInput) (*RecomputeJobOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecomputeJob not implemented")
+}
 func (UnimplementedAttendanceServiceServer) mustEmbedUnimplementedAttendanceServiceServer() {}
 
 // UnsafeAttendanceServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -370,6 +558,58 @@ func (x *attendanceServiceServiceAddBatchAttendanceServer) Recv() (*ServiceAddBa
 	return m, nil
 }
 
+func _AttendanceService_AddBatchAttendanceStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AttendanceServiceServer).AddBatchAttendanceStream(&attendanceServiceAddBatchAttendanceStreamServer{ServerStream: stream})
+}
+
+type AttendanceService_AddBatchAttendanceStreamServer interface {
+	Send(*AddBatchAttendanceResult) error
+	Recv() (*AddBatchAttendanceItem, error)
+	grpc.ServerStream
+}
+
+type attendanceServiceAddBatchAttendanceStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *attendanceServiceAddBatchAttendanceStreamServer) Send(m *AddBatchAttendanceResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *attendanceServiceAddBatchAttendanceStreamServer) Recv() (*AddBatchAttendanceItem, error) {
+	m := new(AddBatchAttendanceItem)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _AttendanceService_ServiceAddBatchAttendanceStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AttendanceServiceServer).ServiceAddBatchAttendanceStream(&attendanceServiceServiceAddBatchAttendanceStreamServer{ServerStream: stream})
+}
+
+type AttendanceService_ServiceAddBatchAttendanceStreamServer interface {
+	Send(*AddBatchAttendanceResult) error
+	Recv() (*ServiceAddBatchAttendanceItem, error)
+	grpc.ServerStream
+}
+
+type attendanceServiceServiceAddBatchAttendanceStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *attendanceServiceServiceAddBatchAttendanceStreamServer) Send(m *AddBatchAttendanceResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *attendanceServiceServiceAddBatchAttendanceStreamServer) Recv() (*ServiceAddBatchAttendanceItem, error) {
+	m := new(ServiceAddBatchAttendanceItem)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _AttendanceService_GetAttendanceRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAttendanceRecordsInput)
 	if err := dec(in); err != nil {
@@ -478,6 +718,114 @@ func _AttendanceService_DeleteDailyAttendanceSummary_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AttendanceService_CreateCorrectionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCorrectionRequestInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).CreateCorrectionRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_CreateCorrectionRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).CreateCorrectionRequest(ctx, req.(*CreateCorrectionRequestInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_ApproveCorrectionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewCorrectionRequestInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).ApproveCorrectionRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_ApproveCorrectionRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).ApproveCorrectionRequest(ctx, req.(*ReviewCorrectionRequestInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_RejectCorrectionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewCorrectionRequestInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).RejectCorrectionRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_RejectCorrectionRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).RejectCorrectionRequest(ctx, req.(*ReviewCorrectionRequestInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_ListPendingCorrectionRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingCorrectionRequestsInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).ListPendingCorrectionRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_ListPendingCorrectionRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).ListPendingCorrectionRequests(ctx, req.(*ListPendingCorrectionRequestsInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_RecomputeDailySummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputeDailySummariesInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).RecomputeDailySummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_RecomputeDailySummaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).RecomputeDailySummaries(ctx, req.(*RecomputeDailySummariesInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_GetRecomputeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecomputeJobInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).GetRecomputeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_GetRecomputeJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).GetRecomputeJob(ctx, req.(*GetRecomputeJobInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AttendanceService_ServiceDesc is the grpc.ServiceDesc for AttendanceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -517,6 +865,30 @@ var AttendanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDailyAttendanceSummary",
 			Handler:    _AttendanceService_DeleteDailyAttendanceSummary_Handler,
 		},
+		{
+			MethodName: "CreateCorrectionRequest",
+			Handler:    _AttendanceService_CreateCorrectionRequest_Handler,
+		},
+		{
+			MethodName: "ApproveCorrectionRequest",
+			Handler:    _AttendanceService_ApproveCorrectionRequest_Handler,
+		},
+		{
+			MethodName: "RejectCorrectionRequest",
+			Handler:    _AttendanceService_RejectCorrectionRequest_Handler,
+		},
+		{
+			MethodName: "ListPendingCorrectionRequests",
+			Handler:    _AttendanceService_ListPendingCorrectionRequests_Handler,
+		},
+		{
+			MethodName: "RecomputeDailySummaries",
+			Handler:    _AttendanceService_RecomputeDailySummaries_Handler,
+		},
+		{
+			MethodName: "GetRecomputeJob",
+			Handler:    _AttendanceService_GetRecomputeJob_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -529,6 +901,18 @@ var AttendanceService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _AttendanceService_ServiceAddBatchAttendance_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "AddBatchAttendanceStream",
+			Handler:       _AttendanceService_AddBatchAttendanceStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ServiceAddBatchAttendanceStream",
+			Handler:       _AttendanceService_ServiceAddBatchAttendanceStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "proto/attendance.proto",
 }
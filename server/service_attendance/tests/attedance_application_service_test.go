@@ -55,7 +55,7 @@ func TestAddAttendanceRecordInApplicationService(t *testing.T) {
 		FaceImageURL:        "http://example.com/face.jpg",
 		LocationCoordinates: "37.7749,-122.4194",
 	}
-	if err := service.AddAttendance(ctx, req); err != nil {
+	if _, err := service.AddAttendance(ctx, req); err != nil {
 		t.Fatalf("failed to add attendance record: %+v", err) // TODO: remove in production
 	}
 	t.Log("add attendance record successfully")
@@ -262,6 +262,11 @@ func initAttendanceApplicationServiceTest() error {
 	); err != nil {
 		return err
 	}
+	if err := domainRepository.SetCorrectionRepository(
+		infraRepository.NewCorrectionRepository(posgresClient),
+	); err != nil {
+		return err
+	}
 	// init cache
 	if err := initRedisDistributedCache(
 		&domainConfig.RedisSetting{
@@ -292,12 +297,11 @@ func initAttendanceApplicationServiceTest() error {
 		configWorker,
 		domainLogger.GetLogger(),
 		domainRepository.GetAttendanceRepository(),
+		domainRepository.GetUserRepository(),
+		domainRepository.GetCorrectionRepository(),
 	)
 	_ = domainWorker.SetWorkerAttendanceServiceWorker(worker)
 	global.AttendanceServiceWorker = worker
-	if err := worker.RunDailySummaryWorker(); err != nil {
-		return err
-	}
 	return nil
 }
 
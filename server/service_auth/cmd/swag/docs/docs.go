@@ -24,9 +24,504 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/v1/auth/2fa": {
+            "get": {
+                "description": "Get two factor status of current user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Two Factor Auth"
+                ],
+                "summary": "Two factor status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/2fa/disable": {
+            "post": {
+                "description": "Disable two factor with TOTP code or recovery code, not allowed when required by policy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Two Factor Auth"
+                ],
+                "summary": "Two factor disable",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body two factor code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TwoFactorCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/2fa/enroll": {
+            "post": {
+                "description": "Create TOTP secret for current user, two factor is enabled after verify",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Two Factor Auth"
+                ],
+                "summary": "Two factor enroll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/2fa/recovery-codes": {
+            "post": {
+                "description": "Regenerate recovery codes, old codes are invalidated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Two Factor Auth"
+                ],
+                "summary": "Two factor recovery codes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body two factor code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TwoFactorCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/2fa/verify": {
+            "post": {
+                "description": "Verify first TOTP code, enable two factor and return recovery codes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Two Factor Auth"
+                ],
+                "summary": "Two factor verify",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body two factor code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TwoFactorCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
+        },
         "/v1/auth/device": {
             "post": {
-                "description": "Update session device",
+                "description": "Update session device",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Core Auth"
+                ],
+                "summary": "Update session device",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body update device",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateDeviceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete session device",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Core Auth"
+                ],
+                "summary": "Delete session device",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body delete device",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.DeleteDeviceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/jwks": {
+            "get": {
+                "description": "Public keys (JWK Set) used to verify access/device tokens signed by auth service, response is raw JWK Set",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Core Auth"
+                ],
+                "summary": "JWKS",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.JwksOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/login": {
+            "post": {
+                "description": "User login for user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Core Auth"
+                ],
+                "summary": "User login",
+                "parameters": [
+                    {
+                        "description": "Request body login",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/login/2fa": {
+            "post": {
+                "description": "Complete admin login with TOTP code or recovery code using the challenge token from login admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Two Factor Auth"
+                ],
+                "summary": "Admin login two factor",
+                "parameters": [
+                    {
+                        "description": "Request body login two factor",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoginTwoFactorRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/login/2fa/enroll": {
+            "post": {
+                "description": "Create TOTP secret with the challenge token when two factor is required but not set up",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Two Factor Auth"
+                ],
+                "summary": "Admin login two factor enroll",
+                "parameters": [
+                    {
+                        "description": "Request body login two factor enroll",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoginTwoFactorEnrollRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/login/admin": {
+            "post": {
+                "description": "User login for admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Core Auth"
+                ],
+                "summary": "Admin login",
+                "parameters": [
+                    {
+                        "description": "Request body login",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/logout": {
+            "post": {
+                "description": "User logout",
                 "consumes": [
                     "application/json"
                 ],
@@ -36,7 +531,7 @@ const docTemplate = `{
                 "tags": [
                     "Core Auth"
                 ],
-                "summary": "Update session device",
+                "summary": "User logout",
                 "parameters": [
                     {
                         "type": "string",
@@ -44,15 +539,6 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "description": "Request body update device",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.UpdateDeviceRequest"
-                        }
                     }
                 ],
                 "responses": {
@@ -69,9 +555,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete session device",
+            }
+        },
+        "/v1/auth/me": {
+            "get": {
+                "description": "User get base info",
                 "consumes": [
                     "application/json"
                 ],
@@ -81,7 +569,7 @@ const docTemplate = `{
                 "tags": [
                     "Core Auth"
                 ],
-                "summary": "Delete session device",
+                "summary": "User get base info",
                 "parameters": [
                     {
                         "type": "string",
@@ -89,16 +577,45 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
                     },
-                    {
-                        "description": "Request body delete device",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.DeleteDeviceRequest"
+                            "$ref": "#/definitions/dto.ErrResponseData"
                         }
                     }
+                }
+            }
+        },
+        "/v1/auth/oidc/authorize/{company_id}": {
+            "get": {
+                "description": "Start OpenID Connect login of company, redirect user to authorization_url",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "OAuth"
+                ],
+                "summary": "SSO authorize",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company id",
+                        "name": "company_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
@@ -116,9 +633,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/auth/login": {
+        "/v1/auth/oidc/callback": {
             "post": {
-                "description": "User login for user",
+                "description": "Exchange code and state returned by identity provider for access/refresh token",
                 "consumes": [
                     "application/json"
                 ],
@@ -126,17 +643,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Core Auth"
+                    "OAuth"
                 ],
-                "summary": "User login",
+                "summary": "SSO callback",
                 "parameters": [
                     {
-                        "description": "Request body login",
+                        "description": "Request body sso callback",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.LoginRequest"
+                            "$ref": "#/definitions/dto.OidcCallbackRequest"
                         }
                     }
                 ],
@@ -156,9 +673,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/auth/login/admin": {
-            "post": {
-                "description": "User login for admin",
+        "/v1/auth/oidc/config": {
+            "get": {
+                "description": "Get OpenID Connect config of company, client secret is not returned",
                 "consumes": [
                     "application/json"
                 ],
@@ -166,18 +683,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Core Auth"
+                    "OAuth"
                 ],
-                "summary": "Admin login",
+                "summary": "Get SSO config",
                 "parameters": [
                     {
-                        "description": "Request body login",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.LoginRequest"
-                        }
+                        "type": "string",
+                        "description": "Authorization Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Company id, required for system admin",
+                        "name": "company_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -194,11 +715,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/auth/logout": {
-            "post": {
-                "description": "User logout",
+            },
+            "put": {
+                "description": "Create or update OpenID Connect config of company",
                 "consumes": [
                     "application/json"
                 ],
@@ -206,9 +725,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Core Auth"
+                    "OAuth"
                 ],
-                "summary": "User logout",
+                "summary": "Update SSO config",
                 "parameters": [
                     {
                         "type": "string",
@@ -216,6 +735,15 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "Request body sso config",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.OidcConfigRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -232,11 +760,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/auth/me": {
-            "get": {
-                "description": "User get base info",
+            },
+            "delete": {
+                "description": "Delete OpenID Connect config of company",
                 "consumes": [
                     "application/json"
                 ],
@@ -244,9 +770,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Core Auth"
+                    "OAuth"
                 ],
-                "summary": "User get base info",
+                "summary": "Delete SSO config",
                 "parameters": [
                     {
                         "type": "string",
@@ -254,6 +780,12 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Company id, required for system admin",
+                        "name": "company_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -311,6 +843,53 @@ const docTemplate = `{
                     }
                 }
             }
+        },
+        "/v1/auth/unlock": {
+            "post": {
+                "description": "Admin or manager unlock user locked by too many failed logins",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Core Auth"
+                ],
+                "summary": "Unlock user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body unlock user",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnlockUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
         }
     },
     "definitions": {
@@ -362,6 +941,97 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.LoginTwoFactorEnrollRequest": {
+            "type": "object",
+            "required": [
+                "challenge_token"
+            ],
+            "properties": {
+                "challenge_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LoginTwoFactorRequest": {
+            "type": "object",
+            "required": [
+                "challenge_token",
+                "code"
+            ],
+            "properties": {
+                "challenge_token": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 6
+                }
+            }
+        },
+        "dto.OidcCallbackRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "state"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.OidcConfigRequest": {
+            "type": "object",
+            "required": [
+                "client_id",
+                "issuer"
+            ],
+            "properties": {
+                "client_id": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "client_secret": {
+                    "description": "Bỏ trống để giữ secret đang lưu",
+                    "type": "string"
+                },
+                "company_id": {
+                    "description": "Bắt buộc với SYSTEM_ADMIN",
+                    "type": "string"
+                },
+                "email_claim": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "is_enabled": {
+                    "type": "boolean"
+                },
+                "issuer": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "role_claim": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "role_mapping": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "dto.RefreshTokenRequest": {
             "type": "object",
             "required": [
@@ -393,6 +1063,30 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.TwoFactorCodeRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 6
+                }
+            }
+        },
+        "dto.UnlockUserRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.UpdateDeviceRequest": {
             "type": "object",
             "required": [
@@ -417,6 +1111,46 @@ const docTemplate = `{
                     "type": "integer"
                 }
             }
+        },
+        "model.JsonWebKeyOutput": {
+            "type": "object",
+            "properties": {
+                "alg": {
+                    "type": "string"
+                },
+                "crv": {
+                    "type": "string"
+                },
+                "e": {
+                    "type": "string"
+                },
+                "kid": {
+                    "type": "string"
+                },
+                "kty": {
+                    "type": "string"
+                },
+                "n": {
+                    "type": "string"
+                },
+                "use": {
+                    "type": "string"
+                },
+                "x": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.JwksOutput": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.JsonWebKeyOutput"
+                    }
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -4,6 +4,8 @@ go 1.24.3
 
 replace github.com/youknow2509/cio_verify_face/server/pkg/observability => ../pkg/observability
 
+replace github.com/youknow2509/cio_verify_face/server/pkg/password => ../pkg/password
+
 require (
 	github.com/gin-contrib/cors v1.7.6
 	github.com/gin-gonic/gin v1.10.1
@@ -14,6 +16,7 @@ require (
 	github.com/swaggo/gin-swagger v1.6.0
 	github.com/swaggo/swag v1.16.5
 	github.com/youknow2509/cio_verify_face/server/pkg/observability v0.0.0
+	github.com/youknow2509/cio_verify_face/server/pkg/password v0.0.0
 	google.golang.org/grpc v1.75.1
 )
 
@@ -17,9 +17,33 @@ const (
 	AuthUUIDParseErrorCode                      = 10009
 	AuthDontHavePermissionErrorCode             = 10010
 	TokenExpiredErrorCode                       = 10011
+	AuthLoginTooManyAttemptsErrorCode           = 10012
+	AuthTwoFactorCodeInvalidErrorCode           = 10013
+	AuthTwoFactorChallengeInvalidErrorCode      = 10014
+	AuthTwoFactorAlreadyEnabledErrorCode        = 10015
+	AuthTwoFactorNotEnabledErrorCode            = 10016
+	AuthTwoFactorRequiredByPolicyErrorCode      = 10017
+	AuthOidcNotConfiguredErrorCode              = 10018
+	AuthOidcStateInvalidErrorCode               = 10019
+	AuthOidcProviderErrorCode                   = 10020
+	AuthOidcIdentityInvalidErrorCode            = 10021
+	AuthOidcUserNotAllowedErrorCode             = 10022
+	AuthOidcConfigInvalidErrorCode              = 10023
 )
 
 var mapAuthErrors = map[int]string{
+	AuthOidcConfigInvalidErrorCode:              "Single sign-on configuration is invalid",
+	AuthOidcUserNotAllowedErrorCode:             "User is not allowed to sign in with single sign-on",
+	AuthOidcIdentityInvalidErrorCode:            "Identity provider response is invalid",
+	AuthOidcProviderErrorCode:                   "Cannot connect to identity provider",
+	AuthOidcStateInvalidErrorCode:               "Single sign-on state is invalid or expired",
+	AuthOidcNotConfiguredErrorCode:              "Single sign-on is not configured for this company",
+	AuthTwoFactorRequiredByPolicyErrorCode:      "Two-factor authentication is required by policy",
+	AuthTwoFactorNotEnabledErrorCode:            "Two-factor authentication is not enabled",
+	AuthTwoFactorAlreadyEnabledErrorCode:        "Two-factor authentication is already enabled",
+	AuthTwoFactorChallengeInvalidErrorCode:      "Two-factor challenge is invalid or expired",
+	AuthTwoFactorCodeInvalidErrorCode:           "Two-factor code is invalid",
+	AuthLoginTooManyAttemptsErrorCode:           "Too many failed login attempts, please try again later",
 	AuthDontHavePermissionErrorCode:             "Don't have permission",
 	TokenExpiredErrorCode:                       "Token is expired",
 	AuthUUIDParseErrorCode:                      "UUID parse error",
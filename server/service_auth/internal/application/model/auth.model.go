@@ -66,6 +66,44 @@ type (
 		Role     int       `json:"role"`
 	}
 
+	UnlockUserInput struct {
+		UserId       uuid.UUID `json:"user_id"`
+		Role         int       `json:"role"`
+		TargetUserId uuid.UUID `json:"target_user_id"`
+		ClientIp     string    `json:"client_ip"`
+		UserAgent    string    `json:"user_agent"`
+	}
+
+	// =======================================================
+
+	TwoFactorEnrollInput struct {
+		UserId    uuid.UUID `json:"user_id"`
+		Role      int       `json:"role"`
+		ClientIp  string    `json:"client_ip"`
+		UserAgent string    `json:"user_agent"`
+	}
+
+	// Mã TOTP hoặc recovery code
+	TwoFactorCodeInput struct {
+		UserId    uuid.UUID `json:"user_id"`
+		Role      int       `json:"role"`
+		Code      string    `json:"code"`
+		ClientIp  string    `json:"client_ip"`
+		UserAgent string    `json:"user_agent"`
+	}
+
+	LoginTwoFactorEnrollInput struct {
+		ChallengeToken string `json:"challenge_token"`
+		ClientIp       string `json:"client_ip"`
+	}
+
+	LoginTwoFactorInput struct {
+		ChallengeToken string `json:"challenge_token"`
+		Code           string `json:"code"`
+		ClientIp       string `json:"client_ip"`
+		UserAgent      string `json:"user_agent"`
+	}
+
 	// =======================================================
 
 	ChangePasswordInput struct {
@@ -135,9 +173,31 @@ type (
 		ExpireAt int64  `json:"expire_at"`
 	}
 
+	// Khi cần 2FA chỉ trả về challenge, token được cấp sau khi xác thực mã
 	LoginOutput struct {
-		AccessToken  string `json:"access_token"`
-		RefreshToken string `json:"refresh_token"`
+		AccessToken            string   `json:"access_token,omitempty"`
+		RefreshToken           string   `json:"refresh_token,omitempty"`
+		TwoFactorRequired      bool     `json:"two_factor_required,omitempty"`
+		TwoFactorSetupRequired bool     `json:"two_factor_setup_required,omitempty"`
+		ChallengeToken         string   `json:"challenge_token,omitempty"`
+		ChallengeExpireAt      int64    `json:"challenge_expire_at,omitempty"`
+		RecoveryCodes          []string `json:"recovery_codes,omitempty"`
+	}
+
+	TwoFactorStatusOutput struct {
+		Enabled           bool  `json:"enabled"`
+		Required          bool  `json:"required"`
+		RecoveryCodesLeft int   `json:"recovery_codes_left"`
+		EnabledAt         int64 `json:"enabled_at,omitempty"`
+	}
+
+	TwoFactorEnrollOutput struct {
+		Secret     string `json:"secret"`
+		OtpauthUri string `json:"otpauth_uri"`
+	}
+
+	TwoFactorRecoveryCodesOutput struct {
+		RecoveryCodes []string `json:"recovery_codes"`
 	}
 
 	RefreshTokenOutput struct {
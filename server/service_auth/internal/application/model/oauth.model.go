@@ -0,0 +1,75 @@
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+// =======================================================
+//
+//	For Input OAuth Model
+//
+// =======================================================
+type (
+	OidcConfigInput struct {
+		UserId       uuid.UUID `json:"user_id"`
+		Role         int       `json:"role"`
+		CompanyIdReq uuid.UUID `json:"company_id_req"` // Bắt buộc với SYSTEM_ADMIN
+		ClientIp     string    `json:"client_ip"`
+		UserAgent    string    `json:"user_agent"`
+	}
+
+	UpsertOidcConfigInput struct {
+		UserId       uuid.UUID      `json:"user_id"`
+		Role         int            `json:"role"`
+		CompanyIdReq uuid.UUID      `json:"company_id_req"` // Bắt buộc với SYSTEM_ADMIN
+		Issuer       string         `json:"issuer"`
+		ClientId     string         `json:"client_id"`
+		ClientSecret string         `json:"client_secret"` // Rỗng thì giữ secret đang lưu
+		Scopes       []string       `json:"scopes"`
+		EmailClaim   string         `json:"email_claim"`
+		RoleClaim    string         `json:"role_claim"`
+		RoleMapping  map[string]int `json:"role_mapping"`
+		IsEnabled    bool           `json:"is_enabled"`
+		ClientIp     string         `json:"client_ip"`
+		UserAgent    string         `json:"user_agent"`
+	}
+
+	OidcAuthorizeInput struct {
+		CompanyId uuid.UUID `json:"company_id"`
+		ClientIp  string    `json:"client_ip"`
+	}
+
+	OidcCallbackInput struct {
+		Code      string `json:"code"`
+		State     string `json:"state"`
+		ClientIp  string `json:"client_ip"`
+		UserAgent string `json:"user_agent"`
+	}
+)
+
+// =======================================================
+//
+//	For Output OAuth Model
+//
+// =======================================================
+type (
+	OidcConfigOutput struct {
+		CompanyId       string         `json:"company_id"`
+		Issuer          string         `json:"issuer"`
+		ClientId        string         `json:"client_id"`
+		HasClientSecret bool           `json:"has_client_secret"`
+		Scopes          []string       `json:"scopes"`
+		EmailClaim      string         `json:"email_claim"`
+		RoleClaim       string         `json:"role_claim"`
+		RoleMapping     map[string]int `json:"role_mapping"`
+		IsEnabled       bool           `json:"is_enabled"`
+		RedirectUri     string         `json:"redirect_uri"` // Đăng ký url này với IdP
+		UpdatedAt       int64          `json:"updated_at,omitempty"`
+	}
+
+	OidcAuthorizeOutput struct {
+		AuthorizationUrl string `json:"authorization_url"`
+		State            string `json:"state"`
+		ExpireAt         int64  `json:"expire_at"`
+	}
+)
@@ -49,8 +49,9 @@ type BlockTokenUserRefreshInput struct {
 
 // Block device access token
 type BlockTokenDeviceInput struct {
-	DeviceId uuid.UUID `json:"device_id" validate:"required"`
-	TokenId  uuid.UUID `json:"token_id" validate:"required"`
+	DeviceId  uuid.UUID `json:"device_id" validate:"required"`
+	TokenId   uuid.UUID `json:"token_id" validate:"required"`
+	ExpiresAt time.Time `json:"expires_at"` // zero nếu không rõ thời hạn token
 }
 
 // Block device refresh token
@@ -82,6 +83,21 @@ type ParseTokenDeviceOutput struct {
 	TokenId   string    `json:"token_id"`
 }
 
+// Public keys verify token - JWKS
+type JwksOutput struct {
+	Keys []JsonWebKeyOutput `json:"keys"`
+}
+type JsonWebKeyOutput struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
 // Refresh user token
 type RefreshTokenUserInput struct {
 	AccessToken  string `json:"access_token" validate:"required"`
@@ -30,6 +30,8 @@ type (
 		UpdateDeviceSession(ctx context.Context, input *model.UpdateDeviceSessionInput) (*model.UpdateDeviceSessionOutput, *errorService.Error)
 		// Delete device token
 		DeleteDeviceSession(ctx context.Context, input *model.DeleteDeviceSessionInput) *errorService.Error
+		// Unlock user locked by failed login
+		UnlockUser(ctx context.Context, input *model.UnlockUserInput) *errorService.Error
 	}
 
 	// Auth Cache Service for optimized operations
@@ -2,19 +2,21 @@ package impl
 
 import (
 	"context"
+	stdErrors "errors"
 	"net/netip"
 	"time"
 
+	pkgPassword "github.com/youknow2509/cio_verify_face/server/pkg/password"
 	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/errors"
 	applicationModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/model"
 	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/service"
 	constants "github.com/youknow2509/cio_verify_face/server/service_auth/internal/constants"
 	domainError "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/errors"
 	domainModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/model"
+	domainPassword "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/password"
 	domainRepository "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/repository"
 	domainToken "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/token"
 	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/global"
-	utilsCrypto "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/crypto"
 	utilsRandom "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/random"
 	utilsUuid "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/uuid"
 )
@@ -38,6 +40,220 @@ func (c *CoreAuthService) initCacheStrategy() error {
 	return nil
 }
 
+// checkPassword so sánh mật khẩu constant-time, hash lại khi hash đang lưu là legacy hoặc khác tham số cấu hình
+// Hash đang lưu sai định dạng coi như sai mật khẩu, không trả lỗi hệ thống
+func (c *CoreAuthService) checkPassword(ctx context.Context, user *domainModel.UserBaseInfoOutput, password string) (bool, error) {
+	hasher := domainPassword.GetPasswordHasher()
+	match, err := hasher.Verify(password, user.UserSalt, user.UserPassword)
+	if stdErrors.Is(err, pkgPassword.ErrInvalidHash) {
+		global.Logger.Warn("Stored user password hash is malformed", "user_id", user.UserID)
+		return false, nil
+	}
+	if err != nil || !match {
+		return false, err
+	}
+	if hasher.NeedsRehash(user.UserPassword) {
+		c.rehashPassword(ctx, user.UserID, hasher, password)
+	}
+	return true, nil
+}
+
+// rehashPassword lưu hash mới, lỗi không làm đăng nhập thất bại vì hash cũ vẫn hợp lệ
+func (c *CoreAuthService) rehashPassword(ctx context.Context, userID string, hasher domainPassword.IPasswordHasher, password string) {
+	userUuid, err := utilsUuid.ParseUUID(userID)
+	if err != nil {
+		global.Logger.Warn("Error parsing user id for password rehash: ", err)
+		return
+	}
+	passwordHash, err := hasher.Hash(password)
+	if err != nil {
+		global.Logger.Warn("Error rehashing user password: ", err)
+		return
+	}
+	domainRepo, err := domainRepository.GetUserRepository()
+	if err != nil {
+		global.Logger.Warn("Error getting user repository: ", err)
+		return
+	}
+	// Hash mới tự chứa salt, cột salt chỉ còn dùng cho hash legacy
+	if err := domainRepo.UpdateUserPassword(ctx, &domainModel.UpdateUserPasswordInput{
+		UserID:       userUuid,
+		Salt:         "",
+		PasswordHash: passwordHash,
+	}); err != nil {
+		global.Logger.Warn("Error saving rehashed user password: ", err)
+		return
+	}
+	global.Logger.Info("User password rehashed", "user_id", userID)
+}
+
+// createUserSession tạo access/refresh token và session cho user, dùng cho Login và đăng nhập SSO
+func (c *CoreAuthService) createUserSession(ctx context.Context, userID string, clientIp string, userAgent string) (*applicationModel.LoginOutput, *errors.Error) {
+	domainRepo, err := domainRepository.GetUserRepository()
+	if err != nil {
+		global.Logger.Error("Error getting user repository: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	// Create session
+	tokenService := domainToken.GetTokenService()
+	tokenId := utilsRandom.GenerateUUID()
+	// Get company ID
+	companyRepo, err := domainRepository.GetCompanyRepository()
+	if err != nil {
+		global.Logger.Error("Error getting company repository: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	userUuid, _ := utilsUuid.ParseUUID(userID)
+	companyReps, err := companyRepo.GetCompanyUser(ctx, &domainModel.GetCompanyUserInput{UserID: userUuid})
+	if err != nil {
+		global.Logger.Error("Error getting company user: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	companyId := ""
+	if companyReps != nil {
+		companyId = companyReps.CompanyID.String()
+	}
+	// Create access token
+	timeTtlAccessToken := time.Duration(constants.TTL_ACCESS_TOKEN) * time.Second
+	accessToken, err := tokenService.CreateUserToken(
+		ctx,
+		&domainModel.TokenUserJwtInput{
+			UserId:    userID,
+			CompanyId: companyId,
+			TokenId:   tokenId.String(),
+			Role:      domainModel.RoleUser,
+			Expires:   time.Now().Add(timeTtlAccessToken),
+		},
+	)
+	if err != nil {
+		global.Logger.Warn("Error creating user token: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	// Create refresh token
+	timeTtlRefreshToken := time.Duration(constants.TTL_REFRESH_TOKEN) * time.Second
+	refreshToken, err := tokenService.CreateUserRefreshToken(
+		ctx,
+		&domainModel.TokenUserRefreshInput{
+			UserId:  userID,
+			TokenId: tokenId.String(),
+			Expires: time.Now().Add(timeTtlRefreshToken),
+		},
+	)
+	if err != nil {
+		global.Logger.Warn("Error creating user refresh token: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	// Initialize cache strategy
+	if err := c.initCacheStrategy(); err != nil {
+		global.Logger.Error("Error initializing cache strategy: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+
+	// Save session to db and cache
+	uuidUser, _ := utilsUuid.ParseUUID(userID)
+	ipAddr, _ := netip.ParseAddr(clientIp)
+	if err := domainRepo.CreateUserSession(
+		ctx,
+		&domainModel.CreateUserSessionInput{
+			SessionID:    tokenId,
+			UserID:       uuidUser,
+			IPAddress:    ipAddr,
+			UserAgent:    userAgent,
+			RefreshToken: refreshToken,
+			ExpiredAt:    time.Now().Add(timeTtlRefreshToken),
+		},
+	); err != nil {
+		global.Logger.Error("Error creating user session: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+
+	// Use cache strategy to set session
+	if err := c.cacheStrategy.SetUserSession(ctx, tokenId.String(), userID, domainModel.RoleUser, constants.TTL_ACCESS_TOKEN); err != nil {
+		global.Logger.Error("Error setting session in cache: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	// Return session
+	return &applicationModel.LoginOutput{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// createAdminSession tạo access/refresh token và session cho admin, dùng cho LoginAdmin và bước xác thực 2FA
+func (c *CoreAuthService) createAdminSession(ctx context.Context, userID string, companyId string, clientIp string, userAgent string) (*applicationModel.LoginOutput, *errors.Error) {
+	tokenService := domainToken.GetTokenService()
+	tokenId := utilsRandom.GenerateUUID()
+	domainRepo, err := domainRepository.GetUserRepository()
+	if err != nil {
+		global.Logger.Error("Error getting user repository: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	// Create access token
+	timeTtlAccessToken := time.Duration(constants.TTL_ACCESS_TOKEN) * time.Second
+	accessToken, err := tokenService.CreateUserToken(
+		ctx,
+		&domainModel.TokenUserJwtInput{
+			UserId:    userID,
+			CompanyId: companyId,
+			TokenId:   tokenId.String(),
+			Role:      domainModel.RoleManager,
+			Expires:   time.Now().Add(timeTtlAccessToken),
+		},
+	)
+	if err != nil {
+		global.Logger.Error("Error creating user token: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	// Create refresh token
+	timeTtlRefreshToken := time.Duration(constants.TTL_REFRESH_TOKEN) * time.Second
+	refreshToken, err := tokenService.CreateUserRefreshToken(
+		ctx,
+		&domainModel.TokenUserRefreshInput{
+			UserId:  userID,
+			TokenId: tokenId.String(),
+			Expires: time.Now().Add(timeTtlRefreshToken),
+		},
+	)
+	if err != nil {
+		global.Logger.Error("Error creating user refresh token: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	// Initialize cache strategy
+	if err := c.initCacheStrategy(); err != nil {
+		global.Logger.Error("Error initializing cache strategy: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+
+	// Save session to db and cache
+	uuidUser, _ := utilsUuid.ParseUUID(userID)
+	ipAddr, _ := netip.ParseAddr(clientIp)
+	if err := domainRepo.CreateUserSession(
+		ctx,
+		&domainModel.CreateUserSessionInput{
+			SessionID:    tokenId,
+			UserID:       uuidUser,
+			IPAddress:    ipAddr,
+			UserAgent:    userAgent,
+			RefreshToken: refreshToken,
+			ExpiredAt:    time.Now().Add(timeTtlRefreshToken),
+		},
+	); err != nil {
+		global.Logger.Error("Error creating user session: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+
+	// Use cache strategy to set session
+	if err := c.cacheStrategy.SetUserSession(ctx, tokenId.String(), userID, domainModel.RoleAdmin, constants.TTL_ACCESS_TOKEN); err != nil {
+		global.Logger.Error("Error setting session in cache: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	// Return session
+	return &applicationModel.LoginOutput{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
 // CreateDeviceSession implements service.ICoreAuthService.
 func (c *CoreAuthService) UpdateDeviceSession(ctx context.Context, input *applicationModel.UpdateDeviceSessionInput) (*applicationModel.UpdateDeviceSessionOutput, *errors.Error) {
 	// Initialize cache strategy
@@ -265,6 +481,10 @@ func (c *CoreAuthService) GetMyInfo(ctx context.Context, input *applicationModel
 
 // Login implements service.ICoreAuthService.
 func (c *CoreAuthService) Login(ctx context.Context, input *applicationModel.LoginInput) (*applicationModel.LoginOutput, *errors.Error) {
+	// Check client ip spam login fail
+	if c.isLoginIpBlocked(ctx, input.ClientIp) {
+		return nil, errors.GetError(errors.AuthLoginTooManyAttemptsErrorCode)
+	}
 	// Get info user with mail
 	domainRepo, err := domainRepository.GetUserRepository()
 	if err != nil {
@@ -278,109 +498,42 @@ func (c *CoreAuthService) Login(ctx context.Context, input *applicationModel.Log
 	}
 	if response == nil {
 		// User not found
+		c.recordLoginFail(ctx, nil, input.ClientIp, input.UserAgent)
 		return nil, errors.GetError(errors.UserNotFoundErrorCode)
 	}
 	// Check role
 	if response.Role != domainModel.RoleUser {
 		// Role not match
+		c.recordLoginFail(ctx, nil, input.ClientIp, input.UserAgent)
 		return nil, errors.GetError(errors.UserNotFoundErrorCode)
 	}
-	// Check password
-	if !utilsCrypto.ComparePasswordWithHash(
-		input.Password,
-		response.UserSalt,
-		response.UserPassword,
-	) {
-		// Password not match
-		return nil, errors.GetError(errors.UserPasswordIncorrectErrorCode)
-	}
-	// Create session
-	tokenService := domainToken.GetTokenService()
-	tokenId := utilsRandom.GenerateUUID()
-	// Get company ID
-	companyRepo, err := domainRepository.GetCompanyRepository()
-	if err != nil {
-		global.Logger.Error("Error getting company repository: ", err)
-		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
-	}
-	userUuid, _ := utilsUuid.ParseUUID(response.UserID)
-	companyReps, err := companyRepo.GetCompanyUser(ctx, &domainModel.GetCompanyUserInput{UserID: userUuid})
-	if err != nil {
-		global.Logger.Error("Error getting company user: ", err)
-		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
-	}
-	companyId := ""
-	if companyReps != nil {
-		companyId = companyReps.CompanyID.String()
-	}
-	// Create access token
-	timeTtlAccessToken := time.Duration(constants.TTL_ACCESS_TOKEN) * time.Second
-	accessToken, err := tokenService.CreateUserToken(
-		ctx,
-		&domainModel.TokenUserJwtInput{
-			UserId:    response.UserID,
-			CompanyId: companyId,
-			TokenId:   tokenId.String(),
-			Role:      domainModel.RoleUser,
-			Expires:   time.Now().Add(timeTtlAccessToken),
-		},
-	)
-	if err != nil {
-		global.Logger.Warn("Error creating user token: ", err)
-		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	// Check user locked
+	if isUserLocked(response, time.Now()) {
+		return nil, errors.GetError(errors.UserBlockedErrorCode)
 	}
-	// Create refresh token
-	timeTtlRefreshToken := time.Duration(constants.TTL_REFRESH_TOKEN) * time.Second
-	refreshToken, err := tokenService.CreateUserRefreshToken(
-		ctx,
-		&domainModel.TokenUserRefreshInput{
-			UserId:  response.UserID,
-			TokenId: tokenId.String(),
-			Expires: time.Now().Add(timeTtlRefreshToken),
-		},
-	)
+	// Check password
+	match, err := c.checkPassword(ctx, response, input.Password)
 	if err != nil {
-		global.Logger.Warn("Error creating user refresh token: ", err)
-		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
-	}
-	// Initialize cache strategy
-	if err := c.initCacheStrategy(); err != nil {
-		global.Logger.Error("Error initializing cache strategy: ", err)
+		global.Logger.Error("Error verifying user password: ", err)
 		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
 	}
-
-	// Save session to db and cache
-	uuidUser, _ := utilsUuid.ParseUUID(response.UserID)
-	ipAddr, _ := netip.ParseAddr(input.ClientIp)
-	if err := domainRepo.CreateUserSession(
-		ctx,
-		&domainModel.CreateUserSessionInput{
-			SessionID:    tokenId,
-			UserID:       uuidUser,
-			IPAddress:    ipAddr,
-			UserAgent:    input.UserAgent,
-			RefreshToken: refreshToken,
-			ExpiredAt:    time.Now().Add(timeTtlRefreshToken),
-		},
-	); err != nil {
-		global.Logger.Error("Error creating user session: ", err)
-		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
-	}
-
-	// Use cache strategy to set session
-	if err := c.cacheStrategy.SetUserSession(ctx, tokenId.String(), response.UserID, domainModel.RoleUser, constants.TTL_ACCESS_TOKEN); err != nil {
-		global.Logger.Error("Error setting session in cache: ", err)
-		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	if !match {
+		// Password not match
+		if c.recordLoginFail(ctx, response, input.ClientIp, input.UserAgent) {
+			return nil, errors.GetError(errors.UserBlockedErrorCode)
+		}
+		return nil, errors.GetError(errors.UserPasswordIncorrectErrorCode)
 	}
-	// Return session
-	return &applicationModel.LoginOutput{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-	}, nil
+	c.resetLoginFail(ctx, response, input.ClientIp, input.UserAgent)
+	return c.createUserSession(ctx, response.UserID, input.ClientIp, input.UserAgent)
 }
 
 // LoginAdmin implements service.ICoreAuthService.
 func (c *CoreAuthService) LoginAdmin(ctx context.Context, input *applicationModel.LoginInputAdmin) (*applicationModel.LoginOutput, *errors.Error) {
+	// Check client ip spam login fail
+	if c.isLoginIpBlocked(ctx, input.ClientIp) {
+		return nil, errors.GetError(errors.AuthLoginTooManyAttemptsErrorCode)
+	}
 	// Get info user with mail
 	domainRepo, err := domainRepository.GetUserRepository()
 	if err != nil {
@@ -394,25 +547,33 @@ func (c *CoreAuthService) LoginAdmin(ctx context.Context, input *applicationMode
 	}
 	if response == nil {
 		// User not found
+		c.recordLoginFail(ctx, nil, input.ClientIp, input.UserAgent)
 		return nil, errors.GetError(errors.UserNotFoundErrorCode)
 	}
 	// Check role
 	if response.Role != domainModel.RoleManager {
 		// Role not match
+		c.recordLoginFail(ctx, nil, input.ClientIp, input.UserAgent)
 		return nil, errors.GetError(errors.UserNotFoundErrorCode)
 	}
+	// Check user locked
+	if isUserLocked(response, time.Now()) {
+		return nil, errors.GetError(errors.UserBlockedErrorCode)
+	}
 	// Check password
-	if !utilsCrypto.ComparePasswordWithHash(
-		input.Password,
-		response.UserSalt,
-		response.UserPassword,
-	) {
+	match, err := c.checkPassword(ctx, response, input.Password)
+	if err != nil {
+		global.Logger.Error("Error verifying user password: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if !match {
 		// Password not match
+		if c.recordLoginFail(ctx, response, input.ClientIp, input.UserAgent) {
+			return nil, errors.GetError(errors.UserBlockedErrorCode)
+		}
 		return nil, errors.GetError(errors.UserPasswordIncorrectErrorCode)
 	}
-	// Create session
-	tokenService := domainToken.GetTokenService()
-	tokenId := utilsRandom.GenerateUUID()
+	c.resetLoginFail(ctx, response, input.ClientIp, input.UserAgent)
 	// Get company ID
 	companyRepo, err := domainRepository.GetCompanyRepository()
 	if err != nil {
@@ -429,70 +590,16 @@ func (c *CoreAuthService) LoginAdmin(ctx context.Context, input *applicationMode
 	if companyReps != nil {
 		companyId = companyReps.CompanyID.String()
 	}
-	// Create access token
-	timeTtlAccessToken := time.Duration(constants.TTL_ACCESS_TOKEN) * time.Second
-	accessToken, err := tokenService.CreateUserToken(
-		ctx,
-		&domainModel.TokenUserJwtInput{
-			UserId:    response.UserID,
-			CompanyId: companyId,
-			TokenId:   tokenId.String(),
-			Role:      domainModel.RoleManager,
-			Expires:   time.Now().Add(timeTtlAccessToken),
-		},
-	)
-	if err != nil {
-		global.Logger.Error("Error creating user token: ", err)
-		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
-	}
-	// Create refresh token
-	timeTtlRefreshToken := time.Duration(constants.TTL_REFRESH_TOKEN) * time.Second
-	refreshToken, err := tokenService.CreateUserRefreshToken(
-		ctx,
-		&domainModel.TokenUserRefreshInput{
-			UserId:  response.UserID,
-			TokenId: tokenId.String(),
-			Expires: time.Now().Add(timeTtlRefreshToken),
-		},
-	)
-	if err != nil {
-		global.Logger.Error("Error creating user refresh token: ", err)
-		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
-	}
-	// Initialize cache strategy
-	if err := c.initCacheStrategy(); err != nil {
-		global.Logger.Error("Error initializing cache strategy: ", err)
-		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	// Check two factor, cần 2FA thì chỉ trả về challenge
+	challenge, errApp := c.startTwoFactorChallenge(ctx, response, companyReps, companyId)
+	if errApp != nil {
+		return nil, errApp
 	}
-
-	// Save session to db and cache
-	uuidUser, _ := utilsUuid.ParseUUID(response.UserID)
-	ipAddr, _ := netip.ParseAddr(input.ClientIp)
-	if err := domainRepo.CreateUserSession(
-		ctx,
-		&domainModel.CreateUserSessionInput{
-			SessionID:    tokenId,
-			UserID:       uuidUser,
-			IPAddress:    ipAddr,
-			UserAgent:    input.UserAgent,
-			RefreshToken: refreshToken,
-			ExpiredAt:    time.Now().Add(timeTtlRefreshToken),
-		},
-	); err != nil {
-		global.Logger.Error("Error creating user session: ", err)
-		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
-	}
-
-	// Use cache strategy to set session
-	if err := c.cacheStrategy.SetUserSession(ctx, tokenId.String(), response.UserID, domainModel.RoleAdmin, constants.TTL_ACCESS_TOKEN); err != nil {
-		global.Logger.Error("Error setting session in cache: ", err)
-		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	if challenge != nil {
+		return challenge, nil
 	}
-	// Return session
-	return &applicationModel.LoginOutput{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-	}, nil
+	// Create session
+	return c.createAdminSession(ctx, response.UserID, companyId, input.ClientIp, input.UserAgent)
 }
 
 // Logout implements service.ICoreAuthService.
@@ -595,6 +702,18 @@ func (c *CoreAuthService) RefreshToken(ctx context.Context, input *applicationMo
 		// Refresh token not match
 		return nil, errors.GetError(errors.AuthCannotRefreshTokenErrorCode)
 	}
+	// Check user locked
+	userBase, err := domainRepo.GetUserBaseByID(ctx, userId)
+	if err != nil {
+		global.Logger.Error("Error getting user by ID: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if userBase == nil {
+		return nil, errors.GetError(errors.AuthCannotRefreshTokenErrorCode)
+	}
+	if isUserLocked(userBase, time.Now()) {
+		return nil, errors.GetError(errors.UserBlockedErrorCode)
+	}
 	// Create new access token and refresh token
 	accessTokenTimeTtl := time.Duration(constants.TTL_ACCESS_TOKEN) * time.Second
 	accessToken, err := tokenService.CreateUserToken(
@@ -0,0 +1,336 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/errors"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/model"
+	constants "github.com/youknow2509/cio_verify_face/server/service_auth/internal/constants"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/model"
+	domainRepository "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/repository"
+	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/global"
+	utilsCache "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/cache"
+	utilsCrypto "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/crypto"
+	utilsUuid "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/uuid"
+)
+
+// luaIncrementWithTTL INCR và EXPIRE trong một lệnh, TTL chỉ đặt ở lần tăng đầu tiên (hoặc khi key mất TTL)
+// nên cửa sổ đếm không bị kéo dài bởi các lần sai tiếp theo
+const luaIncrementWithTTL = `
+local count = redis.call('INCR', KEYS[1])
+if count == 1 or redis.call('TTL', KEYS[1]) == -1 then
+	redis.call('EXPIRE', KEYS[1], ARGV[1])
+end
+return count
+`
+
+// UnlockUser implements service.ICoreAuthService.
+// Admin mở khóa mọi tài khoản, manager chỉ mở khóa tài khoản thuộc công ty mình quản lý.
+func (c *CoreAuthService) UnlockUser(ctx context.Context, input *applicationModel.UnlockUserInput) *errors.Error {
+	// Check role
+	if input.Role != domainModel.RoleAdmin && input.Role != domainModel.RoleManager {
+		return errors.GetError(errors.AuthDontHavePermissionErrorCode)
+	}
+	userRepo, err := domainRepository.GetUserRepository()
+	if err != nil {
+		global.Logger.Error("Error getting user repository: ", err)
+		return errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	target, err := userRepo.GetUserBaseByID(ctx, input.TargetUserId)
+	if err != nil {
+		global.Logger.Error("Error getting user by id: ", err)
+		return errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if target == nil {
+		return errors.GetError(errors.UserNotFoundErrorCode)
+	}
+	if input.Role == domainModel.RoleManager {
+		companyRepo, err := domainRepository.GetCompanyRepository()
+		if err != nil {
+			global.Logger.Error("Error getting company repository: ", err)
+			return errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+		}
+		companyReps, err := companyRepo.GetCompanyUser(ctx, &domainModel.GetCompanyUserInput{UserID: input.TargetUserId})
+		if err != nil {
+			global.Logger.Error("Error getting company user: ", err)
+			return errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+		}
+		if companyReps == nil {
+			return errors.GetError(errors.AuthDontHavePermissionErrorCode)
+		}
+		ok, err := companyRepo.CheckUserIsManagementInCompany(
+			ctx,
+			&domainModel.CheckCompanyIsManagementInCompanyInput{
+				CompanyID: companyReps.CompanyID,
+				UserID:    input.UserId,
+			},
+		)
+		if err != nil {
+			global.Logger.Error("Error checking user is management in company: ", err)
+			return errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+		}
+		if !ok {
+			return errors.GetError(errors.AuthDontHavePermissionErrorCode)
+		}
+	}
+	// Unlock in database
+	if err := userRepo.UpdateUserLock(
+		ctx,
+		&domainModel.UpdateUserLockInput{
+			UserID:        input.TargetUserId,
+			IsLocked:      false,
+			LockExpiresAt: nil,
+		},
+	); err != nil {
+		global.Logger.Error("Error unlocking user: ", err)
+		return errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	// Xóa bộ đếm và mức khóa để lần sai tiếp theo bắt đầu lại từ đầu
+	if err := c.initCacheStrategy(); err != nil {
+		global.Logger.Error("Error initializing cache strategy: ", err)
+	} else {
+		userIdHash := utilsCrypto.GetHash(target.UserID)
+		c.cacheStrategy.Delete(ctx, utilsCache.GetKeyCountLoginFail(userIdHash))
+		c.cacheStrategy.Delete(ctx, utilsCache.GetKeyLoginLockLevel(userIdHash))
+	}
+	c.addLockAuditLog(
+		ctx,
+		input.UserId,
+		constants.AuditActionUnlockUser,
+		input.TargetUserId,
+		map[string]interface{}{
+			"is_locked":       target.IsBlocked,
+			"lock_expires_at": lockExpiresAtValue(target.LockExpiresAt),
+		},
+		map[string]interface{}{
+			"is_locked": false,
+			"reason":    "manual_unlock",
+		},
+		input.ClientIp,
+		input.UserAgent,
+	)
+	global.Logger.Info("User unlocked", "user_id", target.UserID, "by", input.UserId.String())
+	return nil
+}
+
+// isUserLocked tài khoản bị khóa khi is_locked và chưa hết hạn, lock_expires_at NULL là khóa vô thời hạn
+func isUserLocked(user *domainModel.UserBaseInfoOutput, now time.Time) bool {
+	if !user.IsBlocked {
+		return false
+	}
+	return user.LockExpiresAt == nil || user.LockExpiresAt.After(now)
+}
+
+// isLoginIpBlocked kiểm tra IP đã vượt số lần đăng nhập sai, lỗi cache không chặn đăng nhập
+func (c *CoreAuthService) isLoginIpBlocked(ctx context.Context, clientIp string) bool {
+	if err := c.initCacheStrategy(); err != nil {
+		global.Logger.Error("Error initializing cache strategy: ", err)
+		return false
+	}
+	value, err := c.cacheStrategy.distributedCache.Get(ctx, utilsCache.GetKeyCountLoginFailIp(utilsCrypto.GetHash(clientIp)))
+	if err != nil {
+		global.Logger.Warn("Error getting login fail count of ip: ", err)
+		return false
+	}
+	if value == "" {
+		return false
+	}
+	count, err := strconv.Atoi(value)
+	if err != nil {
+		return false
+	}
+	return count >= constants.RateLimitLoginFailPerIp
+}
+
+// recordLoginFail tăng bộ đếm sai theo IP và theo tài khoản (user nil khi không tìm thấy tài khoản),
+// trả về true khi tài khoản vừa bị khóa
+func (c *CoreAuthService) recordLoginFail(ctx context.Context, user *domainModel.UserBaseInfoOutput, clientIp string, userAgent string) bool {
+	if err := c.initCacheStrategy(); err != nil {
+		global.Logger.Error("Error initializing cache strategy: ", err)
+		return false
+	}
+	c.incrementLoginFailCounter(ctx, utilsCache.GetKeyCountLoginFailIp(utilsCrypto.GetHash(clientIp)), constants.TTL_COUNT_LOGIN_FAIL_IP)
+	if user == nil {
+		return false
+	}
+	count := c.incrementLoginFailCounter(ctx, utilsCache.GetKeyCountLoginFail(utilsCrypto.GetHash(user.UserID)), constants.TTL_COUNT_LOGIN_FAIL)
+	if count < constants.RateLimitLoginFailPerAccount {
+		return false
+	}
+	return c.lockUser(ctx, user, clientIp, userAgent)
+}
+
+// incrementLoginFailCounter tăng bộ đếm và đặt TTL nguyên tử, trả về 0 khi cache lỗi
+func (c *CoreAuthService) incrementLoginFailCounter(ctx context.Context, key string, ttl int64) int64 {
+	result, err := c.cacheStrategy.distributedCache.LuaScript(ctx, luaIncrementWithTTL, []string{key}, ttl)
+	if err != nil {
+		global.Logger.Warn("Error incrementing login fail count: ", err)
+		return 0
+	}
+	count, ok := result.(int64)
+	if !ok {
+		global.Logger.Warn("Unexpected login fail count result", "key", key, "result", result)
+		return 0
+	}
+	return count
+}
+
+// lockUser khóa tài khoản, thời gian khóa nhân đôi theo số lần bị khóa trong TTL_LOGIN_LOCK_LEVEL
+func (c *CoreAuthService) lockUser(ctx context.Context, user *domainModel.UserBaseInfoOutput, clientIp string, userAgent string) bool {
+	userUuid, err := utilsUuid.ParseUUID(user.UserID)
+	if err != nil {
+		global.Logger.Error("Error parsing user id for lock: ", err)
+		return false
+	}
+	userIdHash := utilsCrypto.GetHash(user.UserID)
+	levelKey := utilsCache.GetKeyLoginLockLevel(userIdHash)
+	level := c.incrementLoginFailCounter(ctx, levelKey, constants.TTL_LOGIN_LOCK_LEVEL)
+	if level == 0 {
+		level = 1
+	}
+	lockExpiresAt := time.Now().Add(loginLockDuration(level))
+	userRepo, err := domainRepository.GetUserRepository()
+	if err != nil {
+		global.Logger.Error("Error getting user repository: ", err)
+		return false
+	}
+	if err := userRepo.UpdateUserLock(
+		ctx,
+		&domainModel.UpdateUserLockInput{
+			UserID:        userUuid,
+			IsLocked:      true,
+			LockExpiresAt: &lockExpiresAt,
+		},
+	); err != nil {
+		global.Logger.Error("Error locking user: ", err)
+		return false
+	}
+	c.cacheStrategy.Delete(ctx, utilsCache.GetKeyCountLoginFail(userIdHash))
+	c.addLockAuditLog(
+		ctx,
+		userUuid,
+		constants.AuditActionLockUser,
+		userUuid,
+		map[string]interface{}{
+			"is_locked":       user.IsBlocked,
+			"lock_expires_at": lockExpiresAtValue(user.LockExpiresAt),
+		},
+		map[string]interface{}{
+			"is_locked":       true,
+			"lock_expires_at": lockExpiresAt.Unix(),
+			"lock_level":      level,
+			"reason":          "too_many_failed_logins",
+		},
+		clientIp,
+		userAgent,
+	)
+	global.Logger.Warn("User locked after too many failed logins", "user_id", user.UserID, "lock_level", level, "lock_expires_at", lockExpiresAt)
+	return true
+}
+
+// resetLoginFail xóa bộ đếm sai của tài khoản khi đăng nhập thành công và gỡ khóa đã hết hạn
+func (c *CoreAuthService) resetLoginFail(ctx context.Context, user *domainModel.UserBaseInfoOutput, clientIp string, userAgent string) {
+	if err := c.initCacheStrategy(); err != nil {
+		global.Logger.Error("Error initializing cache strategy: ", err)
+	} else {
+		c.cacheStrategy.Delete(ctx, utilsCache.GetKeyCountLoginFail(utilsCrypto.GetHash(user.UserID)))
+	}
+	if !user.IsBlocked {
+		return
+	}
+	userUuid, err := utilsUuid.ParseUUID(user.UserID)
+	if err != nil {
+		global.Logger.Error("Error parsing user id for unlock: ", err)
+		return
+	}
+	userRepo, err := domainRepository.GetUserRepository()
+	if err != nil {
+		global.Logger.Error("Error getting user repository: ", err)
+		return
+	}
+	if err := userRepo.UpdateUserLock(
+		ctx,
+		&domainModel.UpdateUserLockInput{
+			UserID:        userUuid,
+			IsLocked:      false,
+			LockExpiresAt: nil,
+		},
+	); err != nil {
+		global.Logger.Error("Error clearing expired user lock: ", err)
+		return
+	}
+	c.addLockAuditLog(
+		ctx,
+		userUuid,
+		constants.AuditActionUnlockUser,
+		userUuid,
+		map[string]interface{}{
+			"is_locked":       true,
+			"lock_expires_at": lockExpiresAtValue(user.LockExpiresAt),
+		},
+		map[string]interface{}{
+			"is_locked": false,
+			"reason":    "lock_expired",
+		},
+		clientIp,
+		userAgent,
+	)
+}
+
+// addLockAuditLog ghi audit log khóa/mở khóa, lỗi chỉ ghi log
+func (c *CoreAuthService) addLockAuditLog(
+	ctx context.Context,
+	actorId uuid.UUID,
+	action string,
+	targetId uuid.UUID,
+	oldValues map[string]interface{},
+	newValues map[string]interface{},
+	clientIp string,
+	userAgent string,
+) {
+	auditRepo, err := domainRepository.GetAuditRepository()
+	if err != nil {
+		global.Logger.Error("Error getting audit repository: ", err)
+		return
+	}
+	if err := auditRepo.AddAuditLog(
+		ctx,
+		&domainModel.AuditLog{
+			UserId:       actorId,
+			Action:       action,
+			ResourceType: constants.AuditResourceTypeUser,
+			ResourceId:   targetId,
+			OldValues:    oldValues,
+			NewValues:    newValues,
+			IpAddress:    clientIp,
+			UserAgent:    userAgent,
+			Timestamp:    time.Now().Unix(),
+		},
+	); err != nil {
+		global.Logger.Error("Error logging audit log: ", err)
+	}
+}
+
+// loginLockDuration TTL_LOGIN_LOCK_BASE nhân đôi theo mức khóa, tối đa TTL_LOGIN_LOCK_MAX
+func loginLockDuration(level int64) time.Duration {
+	duration := time.Duration(constants.TTL_LOGIN_LOCK_BASE) * time.Second
+	maxDuration := time.Duration(constants.TTL_LOGIN_LOCK_MAX) * time.Second
+	for i := int64(1); i < level && duration < maxDuration; i++ {
+		duration *= 2
+	}
+	if duration > maxDuration {
+		duration = maxDuration
+	}
+	return duration
+}
+
+// lockExpiresAtValue giá trị lock_expires_at trong audit log, nil khi không có
+func lockExpiresAtValue(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.Unix()
+}
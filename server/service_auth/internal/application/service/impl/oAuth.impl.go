@@ -1 +1,599 @@
 package impl
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/errors"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/model"
+	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/service"
+	constants "github.com/youknow2509/cio_verify_face/server/service_auth/internal/constants"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/model"
+	domainOidc "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/oidc"
+	domainRepository "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/repository"
+	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/global"
+	utilsCache "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/cache"
+	utilsCrypto "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/crypto"
+	utilsUuid "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/uuid"
+)
+
+// Scope mặc định khi công ty không cấu hình
+var defaultOidcScopes = []string{"openid", "email", "profile"}
+
+// oidcState dữ liệu lưu trong cache giữa bước authorize và callback
+type oidcState struct {
+	CompanyId    string `json:"company_id"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+}
+
+/**
+ * Define OAuthService struct implementing
+ */
+type OAuthService struct {
+	core *CoreAuthService
+}
+
+// GetOidcConfig implements service.IOAuthService.
+func (o *OAuthService) GetOidcConfig(ctx context.Context, input *applicationModel.OidcConfigInput) (*applicationModel.OidcConfigOutput, *errors.Error) {
+	companyId, errApp := o.resolveCompany(ctx, input.UserId, input.Role, input.CompanyIdReq)
+	if errApp != nil {
+		return nil, errApp
+	}
+	config, errApp := o.getConfig(ctx, companyId)
+	if errApp != nil {
+		return nil, errApp
+	}
+	if config == nil {
+		return nil, errors.GetError(errors.AuthOidcNotConfiguredErrorCode)
+	}
+	return toOidcConfigOutput(config), nil
+}
+
+// UpsertOidcConfig implements service.IOAuthService.
+func (o *OAuthService) UpsertOidcConfig(ctx context.Context, input *applicationModel.UpsertOidcConfigInput) (*applicationModel.OidcConfigOutput, *errors.Error) {
+	companyId, errApp := o.resolveCompany(ctx, input.UserId, input.Role, input.CompanyIdReq)
+	if errApp != nil {
+		return nil, errApp
+	}
+	issuer := strings.TrimSuffix(strings.TrimSpace(input.Issuer), "/")
+	if !validIssuer(issuer) {
+		return nil, errors.GetError(errors.AuthOidcConfigInvalidErrorCode)
+	}
+	// SSO chỉ cấp quyền trong công ty, không map sang SYSTEM_ADMIN
+	for _, role := range input.RoleMapping {
+		if role != domainModel.RoleManager && role != domainModel.RoleUser {
+			return nil, errors.GetError(errors.AuthOidcConfigInvalidErrorCode)
+		}
+	}
+	scopes := input.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultOidcScopes
+	}
+	if !containsString(scopes, "openid") {
+		scopes = append([]string{"openid"}, scopes...)
+	}
+	emailClaim := strings.TrimSpace(input.EmailClaim)
+	if emailClaim == "" {
+		emailClaim = "email"
+	}
+	current, errApp := o.getConfig(ctx, companyId)
+	if errApp != nil {
+		return nil, errApp
+	}
+	// Client secret rỗng thì giữ secret đang lưu
+	clientSecret := ""
+	if input.ClientSecret != "" {
+		encrypted, err := utilsCrypto.EncryptAESGCM(global.SettingServer.Oidc.EncryptionKey, input.ClientSecret)
+		if err != nil {
+			global.Logger.Error("Error encrypting oidc client secret: ", err)
+			return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+		}
+		clientSecret = encrypted
+	} else if current != nil {
+		clientSecret = current.ClientSecret
+	} else {
+		return nil, errors.GetError(errors.AuthOidcConfigInvalidErrorCode)
+	}
+	// Kiểm tra issuer có discovery document hợp lệ trước khi lưu
+	if input.IsEnabled {
+		if _, err := domainOidc.GetOidcProvider().Discover(ctx, issuer); err != nil {
+			global.Logger.Warn("Error discovering oidc provider: ", err)
+			return nil, errors.GetError(errors.AuthOidcProviderErrorCode)
+		}
+	}
+	data := &domainModel.UpsertCompanyOidcConfigInput{
+		CompanyID:    companyId,
+		Issuer:       issuer,
+		ClientID:     strings.TrimSpace(input.ClientId),
+		ClientSecret: clientSecret,
+		Scopes:       scopes,
+		EmailClaim:   emailClaim,
+		RoleClaim:    strings.TrimSpace(input.RoleClaim),
+		RoleMapping:  input.RoleMapping,
+		IsEnabled:    input.IsEnabled,
+	}
+	oidcRepo, err := domainRepository.GetOidcRepository()
+	if err != nil {
+		global.Logger.Error("Error getting oidc repository: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if err := oidcRepo.UpsertCompanyOidcConfig(ctx, data); err != nil {
+		global.Logger.Error("Error saving oidc config: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	var oldValues map[string]interface{}
+	if current != nil {
+		oldValues = oidcAuditValues(current.Issuer, current.ClientID, current.RoleClaim, current.RoleMapping, current.IsEnabled)
+	}
+	newValues := oidcAuditValues(data.Issuer, data.ClientID, data.RoleClaim, data.RoleMapping, data.IsEnabled)
+	newValues["client_secret_changed"] = input.ClientSecret != ""
+	o.addOidcAuditLog(ctx, input.UserId, constants.AuditActionUpdateOidcConfig, companyId, oldValues, newValues, input.ClientIp, input.UserAgent)
+	return toOidcConfigOutput(&domainModel.CompanyOidcConfigOutput{
+		CompanyID:    data.CompanyID,
+		Issuer:       data.Issuer,
+		ClientID:     data.ClientID,
+		ClientSecret: data.ClientSecret,
+		Scopes:       data.Scopes,
+		EmailClaim:   data.EmailClaim,
+		RoleClaim:    data.RoleClaim,
+		RoleMapping:  data.RoleMapping,
+		IsEnabled:    data.IsEnabled,
+	}), nil
+}
+
+// DeleteOidcConfig implements service.IOAuthService.
+func (o *OAuthService) DeleteOidcConfig(ctx context.Context, input *applicationModel.OidcConfigInput) *errors.Error {
+	companyId, errApp := o.resolveCompany(ctx, input.UserId, input.Role, input.CompanyIdReq)
+	if errApp != nil {
+		return errApp
+	}
+	current, errApp := o.getConfig(ctx, companyId)
+	if errApp != nil {
+		return errApp
+	}
+	if current == nil {
+		return errors.GetError(errors.AuthOidcNotConfiguredErrorCode)
+	}
+	oidcRepo, err := domainRepository.GetOidcRepository()
+	if err != nil {
+		global.Logger.Error("Error getting oidc repository: ", err)
+		return errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if err := oidcRepo.DeleteCompanyOidcConfig(ctx, companyId); err != nil {
+		global.Logger.Error("Error deleting oidc config: ", err)
+		return errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	o.addOidcAuditLog(
+		ctx,
+		input.UserId,
+		constants.AuditActionDeleteOidcConfig,
+		companyId,
+		oidcAuditValues(current.Issuer, current.ClientID, current.RoleClaim, current.RoleMapping, current.IsEnabled),
+		nil,
+		input.ClientIp,
+		input.UserAgent,
+	)
+	return nil
+}
+
+// OidcAuthorize implements service.IOAuthService.
+func (o *OAuthService) OidcAuthorize(ctx context.Context, input *applicationModel.OidcAuthorizeInput) (*applicationModel.OidcAuthorizeOutput, *errors.Error) {
+	config, errApp := o.getConfig(ctx, input.CompanyId)
+	if errApp != nil {
+		return nil, errApp
+	}
+	if config == nil || !config.IsEnabled {
+		return nil, errors.GetError(errors.AuthOidcNotConfiguredErrorCode)
+	}
+	provider := domainOidc.GetOidcProvider()
+	metadata, err := provider.Discover(ctx, config.Issuer)
+	if err != nil {
+		global.Logger.Warn("Error discovering oidc provider: ", err)
+		return nil, errors.GetError(errors.AuthOidcProviderErrorCode)
+	}
+	state, err := utilsCrypto.RandomToken(constants.RandomTokenOidcState)
+	if err != nil {
+		global.Logger.Error("Error generating oidc state: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	nonce, err := utilsCrypto.RandomToken(constants.RandomTokenOidcNonce)
+	if err != nil {
+		global.Logger.Error("Error generating oidc nonce: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	codeVerifier, err := utilsCrypto.RandomToken(constants.RandomTokenOidcCodeVerifier)
+	if err != nil {
+		global.Logger.Error("Error generating oidc code verifier: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	data, err := json.Marshal(oidcState{
+		CompanyId:    config.CompanyID.String(),
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+	})
+	if err != nil {
+		global.Logger.Error("Error marshal oidc state: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if err := o.core.initCacheStrategy(); err != nil {
+		global.Logger.Error("Error initializing cache strategy: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if err := o.core.cacheStrategy.distributedCache.SetTTL(
+		ctx,
+		utilsCache.GetKeyOidcState(utilsCrypto.GetHash(state)),
+		string(data),
+		constants.TTL_OIDC_STATE,
+	); err != nil {
+		global.Logger.Error("Error saving oidc state: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	return &applicationModel.OidcAuthorizeOutput{
+		AuthorizationUrl: provider.AuthorizationUrl(
+			metadata,
+			&domainModel.OidcAuthorizationUrlInput{
+				ClientID:      config.ClientID,
+				RedirectUri:   global.SettingServer.Oidc.RedirectUri,
+				Scopes:        config.Scopes,
+				State:         state,
+				Nonce:         nonce,
+				CodeChallenge: utilsCrypto.PKCEChallengeS256(codeVerifier),
+			},
+		),
+		State:    state,
+		ExpireAt: time.Now().Add(time.Duration(constants.TTL_OIDC_STATE) * time.Second).Unix(),
+	}, nil
+}
+
+// OidcCallback implements service.IOAuthService.
+func (o *OAuthService) OidcCallback(ctx context.Context, input *applicationModel.OidcCallbackInput) (*applicationModel.LoginOutput, *errors.Error) {
+	state, errApp := o.takeState(ctx, input.State)
+	if errApp != nil {
+		return nil, errApp
+	}
+	if state == nil {
+		return nil, errors.GetError(errors.AuthOidcStateInvalidErrorCode)
+	}
+	companyId, err := utilsUuid.ParseUUID(state.CompanyId)
+	if err != nil {
+		return nil, errors.GetError(errors.AuthOidcStateInvalidErrorCode)
+	}
+	config, errApp := o.getConfig(ctx, companyId)
+	if errApp != nil {
+		return nil, errApp
+	}
+	if config == nil || !config.IsEnabled {
+		return nil, errors.GetError(errors.AuthOidcNotConfiguredErrorCode)
+	}
+	clientSecret, err := utilsCrypto.DecryptAESGCM(global.SettingServer.Oidc.EncryptionKey, config.ClientSecret)
+	if err != nil {
+		global.Logger.Error("Error decrypting oidc client secret: ", err)
+		return nil, errors.GetError(errors.AuthOidcConfigInvalidErrorCode)
+	}
+	provider := domainOidc.GetOidcProvider()
+	metadata, err := provider.Discover(ctx, config.Issuer)
+	if err != nil {
+		global.Logger.Warn("Error discovering oidc provider: ", err)
+		return nil, errors.GetError(errors.AuthOidcProviderErrorCode)
+	}
+	tokens, err := provider.ExchangeCode(
+		ctx,
+		metadata,
+		&domainModel.OidcExchangeCodeInput{
+			ClientID:     config.ClientID,
+			ClientSecret: clientSecret,
+			RedirectUri:  global.SettingServer.Oidc.RedirectUri,
+			Code:         input.Code,
+			CodeVerifier: state.CodeVerifier,
+		},
+	)
+	if err != nil {
+		global.Logger.Warn("Error exchanging oidc code: ", err)
+		return nil, errors.GetError(errors.AuthOidcIdentityInvalidErrorCode)
+	}
+	claims, err := provider.VerifyIDToken(
+		ctx,
+		metadata,
+		&domainModel.OidcVerifyIDTokenInput{
+			IDToken:  tokens.IDToken,
+			ClientID: config.ClientID,
+			Nonce:    state.Nonce,
+		},
+	)
+	if err != nil {
+		global.Logger.Warn("Error verifying oidc id token: ", err)
+		return nil, errors.GetError(errors.AuthOidcIdentityInvalidErrorCode)
+	}
+	email, _ := claims[config.EmailClaim].(string)
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return nil, errors.GetError(errors.AuthOidcIdentityInvalidErrorCode)
+	}
+	// Email chưa xác minh tại IdP không dùng để match user
+	if verified, ok := claims["email_verified"].(bool); ok && !verified {
+		return nil, errors.GetError(errors.AuthOidcIdentityInvalidErrorCode)
+	}
+	// Match user đã tồn tại theo email, không tự tạo user
+	userRepo, err := domainRepository.GetUserRepository()
+	if err != nil {
+		global.Logger.Error("Error getting user repository: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	user, err := userRepo.GetUserBaseByEmail(ctx, email)
+	if err != nil {
+		global.Logger.Error("Error getting user by email: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if user == nil || user.Role == domainModel.RoleAdmin {
+		return nil, errors.GetError(errors.AuthOidcUserNotAllowedErrorCode)
+	}
+	// IdP của công ty chỉ đăng nhập được user thuộc công ty đó
+	userUuid, err := utilsUuid.ParseUUID(user.UserID)
+	if err != nil {
+		global.Logger.Error("Error parsing user id: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	companyRepo, err := domainRepository.GetCompanyRepository()
+	if err != nil {
+		global.Logger.Error("Error getting company repository: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	companyReps, err := companyRepo.GetCompanyUser(ctx, &domainModel.GetCompanyUserInput{UserID: userUuid})
+	if err != nil {
+		global.Logger.Error("Error getting company user: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if companyReps == nil || companyReps.CompanyID != companyId {
+		return nil, errors.GetError(errors.AuthOidcUserNotAllowedErrorCode)
+	}
+	if isUserLocked(user, time.Now()) {
+		return nil, errors.GetError(errors.UserBlockedErrorCode)
+	}
+	role, ok := oidcRole(config, claims, user.Role)
+	if !ok {
+		return nil, errors.GetError(errors.AuthOidcUserNotAllowedErrorCode)
+	}
+	if role == domainModel.RoleUser {
+		return o.core.createUserSession(ctx, user.UserID, input.ClientIp, input.UserAgent)
+	}
+	// COMPANY_ADMIN vẫn áp dụng chính sách 2FA như LoginAdmin
+	challenge, errApp := o.core.startTwoFactorChallenge(ctx, user, companyReps, companyId.String())
+	if errApp != nil {
+		return nil, errApp
+	}
+	if challenge != nil {
+		return challenge, nil
+	}
+	return o.core.createAdminSession(ctx, user.UserID, companyId.String(), input.ClientIp, input.UserAgent)
+}
+
+// resolveCompany công ty được cấu hình: SYSTEM_ADMIN truyền company id, COMPANY_ADMIN là công ty mình quản lý
+func (o *OAuthService) resolveCompany(ctx context.Context, userId uuid.UUID, role int, companyIdReq uuid.UUID) (uuid.UUID, *errors.Error) {
+	switch role {
+	case domainModel.RoleAdmin:
+		if companyIdReq == uuid.Nil {
+			return uuid.Nil, errors.GetError(errors.AuthOidcConfigInvalidErrorCode)
+		}
+		return companyIdReq, nil
+	case domainModel.RoleManager:
+		companyRepo, err := domainRepository.GetCompanyRepository()
+		if err != nil {
+			global.Logger.Error("Error getting company repository: ", err)
+			return uuid.Nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+		}
+		companyReps, err := companyRepo.GetCompanyUser(ctx, &domainModel.GetCompanyUserInput{UserID: userId})
+		if err != nil {
+			global.Logger.Error("Error getting company user: ", err)
+			return uuid.Nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+		}
+		if companyReps == nil {
+			return uuid.Nil, errors.GetError(errors.AuthDontHavePermissionErrorCode)
+		}
+		ok, err := companyRepo.CheckUserIsManagementInCompany(
+			ctx,
+			&domainModel.CheckCompanyIsManagementInCompanyInput{
+				CompanyID: companyReps.CompanyID,
+				UserID:    userId,
+			},
+		)
+		if err != nil {
+			global.Logger.Error("Error checking user is management in company: ", err)
+			return uuid.Nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+		}
+		if !ok {
+			return uuid.Nil, errors.GetError(errors.AuthDontHavePermissionErrorCode)
+		}
+		return companyReps.CompanyID, nil
+	default:
+		return uuid.Nil, errors.GetError(errors.AuthDontHavePermissionErrorCode)
+	}
+}
+
+// getConfig đọc cấu hình OIDC của công ty, nil khi chưa cấu hình
+func (o *OAuthService) getConfig(ctx context.Context, companyId uuid.UUID) (*domainModel.CompanyOidcConfigOutput, *errors.Error) {
+	oidcRepo, err := domainRepository.GetOidcRepository()
+	if err != nil {
+		global.Logger.Error("Error getting oidc repository: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	config, err := oidcRepo.GetCompanyOidcConfig(ctx, companyId)
+	if err != nil {
+		global.Logger.Error("Error getting oidc config: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	return config, nil
+}
+
+// luaGetAndDelete GET và DEL trong một lệnh, hai callback cùng state chỉ một bên đọc được giá trị
+const luaGetAndDelete = `
+local value = redis.call('GET', KEYS[1])
+if value then
+	redis.call('DEL', KEYS[1])
+end
+return value
+`
+
+// takeState đọc và xóa state nguyên tử, mỗi state chỉ dùng cho một callback
+func (o *OAuthService) takeState(ctx context.Context, state string) (*oidcState, *errors.Error) {
+	if state == "" {
+		return nil, nil
+	}
+	if err := o.core.initCacheStrategy(); err != nil {
+		global.Logger.Error("Error initializing cache strategy: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	key := utilsCache.GetKeyOidcState(utilsCrypto.GetHash(state))
+	result, err := o.core.cacheStrategy.distributedCache.LuaScript(ctx, luaGetAndDelete, []string{key})
+	if err != nil {
+		global.Logger.Error("Error taking oidc state: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	// State không tồn tại hoặc đã được dùng: script trả về nil
+	value, _ := result.(string)
+	if value == "" {
+		return nil, nil
+	}
+	var data oidcState
+	if err := json.Unmarshal([]byte(value), &data); err != nil {
+		global.Logger.Warn("Invalid oidc state in cache: ", err)
+		return nil, nil
+	}
+	return &data, nil
+}
+
+// addOidcAuditLog ghi audit log thay đổi cấu hình SSO của công ty
+func (o *OAuthService) addOidcAuditLog(
+	ctx context.Context,
+	actorId uuid.UUID,
+	action string,
+	companyId uuid.UUID,
+	oldValues map[string]interface{},
+	newValues map[string]interface{},
+	clientIp string,
+	userAgent string,
+) {
+	auditRepo, err := domainRepository.GetAuditRepository()
+	if err != nil {
+		global.Logger.Error("Error getting audit repository: ", err)
+		return
+	}
+	if err := auditRepo.AddAuditLog(
+		ctx,
+		&domainModel.AuditLog{
+			UserId:       actorId,
+			Action:       action,
+			ResourceType: constants.AuditResourceTypeCompany,
+			ResourceId:   companyId,
+			OldValues:    oldValues,
+			NewValues:    newValues,
+			IpAddress:    clientIp,
+			UserAgent:    userAgent,
+			Timestamp:    time.Now().Unix(),
+		},
+	); err != nil {
+		global.Logger.Error("Error logging audit log: ", err)
+	}
+}
+
+// oidcRole role đăng nhập theo role_mapping, lấy role cao nhất được map nhưng không vượt role của user trong DB.
+// Không cấu hình role_claim thì dùng role trong DB, có cấu hình mà không giá trị nào được map thì không cho đăng nhập.
+func oidcRole(config *domainModel.CompanyOidcConfigOutput, claims map[string]interface{}, userRole int) (int, bool) {
+	if config.RoleClaim == "" {
+		return userRole, true
+	}
+	var values []string
+	switch v := claims[config.RoleClaim].(type) {
+	case string:
+		values = []string{v}
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+	role := -1
+	for _, value := range values {
+		if mapped, ok := config.RoleMapping[value]; ok && (role == -1 || mapped < role) {
+			role = mapped
+		}
+	}
+	if role == -1 {
+		return 0, false
+	}
+	if role < userRole {
+		role = userRole
+	}
+	return role, true
+}
+
+// validIssuer issuer phải là https, chỉ cho http với localhost (môi trường dev, test)
+func validIssuer(issuer string) bool {
+	u, err := url.Parse(issuer)
+	if err != nil || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+		return false
+	}
+	switch u.Scheme {
+	case "https":
+		return true
+	case "http":
+		host := u.Hostname()
+		return host == "localhost" || host == "127.0.0.1" || host == "::1"
+	default:
+		return false
+	}
+}
+
+// oidcAuditValues giá trị cấu hình ghi vào audit log, không ghi client secret
+func oidcAuditValues(issuer string, clientId string, roleClaim string, roleMapping map[string]int, isEnabled bool) map[string]interface{} {
+	return map[string]interface{}{
+		"issuer":       issuer,
+		"client_id":    clientId,
+		"role_claim":   roleClaim,
+		"role_mapping": roleMapping,
+		"is_enabled":   isEnabled,
+	}
+}
+
+// toOidcConfigOutput không trả client secret ra ngoài
+func toOidcConfigOutput(config *domainModel.CompanyOidcConfigOutput) *applicationModel.OidcConfigOutput {
+	output := &applicationModel.OidcConfigOutput{
+		CompanyId:       config.CompanyID.String(),
+		Issuer:          config.Issuer,
+		ClientId:        config.ClientID,
+		HasClientSecret: config.ClientSecret != "",
+		Scopes:          config.Scopes,
+		EmailClaim:      config.EmailClaim,
+		RoleClaim:       config.RoleClaim,
+		RoleMapping:     config.RoleMapping,
+		IsEnabled:       config.IsEnabled,
+		RedirectUri:     global.SettingServer.Oidc.RedirectUri,
+	}
+	if config.UpdatedAt != nil {
+		output.UpdatedAt = config.UpdatedAt.Unix()
+	}
+	return output
+}
+
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * NewOAuthService creates a new instance of OAuthService
+ */
+func NewOAuthService() service.IOAuthService {
+	return &OAuthService{
+		core: &CoreAuthService{},
+	}
+}
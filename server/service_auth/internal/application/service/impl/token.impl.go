@@ -46,6 +46,15 @@ func (t *TokenService) ParseTokenDevice(ctx context.Context, input model.ParseTo
 			global.Logger.Error("failed to unmarshal token status from cache", "error", err.Error(), "key", key)
 			return nil, err
 		}
+		// Token có thể bị thu hồi sau khi kết quả parse được cache
+		revoked, err := isDeviceTokenRevoked(ctx, cachedRes.TokenId)
+		if err != nil {
+			return nil, err
+		}
+		if revoked {
+			global.Logger.Warn("device token revoked", "token_id", cachedRes.TokenId)
+			return nil, nil
+		}
 		return &cachedRes, nil
 	}
 	// 2. Validate token validate
@@ -70,7 +79,16 @@ func (t *TokenService) ParseTokenDevice(ctx context.Context, input model.ParseTo
 			return nil, nil
 		}
 	}
-	// 3. Cache token status
+	// 3. Check token revoked (refresh, delete, disable or compromised device)
+	revoked, err := isDeviceTokenRevoked(ctx, tokenData.TokenId)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		global.Logger.Warn("device token revoked", "token_id", tokenData.TokenId)
+		return nil, nil
+	}
+	// 4. Cache token status
 	output := &model.ParseTokenDeviceOutput{
 		TokenId:   tokenData.TokenId,
 		DeviceId:  tokenData.DeviceId,
@@ -89,23 +107,46 @@ func (t *TokenService) ParseTokenDevice(ctx context.Context, input model.ParseTo
 }
 
 // BlockTokenDevice implements service.ITokenService.
+// Chỉ thu hồi token id đến khi token hết hạn, trạng thái device và token hiện tại do service device quản lý
 func (t *TokenService) BlockTokenDevice(ctx context.Context, input model.BlockTokenDeviceInput) error {
-	//
-	db := domainRepo.GetDeviceRepository()
-	exist, err := db.DeviceExist(ctx, input.DeviceId)
+	ttl := int64(constants.TTL_DEVICE_TOKEN_LONG)
+	if !input.ExpiresAt.IsZero() {
+		ttl = int64(time.Until(input.ExpiresAt).Seconds())
+		if ttl <= 0 {
+			// Token đã hết hạn, không cần thu hồi
+			return nil
+		}
+	}
+	// Block device token in cache, service device và ws delivery dùng chung key này
+	cache, err := domainCache.GetDistributedCache()
 	if err != nil {
+		global.Logger.Error("distributed cache not initialized", "error", err.Error())
 		return err
 	}
-	if !exist {
-		global.Logger.Warn("device not found", "device_id", input.DeviceId)
-		return errors.New("device not found")
-	}
-	if err := db.BlockDeviceToken(ctx, input.DeviceId); err != nil {
+	key := sharedCache.GetKeyStatusTokenDevice(sharedCrypto.GetHash(input.TokenId.String()))
+	if err := cache.SetTTL(ctx, key, "0", ttl); err != nil {
+		global.Logger.Error("failed to block device token in cache", "error", err.Error(), "key", key)
 		return err
 	}
 	return nil
 }
 
+// isDeviceTokenRevoked token id bị thu hồi khi key status trong cache có giá trị "0"
+func isDeviceTokenRevoked(ctx context.Context, tokenId string) (bool, error) {
+	cache, err := domainCache.GetDistributedCache()
+	if err != nil {
+		global.Logger.Error("distributed cache not initialized", "error", err.Error())
+		return false, err
+	}
+	key := sharedCache.GetKeyStatusTokenDevice(sharedCrypto.GetHash(tokenId))
+	status, err := cache.Get(ctx, key)
+	if err != nil {
+		global.Logger.Error("failed to get device token status from cache", "error", err.Error(), "key", key)
+		return false, err
+	}
+	return status == "0", nil
+}
+
 // BlockTokenUser implements service.ITokenService.
 func (t *TokenService) BlockTokenUser(ctx context.Context, input model.BlockTokenUserInput) error {
 	//
@@ -505,6 +546,34 @@ func (t *TokenService) RefreshTokenUser(ctx context.Context, input model.Refresh
 	}, nil
 }
 
+// GetJwks implements service.ITokenService.
+func (t *TokenService) GetJwks(ctx context.Context) (*model.JwksOutput, error) {
+	tokenService := domainToken.GetTokenService()
+	if tokenService == nil {
+		global.Logger.Error("token service not initialized")
+		return nil, errors.New("internal error")
+	}
+	keys, err := tokenService.GetJwks(ctx)
+	if err != nil {
+		global.Logger.Error("failed to get jwks", "error", err.Error())
+		return nil, err
+	}
+	output := &model.JwksOutput{Keys: make([]model.JsonWebKeyOutput, 0, len(keys))}
+	for _, key := range keys {
+		output.Keys = append(output.Keys, model.JsonWebKeyOutput{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+	return output, nil
+}
+
 // New token service and impl
 func NewTokenService() appService.ITokenService {
 	return &TokenService{}
@@ -1 +1,714 @@
-package impl
\ No newline at end of file
+package impl
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/errors"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/model"
+	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/service"
+	constants "github.com/youknow2509/cio_verify_face/server/service_auth/internal/constants"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/model"
+	domainRepository "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/repository"
+	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/global"
+	utilsCache "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/cache"
+	utilsCrypto "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/crypto"
+	utilsTotp "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/totp"
+	utilsUuid "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/uuid"
+)
+
+// Ký tự recovery code, bỏ các ký tự dễ nhầm (0/o, 1/l/i)
+const recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+
+// twoFactorChallenge dữ liệu challenge đăng nhập 2FA lưu trong cache
+type twoFactorChallenge struct {
+	UserId    string `json:"user_id"`
+	CompanyId string `json:"company_id"`
+	Setup     bool   `json:"setup"` // Chưa đăng ký 2FA, phải enroll bằng challenge trước khi xác thực
+}
+
+/**
+ * Define TwoFactorAuthService struct implementing
+ */
+type TwoFactorAuthService struct {
+	core *CoreAuthService
+}
+
+// GetStatus implements service.ITwoFactorAuthService.
+func (t *TwoFactorAuthService) GetStatus(ctx context.Context, input *applicationModel.TwoFactorEnrollInput) (*applicationModel.TwoFactorStatusOutput, *errors.Error) {
+	if !isTwoFactorRole(input.Role) {
+		return nil, errors.GetError(errors.AuthDontHavePermissionErrorCode)
+	}
+	twoFactor, errApp := t.getUserTwoFactor(ctx, input.UserId)
+	if errApp != nil {
+		return nil, errApp
+	}
+	required, errApp := t.requiredForUser(ctx, input.UserId, input.Role)
+	if errApp != nil {
+		return nil, errApp
+	}
+	output := &applicationModel.TwoFactorStatusOutput{
+		Required: required,
+	}
+	if twoFactor != nil && twoFactor.IsEnabled {
+		output.Enabled = true
+		output.RecoveryCodesLeft = twoFactor.RecoveryCodesLeft
+		if twoFactor.EnabledAt != nil {
+			output.EnabledAt = twoFactor.EnabledAt.Unix()
+		}
+	}
+	return output, nil
+}
+
+// Enroll implements service.ITwoFactorAuthService.
+func (t *TwoFactorAuthService) Enroll(ctx context.Context, input *applicationModel.TwoFactorEnrollInput) (*applicationModel.TwoFactorEnrollOutput, *errors.Error) {
+	if !isTwoFactorRole(input.Role) {
+		return nil, errors.GetError(errors.AuthDontHavePermissionErrorCode)
+	}
+	return t.enroll(ctx, input.UserId)
+}
+
+// Verify implements service.ITwoFactorAuthService.
+func (t *TwoFactorAuthService) Verify(ctx context.Context, input *applicationModel.TwoFactorCodeInput) (*applicationModel.TwoFactorRecoveryCodesOutput, *errors.Error) {
+	if !isTwoFactorRole(input.Role) {
+		return nil, errors.GetError(errors.AuthDontHavePermissionErrorCode)
+	}
+	twoFactor, errApp := t.getUserTwoFactor(ctx, input.UserId)
+	if errApp != nil {
+		return nil, errApp
+	}
+	if twoFactor == nil {
+		return nil, errors.GetError(errors.AuthTwoFactorNotEnabledErrorCode)
+	}
+	if twoFactor.IsEnabled {
+		return nil, errors.GetError(errors.AuthTwoFactorAlreadyEnabledErrorCode)
+	}
+	recoveryCodes, errApp := t.enable(ctx, twoFactor, input.Code, input.ClientIp, input.UserAgent)
+	if errApp != nil {
+		return nil, errApp
+	}
+	return &applicationModel.TwoFactorRecoveryCodesOutput{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// Disable implements service.ITwoFactorAuthService.
+func (t *TwoFactorAuthService) Disable(ctx context.Context, input *applicationModel.TwoFactorCodeInput) *errors.Error {
+	if !isTwoFactorRole(input.Role) {
+		return errors.GetError(errors.AuthDontHavePermissionErrorCode)
+	}
+	twoFactor, errApp := t.getUserTwoFactor(ctx, input.UserId)
+	if errApp != nil {
+		return errApp
+	}
+	if twoFactor == nil || !twoFactor.IsEnabled {
+		return errors.GetError(errors.AuthTwoFactorNotEnabledErrorCode)
+	}
+	required, errApp := t.requiredForUser(ctx, input.UserId, input.Role)
+	if errApp != nil {
+		return errApp
+	}
+	if required {
+		return errors.GetError(errors.AuthTwoFactorRequiredByPolicyErrorCode)
+	}
+	match, err := t.verifyCode(ctx, twoFactor, input.Code, input.ClientIp, input.UserAgent)
+	if err != nil {
+		global.Logger.Error("Error verifying two factor code: ", err)
+		return errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if !match {
+		return errors.GetError(errors.AuthTwoFactorCodeInvalidErrorCode)
+	}
+	twoFactorRepo, err := domainRepository.GetTwoFactorRepository()
+	if err != nil {
+		global.Logger.Error("Error getting two factor repository: ", err)
+		return errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if err := twoFactorRepo.DeleteUserTwoFactor(ctx, input.UserId); err != nil {
+		global.Logger.Error("Error deleting user two factor: ", err)
+		return errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	t.addTwoFactorAuditLog(
+		ctx,
+		input.UserId,
+		constants.AuditActionDisableTwoFactor,
+		map[string]interface{}{"two_factor_enabled": true},
+		map[string]interface{}{"two_factor_enabled": false},
+		input.ClientIp,
+		input.UserAgent,
+	)
+	return nil
+}
+
+// RegenerateRecoveryCodes implements service.ITwoFactorAuthService.
+func (t *TwoFactorAuthService) RegenerateRecoveryCodes(ctx context.Context, input *applicationModel.TwoFactorCodeInput) (*applicationModel.TwoFactorRecoveryCodesOutput, *errors.Error) {
+	if !isTwoFactorRole(input.Role) {
+		return nil, errors.GetError(errors.AuthDontHavePermissionErrorCode)
+	}
+	twoFactor, errApp := t.getUserTwoFactor(ctx, input.UserId)
+	if errApp != nil {
+		return nil, errApp
+	}
+	if twoFactor == nil || !twoFactor.IsEnabled {
+		return nil, errors.GetError(errors.AuthTwoFactorNotEnabledErrorCode)
+	}
+	match, err := t.verifyCode(ctx, twoFactor, input.Code, input.ClientIp, input.UserAgent)
+	if err != nil {
+		global.Logger.Error("Error verifying two factor code: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if !match {
+		return nil, errors.GetError(errors.AuthTwoFactorCodeInvalidErrorCode)
+	}
+	recoveryCodes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		global.Logger.Error("Error generating recovery codes: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	twoFactorRepo, err := domainRepository.GetTwoFactorRepository()
+	if err != nil {
+		global.Logger.Error("Error getting two factor repository: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if err := twoFactorRepo.UpdateTwoFactorRecoveryCodes(
+		ctx,
+		&domainModel.UpdateTwoFactorRecoveryCodesInput{
+			UserID:             input.UserId,
+			RecoveryCodeHashes: hashes,
+		},
+	); err != nil {
+		global.Logger.Error("Error updating recovery codes: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	t.addTwoFactorAuditLog(
+		ctx,
+		input.UserId,
+		constants.AuditActionResetRecoveryCodes,
+		map[string]interface{}{"recovery_codes_left": twoFactor.RecoveryCodesLeft},
+		map[string]interface{}{"recovery_codes_left": len(hashes)},
+		input.ClientIp,
+		input.UserAgent,
+	)
+	return &applicationModel.TwoFactorRecoveryCodesOutput{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// LoginEnroll implements service.ITwoFactorAuthService.
+func (t *TwoFactorAuthService) LoginEnroll(ctx context.Context, input *applicationModel.LoginTwoFactorEnrollInput) (*applicationModel.TwoFactorEnrollOutput, *errors.Error) {
+	challenge, errApp := t.getChallenge(ctx, input.ChallengeToken)
+	if errApp != nil {
+		return nil, errApp
+	}
+	if challenge == nil || !challenge.Setup {
+		return nil, errors.GetError(errors.AuthTwoFactorChallengeInvalidErrorCode)
+	}
+	userUuid, err := utilsUuid.ParseUUID(challenge.UserId)
+	if err != nil {
+		return nil, errors.GetError(errors.AuthTwoFactorChallengeInvalidErrorCode)
+	}
+	return t.enroll(ctx, userUuid)
+}
+
+// LoginVerify implements service.ITwoFactorAuthService.
+func (t *TwoFactorAuthService) LoginVerify(ctx context.Context, input *applicationModel.LoginTwoFactorInput) (*applicationModel.LoginOutput, *errors.Error) {
+	challenge, errApp := t.getChallenge(ctx, input.ChallengeToken)
+	if errApp != nil {
+		return nil, errApp
+	}
+	if challenge == nil {
+		return nil, errors.GetError(errors.AuthTwoFactorChallengeInvalidErrorCode)
+	}
+	userUuid, err := utilsUuid.ParseUUID(challenge.UserId)
+	if err != nil {
+		return nil, errors.GetError(errors.AuthTwoFactorChallengeInvalidErrorCode)
+	}
+	// Tài khoản có thể bị khóa trong lúc challenge còn hạn
+	userRepo, err := domainRepository.GetUserRepository()
+	if err != nil {
+		global.Logger.Error("Error getting user repository: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	user, err := userRepo.GetUserBaseByID(ctx, userUuid)
+	if err != nil {
+		global.Logger.Error("Error getting user by id: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if user == nil {
+		t.deleteChallenge(ctx, input.ChallengeToken)
+		return nil, errors.GetError(errors.AuthTwoFactorChallengeInvalidErrorCode)
+	}
+	if isUserLocked(user, time.Now()) {
+		t.deleteChallenge(ctx, input.ChallengeToken)
+		return nil, errors.GetError(errors.UserBlockedErrorCode)
+	}
+	twoFactor, errApp := t.getUserTwoFactor(ctx, userUuid)
+	if errApp != nil {
+		return nil, errApp
+	}
+	var recoveryCodes []string
+	switch {
+	case twoFactor != nil && twoFactor.IsEnabled:
+		match, err := t.verifyCode(ctx, twoFactor, input.Code, input.ClientIp, input.UserAgent)
+		if err != nil {
+			global.Logger.Error("Error verifying two factor code: ", err)
+			return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+		}
+		if !match {
+			return nil, t.loginVerifyFail(ctx, input, user)
+		}
+	case twoFactor != nil && challenge.Setup:
+		// Mã đầu tiên sau khi enroll bằng challenge, bật 2FA và trả recovery code cùng token
+		recoveryCodes, errApp = t.enable(ctx, twoFactor, input.Code, input.ClientIp, input.UserAgent)
+		if errApp != nil {
+			if errApp.Code == errors.AuthTwoFactorCodeInvalidErrorCode {
+				return nil, t.loginVerifyFail(ctx, input, user)
+			}
+			return nil, errApp
+		}
+	case challenge.Setup:
+		return nil, errors.GetError(errors.AuthTwoFactorNotEnabledErrorCode)
+	default:
+		// 2FA bị tắt sau khi tạo challenge, phải đăng nhập lại
+		t.deleteChallenge(ctx, input.ChallengeToken)
+		return nil, errors.GetError(errors.AuthTwoFactorChallengeInvalidErrorCode)
+	}
+	t.deleteChallenge(ctx, input.ChallengeToken)
+	output, errApp := t.core.createAdminSession(ctx, user.UserID, challenge.CompanyId, input.ClientIp, input.UserAgent)
+	if errApp != nil {
+		return nil, errApp
+	}
+	output.RecoveryCodes = recoveryCodes
+	return output, nil
+}
+
+// loginVerifyFail mã sai được tính vào bộ đếm đăng nhập sai, challenge bị hủy khi sai quá số lần cho phép
+func (t *TwoFactorAuthService) loginVerifyFail(ctx context.Context, input *applicationModel.LoginTwoFactorInput, user *domainModel.UserBaseInfoOutput) *errors.Error {
+	if t.core.recordLoginFail(ctx, user, input.ClientIp, input.UserAgent) {
+		t.deleteChallenge(ctx, input.ChallengeToken)
+		return errors.GetError(errors.UserBlockedErrorCode)
+	}
+	count := t.core.incrementLoginFailCounter(
+		ctx,
+		utilsCache.GetKeyCountTwoFactorChallengeFail(utilsCrypto.GetHash(input.ChallengeToken)),
+		constants.TTL_TWO_FACTOR_CHALLENGE,
+	)
+	if count >= constants.RateLimitTwoFactorChallengeFail {
+		t.deleteChallenge(ctx, input.ChallengeToken)
+		return errors.GetError(errors.AuthLoginTooManyAttemptsErrorCode)
+	}
+	return errors.GetError(errors.AuthTwoFactorCodeInvalidErrorCode)
+}
+
+// enroll tạo secret mới, 2FA chỉ được bật sau khi xác thực mã đầu tiên
+func (t *TwoFactorAuthService) enroll(ctx context.Context, userId uuid.UUID) (*applicationModel.TwoFactorEnrollOutput, *errors.Error) {
+	twoFactor, errApp := t.getUserTwoFactor(ctx, userId)
+	if errApp != nil {
+		return nil, errApp
+	}
+	if twoFactor != nil && twoFactor.IsEnabled {
+		return nil, errors.GetError(errors.AuthTwoFactorAlreadyEnabledErrorCode)
+	}
+	userRepo, err := domainRepository.GetUserRepository()
+	if err != nil {
+		global.Logger.Error("Error getting user repository: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	user, err := userRepo.GetUserBaseByID(ctx, userId)
+	if err != nil {
+		global.Logger.Error("Error getting user by id: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if user == nil {
+		return nil, errors.GetError(errors.UserNotFoundErrorCode)
+	}
+	secret, err := utilsTotp.GenerateSecret()
+	if err != nil {
+		global.Logger.Error("Error generating two factor secret: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	encryptedSecret, err := utilsCrypto.EncryptAESGCM(global.SettingServer.TwoFactor.EncryptionKey, secret)
+	if err != nil {
+		global.Logger.Error("Error encrypting two factor secret: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	twoFactorRepo, err := domainRepository.GetTwoFactorRepository()
+	if err != nil {
+		global.Logger.Error("Error getting two factor repository: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if err := twoFactorRepo.UpsertUserTwoFactorSecret(
+		ctx,
+		&domainModel.UpsertUserTwoFactorSecretInput{
+			UserID: userId,
+			Secret: encryptedSecret,
+		},
+	); err != nil {
+		global.Logger.Error("Error saving two factor secret: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	return &applicationModel.TwoFactorEnrollOutput{
+		Secret:     secret,
+		OtpauthUri: utilsTotp.URI(global.SettingServer.TwoFactor.Issuer, user.UserEmail, secret),
+	}, nil
+}
+
+// enable xác thực mã đầu tiên, bật 2FA và trả về recovery code (chỉ hiển thị một lần)
+func (t *TwoFactorAuthService) enable(ctx context.Context, twoFactor *domainModel.UserTwoFactorOutput, code string, clientIp string, userAgent string) ([]string, *errors.Error) {
+	secret, err := utilsCrypto.DecryptAESGCM(global.SettingServer.TwoFactor.EncryptionKey, twoFactor.Secret)
+	if err != nil {
+		global.Logger.Error("Error decrypting two factor secret: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	step, ok := utilsTotp.Validate(secret, code, time.Now(), constants.TWO_FACTOR_SKEW_STEPS)
+	if !ok {
+		return nil, errors.GetError(errors.AuthTwoFactorCodeInvalidErrorCode)
+	}
+	recoveryCodes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		global.Logger.Error("Error generating recovery codes: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	twoFactorRepo, err := domainRepository.GetTwoFactorRepository()
+	if err != nil {
+		global.Logger.Error("Error getting two factor repository: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	enabled, err := twoFactorRepo.EnableUserTwoFactor(
+		ctx,
+		&domainModel.EnableUserTwoFactorInput{
+			UserID:             twoFactor.UserID,
+			LastUsedStep:       step,
+			RecoveryCodeHashes: hashes,
+		},
+	)
+	if err != nil {
+		global.Logger.Error("Error enabling two factor: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if !enabled {
+		return nil, errors.GetError(errors.AuthTwoFactorAlreadyEnabledErrorCode)
+	}
+	t.addTwoFactorAuditLog(
+		ctx,
+		twoFactor.UserID,
+		constants.AuditActionEnableTwoFactor,
+		map[string]interface{}{"two_factor_enabled": false},
+		map[string]interface{}{"two_factor_enabled": true},
+		clientIp,
+		userAgent,
+	)
+	return recoveryCodes, nil
+}
+
+// verifyCode kiểm tra mã TOTP (không cho dùng lại time step đã dùng) hoặc recovery code (mỗi mã dùng một lần)
+func (t *TwoFactorAuthService) verifyCode(ctx context.Context, twoFactor *domainModel.UserTwoFactorOutput, code string, clientIp string, userAgent string) (bool, error) {
+	twoFactorRepo, err := domainRepository.GetTwoFactorRepository()
+	if err != nil {
+		return false, err
+	}
+	code = strings.TrimSpace(code)
+	if isTotpCode(code) {
+		secret, err := utilsCrypto.DecryptAESGCM(global.SettingServer.TwoFactor.EncryptionKey, twoFactor.Secret)
+		if err != nil {
+			return false, err
+		}
+		step, ok := utilsTotp.Validate(secret, code, time.Now(), constants.TWO_FACTOR_SKEW_STEPS)
+		if !ok || step <= twoFactor.LastUsedStep {
+			return false, nil
+		}
+		return twoFactorRepo.UpdateTwoFactorLastUsedStep(
+			ctx,
+			&domainModel.UpdateTwoFactorLastUsedStepInput{
+				UserID:       twoFactor.UserID,
+				LastUsedStep: step,
+			},
+		)
+	}
+	used, err := twoFactorRepo.UseTwoFactorRecoveryCode(
+		ctx,
+		&domainModel.UseTwoFactorRecoveryCodeInput{
+			UserID:   twoFactor.UserID,
+			CodeHash: utilsCrypto.GetHash(normalizeRecoveryCode(code)),
+		},
+	)
+	if err != nil || !used {
+		return false, err
+	}
+	t.addTwoFactorAuditLog(
+		ctx,
+		twoFactor.UserID,
+		constants.AuditActionUseRecoveryCode,
+		map[string]interface{}{"recovery_codes_left": twoFactor.RecoveryCodesLeft},
+		map[string]interface{}{"recovery_codes_left": twoFactor.RecoveryCodesLeft - 1},
+		clientIp,
+		userAgent,
+	)
+	return true, nil
+}
+
+// addTwoFactorAuditLog ghi audit log bật/tắt 2FA và dùng/tạo lại recovery code, user tự thao tác trên 2FA của mình, lỗi chỉ ghi log
+func (t *TwoFactorAuthService) addTwoFactorAuditLog(
+	ctx context.Context,
+	userId uuid.UUID,
+	action string,
+	oldValues map[string]interface{},
+	newValues map[string]interface{},
+	clientIp string,
+	userAgent string,
+) {
+	auditRepo, err := domainRepository.GetAuditRepository()
+	if err != nil {
+		global.Logger.Error("Error getting audit repository: ", err)
+		return
+	}
+	if err := auditRepo.AddAuditLog(
+		ctx,
+		&domainModel.AuditLog{
+			UserId:       userId,
+			Action:       action,
+			ResourceType: constants.AuditResourceTypeUserTwoFactor,
+			ResourceId:   userId,
+			OldValues:    oldValues,
+			NewValues:    newValues,
+			IpAddress:    clientIp,
+			UserAgent:    userAgent,
+			Timestamp:    time.Now().Unix(),
+		},
+	); err != nil {
+		global.Logger.Error("Error logging two factor audit log: ", err)
+	}
+}
+
+// getUserTwoFactor lấy cấu hình 2FA của user, nil khi chưa enroll
+func (t *TwoFactorAuthService) getUserTwoFactor(ctx context.Context, userId uuid.UUID) (*domainModel.UserTwoFactorOutput, *errors.Error) {
+	twoFactorRepo, err := domainRepository.GetTwoFactorRepository()
+	if err != nil {
+		global.Logger.Error("Error getting two factor repository: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	twoFactor, err := twoFactorRepo.GetUserTwoFactor(ctx, userId)
+	if err != nil {
+		global.Logger.Error("Error getting user two factor: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	return twoFactor, nil
+}
+
+// requiredForUser kiểm tra chính sách 2FA theo công ty của user
+func (t *TwoFactorAuthService) requiredForUser(ctx context.Context, userId uuid.UUID, role int) (bool, *errors.Error) {
+	companyRepo, err := domainRepository.GetCompanyRepository()
+	if err != nil {
+		global.Logger.Error("Error getting company repository: ", err)
+		return false, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	companyReps, err := companyRepo.GetCompanyUser(ctx, &domainModel.GetCompanyUserInput{UserID: userId})
+	if err != nil {
+		global.Logger.Error("Error getting company user: ", err)
+		return false, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	required, err := twoFactorRequired(ctx, role, companyReps)
+	if err != nil {
+		global.Logger.Error("Error getting two factor policy: ", err)
+		return false, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	return required, nil
+}
+
+// getChallenge đọc challenge đăng nhập, nil khi không tồn tại hoặc hết hạn
+func (t *TwoFactorAuthService) getChallenge(ctx context.Context, token string) (*twoFactorChallenge, *errors.Error) {
+	if token == "" {
+		return nil, nil
+	}
+	if err := t.core.initCacheStrategy(); err != nil {
+		global.Logger.Error("Error initializing cache strategy: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	value, err := t.core.cacheStrategy.distributedCache.Get(ctx, utilsCache.GetKeyTwoFactorChallenge(utilsCrypto.GetHash(token)))
+	if err != nil {
+		global.Logger.Error("Error getting two factor challenge: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if value == "" {
+		return nil, nil
+	}
+	var challenge twoFactorChallenge
+	if err := json.Unmarshal([]byte(value), &challenge); err != nil {
+		global.Logger.Warn("Invalid two factor challenge in cache: ", err)
+		return nil, nil
+	}
+	return &challenge, nil
+}
+
+// deleteChallenge hủy challenge, challenge chỉ dùng được một lần
+func (t *TwoFactorAuthService) deleteChallenge(ctx context.Context, token string) {
+	if err := t.core.initCacheStrategy(); err != nil {
+		global.Logger.Error("Error initializing cache strategy: ", err)
+		return
+	}
+	tokenHash := utilsCrypto.GetHash(token)
+	t.core.cacheStrategy.Delete(ctx, utilsCache.GetKeyTwoFactorChallenge(tokenHash))
+	t.core.cacheStrategy.Delete(ctx, utilsCache.GetKeyCountTwoFactorChallengeFail(tokenHash))
+}
+
+// startTwoFactorChallenge tạo challenge khi user đã bật 2FA hoặc chính sách bắt buộc 2FA, nil khi không cần 2FA
+func (c *CoreAuthService) startTwoFactorChallenge(ctx context.Context, user *domainModel.UserBaseInfoOutput, companyReps *domainModel.GetCompanyUserOutput, companyId string) (*applicationModel.LoginOutput, *errors.Error) {
+	userUuid, err := utilsUuid.ParseUUID(user.UserID)
+	if err != nil {
+		global.Logger.Error("Error parsing user id: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	twoFactorRepo, err := domainRepository.GetTwoFactorRepository()
+	if err != nil {
+		global.Logger.Error("Error getting two factor repository: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	twoFactor, err := twoFactorRepo.GetUserTwoFactor(ctx, userUuid)
+	if err != nil {
+		global.Logger.Error("Error getting user two factor: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	enabled := twoFactor != nil && twoFactor.IsEnabled
+	if !enabled {
+		required, err := twoFactorRequired(ctx, user.Role, companyReps)
+		if err != nil {
+			global.Logger.Error("Error getting two factor policy: ", err)
+			return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+		}
+		if !required {
+			return nil, nil
+		}
+	}
+	token, err := utilsCrypto.RandomToken(constants.RandomTokenTwoFactorChallenge)
+	if err != nil {
+		global.Logger.Error("Error generating two factor challenge: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	data, err := json.Marshal(twoFactorChallenge{
+		UserId:    user.UserID,
+		CompanyId: companyId,
+		Setup:     !enabled,
+	})
+	if err != nil {
+		global.Logger.Error("Error marshal two factor challenge: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if err := c.initCacheStrategy(); err != nil {
+		global.Logger.Error("Error initializing cache strategy: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	if err := c.cacheStrategy.distributedCache.SetTTL(
+		ctx,
+		utilsCache.GetKeyTwoFactorChallenge(utilsCrypto.GetHash(token)),
+		string(data),
+		constants.TTL_TWO_FACTOR_CHALLENGE,
+	); err != nil {
+		global.Logger.Error("Error saving two factor challenge: ", err)
+		return nil, errors.GetError(errors.SystemTemporaryUnavailableErrorCode)
+	}
+	return &applicationModel.LoginOutput{
+		TwoFactorRequired:      true,
+		TwoFactorSetupRequired: !enabled,
+		ChallengeToken:         token,
+		ChallengeExpireAt:      time.Now().Add(time.Duration(constants.TTL_TWO_FACTOR_CHALLENGE) * time.Second).Unix(),
+	}, nil
+}
+
+// twoFactorRequired đọc danh sách role bắt buộc 2FA (JSON, ví dụ [0, 1]) của công ty,
+// công ty không cấu hình thì dùng system setting. Giá trị sai định dạng được coi là bắt buộc.
+func twoFactorRequired(ctx context.Context, role int, companyReps *domainModel.GetCompanyUserOutput) (bool, error) {
+	companyRepo, err := domainRepository.GetCompanyRepository()
+	if err != nil {
+		return false, err
+	}
+	var value *string
+	if companyReps != nil {
+		value, err = companyRepo.GetCompanySetting(ctx, &domainModel.GetCompanySettingInput{
+			CompanyID:  companyReps.CompanyID,
+			SettingKey: constants.TWO_FACTOR_REQUIRED_ROLES_SETTING,
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+	if value == nil {
+		value, err = companyRepo.GetSystemSetting(ctx, constants.TWO_FACTOR_REQUIRED_ROLES_SETTING)
+		if err != nil {
+			return false, err
+		}
+	}
+	if value == nil {
+		return false, nil
+	}
+	var roles []int
+	if err := json.Unmarshal([]byte(*value), &roles); err != nil {
+		global.Logger.Error("Invalid two factor policy, require two factor: ", err)
+		return true, nil
+	}
+	for _, r := range roles {
+		if r == role {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isTwoFactorRole 2FA dành cho SYSTEM_ADMIN và COMPANY_ADMIN
+func isTwoFactorRole(role int) bool {
+	return role == domainModel.RoleAdmin || role == domainModel.RoleManager
+}
+
+// isTotpCode mã TOTP gồm đúng Digits chữ số, còn lại coi là recovery code
+func isTotpCode(code string) bool {
+	if len(code) != utilsTotp.Digits {
+		return false
+	}
+	for _, ch := range code {
+		if ch < '0' || ch > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeRecoveryCode bỏ dấu gạch, khoảng trắng và chữ hoa
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
+
+// generateRecoveryCodes tạo recovery code dạng xxxxx-xxxxx và hash để lưu DB
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, constants.TWO_FACTOR_RECOVERY_CODES)
+	hashes := make([]string, 0, constants.TWO_FACTOR_RECOVERY_CODES)
+	max := big.NewInt(int64(len(recoveryCodeAlphabet)))
+	for i := 0; i < constants.TWO_FACTOR_RECOVERY_CODES; i++ {
+		raw := make([]byte, constants.RandomRecoveryCodeLength)
+		for j := range raw {
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return nil, nil, err
+			}
+			raw[j] = recoveryCodeAlphabet[n.Int64()]
+		}
+		half := len(raw) / 2
+		codes = append(codes, string(raw[:half])+"-"+string(raw[half:]))
+		hashes = append(hashes, utilsCrypto.GetHash(string(raw)))
+	}
+	return codes, hashes, nil
+}
+
+/**
+ * NewTwoFactorAuthService creates a new instance of TwoFactorAuthService
+ */
+func NewTwoFactorAuthService() service.ITwoFactorAuthService {
+	return &TwoFactorAuthService{
+		core: &CoreAuthService{},
+	}
+}
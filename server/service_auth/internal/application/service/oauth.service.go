@@ -1,8 +1,11 @@
 package service
 
 import (
+	"context"
 	"errors"
-	// errorService "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/errors"
+
+	errorService "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/errors"
+	model "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/model"
 )
 
 // =======================================================
@@ -13,6 +16,16 @@ import (
 type (
 	// OAuth & Social Login
 	IOAuthService interface {
+		// Get OIDC config of company
+		GetOidcConfig(ctx context.Context, input *model.OidcConfigInput) (*model.OidcConfigOutput, *errorService.Error)
+		// Create or update OIDC config of company
+		UpsertOidcConfig(ctx context.Context, input *model.UpsertOidcConfigInput) (*model.OidcConfigOutput, *errorService.Error)
+		// Delete OIDC config of company
+		DeleteOidcConfig(ctx context.Context, input *model.OidcConfigInput) *errorService.Error
+		// Start SSO login, return authorization url of IdP
+		OidcAuthorize(ctx context.Context, input *model.OidcAuthorizeInput) (*model.OidcAuthorizeOutput, *errorService.Error)
+		// Finish SSO login with code and state from IdP, return token pair as Login
+		OidcCallback(ctx context.Context, input *model.OidcCallbackInput) (*model.LoginOutput, *errorService.Error)
 	}
 )
 
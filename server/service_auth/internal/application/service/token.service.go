@@ -23,6 +23,9 @@ type ITokenService interface {
 	BlockTokenDevice(ctx context.Context, input model.BlockTokenDeviceInput) error
 	CheckTokenDevice(ctx context.Context, input model.CheckTokenDeviceInput) (bool, string, error)
 	ParseTokenDevice(ctx context.Context, input model.ParseTokenDeviceInput) (*model.ParseTokenDeviceOutput, error)
+
+	// Public keys operations
+	GetJwks(ctx context.Context) (*model.JwksOutput, error)
 }
 
 /**
@@ -1,8 +1,11 @@
 package service
 
 import (
+	"context"
 	"errors"
-	// errorService "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/errors"
+
+	errorService "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/errors"
+	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/model"
 )
 
 // =======================================================
@@ -13,6 +16,20 @@ import (
 type (
 	// Two-Factor Authentication
 	ITwoFactorAuthService interface {
+		// Get two factor status
+		GetStatus(ctx context.Context, input *model.TwoFactorEnrollInput) (*model.TwoFactorStatusOutput, *errorService.Error)
+		// Enroll, generate secret and otpauth uri
+		Enroll(ctx context.Context, input *model.TwoFactorEnrollInput) (*model.TwoFactorEnrollOutput, *errorService.Error)
+		// Verify first code, enable two factor and return recovery codes
+		Verify(ctx context.Context, input *model.TwoFactorCodeInput) (*model.TwoFactorRecoveryCodesOutput, *errorService.Error)
+		// Disable two factor
+		Disable(ctx context.Context, input *model.TwoFactorCodeInput) *errorService.Error
+		// Regenerate recovery codes
+		RegenerateRecoveryCodes(ctx context.Context, input *model.TwoFactorCodeInput) (*model.TwoFactorRecoveryCodesOutput, *errorService.Error)
+		// Enroll with login challenge, when policy require two factor but user not enroll
+		LoginEnroll(ctx context.Context, input *model.LoginTwoFactorEnrollInput) (*model.TwoFactorEnrollOutput, *errorService.Error)
+		// Exchange login challenge and code for token pair
+		LoginVerify(ctx context.Context, input *model.LoginTwoFactorInput) (*model.LoginOutput, *errorService.Error)
 	}
 )
 
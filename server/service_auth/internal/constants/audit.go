@@ -7,4 +7,15 @@ const (
 	AuditActionUpdateSessionDevice = "update_session_device"
 	AuditActionDeleteSessionDevice = "delete_session_device"
 	AuditResourceTypeDevice        = "device"
+	AuditActionLockUser            = "lock_user"
+	AuditActionUnlockUser          = "unlock_user"
+	AuditResourceTypeUser          = "user"
+	AuditActionEnableTwoFactor     = "enable_two_factor"
+	AuditActionDisableTwoFactor    = "disable_two_factor"
+	AuditActionResetRecoveryCodes  = "reset_two_factor_recovery_codes"
+	AuditActionUseRecoveryCode     = "use_two_factor_recovery_code"
+	AuditResourceTypeUserTwoFactor = "user_two_factor"
+	AuditActionUpdateOidcConfig    = "update_oidc_config"
+	AuditActionDeleteOidcConfig    = "delete_oidc_config"
+	AuditResourceTypeCompany       = "company"
 )
@@ -27,6 +27,13 @@ const (
 	DEFAULT_AVATAR_USER = "https://avatars.githubusercontent.com/u/88392742?v=4"
 )
 
+// Two factor authentication
+const (
+	TWO_FACTOR_SKEW_STEPS             = 1  // Chấp nhận lệch ±1 chu kỳ 30 giây
+	TWO_FACTOR_RECOVERY_CODES         = 10 // Số recovery code mỗi lần tạo
+	TWO_FACTOR_REQUIRED_ROLES_SETTING = "two_factor_required_roles"
+)
+
 const (
 	DeviceTypeWeb     = 1
 	DeviceTypeMobile  = 2
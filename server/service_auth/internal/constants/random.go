@@ -6,4 +6,9 @@ package constants
 const (
 	RandomTokenResetPasswordLength = 32
 	RandomTokenDeviceLength        = 64
+	RandomTokenTwoFactorChallenge  = 32
+	RandomRecoveryCodeLength       = 10 // Ký tự, hiển thị dạng xxxxx-xxxxx
+	RandomTokenOidcState           = 32
+	RandomTokenOidcNonce           = 32
+	RandomTokenOidcCodeVerifier    = 32 // 64 ký tự hex, PKCE yêu cầu 43-128 ký tự
 )
\ No newline at end of file
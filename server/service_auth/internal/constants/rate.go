@@ -7,6 +7,12 @@ const (
 	RateLimitVerifyOTPRegisterFail = 5 // 5 requests per minute
 	// Rate limit spam reset password send mail
 	RateLimitResetPasswordSendMail = 5 // 5 requests per minute
+	// Số lần đăng nhập sai tối đa của một tài khoản trước khi bị khóa
+	RateLimitLoginFailPerAccount = 5
+	// Số lần đăng nhập sai tối đa của một IP trước khi bị chặn
+	RateLimitLoginFailPerIp = 20
+	// Số lần nhập sai mã 2FA tối đa của một challenge
+	RateLimitTwoFactorChallengeFail = 5
 )
 
 const (
@@ -33,6 +33,8 @@ const (
 	// Additional cache TTLs
 	TTL_USER_PERMISSION = 60 * 10 // 10 minutes
 	TTL_DEVICE_CHECK    = 60 * 5  // 5 minutes
+
+	TTL_JWKS_CACHE = 60 * 5 // 5 minutes, service khác cache public key trong khoảng này
 )
 
 // For spam and count spam
@@ -59,5 +61,15 @@ const (
 	TTL_BLOCK_SPAM_VERIFY_RESET_PW_TK       = 60 * 60 * 12 // 12 hours
 	TTL_LOCAL_BLOCK_SPAM_VERIFY_RESET_PW_TK = 60 * 10      // 10 minutes
 
+	TTL_COUNT_LOGIN_FAIL    = 60 * 15      // 15 minutes
+	TTL_COUNT_LOGIN_FAIL_IP = 60 * 15      // 15 minutes
+	TTL_LOGIN_LOCK_LEVEL    = 60 * 60 * 24 // 24 hours, lần khóa tiếp theo trong khoảng này bị tăng thời gian khóa
+	TTL_LOGIN_LOCK_BASE     = 60 * 5       // 5 minutes, thời gian khóa lần đầu, nhân đôi mỗi lần khóa tiếp theo
+	TTL_LOGIN_LOCK_MAX      = 60 * 60 * 24 // 24 hours
+
+	TTL_TWO_FACTOR_CHALLENGE = 60 * 5 // 5 minutes
+
+	TTL_OIDC_STATE = 60 * 10 // 10 minutes, thời gian user đăng nhập tại IdP
+
 	// v.v
 )
@@ -22,6 +22,9 @@ type (
 		Logstash          LogstashSetting      `mapstructure:"logstash"`
 		SMTP              SMTPSetting          `mapstructure:"smtp"`
 		JWT               JWTSetting           `mapstructure:"jwt"`
+		Password          PasswordSetting      `mapstructure:"password"`
+		TwoFactor         TwoFactorSetting     `mapstructure:"two_factor"`
+		Oidc              OidcSetting          `mapstructure:"oidc"`
 		Logger            LoggerSetting        `mapstructure:"logger"`
 		RateLimitPolicies []RateLimitPolicy    `mapstructure:"policy_rate_limit"`
 		Observability     ObservabilitySetting `mapstructure:"observability"`
@@ -312,10 +315,50 @@ type SMTPSetting struct {
 
 // jwt
 type JWTSetting struct {
-	Secret   string   `mapstructure:"secret"`
-	Issuer   string   `mapstructure:"issuer"`
-	Subject  string   `mapstructure:"subject"`
-	Audience []string `mapstructure:"audience"`
+	Secret            string          `mapstructure:"secret"`       // HS256 cũ, chỉ dùng xác thực token phát hành trước khi chuyển sang khóa bất đối xứng
+	SecretUntil       string          `mapstructure:"secret_until"` // Bắt buộc khi có secret (RFC 3339 hoặc 2006-01-02), sau thời điểm này token HS256 bị từ chối
+	Issuer            string          `mapstructure:"issuer"`
+	Subject           string          `mapstructure:"subject"`
+	Audience          []string        `mapstructure:"audience"`
+	ActiveKid         string          `mapstructure:"active_kid"`          // Khóa dùng để ký, rỗng thì dùng khóa đầu tiên
+	Keys              []JWTKeySetting `mapstructure:"keys"`                // Tất cả khóa đều dùng để xác thực và được công bố qua JWKS
+	AllowEphemeralKey bool            `mapstructure:"allow_ephemeral_key"` // Chỉ dùng cho dev: không cấu hình keys thì sinh khóa tạm khi khởi động
+}
+
+// jwt signing key
+type JWTKeySetting struct {
+	Kid            string `mapstructure:"kid"`
+	Algorithm      string `mapstructure:"algorithm"`        // RS256 | EdDSA
+	PrivateKeyFile string `mapstructure:"private_key_file"` // Đường dẫn file PEM (PKCS#8, hoặc PKCS#1 với RSA)
+	PrivateKey     string `mapstructure:"private_key"`      // Nội dung PEM, ưu tiên hơn private_key_file
+}
+
+// password hashing
+type PasswordSetting struct {
+	Algorithm string `mapstructure:"algorithm"` // argon2id | bcrypt, thuật toán dùng cho hash mới
+	Argon2id  struct {
+		Memory      uint32 `mapstructure:"memory"`      // KiB
+		Iterations  uint32 `mapstructure:"iterations"`  // Số vòng lặp
+		Parallelism uint8  `mapstructure:"parallelism"` // Số luồng
+		SaltLength  uint32 `mapstructure:"salt_length"` // Bytes
+		KeyLength   uint32 `mapstructure:"key_length"`  // Bytes
+	} `mapstructure:"argon2id"`
+	Bcrypt struct {
+		Cost int `mapstructure:"cost"`
+	} `mapstructure:"bcrypt"`
+}
+
+// two factor authentication (TOTP)
+type TwoFactorSetting struct {
+	Issuer        string `mapstructure:"issuer"`         // Tên hiển thị trong app authenticator
+	EncryptionKey string `mapstructure:"encryption_key"` // Khóa mã hóa TOTP secret lưu trong DB
+}
+
+// oidc
+type OidcSetting struct {
+	RedirectUri   string `mapstructure:"redirect_uri"`   // Callback url của frontend đã đăng ký với IdP
+	EncryptionKey string `mapstructure:"encryption_key"` // Khóa mã hóa client secret lưu trong DB
+	HttpTimeout   int    `mapstructure:"http_timeout"`   // Giây, timeout khi gọi IdP
 }
 
 // logger
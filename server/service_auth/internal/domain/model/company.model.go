@@ -29,3 +29,8 @@ type UpdateDeviceSessionInput struct {
 type DeleteDeviceSessionInput struct {
 	DeviceId uuid.UUID `json:"device_id"`
 }
+
+type GetCompanySettingInput struct {
+	CompanyID  uuid.UUID `json:"company_id"`
+	SettingKey string    `json:"setting_key"`
+}
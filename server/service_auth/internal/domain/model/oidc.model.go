@@ -0,0 +1,78 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ======================================
+//
+//	Company OIDC config
+//
+// ======================================
+type CompanyOidcConfigOutput struct {
+	CompanyID    uuid.UUID      `json:"company_id"`
+	Issuer       string         `json:"issuer"`
+	ClientID     string         `json:"client_id"`
+	ClientSecret string         `json:"client_secret"` // Encrypted
+	Scopes       []string       `json:"scopes"`
+	EmailClaim   string         `json:"email_claim"`
+	RoleClaim    string         `json:"role_claim"`
+	RoleMapping  map[string]int `json:"role_mapping"`
+	IsEnabled    bool           `json:"is_enabled"`
+	UpdatedAt    *time.Time     `json:"updated_at"`
+}
+
+type UpsertCompanyOidcConfigInput struct {
+	CompanyID    uuid.UUID      `json:"company_id"`
+	Issuer       string         `json:"issuer"`
+	ClientID     string         `json:"client_id"`
+	ClientSecret string         `json:"client_secret"` // Encrypted
+	Scopes       []string       `json:"scopes"`
+	EmailClaim   string         `json:"email_claim"`
+	RoleClaim    string         `json:"role_claim"`
+	RoleMapping  map[string]int `json:"role_mapping"`
+	IsEnabled    bool           `json:"is_enabled"`
+}
+
+// ======================================
+//
+//	OpenID Connect provider
+//
+// ======================================
+// Metadata from {issuer}/.well-known/openid-configuration
+type OidcProviderMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksUri               string `json:"jwks_uri"`
+}
+
+type OidcAuthorizationUrlInput struct {
+	ClientID      string   `json:"client_id"`
+	RedirectUri   string   `json:"redirect_uri"`
+	Scopes        []string `json:"scopes"`
+	State         string   `json:"state"`
+	Nonce         string   `json:"nonce"`
+	CodeChallenge string   `json:"code_challenge"` // PKCE S256
+}
+
+type OidcExchangeCodeInput struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	RedirectUri  string `json:"redirect_uri"`
+	Code         string `json:"code"`
+	CodeVerifier string `json:"code_verifier"`
+}
+
+type OidcExchangeCodeOutput struct {
+	AccessToken string `json:"access_token"`
+	IDToken     string `json:"id_token"`
+}
+
+type OidcVerifyIDTokenInput struct {
+	IDToken  string `json:"id_token"`
+	ClientID string `json:"client_id"`
+	Nonce    string `json:"nonce"`
+}
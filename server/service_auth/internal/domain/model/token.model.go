@@ -106,3 +106,22 @@ type (
 		IssuedAt  time.Time `json:"iat,omitempty"`
 	}
 )
+
+// ========================================
+//
+//	Token key model
+//
+// ========================================
+type (
+	// Public key dùng xác thực token, định dạng JWK (RFC 7517, RFC 8037)
+	TokenJsonWebKey struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		Alg string `json:"alg"`
+		N   string `json:"n,omitempty"`
+		E   string `json:"e,omitempty"`
+		Crv string `json:"crv,omitempty"`
+		X   string `json:"x,omitempty"`
+	}
+)
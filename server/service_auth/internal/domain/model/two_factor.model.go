@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// For two factor authentication
+type UserTwoFactorOutput struct {
+	UserID            uuid.UUID  `json:"user_id"`
+	Secret            string     `json:"secret"` // Secret đã mã hóa
+	IsEnabled         bool       `json:"is_enabled"`
+	LastUsedStep      int64      `json:"last_used_step"`
+	RecoveryCodesLeft int        `json:"recovery_codes_left"`
+	EnabledAt         *time.Time `json:"enabled_at"`
+}
+
+type UpsertUserTwoFactorSecretInput struct {
+	UserID uuid.UUID `json:"user_id"`
+	Secret string    `json:"secret"`
+}
+
+type EnableUserTwoFactorInput struct {
+	UserID             uuid.UUID `json:"user_id"`
+	LastUsedStep       int64     `json:"last_used_step"`
+	RecoveryCodeHashes []string  `json:"recovery_code_hashes"`
+}
+
+type UpdateTwoFactorLastUsedStepInput struct {
+	UserID       uuid.UUID `json:"user_id"`
+	LastUsedStep int64     `json:"last_used_step"`
+}
+
+type UpdateTwoFactorRecoveryCodesInput struct {
+	UserID             uuid.UUID `json:"user_id"`
+	RecoveryCodeHashes []string  `json:"recovery_code_hashes"`
+}
+
+type UseTwoFactorRecoveryCodeInput struct {
+	UserID   uuid.UUID `json:"user_id"`
+	CodeHash string    `json:"code_hash"`
+}
@@ -62,6 +62,20 @@ type (
 		ExpiredAt    time.Time `json:"expired_at"`
 	}
 
+	// UpdateUserPasswordInput
+	UpdateUserPasswordInput struct {
+		UserID       uuid.UUID `json:"user_id"`
+		Salt         string    `json:"salt"`
+		PasswordHash string    `json:"password_hash"`
+	}
+
+	// UpdateUserLockInput, LockExpiresAt nil khi khóa vĩnh viễn hoặc mở khóa
+	UpdateUserLockInput struct {
+		UserID        uuid.UUID  `json:"user_id"`
+		IsLocked      bool       `json:"is_locked"`
+		LockExpiresAt *time.Time `json:"lock_expires_at"`
+	}
+
 	// v.v
 )
 
@@ -74,8 +88,9 @@ type (
 		UserEmail    string `json:"user_email"`
 		UserSalt     string `json:"user_salt"`
 		UserPassword string `json:"user_password"`
-		IsBlocked    bool   `json:"is_blocked"`
-		Role         int    `json:"role"`
+		IsBlocked     bool       `json:"is_blocked"`
+		LockExpiresAt *time.Time `json:"lock_expires_at"`
+		Role          int        `json:"role"`
 	}
 
 	// GetRefreshSessionInfoOutput
@@ -0,0 +1,76 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/model"
+)
+
+// ========================================
+//
+//	OpenID Connect provider interface
+//
+// ========================================
+type IOidcProvider interface {
+	/**
+	 * Get provider metadata (discovery document) of issuer
+	 * @param ctx context.Context
+	 * @param issuer string - issuer url configured by company
+	 * @return *model.OidcProviderMetadata, error
+	 */
+	Discover(ctx context.Context, issuer string) (*model.OidcProviderMetadata, error)
+
+	/**
+	 * Build authorization url (authorization code flow with PKCE)
+	 * @param metadata *model.OidcProviderMetadata
+	 * @param input *model.OidcAuthorizationUrlInput
+	 * @return string
+	 */
+	AuthorizationUrl(metadata *model.OidcProviderMetadata, input *model.OidcAuthorizationUrlInput) string
+
+	/**
+	 * Exchange authorization code for tokens at token endpoint
+	 * @param ctx context.Context
+	 * @param metadata *model.OidcProviderMetadata
+	 * @param input *model.OidcExchangeCodeInput
+	 * @return *model.OidcExchangeCodeOutput, error
+	 */
+	ExchangeCode(ctx context.Context, metadata *model.OidcProviderMetadata, input *model.OidcExchangeCodeInput) (*model.OidcExchangeCodeOutput, error)
+
+	/**
+	 * Verify ID token (signature with provider JWKS, issuer, audience, expiry, nonce)
+	 * @param ctx context.Context
+	 * @param metadata *model.OidcProviderMetadata
+	 * @param input *model.OidcVerifyIDTokenInput
+	 * @return map[string]interface{}, error - claims of ID token
+	 */
+	VerifyIDToken(ctx context.Context, metadata *model.OidcProviderMetadata, input *model.OidcVerifyIDTokenInput) (map[string]interface{}, error)
+}
+
+/**
+ * Variable save interface of IOidcProvider
+ */
+var _IOidcProvider IOidcProvider
+
+// ================================================================================
+//
+//	Getter and setter for oidc provider
+//
+// ================================================================================
+// GetOidcProvider returns the current oidc provider instance.
+func GetOidcProvider() IOidcProvider {
+	return _IOidcProvider
+}
+
+// SetOidcProvider sets the oidc provider instance.
+func SetOidcProvider(provider IOidcProvider) error {
+	if provider == nil {
+		return errors.New("oidc provider cannot be nil")
+	}
+	if _IOidcProvider != nil {
+		return errors.New("oidc provider is already set, cannot be overwritten")
+	}
+	_IOidcProvider = provider
+	return nil
+}
@@ -0,0 +1,57 @@
+package password
+
+import "errors"
+
+// ========================================
+//
+//	Password hasher interface
+//
+// ========================================
+type IPasswordHasher interface {
+	/**
+	 * Hash password, result is self-describing (algorithm and cost params)
+	 * @param password string
+	 * @return string, error
+	 */
+	Hash(password string) (string, error)
+
+	/**
+	 * Verify password in constant time
+	 * @param password string
+	 * @param salt string - only used for legacy sha256(password + salt) hash
+	 * @param encoded string - stored password hash
+	 * @return bool, error
+	 */
+	Verify(password, salt, encoded string) (bool, error)
+
+	/**
+	 * Check stored hash is legacy or use outdated algorithm/cost params
+	 * @param encoded string
+	 * @return bool
+	 */
+	NeedsRehash(encoded string) bool
+}
+
+var _IPasswordHasher IPasswordHasher
+
+// ================================================================================
+//
+//	Getter and setter for password hasher
+//
+// ================================================================================
+// GetPasswordHasher returns the current password hasher instance.
+func GetPasswordHasher() IPasswordHasher {
+	return _IPasswordHasher
+}
+
+// SetPasswordHasher sets the password hasher instance.
+func SetPasswordHasher(hasher IPasswordHasher) error {
+	if hasher == nil {
+		return errors.New("password hasher cannot be nil")
+	}
+	if _IPasswordHasher != nil {
+		return errors.New("password hasher is already set, cannot be overwritten")
+	}
+	_IPasswordHasher = hasher
+	return nil
+}
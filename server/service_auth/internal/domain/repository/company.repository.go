@@ -20,6 +20,10 @@ type ICompanyRepository interface {
 	UpdateDeviceSession(ctx context.Context, data *model.UpdateDeviceSessionInput) error
 	// Delete device session
 	DeleteDeviceSession(ctx context.Context, data *model.DeleteDeviceSessionInput) error
+	// Get company setting value, nil if company not set
+	GetCompanySetting(ctx context.Context, input *model.GetCompanySettingInput) (*string, error)
+	// Get system setting value, nil if not set
+	GetSystemSetting(ctx context.Context, settingKey string) (*string, error)
 }
 
 /**
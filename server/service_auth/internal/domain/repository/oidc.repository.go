@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	model "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/model"
+)
+
+/**
+ * Interface for oidc repository
+ */
+type IOidcRepository interface {
+	// Get OIDC config of company, nil if company not configured
+	GetCompanyOidcConfig(ctx context.Context, companyID uuid.UUID) (*model.CompanyOidcConfigOutput, error)
+	// Create or replace OIDC config of company
+	UpsertCompanyOidcConfig(ctx context.Context, data *model.UpsertCompanyOidcConfigInput) error
+	// Delete OIDC config of company
+	DeleteCompanyOidcConfig(ctx context.Context, companyID uuid.UUID) error
+}
+
+/**
+ * Variable for Oidc repository instance
+ */
+var _vOidcRepository IOidcRepository
+
+/**
+ * Set the Oidc repository instance
+ */
+func SetOidcRepository(v IOidcRepository) error {
+	if _vOidcRepository != nil {
+		return errors.New("Oidc repository initialization failed, not nil")
+	}
+	_vOidcRepository = v
+	return nil
+}
+
+/**
+ * Get the Oidc repository instance
+ */
+func GetOidcRepository() (IOidcRepository, error) {
+	if _vOidcRepository == nil {
+		return nil, errors.New("Oidc repository not initialized")
+	}
+	return _vOidcRepository, nil
+}
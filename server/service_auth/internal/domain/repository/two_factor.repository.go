@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	model "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/model"
+)
+
+/**
+ * Interface for two factor repository
+ */
+type ITwoFactorRepository interface {
+	// Get two factor of user, nil if user not enroll
+	GetUserTwoFactor(ctx context.Context, userID uuid.UUID) (*model.UserTwoFactorOutput, error)
+	// Save secret when enroll, not overwrite enabled two factor
+	UpsertUserTwoFactorSecret(ctx context.Context, data *model.UpsertUserTwoFactorSecretInput) error
+	// Enable two factor after verify first code, false if already enabled
+	EnableUserTwoFactor(ctx context.Context, data *model.EnableUserTwoFactorInput) (bool, error)
+	// Save last used step, false if step already used
+	UpdateTwoFactorLastUsedStep(ctx context.Context, data *model.UpdateTwoFactorLastUsedStepInput) (bool, error)
+	// Replace recovery codes
+	UpdateTwoFactorRecoveryCodes(ctx context.Context, data *model.UpdateTwoFactorRecoveryCodesInput) error
+	// Use recovery code, false if code not found or already used
+	UseTwoFactorRecoveryCode(ctx context.Context, data *model.UseTwoFactorRecoveryCodeInput) (bool, error)
+	// Delete two factor of user
+	DeleteUserTwoFactor(ctx context.Context, userID uuid.UUID) error
+}
+
+/**
+ * Variable for TwoFactor repository instance
+ */
+var _vTwoFactorRepository ITwoFactorRepository
+
+/**
+ * Set the TwoFactor repository instance
+ */
+func SetTwoFactorRepository(v ITwoFactorRepository) error {
+	if _vTwoFactorRepository != nil {
+		return errors.New("TwoFactor repository initialization failed, not nil")
+	}
+	_vTwoFactorRepository = v
+	return nil
+}
+
+/**
+ * Get the TwoFactor repository instance
+ */
+func GetTwoFactorRepository() (ITwoFactorRepository, error) {
+	if _vTwoFactorRepository == nil {
+		return nil, errors.New("TwoFactor repository not initialized")
+	}
+	return _vTwoFactorRepository, nil
+}
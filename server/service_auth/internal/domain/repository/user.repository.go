@@ -29,6 +29,10 @@ type IUserRepository interface {
 	GetUserSessionByID(ctx context.Context, sessionID uuid.UUID) (*model.UserSessionOutput, error)
 	// Refresh user session
 	RefreshSession(ctx context.Context, data *model.RefreshSessionInput) error
+	// Update user password hash
+	UpdateUserPassword(ctx context.Context, data *model.UpdateUserPasswordInput) error
+	// Update user lock status
+	UpdateUserLock(ctx context.Context, data *model.UpdateUserLockInput) error
 	// v.v
 
 	// ======================================================
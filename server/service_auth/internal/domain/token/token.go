@@ -93,6 +93,13 @@ type ITokenService interface {
 	 * @return *model.TokenDeviceJwtOutput, *domainErrors.TokenValidationError
 	 */
 	ParseDeviceToken(ctx context.Context, token string) (*model.TokenDeviceJwtOutput, *domainErrors.TokenValidationError)
+
+	/**
+	 * Get public keys used to verify tokens - JWKS
+	 * @param ctx context.Context
+	 * @return []model.TokenJsonWebKey, error
+	 */
+	GetJwks(ctx context.Context) ([]model.TokenJsonWebKey, error)
 	// v.v
 }
 
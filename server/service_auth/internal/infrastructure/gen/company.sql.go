@@ -75,6 +75,26 @@ func (q *Queries) DisableDevice(ctx context.Context, deviceID pgtype.UUID) error
 	return err
 }
 
+const getCompanySettingValue = `-- name: GetCompanySettingValue :one
+SELECT setting_value
+FROM company_settings
+WHERE company_id = $1
+  AND setting_key = $2
+LIMIT 1
+`
+
+type GetCompanySettingValueParams struct {
+	CompanyID  pgtype.UUID
+	SettingKey string
+}
+
+func (q *Queries) GetCompanySettingValue(ctx context.Context, arg GetCompanySettingValueParams) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, getCompanySettingValue, arg.CompanyID, arg.SettingKey)
+	var setting_value pgtype.Text
+	err := row.Scan(&setting_value)
+	return setting_value, err
+}
+
 const getCompanyUser = `-- name: GetCompanyUser :one
 SELECT 
     company_id
@@ -90,6 +110,20 @@ func (q *Queries) GetCompanyUser(ctx context.Context, employeeID pgtype.UUID) (p
 	return company_id, err
 }
 
+const getSystemSettingValue = `-- name: GetSystemSettingValue :one
+SELECT setting_value
+FROM system_settings
+WHERE setting_key = $1
+LIMIT 1
+`
+
+func (q *Queries) GetSystemSettingValue(ctx context.Context, settingKey string) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, getSystemSettingValue, settingKey)
+	var setting_value pgtype.Text
+	err := row.Scan(&setting_value)
+	return setting_value, err
+}
+
 const updateDeviceSession = `-- name: UpdateDeviceSession :exec
 UPDATE devices
 SET token = $2,
@@ -68,6 +68,20 @@ type Company struct {
 	UpdatedAt             pgtype.Timestamptz
 }
 
+type CompanyOidcConfig struct {
+	CompanyID    pgtype.UUID
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	EmailClaim   string
+	RoleClaim    string
+	RoleMapping  []byte
+	IsEnabled    bool
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+}
+
 type CompanySetting struct {
 	SettingID    pgtype.UUID
 	CompanyID    pgtype.UUID
@@ -183,6 +197,17 @@ type UserSession struct {
 	IsActive     pgtype.Bool
 }
 
+type UserTwoFactor struct {
+	UserID        pgtype.UUID
+	Secret        string
+	IsEnabled     bool
+	LastUsedStep  int64
+	RecoveryCodes []string
+	EnabledAt     pgtype.Timestamptz
+	CreatedAt     pgtype.Timestamptz
+	UpdatedAt     pgtype.Timestamptz
+}
+
 type WorkShift struct {
 	ShiftID               pgtype.UUID
 	CompanyID             pgtype.UUID
@@ -55,20 +55,53 @@ func (q *Queries) DeleteUserSessionByID(ctx context.Context, sessionID pgtype.UU
 	return err
 }
 
+const getUserBaseWithID = `-- name: GetUserBaseWithID :one
+SELECT user_id, email, salt, password_hash, role, is_locked, lock_expires_at
+FROM users
+WHERE user_id = $1
+LIMIT 1
+`
+
+type GetUserBaseWithIDRow struct {
+	UserID        pgtype.UUID
+	Email         string
+	Salt          string
+	PasswordHash  string
+	Role          int16
+	IsLocked      pgtype.Bool
+	LockExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) GetUserBaseWithID(ctx context.Context, userID pgtype.UUID) (GetUserBaseWithIDRow, error) {
+	row := q.db.QueryRow(ctx, getUserBaseWithID, userID)
+	var i GetUserBaseWithIDRow
+	err := row.Scan(
+		&i.UserID,
+		&i.Email,
+		&i.Salt,
+		&i.PasswordHash,
+		&i.Role,
+		&i.IsLocked,
+		&i.LockExpiresAt,
+	)
+	return i, err
+}
+
 const getUserBaseWithMail = `-- name: GetUserBaseWithMail :one
-SELECT user_id, email, salt, password_hash, role, is_locked
+SELECT user_id, email, salt, password_hash, role, is_locked, lock_expires_at
 FROM users
 WHERE email = $1
 LIMIT 1
 `
 
 type GetUserBaseWithMailRow struct {
-	UserID       pgtype.UUID
-	Email        string
-	Salt         string
-	PasswordHash string
-	Role         int16
-	IsLocked     pgtype.Bool
+	UserID        pgtype.UUID
+	Email         string
+	Salt          string
+	PasswordHash  string
+	Role          int16
+	IsLocked      pgtype.Bool
+	LockExpiresAt pgtype.Timestamptz
 }
 
 func (q *Queries) GetUserBaseWithMail(ctx context.Context, email string) (GetUserBaseWithMailRow, error) {
@@ -81,6 +114,7 @@ func (q *Queries) GetUserBaseWithMail(ctx context.Context, email string) (GetUse
 		&i.PasswordHash,
 		&i.Role,
 		&i.IsLocked,
+		&i.LockExpiresAt,
 	)
 	return i, err
 }
@@ -154,6 +188,46 @@ func (q *Queries) GetUserSessionByID(ctx context.Context, sessionID pgtype.UUID)
 	return i, err
 }
 
+const updateUserLock = `-- name: UpdateUserLock :exec
+UPDATE users
+SET
+    is_locked = $2,
+    lock_expires_at = $3,
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_id = $1
+`
+
+type UpdateUserLockParams struct {
+	UserID        pgtype.UUID
+	IsLocked      pgtype.Bool
+	LockExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) UpdateUserLock(ctx context.Context, arg UpdateUserLockParams) error {
+	_, err := q.db.Exec(ctx, updateUserLock, arg.UserID, arg.IsLocked, arg.LockExpiresAt)
+	return err
+}
+
+const updateUserPassword = `-- name: UpdateUserPassword :exec
+UPDATE users
+SET
+    salt = $2,
+    password_hash = $3,
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_id = $1
+`
+
+type UpdateUserPasswordParams struct {
+	UserID       pgtype.UUID
+	Salt         string
+	PasswordHash string
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error {
+	_, err := q.db.Exec(ctx, updateUserPassword, arg.UserID, arg.Salt, arg.PasswordHash)
+	return err
+}
+
 const updateUserSession = `-- name: UpdateUserSession :exec
 UPDATE user_sessions
 SET
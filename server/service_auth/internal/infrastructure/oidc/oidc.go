@@ -0,0 +1,322 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/model"
+	domainOidc "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/oidc"
+)
+
+const (
+	// Thời gian cache discovery document và JWKS của provider
+	metadataCacheTtl = time.Hour
+	// Giới hạn kích thước response từ provider
+	maxResponseSize = 1 << 20
+	// Sai lệch đồng hồ cho phép khi kiểm tra exp, iat
+	clockSkew = time.Minute
+)
+
+// Thuật toán ký ID token được chấp nhận, không chấp nhận HS* và none
+var allowedSigningMethods = []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}
+
+type (
+	cachedMetadata struct {
+		metadata  *domainModel.OidcProviderMetadata
+		expiresAt time.Time
+	}
+
+	cachedKeys struct {
+		keys      map[string]interface{}
+		expiresAt time.Time
+	}
+
+	jsonWebKey struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	}
+
+	tokenResponse struct {
+		AccessToken      string `json:"access_token"`
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+)
+
+// =======================================================
+// Define oidc provider infrastructure implementation in domain
+// =======================================================
+type OidcProvider struct {
+	client   *http.Client
+	mu       sync.Mutex
+	metadata map[string]cachedMetadata
+	keys     map[string]cachedKeys
+}
+
+// Discover implements oidc.IOidcProvider.
+func (o *OidcProvider) Discover(ctx context.Context, issuer string) (*domainModel.OidcProviderMetadata, error) {
+	issuer = strings.TrimSuffix(issuer, "/")
+	o.mu.Lock()
+	cached, ok := o.metadata[issuer]
+	o.mu.Unlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.metadata, nil
+	}
+	var metadata domainModel.OidcProviderMetadata
+	if err := o.getJson(ctx, issuer+"/.well-known/openid-configuration", &metadata); err != nil {
+		return nil, fmt.Errorf("discovery: %w", err)
+	}
+	// Issuer trong discovery document phải trùng issuer đã cấu hình (OpenID Connect Discovery 4.3)
+	if strings.TrimSuffix(metadata.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("discovery: issuer mismatch %q", metadata.Issuer)
+	}
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JwksUri == "" {
+		return nil, errors.New("discovery: missing endpoint")
+	}
+	o.mu.Lock()
+	o.metadata[issuer] = cachedMetadata{metadata: &metadata, expiresAt: time.Now().Add(metadataCacheTtl)}
+	o.mu.Unlock()
+	return &metadata, nil
+}
+
+// AuthorizationUrl implements oidc.IOidcProvider.
+func (o *OidcProvider) AuthorizationUrl(metadata *domainModel.OidcProviderMetadata, input *domainModel.OidcAuthorizationUrlInput) string {
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", input.ClientID)
+	query.Set("redirect_uri", input.RedirectUri)
+	query.Set("scope", strings.Join(input.Scopes, " "))
+	query.Set("state", input.State)
+	query.Set("nonce", input.Nonce)
+	query.Set("code_challenge", input.CodeChallenge)
+	query.Set("code_challenge_method", "S256")
+	separator := "?"
+	if strings.Contains(metadata.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return metadata.AuthorizationEndpoint + separator + query.Encode()
+}
+
+// ExchangeCode implements oidc.IOidcProvider.
+func (o *OidcProvider) ExchangeCode(ctx context.Context, metadata *domainModel.OidcProviderMetadata, input *domainModel.OidcExchangeCodeInput) (*domainModel.OidcExchangeCodeOutput, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", input.Code)
+	form.Set("redirect_uri", input.RedirectUri)
+	form.Set("client_id", input.ClientID)
+	form.Set("client_secret", input.ClientSecret)
+	form.Set("code_verifier", input.CodeVerifier)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var token tokenResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&token); err != nil {
+		return nil, fmt.Errorf("token endpoint: status %d: %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK || token.Error != "" {
+		return nil, fmt.Errorf("token endpoint: status %d: %s %s", resp.StatusCode, token.Error, token.ErrorDescription)
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("token endpoint: missing id_token")
+	}
+	return &domainModel.OidcExchangeCodeOutput{
+		AccessToken: token.AccessToken,
+		IDToken:     token.IDToken,
+	}, nil
+}
+
+// VerifyIDToken implements oidc.IOidcProvider.
+func (o *OidcProvider) VerifyIDToken(ctx context.Context, metadata *domainModel.OidcProviderMetadata, input *domainModel.OidcVerifyIDTokenInput) (map[string]interface{}, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(
+		input.IDToken,
+		claims,
+		func(token *jwt.Token) (any, error) {
+			kid, _ := token.Header["kid"].(string)
+			return o.signingKey(ctx, metadata.JwksUri, kid)
+		},
+		jwt.WithValidMethods(allowedSigningMethods),
+		jwt.WithIssuer(metadata.Issuer),
+		jwt.WithAudience(input.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(clockSkew),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("id token: %w", err)
+	}
+	// Nhiều audience thì azp phải là client của công ty (OpenID Connect Core 3.1.3.7)
+	if azp, ok := claims["azp"].(string); ok && azp != input.ClientID {
+		return nil, errors.New("id token: azp mismatch")
+	}
+	if nonce, _ := claims["nonce"].(string); input.Nonce == "" || nonce != input.Nonce {
+		return nil, errors.New("id token: nonce mismatch")
+	}
+	return claims, nil
+}
+
+// signingKey tìm public key theo kid, tải lại JWKS một lần khi không thấy kid (provider xoay key)
+func (o *OidcProvider) signingKey(ctx context.Context, jwksUri string, kid string) (interface{}, error) {
+	for _, refresh := range []bool{false, true} {
+		keys, err := o.getKeys(ctx, jwksUri, refresh)
+		if err != nil {
+			return nil, err
+		}
+		if key, ok := keys[kid]; ok {
+			return key, nil
+		}
+		// Token không có kid và JWKS chỉ có một key
+		if kid == "" && len(keys) == 1 {
+			for _, key := range keys {
+				return key, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("jwks: key %q not found", kid)
+}
+
+// getKeys đọc JWKS từ cache hoặc provider
+func (o *OidcProvider) getKeys(ctx context.Context, jwksUri string, refresh bool) (map[string]interface{}, error) {
+	o.mu.Lock()
+	cached, ok := o.keys[jwksUri]
+	o.mu.Unlock()
+	if ok && !refresh && time.Now().Before(cached.expiresAt) {
+		return cached.keys, nil
+	}
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := o.getJson(ctx, jwksUri, &jwks); err != nil {
+		return nil, fmt.Errorf("jwks: %w", err)
+	}
+	keys := make(map[string]interface{}, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			// Bỏ qua key không hỗ trợ, các key còn lại vẫn dùng được
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	o.mu.Lock()
+	o.keys[jwksUri] = cachedKeys{keys: keys, expiresAt: time.Now().Add(metadataCacheTtl)}
+	o.mu.Unlock()
+	return keys, nil
+}
+
+// getJson GET url và decode json
+func (o *OidcProvider) getJson(ctx context.Context, rawUrl string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawUrl, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(out)
+}
+
+// publicKey chuyển JWK (RSA, EC) sang public key
+func (k *jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if len(n) == 0 || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("invalid rsa key")
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, errors.New("invalid ec key")
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+/**
+ * NewOidcProvider create new instance of OidcProvider, timeout is http timeout when call provider
+ */
+func NewOidcProvider(timeout time.Duration) domainOidc.IOidcProvider {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &OidcProvider{
+		client:   &http.Client{Timeout: timeout},
+		metadata: make(map[string]cachedMetadata),
+		keys:     make(map[string]cachedKeys),
+	}
+}
@@ -118,6 +118,45 @@ func (c *CompanyRepository) UpdateDeviceSession(ctx context.Context, data *model
 	)
 }
 
+// GetCompanySetting implements repository.ICompanyRepository.
+func (c *CompanyRepository) GetCompanySetting(ctx context.Context, input *model.GetCompanySettingInput) (*string, error) {
+	response, err := c.q.GetCompanySettingValue(
+		ctx,
+		db.GetCompanySettingValueParams{
+			CompanyID: pgtype.UUID{
+				Valid: true,
+				Bytes: input.CompanyID,
+			},
+			SettingKey: input.SettingKey,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !response.Valid {
+		return nil, nil
+	}
+	return &response.String, nil
+}
+
+// GetSystemSetting implements repository.ICompanyRepository.
+func (c *CompanyRepository) GetSystemSetting(ctx context.Context, settingKey string) (*string, error) {
+	response, err := c.q.GetSystemSettingValue(ctx, settingKey)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !response.Valid {
+		return nil, nil
+	}
+	return &response.String, nil
+}
+
 /**
  * New CompanyRepository
  */
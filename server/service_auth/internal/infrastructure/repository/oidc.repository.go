@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/model"
+	domainRepository "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/repository"
+	db "github.com/youknow2509/cio_verify_face/server/service_auth/internal/infrastructure/gen"
+)
+
+/**
+ * Struct impl IOidcRepository
+ */
+type OidcRepository struct {
+	q db.Queries
+}
+
+// GetCompanyOidcConfig implements repository.IOidcRepository.
+func (o *OidcRepository) GetCompanyOidcConfig(ctx context.Context, companyID uuid.UUID) (*model.CompanyOidcConfigOutput, error) {
+	response, err := o.q.GetCompanyOidcConfig(ctx, pgtype.UUID{Bytes: companyID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	roleMapping := map[string]int{}
+	if len(response.RoleMapping) > 0 {
+		if err := json.Unmarshal(response.RoleMapping, &roleMapping); err != nil {
+			return nil, err
+		}
+	}
+	return &model.CompanyOidcConfigOutput{
+		CompanyID:    response.CompanyID.Bytes,
+		Issuer:       response.Issuer,
+		ClientID:     response.ClientID,
+		ClientSecret: response.ClientSecret,
+		Scopes:       response.Scopes,
+		EmailClaim:   response.EmailClaim,
+		RoleClaim:    response.RoleClaim,
+		RoleMapping:  roleMapping,
+		IsEnabled:    response.IsEnabled,
+		UpdatedAt:    timestamptzPtr(response.UpdatedAt),
+	}, nil
+}
+
+// UpsertCompanyOidcConfig implements repository.IOidcRepository.
+func (o *OidcRepository) UpsertCompanyOidcConfig(ctx context.Context, data *model.UpsertCompanyOidcConfigInput) error {
+	roleMapping := data.RoleMapping
+	if roleMapping == nil {
+		roleMapping = map[string]int{}
+	}
+	roleMappingJson, err := json.Marshal(roleMapping)
+	if err != nil {
+		return err
+	}
+	return o.q.UpsertCompanyOidcConfig(
+		ctx,
+		db.UpsertCompanyOidcConfigParams{
+			CompanyID:    pgtype.UUID{Bytes: data.CompanyID, Valid: true},
+			Issuer:       data.Issuer,
+			ClientID:     data.ClientID,
+			ClientSecret: data.ClientSecret,
+			Scopes:       data.Scopes,
+			EmailClaim:   data.EmailClaim,
+			RoleClaim:    data.RoleClaim,
+			RoleMapping:  roleMappingJson,
+			IsEnabled:    data.IsEnabled,
+		},
+	)
+}
+
+// DeleteCompanyOidcConfig implements repository.IOidcRepository.
+func (o *OidcRepository) DeleteCompanyOidcConfig(ctx context.Context, companyID uuid.UUID) error {
+	return o.q.DeleteCompanyOidcConfig(ctx, pgtype.UUID{Bytes: companyID, Valid: true})
+}
+
+/**
+ * New OidcRepository
+ */
+func NewOidcRepository(client *pgxpool.Pool) domainRepository.IOidcRepository {
+	return &OidcRepository{
+		q: *db.New(client),
+	}
+}
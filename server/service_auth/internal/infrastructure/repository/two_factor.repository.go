@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/model"
+	domainRepository "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/repository"
+	db "github.com/youknow2509/cio_verify_face/server/service_auth/internal/infrastructure/gen"
+)
+
+/**
+ * Struct impl ITwoFactorRepository
+ */
+type TwoFactorRepository struct {
+	q db.Queries
+}
+
+// GetUserTwoFactor implements repository.ITwoFactorRepository.
+func (t *TwoFactorRepository) GetUserTwoFactor(ctx context.Context, userID uuid.UUID) (*model.UserTwoFactorOutput, error) {
+	response, err := t.q.GetUserTwoFactor(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &model.UserTwoFactorOutput{
+		UserID:            response.UserID.Bytes,
+		Secret:            response.Secret,
+		IsEnabled:         response.IsEnabled,
+		LastUsedStep:      response.LastUsedStep,
+		RecoveryCodesLeft: int(response.RecoveryCodesLeft),
+		EnabledAt:         timestamptzPtr(response.EnabledAt),
+	}, nil
+}
+
+// UpsertUserTwoFactorSecret implements repository.ITwoFactorRepository.
+func (t *TwoFactorRepository) UpsertUserTwoFactorSecret(ctx context.Context, data *model.UpsertUserTwoFactorSecretInput) error {
+	return t.q.UpsertUserTwoFactorSecret(
+		ctx,
+		db.UpsertUserTwoFactorSecretParams{
+			UserID: pgtype.UUID{Bytes: data.UserID, Valid: true},
+			Secret: data.Secret,
+		},
+	)
+}
+
+// EnableUserTwoFactor implements repository.ITwoFactorRepository.
+func (t *TwoFactorRepository) EnableUserTwoFactor(ctx context.Context, data *model.EnableUserTwoFactorInput) (bool, error) {
+	rows, err := t.q.EnableUserTwoFactor(
+		ctx,
+		db.EnableUserTwoFactorParams{
+			UserID:        pgtype.UUID{Bytes: data.UserID, Valid: true},
+			LastUsedStep:  data.LastUsedStep,
+			RecoveryCodes: data.RecoveryCodeHashes,
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// UpdateTwoFactorLastUsedStep implements repository.ITwoFactorRepository.
+func (t *TwoFactorRepository) UpdateTwoFactorLastUsedStep(ctx context.Context, data *model.UpdateTwoFactorLastUsedStepInput) (bool, error) {
+	rows, err := t.q.UpdateTwoFactorLastUsedStep(
+		ctx,
+		db.UpdateTwoFactorLastUsedStepParams{
+			UserID:       pgtype.UUID{Bytes: data.UserID, Valid: true},
+			LastUsedStep: data.LastUsedStep,
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// UpdateTwoFactorRecoveryCodes implements repository.ITwoFactorRepository.
+func (t *TwoFactorRepository) UpdateTwoFactorRecoveryCodes(ctx context.Context, data *model.UpdateTwoFactorRecoveryCodesInput) error {
+	return t.q.UpdateTwoFactorRecoveryCodes(
+		ctx,
+		db.UpdateTwoFactorRecoveryCodesParams{
+			UserID:        pgtype.UUID{Bytes: data.UserID, Valid: true},
+			RecoveryCodes: data.RecoveryCodeHashes,
+		},
+	)
+}
+
+// UseTwoFactorRecoveryCode implements repository.ITwoFactorRepository.
+func (t *TwoFactorRepository) UseTwoFactorRecoveryCode(ctx context.Context, data *model.UseTwoFactorRecoveryCodeInput) (bool, error) {
+	rows, err := t.q.UseTwoFactorRecoveryCode(
+		ctx,
+		db.UseTwoFactorRecoveryCodeParams{
+			UserID:   pgtype.UUID{Bytes: data.UserID, Valid: true},
+			CodeHash: data.CodeHash,
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// DeleteUserTwoFactor implements repository.ITwoFactorRepository.
+func (t *TwoFactorRepository) DeleteUserTwoFactor(ctx context.Context, userID uuid.UUID) error {
+	return t.q.DeleteUserTwoFactor(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+}
+
+/**
+ * New TwoFactorRepository
+ */
+func NewTwoFactorRepository(client *pgxpool.Pool) domainRepository.ITwoFactorRepository {
+	return &TwoFactorRepository{
+		q: *db.New(client),
+	}
+}
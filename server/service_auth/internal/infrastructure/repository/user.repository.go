@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -82,18 +83,42 @@ func (u *UserRepository) GetUserBaseByEmail(ctx context.Context, email string) (
 		return nil, err
 	}
 	return &model.UserBaseInfoOutput{
-		UserID:       response.UserID.String(),
-		UserEmail:    response.Email,
-		UserSalt:     response.Salt,
-		UserPassword: response.PasswordHash,
-		IsBlocked:    response.IsLocked.Bool,
-		Role:         int(response.Role),
+		UserID:        response.UserID.String(),
+		UserEmail:     response.Email,
+		UserSalt:      response.Salt,
+		UserPassword:  response.PasswordHash,
+		IsBlocked:     response.IsLocked.Bool,
+		LockExpiresAt: timestamptzPtr(response.LockExpiresAt),
+		Role:          int(response.Role),
 	}, nil
 }
 
 // GetUserBaseByID implements repository.IUserRepository.
 func (u *UserRepository) GetUserBaseByID(ctx context.Context, userID uuid.UUID) (*model.UserBaseInfoOutput, error) {
-	panic("unimplemented")
+	response, err := u.q.GetUserBaseWithID(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &model.UserBaseInfoOutput{
+		UserID:        response.UserID.String(),
+		UserEmail:     response.Email,
+		UserSalt:      response.Salt,
+		UserPassword:  response.PasswordHash,
+		IsBlocked:     response.IsLocked.Bool,
+		LockExpiresAt: timestamptzPtr(response.LockExpiresAt),
+		Role:          int(response.Role),
+	}, nil
+}
+
+// timestamptzPtr trả về nil khi cột NULL
+func timestamptzPtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
 }
 
 // RefreshSession implements repository.IUserRepository.
@@ -108,6 +133,34 @@ func (u *UserRepository) RefreshSession(ctx context.Context, data *model.Refresh
 	)
 }
 
+// UpdateUserPassword implements repository.IUserRepository.
+func (u *UserRepository) UpdateUserPassword(ctx context.Context, data *model.UpdateUserPasswordInput) error {
+	return u.q.UpdateUserPassword(
+		ctx,
+		db.UpdateUserPasswordParams{
+			UserID:       pgtype.UUID{Bytes: data.UserID, Valid: true},
+			Salt:         data.Salt,
+			PasswordHash: data.PasswordHash,
+		},
+	)
+}
+
+// UpdateUserLock implements repository.IUserRepository.
+func (u *UserRepository) UpdateUserLock(ctx context.Context, data *model.UpdateUserLockInput) error {
+	lockExpiresAt := pgtype.Timestamptz{}
+	if data.LockExpiresAt != nil {
+		lockExpiresAt = pgtype.Timestamptz{Time: *data.LockExpiresAt, Valid: true}
+	}
+	return u.q.UpdateUserLock(
+		ctx,
+		db.UpdateUserLockParams{
+			UserID:        pgtype.UUID{Bytes: data.UserID, Valid: true},
+			IsLocked:      pgtype.Bool{Bool: data.IsLocked, Valid: true},
+			LockExpiresAt: lockExpiresAt,
+		},
+	)
+}
+
 // RemoveUserSession implements repository.IUserRepository.
 func (u *UserRepository) RemoveUserSession(ctx context.Context, data *model.RemoveUserSessionInput) error {
 	return u.q.DeleteUserSessionByID(ctx, pgtype.UUID{Bytes: data.SessionID, Valid: true})
@@ -5,6 +5,19 @@ FROM employees
 WHERE employee_id = $1
 LIMIT 1;
 
+-- name: GetCompanySettingValue :one
+SELECT setting_value
+FROM company_settings
+WHERE company_id = $1
+  AND setting_key = $2
+LIMIT 1;
+
+-- name: GetSystemSettingValue :one
+SELECT setting_value
+FROM system_settings
+WHERE setting_key = $1
+LIMIT 1;
+
 -- name: CheckUserIsManagementInCompany :one
 SELECT e.employee_code
 FROM employees e
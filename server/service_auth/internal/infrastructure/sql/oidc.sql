@@ -0,0 +1,45 @@
+-- name: GetCompanyOidcConfig :one
+SELECT
+    company_id,
+    issuer,
+    client_id,
+    client_secret,
+    scopes,
+    email_claim,
+    role_claim,
+    role_mapping,
+    is_enabled,
+    updated_at
+FROM company_oidc_configs
+WHERE company_id = $1
+LIMIT 1;
+
+-- name: UpsertCompanyOidcConfig :exec
+INSERT INTO company_oidc_configs (
+    company_id,
+    issuer,
+    client_id,
+    client_secret,
+    scopes,
+    email_claim,
+    role_claim,
+    role_mapping,
+    is_enabled,
+    created_at,
+    updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+ON CONFLICT (company_id) DO UPDATE
+SET
+    issuer = EXCLUDED.issuer,
+    client_id = EXCLUDED.client_id,
+    client_secret = EXCLUDED.client_secret,
+    scopes = EXCLUDED.scopes,
+    email_claim = EXCLUDED.email_claim,
+    role_claim = EXCLUDED.role_claim,
+    role_mapping = EXCLUDED.role_mapping,
+    is_enabled = EXCLUDED.is_enabled,
+    updated_at = CURRENT_TIMESTAMP;
+
+-- name: DeleteCompanyOidcConfig :exec
+DELETE FROM company_oidc_configs
+WHERE company_id = $1;
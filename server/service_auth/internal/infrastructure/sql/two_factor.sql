@@ -0,0 +1,66 @@
+-- name: GetUserTwoFactor :one
+SELECT
+    user_id,
+    secret,
+    is_enabled,
+    last_used_step,
+    cardinality(recovery_codes)::int AS recovery_codes_left,
+    enabled_at
+FROM user_two_factor
+WHERE user_id = $1
+LIMIT 1;
+
+-- name: UpsertUserTwoFactorSecret :exec
+INSERT INTO user_two_factor (
+    user_id,
+    secret,
+    created_at,
+    updated_at
+) VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+ON CONFLICT (user_id) DO UPDATE
+SET
+    secret = EXCLUDED.secret,
+    last_used_step = 0,
+    recovery_codes = '{}',
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_two_factor.is_enabled = FALSE;
+
+-- name: EnableUserTwoFactor :execrows
+UPDATE user_two_factor
+SET
+    is_enabled = TRUE,
+    last_used_step = $2,
+    recovery_codes = $3,
+    enabled_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_id = $1
+  AND is_enabled = FALSE;
+
+-- name: UpdateTwoFactorLastUsedStep :execrows
+UPDATE user_two_factor
+SET
+    last_used_step = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_id = $1
+  AND last_used_step < $2;
+
+-- name: UpdateTwoFactorRecoveryCodes :exec
+UPDATE user_two_factor
+SET
+    recovery_codes = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_id = $1
+  AND is_enabled = TRUE;
+
+-- name: UseTwoFactorRecoveryCode :execrows
+UPDATE user_two_factor
+SET
+    recovery_codes = array_remove(recovery_codes, sqlc.arg(code_hash)::text),
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_id = $1
+  AND is_enabled = TRUE
+  AND sqlc.arg(code_hash)::text = ANY(recovery_codes);
+
+-- name: DeleteUserTwoFactor :exec
+DELETE FROM user_two_factor
+WHERE user_id = $1;
@@ -10,11 +10,17 @@ WHERE user_id = $1
 LIMIT 1;
 
 -- name: GetUserBaseWithMail :one
-SELECT user_id, email, salt, password_hash, role, is_locked
+SELECT user_id, email, salt, password_hash, role, is_locked, lock_expires_at
 FROM users
 WHERE email = $1
 LIMIT 1;
 
+-- name: GetUserBaseWithID :one
+SELECT user_id, email, salt, password_hash, role, is_locked, lock_expires_at
+FROM users
+WHERE user_id = $1
+LIMIT 1;
+
 -- name: CreateUserSession :exec
 INSERT INTO user_sessions (
     session_id, 
@@ -43,6 +49,22 @@ FROM user_sessions
 WHERE session_id = $1
 LIMIT 1;
 
+-- name: UpdateUserPassword :exec
+UPDATE users
+SET
+    salt = $2,
+    password_hash = $3,
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_id = $1;
+
+-- name: UpdateUserLock :exec
+UPDATE users
+SET
+    is_locked = $2,
+    lock_expires_at = $3,
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_id = $1;
+
 -- name: UpdateUserSession :exec
 UPDATE user_sessions
 SET
@@ -0,0 +1,145 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+	domainConfig "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/config"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/model"
+	utilsRandom "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/random"
+)
+
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+
+	// Độ dài tối thiểu của khóa RSA
+	minRsaKeyBits = 2048
+)
+
+// =======================================================
+//
+//	Signing key
+//
+// =======================================================
+type signingKey struct {
+	kid     string
+	method  jwt.SigningMethod
+	private crypto.Signer
+}
+
+// jwk trả về public key dạng JWK (RFC 7517, RFC 8037)
+func (k *signingKey) jwk() domainModel.TokenJsonWebKey {
+	out := domainModel.TokenJsonWebKey{
+		Kid: k.kid,
+		Use: "sig",
+		Alg: k.method.Alg(),
+	}
+	switch pub := k.private.Public().(type) {
+	case *rsa.PublicKey:
+		out.Kty = "RSA"
+		out.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		out.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		out.Kty = "OKP"
+		out.Crv = "Ed25519"
+		out.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+	return out
+}
+
+// loadSigningKeys đọc các khóa ký trong cấu hình, kid không được trùng
+func loadSigningKeys(settings []domainConfig.JWTKeySetting) ([]*signingKey, error) {
+	keys := make([]*signingKey, 0, len(settings))
+	seen := make(map[string]bool, len(settings))
+	for _, setting := range settings {
+		if setting.Kid == "" {
+			return nil, errors.New("jwt key: kid is required")
+		}
+		if seen[setting.Kid] {
+			return nil, fmt.Errorf("jwt key %s: duplicate kid", setting.Kid)
+		}
+		seen[setting.Kid] = true
+		key, err := parseSigningKey(setting)
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %s: %w", setting.Kid, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// parseSigningKey đọc private key PEM và kiểm tra khớp thuật toán
+func parseSigningKey(setting domainConfig.JWTKeySetting) (*signingKey, error) {
+	data := []byte(setting.PrivateKey)
+	if setting.PrivateKey == "" {
+		if setting.PrivateKeyFile == "" {
+			return nil, errors.New("private_key or private_key_file is required")
+		}
+		file, err := os.ReadFile(setting.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		data = file
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid pem")
+	}
+	var (
+		parsed any
+		err    error
+	)
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported pem type %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch private := parsed.(type) {
+	case *rsa.PrivateKey:
+		if setting.Algorithm != "" && setting.Algorithm != AlgorithmRS256 {
+			return nil, fmt.Errorf("rsa key cannot be used with %s", setting.Algorithm)
+		}
+		if private.N.BitLen() < minRsaKeyBits {
+			return nil, fmt.Errorf("rsa key must be at least %d bits", minRsaKeyBits)
+		}
+		return &signingKey{kid: setting.Kid, method: jwt.SigningMethodRS256, private: private}, nil
+	case ed25519.PrivateKey:
+		if setting.Algorithm != "" && setting.Algorithm != AlgorithmEdDSA {
+			return nil, fmt.Errorf("ed25519 key cannot be used with %s", setting.Algorithm)
+		}
+		return &signingKey{kid: setting.Kid, method: jwt.SigningMethodEdDSA, private: private}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", parsed)
+	}
+}
+
+// newEphemeralSigningKey tạo khóa Ed25519 tạm trong bộ nhớ khi chưa cấu hình khóa,
+// token sẽ mất hiệu lực khi restart và không dùng được khi chạy nhiều instance
+func newEphemeralSigningKey() (*signingKey, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &signingKey{
+		kid:     "ephemeral-" + utilsRandom.RandomString(12),
+		method:  jwt.SigningMethodEdDSA,
+		private: private,
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	domainConfig "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/config"
 	domainErrors "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/errors"
 	domainModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/model"
 	domainToken "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/token"
@@ -48,10 +49,20 @@ type (
 // Define token infrastructure implementation in domain
 // =======================================================
 type TokenService struct {
-	secret   string
-	issuer   string
-	subject  string
-	audience []string
+	secret       string
+	secretUntil  time.Time // Hết hạn chấp nhận token HS256 cũ
+	issuer       string
+	subject      string
+	audience     []string
+	activeKey    *signingKey
+	keys         map[string]*signingKey
+	jwks         []domainModel.TokenJsonWebKey
+	validMethods []string
+}
+
+// GetJwks implements token.ITokenService.
+func (t *TokenService) GetJwks(ctx context.Context) ([]domainModel.TokenJsonWebKey, error) {
+	return t.jwks, nil
 }
 
 // ParseUserRefreshToken implements token.ITokenService.
@@ -59,9 +70,8 @@ func (t *TokenService) ParseUserRefreshToken(ctx context.Context, token string)
 	parsedToken, err := jwt.ParseWithClaims(
 		token,
 		&TokenUserRefreshJwtClaim{},
-		func(token *jwt.Token) (any, error) {
-			return []byte(t.secret), nil
-		},
+		t.keyFunc,
+		jwt.WithValidMethods(t.validMethods),
 	)
 	if e := handleError(err); e != nil {
 		return nil, e
@@ -111,8 +121,7 @@ func (t *TokenService) CreateDeviceToken(ctx context.Context, input *domainModel
 		DeviceId:  input.DeviceId,
 		CompanyId: input.CompanyId,
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaims)
-	return token.SignedString([]byte(t.secret))
+	return t.sign(tokenClaims)
 }
 
 // CreateServiceRefreshToken implements token.ITokenService.
@@ -132,9 +141,8 @@ func (t *TokenService) ParseDeviceToken(ctx context.Context, token string) (*dom
 	parsedToken, err := jwt.ParseWithClaims(
 		token,
 		&TokenDeviceJwtClaim{},
-		func(token *jwt.Token) (any, error) {
-			return []byte(t.secret), nil
-		},
+		t.keyFunc,
+		jwt.WithValidMethods(t.validMethods),
 	)
 	if e := handleError(err); e != nil {
 		return nil, e
@@ -175,8 +183,7 @@ func (t *TokenService) CreateServiceToken(ctx context.Context, input *domainMode
 		ServiceName: input.ServiceName,
 		Type:        input.Type,
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaims)
-	return token.SignedString([]byte(t.secret))
+	return t.sign(tokenClaims)
 }
 
 // CreateUserToken implements token.ITokenService.
@@ -195,8 +202,7 @@ func (t *TokenService) CreateUserToken(ctx context.Context, input *domainModel.T
 		Role:      input.Role,
 		CompanyId: input.CompanyId,
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaim)
-	return token.SignedString([]byte(t.secret))
+	return t.sign(tokenClaim)
 }
 
 // CreateUserRefreshToken implements token.ITokenService.
@@ -212,8 +218,7 @@ func (t *TokenService) CreateUserRefreshToken(ctx context.Context, input *domain
 			ID:        input.TokenId,
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaim)
-	return token.SignedString([]byte(t.secret))
+	return t.sign(tokenClaim)
 }
 
 // ParseServiceToken implements token.ITokenService.
@@ -221,9 +226,8 @@ func (t *TokenService) ParseServiceToken(ctx context.Context, token string) (*do
 	parsedToken, err := jwt.ParseWithClaims(
 		token,
 		&TokenServiceJwtClaim{},
-		func(token *jwt.Token) (any, error) {
-			return []byte(t.secret), nil
-		},
+		t.keyFunc,
+		jwt.WithValidMethods(t.validMethods),
 	)
 	if e := handleError(err); e != nil {
 		return nil, e
@@ -254,9 +258,8 @@ func (t *TokenService) ParseUserToken(ctx context.Context, tokenString string) (
 	token, err := jwt.ParseWithClaims(
 		tokenString,
 		&TokenUserJwtClaim{},
-		func(token *jwt.Token) (any, error) {
-			return []byte(t.secret), nil
-		},
+		t.keyFunc,
+		jwt.WithValidMethods(t.validMethods),
 	)
 	if e := handleError(err); e != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
@@ -305,20 +308,75 @@ func (t *TokenService) ParseUserToken(ctx context.Context, tokenString string) (
 }
 
 /**
- * New token implementation
+ * New token implementation, sign with active key (RS256 | EdDSA) and verify with all configured keys
  */
-func NewTokenService(
-	secret string,
-	issuer string,
-	subject string,
-	audience []string,
-) domainToken.ITokenService {
-	return &TokenService{
-		secret:   secret,
-		issuer:   issuer,
-		subject:  subject,
-		audience: audience,
+func NewTokenService(setting *domainConfig.JWTSetting) (domainToken.ITokenService, error) {
+	keys, err := loadSigningKeys(setting.Keys)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		if !setting.AllowEphemeralKey {
+			return nil, errors.New("jwt keys are not configured, set allow_ephemeral_key only for development")
+		}
+		key, err := newEphemeralSigningKey()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	var secretUntil time.Time
+	if setting.Secret != "" {
+		secretUntil, err = parseSecretUntil(setting.SecretUntil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	service := &TokenService{
+		secret:      setting.Secret,
+		secretUntil: secretUntil,
+		issuer:      setting.Issuer,
+		subject:     setting.Subject,
+		audience:    setting.Audience,
+		activeKey:   keys[0],
+		keys:        make(map[string]*signingKey, len(keys)),
+	}
+	methods := map[string]bool{}
+	for _, key := range keys {
+		service.keys[key.kid] = key
+		service.jwks = append(service.jwks, key.jwk())
+		if !methods[key.method.Alg()] {
+			methods[key.method.Alg()] = true
+			service.validMethods = append(service.validMethods, key.method.Alg())
+		}
+	}
+	if setting.ActiveKid != "" {
+		active, ok := service.keys[setting.ActiveKid]
+		if !ok {
+			return nil, fmt.Errorf("jwt active_kid %s not found in keys", setting.ActiveKid)
+		}
+		service.activeKey = active
+	}
+	// Token HS256 cũ chỉ được chấp nhận tới secret_until
+	if setting.Secret != "" && time.Now().Before(secretUntil) {
+		service.validMethods = append(service.validMethods, jwt.SigningMethodHS256.Alg())
+	}
+	return service, nil
+}
+
+// parseSecretUntil secret HS256 cũ phải có hạn dùng rõ ràng để không bị bỏ quên trong cấu hình
+func parseSecretUntil(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, errors.New("jwt secret_until is required when legacy secret is configured")
+	}
+	if until, err := time.Parse(time.RFC3339, value); err == nil {
+		return until, nil
+	}
+	until, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("jwt secret_until %q: %w", value, err)
 	}
+	return until, nil
 }
 
 // =======================================================
@@ -326,6 +384,32 @@ func NewTokenService(
 //	Helper functions
 //
 // =======================================================
+// sign ký token bằng khóa đang active, kid được đặt trong header để bên xác thực chọn public key
+func (t *TokenService) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(t.activeKey.method, claims)
+	token.Header["kid"] = t.activeKey.kid
+	return token.SignedString(t.activeKey.private)
+}
+
+// keyFunc chọn khóa xác thực theo kid, thuật toán của token phải khớp với khóa
+func (t *TokenService) keyFunc(token *jwt.Token) (any, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		if t.secret == "" || !time.Now().Before(t.secretUntil) {
+			return nil, errors.New("hmac signed token is not accepted")
+		}
+		return []byte(t.secret), nil
+	}
+	kid, _ := token.Header["kid"].(string)
+	key, ok := t.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("signing method %s does not match key %q", token.Method.Alg(), kid)
+	}
+	return key.private.Public(), nil
+}
+
 func handleError(err error) *domainErrors.TokenValidationError {
 	if errors.Is(err, jwt.ErrTokenMalformed) {
 		return domainErrors.GetTokenValidationError(domainErrors.TokenMalformedErrorCode)
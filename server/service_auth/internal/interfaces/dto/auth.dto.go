@@ -17,6 +17,40 @@ type UpdateDeviceRequest struct {
 	DeviceId   string `json:"device_id" validate:"required"`
 }
 
+type UnlockUserRequest struct {
+	UserId string `json:"user_id" validate:"required"`
+}
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" validate:"required,min=6,max=20"`
+}
+
+type LoginTwoFactorEnrollRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+}
+
+type LoginTwoFactorRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	Code           string `json:"code" validate:"required,min=6,max=20"`
+}
+
+type OidcConfigRequest struct {
+	CompanyId    string         `json:"company_id"` // Bắt buộc với SYSTEM_ADMIN
+	Issuer       string         `json:"issuer" validate:"required,url,max=500"`
+	ClientId     string         `json:"client_id" validate:"required,max=255"`
+	ClientSecret string         `json:"client_secret"` // Bỏ trống để giữ secret đang lưu
+	Scopes       []string       `json:"scopes"`
+	EmailClaim   string         `json:"email_claim" validate:"max=100"`
+	RoleClaim    string         `json:"role_claim" validate:"max=100"`
+	RoleMapping  map[string]int `json:"role_mapping"`
+	IsEnabled    bool           `json:"is_enabled"`
+}
+
+type OidcCallbackRequest struct {
+	Code  string `json:"code" validate:"required"`
+	State string `json:"state" validate:"required"`
+}
+
 type RegisterRequest struct {
 	Email string `json:"email" validate:"required,email"`
 }
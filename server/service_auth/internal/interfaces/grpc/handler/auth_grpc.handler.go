@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/model"
@@ -145,6 +146,36 @@ func (a *AuthGRPCHandler) ParseDeviceToken(ctx context.Context, req *pb.ParseDev
 	return resp, nil
 }
 
+func (a *AuthGRPCHandler) BlockTokenDevice(ctx context.Context, req *pb.BlockTokenDeviceRequest) (*emptypb.Empty, error) {
+	tok := service.GetTokenService()
+	if tok == nil {
+		return nil, status.Error(codes.FailedPrecondition, "token service not initialized")
+	}
+
+	in, err := toModelBlockTokenDeviceInput(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	if err := tok.BlockTokenDevice(ctx, in); err != nil {
+		return nil, status.Errorf(codes.Internal, "block device token failed: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (a *AuthGRPCHandler) GetJwks(ctx context.Context, req *emptypb.Empty) (*pb.GetJwksResponse, error) {
+	tok := service.GetTokenService()
+	if tok == nil {
+		return nil, status.Error(codes.FailedPrecondition, "token service not initialized")
+	}
+
+	out, err := tok.GetJwks(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get jwks failed: %v", err)
+	}
+	return toPbGetJwksResponse(out), nil
+}
+
 // -------- Mapping helpers (TODO: map field theo proto/model thực tế) --------
 func toModelCreateUserTokenInput(req *pb.CreateUserTokenRequest) (model.CreateTokenUserInput, error) {
 	userUuid, err := uuidUtils.ParseUUID(req.GetUserId())
@@ -215,3 +246,39 @@ func toPbParseDeviceTokenResponse(input *model.ParseTokenDeviceOutput) (*pb.Pars
 		ExpiresAt: input.Expires.Unix(),
 	}, nil
 }
+
+func toModelBlockTokenDeviceInput(req *pb.BlockTokenDeviceRequest) (model.BlockTokenDeviceInput, error) {
+	deviceUuid, err := uuidUtils.ParseUUID(req.GetDeviceId())
+	if err != nil {
+		return model.BlockTokenDeviceInput{}, errors.New("invalid device ID format")
+	}
+	tokenUuid, err := uuidUtils.ParseUUID(req.GetTokenId())
+	if err != nil {
+		return model.BlockTokenDeviceInput{}, errors.New("invalid token ID format")
+	}
+	in := model.BlockTokenDeviceInput{
+		DeviceId: deviceUuid,
+		TokenId:  tokenUuid,
+	}
+	if req.GetExpiresAt() > 0 {
+		in.ExpiresAt = time.Unix(req.GetExpiresAt(), 0)
+	}
+	return in, nil
+}
+
+func toPbGetJwksResponse(out *model.JwksOutput) *pb.GetJwksResponse {
+	resp := &pb.GetJwksResponse{Keys: make([]*pb.JsonWebKey, 0, len(out.Keys))}
+	for _, key := range out.Keys {
+		resp.Keys = append(resp.Keys, &pb.JsonWebKey{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+	return resp
+}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -126,9 +128,10 @@ func (h *AuthBaseHandler) LoginAdmin(c *gin.Context) {
 	response, err := applicationService.GetCoreAuthService().LoginAdmin(
 		c,
 		&applicationModel.LoginInputAdmin{
-			UserName: request.UserName,
-			Password: request.Password,
-			ClientIp: c.ClientIP(),
+			UserName:  request.UserName,
+			Password:  request.Password,
+			ClientIp:  c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
 		},
 	)
 	if err != nil {
@@ -545,3 +548,116 @@ func (h *AuthBaseHandler) DeleteDeviceSession(c *gin.Context) {
 		nil,
 	)
 }
+
+// Unlock user locked by failed login
+// @Summary      Unlock user
+// @Description  Admin or manager unlock user locked by too many failed logins
+// @Tags         Core Auth
+// @Accept       json
+// @Produce      json
+// @Param        Authorization header string true "Authorization Bearer token"
+// @Param        request   body dto.UnlockUserRequest  true  "Request body unlock user"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/auth/unlock [post]
+func (h *AuthBaseHandler) UnlockUser(c *gin.Context) {
+	// Bind the request to the UnlockUserRequest DTO
+	var request dto.UnlockUserRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		interfaceResponse.BadRequestResponse(
+			c,
+			interfaceResponse.ErrCodeParamInvalid,
+			"Invalid request parameters",
+		)
+		return
+	}
+	// Validate the request
+	validate := c.MustGet(constants.MIDDLEWARE_VALIDATE_SERVICE_NAME).(*validator.Validate)
+	if err := validate.Struct(request); err != nil {
+		var fieldErrors []string
+		for _, fieldError := range err.(validator.ValidationErrors) {
+			fieldErrors = append(fieldErrors, fieldError.Field())
+		}
+		interfaceResponse.BadRequestResponse(
+			c,
+			interfaceResponse.ErrCodeParamInvalid,
+			"Invalid request parameters: "+strings.Join(fieldErrors, ", "),
+		)
+		return
+	}
+	// Get data auth from context
+	userIdStr, _, role, exists := utilsContext.GetSessionFromContext(c)
+	if !exists {
+		interfaceResponse.BadRequestResponse(
+			c,
+			interfaceResponse.ErrCodeParamInvalid,
+			"Invalid request parameters",
+		)
+		return
+	}
+	// Validate id str to uuid
+	userId, err := utilsUuid.ParseUUID(userIdStr)
+	if err != nil {
+		interfaceResponse.BadRequestResponse(
+			c,
+			interfaceResponse.ErrCodeParamInvalid,
+			"Invalid data session",
+		)
+		return
+	}
+	targetUserId, err := utilsUuid.ParseUUID(request.UserId)
+	if err != nil {
+		interfaceResponse.BadRequestResponse(
+			c,
+			interfaceResponse.ErrCodeParamInvalid,
+			"Invalid user id",
+		)
+		return
+	}
+	// Call handle to service
+	if err_r := applicationService.GetCoreAuthService().UnlockUser(
+		c,
+		&applicationModel.UnlockUserInput{
+			UserId:       userId,
+			Role:         role,
+			TargetUserId: targetUserId,
+			ClientIp:     c.ClientIP(),
+			UserAgent:    c.Request.UserAgent(),
+		},
+	); err_r != nil {
+		interfaceResponse.ErrorResponse(
+			c,
+			err_r.Code,
+			err_r.Message,
+		)
+		return
+	}
+	interfaceResponse.SuccessResponse(
+		c,
+		interfaceResponse.ErrCodeSuccess,
+		nil,
+	)
+}
+
+// Get public keys verify token
+// @Summary      JWKS
+// @Description  Public keys (JWK Set) used to verify access/device tokens signed by auth service, response is raw JWK Set
+// @Tags         Core Auth
+// @Produce      json
+// @Success      200  {object}  applicationModel.JwksOutput
+// @Failure      500  {object}  dto.ErrResponseData
+// @Router       /v1/auth/jwks [get]
+func (h *AuthBaseHandler) GetJwks(c *gin.Context) {
+	response, err := applicationService.GetTokenService().GetJwks(c)
+	if err != nil {
+		interfaceResponse.ErrorResponse(
+			c,
+			interfaceResponse.ErrInvalidToken,
+			"Cannot get jwks",
+		)
+		return
+	}
+	// Giữ nguyên định dạng JWK Set (RFC 7517) để thư viện JWT bên ngoài đọc được
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", constants.TTL_JWKS_CACHE))
+	c.JSON(http.StatusOK, response)
+}
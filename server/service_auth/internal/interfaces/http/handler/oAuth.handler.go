@@ -0,0 +1,283 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/model"
+	applicationService "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/service"
+	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/interfaces/dto"
+	interfaceResponse "github.com/youknow2509/cio_verify_face/server/service_auth/internal/interfaces/response"
+	utilsUuid "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/uuid"
+)
+
+/**
+ * OAuth handler
+ */
+type AuthOAuthHandler struct {
+}
+
+/**
+ * GetAuthOAuthHandler creates a Get instance of AuthOAuthHandler
+ */
+func GetAuthOAuthHandler() *AuthOAuthHandler {
+	return &AuthOAuthHandler{}
+}
+
+// Start SSO login
+// @Summary      SSO authorize
+// @Description  Start OpenID Connect login of company, redirect user to authorization_url
+// @Tags         OAuth
+// @Accept       json
+// @Produce      json
+// @Param        company_id   path string  true  "Company id"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/auth/oidc/authorize/{company_id} [get]
+func (h *AuthOAuthHandler) OidcAuthorize(c *gin.Context) {
+	companyId, err := utilsUuid.ParseUUID(c.Param("company_id"))
+	if err != nil {
+		interfaceResponse.BadRequestResponse(
+			c,
+			interfaceResponse.ErrCodeParamInvalid,
+			"Invalid company id",
+		)
+		return
+	}
+	// Call handle to service
+	response, err_r := applicationService.GetAuthOAuthService().OidcAuthorize(
+		c,
+		&applicationModel.OidcAuthorizeInput{
+			CompanyId: companyId,
+			ClientIp:  c.ClientIP(),
+		},
+	)
+	if err_r != nil {
+		interfaceResponse.ErrorResponse(
+			c,
+			err_r.Code,
+			err_r.Message,
+		)
+		return
+	}
+	interfaceResponse.SuccessResponse(
+		c,
+		interfaceResponse.ErrCodeSuccess,
+		response,
+	)
+}
+
+// Finish SSO login
+// @Summary      SSO callback
+// @Description  Exchange code and state returned by identity provider for access/refresh token
+// @Tags         OAuth
+// @Accept       json
+// @Produce      json
+// @Param        request   body dto.OidcCallbackRequest  true  "Request body sso callback"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/auth/oidc/callback [post]
+func (h *AuthOAuthHandler) OidcCallback(c *gin.Context) {
+	var request dto.OidcCallbackRequest
+	if !bindAndValidate(c, &request) {
+		return
+	}
+	// Call handle to service
+	response, err := applicationService.GetAuthOAuthService().OidcCallback(
+		c,
+		&applicationModel.OidcCallbackInput{
+			Code:      request.Code,
+			State:     request.State,
+			ClientIp:  c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+		},
+	)
+	if err != nil {
+		interfaceResponse.ErrorResponse(
+			c,
+			err.Code,
+			err.Message,
+		)
+		return
+	}
+	interfaceResponse.SuccessResponse(
+		c,
+		interfaceResponse.ErrCodeSuccess,
+		response,
+	)
+}
+
+// Get SSO config
+// @Summary      Get SSO config
+// @Description  Get OpenID Connect config of company, client secret is not returned
+// @Tags         OAuth
+// @Accept       json
+// @Produce      json
+// @Param        Authorization header string true "Authorization Bearer token"
+// @Param        company_id   query string  false  "Company id, required for system admin"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/auth/oidc/config [get]
+func (h *AuthOAuthHandler) GetOidcConfig(c *gin.Context) {
+	userId, role, ok := sessionUser(c)
+	if !ok {
+		return
+	}
+	companyIdReq, ok := queryCompanyId(c)
+	if !ok {
+		return
+	}
+	// Call handle to service
+	response, err := applicationService.GetAuthOAuthService().GetOidcConfig(
+		c,
+		&applicationModel.OidcConfigInput{
+			UserId:       userId,
+			Role:         role,
+			CompanyIdReq: companyIdReq,
+			ClientIp:     c.ClientIP(),
+			UserAgent:    c.Request.UserAgent(),
+		},
+	)
+	if err != nil {
+		interfaceResponse.ErrorResponse(
+			c,
+			err.Code,
+			err.Message,
+		)
+		return
+	}
+	interfaceResponse.SuccessResponse(
+		c,
+		interfaceResponse.ErrCodeSuccess,
+		response,
+	)
+}
+
+// Create or update SSO config
+// @Summary      Update SSO config
+// @Description  Create or update OpenID Connect config of company
+// @Tags         OAuth
+// @Accept       json
+// @Produce      json
+// @Param        Authorization header string true "Authorization Bearer token"
+// @Param        request   body dto.OidcConfigRequest  true  "Request body sso config"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/auth/oidc/config [put]
+func (h *AuthOAuthHandler) UpsertOidcConfig(c *gin.Context) {
+	var request dto.OidcConfigRequest
+	if !bindAndValidate(c, &request) {
+		return
+	}
+	userId, role, ok := sessionUser(c)
+	if !ok {
+		return
+	}
+	companyIdReq := uuid.Nil
+	if request.CompanyId != "" {
+		id, err := utilsUuid.ParseUUID(request.CompanyId)
+		if err != nil {
+			interfaceResponse.BadRequestResponse(
+				c,
+				interfaceResponse.ErrCodeParamInvalid,
+				"Invalid company id",
+			)
+			return
+		}
+		companyIdReq = id
+	}
+	// Call handle to service
+	response, err := applicationService.GetAuthOAuthService().UpsertOidcConfig(
+		c,
+		&applicationModel.UpsertOidcConfigInput{
+			UserId:       userId,
+			Role:         role,
+			CompanyIdReq: companyIdReq,
+			Issuer:       request.Issuer,
+			ClientId:     request.ClientId,
+			ClientSecret: request.ClientSecret,
+			Scopes:       request.Scopes,
+			EmailClaim:   request.EmailClaim,
+			RoleClaim:    request.RoleClaim,
+			RoleMapping:  request.RoleMapping,
+			IsEnabled:    request.IsEnabled,
+			ClientIp:     c.ClientIP(),
+			UserAgent:    c.Request.UserAgent(),
+		},
+	)
+	if err != nil {
+		interfaceResponse.ErrorResponse(
+			c,
+			err.Code,
+			err.Message,
+		)
+		return
+	}
+	interfaceResponse.SuccessResponse(
+		c,
+		interfaceResponse.ErrCodeSuccess,
+		response,
+	)
+}
+
+// Delete SSO config
+// @Summary      Delete SSO config
+// @Description  Delete OpenID Connect config of company
+// @Tags         OAuth
+// @Accept       json
+// @Produce      json
+// @Param        Authorization header string true "Authorization Bearer token"
+// @Param        company_id   query string  false  "Company id, required for system admin"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/auth/oidc/config [delete]
+func (h *AuthOAuthHandler) DeleteOidcConfig(c *gin.Context) {
+	userId, role, ok := sessionUser(c)
+	if !ok {
+		return
+	}
+	companyIdReq, ok := queryCompanyId(c)
+	if !ok {
+		return
+	}
+	// Call handle to service
+	if err := applicationService.GetAuthOAuthService().DeleteOidcConfig(
+		c,
+		&applicationModel.OidcConfigInput{
+			UserId:       userId,
+			Role:         role,
+			CompanyIdReq: companyIdReq,
+			ClientIp:     c.ClientIP(),
+			UserAgent:    c.Request.UserAgent(),
+		},
+	); err != nil {
+		interfaceResponse.ErrorResponse(
+			c,
+			err.Code,
+			err.Message,
+		)
+		return
+	}
+	interfaceResponse.SuccessResponse(
+		c,
+		interfaceResponse.ErrCodeSuccess,
+		nil,
+	)
+}
+
+// queryCompanyId đọc company_id (không bắt buộc) từ query, trả response lỗi khi sai định dạng
+func queryCompanyId(c *gin.Context) (uuid.UUID, bool) {
+	companyIdStr := c.Query("company_id")
+	if companyIdStr == "" {
+		return uuid.Nil, true
+	}
+	companyId, err := utilsUuid.ParseUUID(companyIdStr)
+	if err != nil {
+		interfaceResponse.BadRequestResponse(
+			c,
+			interfaceResponse.ErrCodeParamInvalid,
+			"Invalid company id",
+		)
+		return uuid.Nil, false
+	}
+	return companyId, true
+}
@@ -0,0 +1,373 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/model"
+	applicationService "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/service"
+	constants "github.com/youknow2509/cio_verify_face/server/service_auth/internal/constants"
+	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/interfaces/dto"
+	interfaceResponse "github.com/youknow2509/cio_verify_face/server/service_auth/internal/interfaces/response"
+	utilsContext "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/context"
+	utilsUuid "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/uuid"
+)
+
+/**
+ * Two factor auth handler
+ */
+type AuthTwoFactorHandler struct {
+}
+
+/**
+ * GetAuthTwoFactorHandler creates a Get instance of AuthTwoFactorHandler
+ */
+func GetAuthTwoFactorHandler() *AuthTwoFactorHandler {
+	return &AuthTwoFactorHandler{}
+}
+
+// Verify two factor code for admin login
+// @Summary      Admin login two factor
+// @Description  Complete admin login with TOTP code or recovery code using the challenge token from login admin
+// @Tags         Two Factor Auth
+// @Accept       json
+// @Produce      json
+// @Param        request   body dto.LoginTwoFactorRequest  true  "Request body login two factor"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/auth/login/2fa [post]
+func (h *AuthTwoFactorHandler) LoginVerify(c *gin.Context) {
+	var request dto.LoginTwoFactorRequest
+	if !bindAndValidate(c, &request) {
+		return
+	}
+	// Call handle to service
+	response, err := applicationService.GetAuthTwoFactorAuthService().LoginVerify(
+		c,
+		&applicationModel.LoginTwoFactorInput{
+			ChallengeToken: request.ChallengeToken,
+			Code:           request.Code,
+			ClientIp:       c.ClientIP(),
+			UserAgent:      c.Request.UserAgent(),
+		},
+	)
+	if err != nil {
+		interfaceResponse.ErrorResponse(
+			c,
+			err.Code,
+			err.Message,
+		)
+		return
+	}
+	interfaceResponse.SuccessResponse(
+		c,
+		interfaceResponse.ErrCodeSuccess,
+		response,
+	)
+}
+
+// Enroll two factor during admin login when policy requires it
+// @Summary      Admin login two factor enroll
+// @Description  Create TOTP secret with the challenge token when two factor is required but not set up
+// @Tags         Two Factor Auth
+// @Accept       json
+// @Produce      json
+// @Param        request   body dto.LoginTwoFactorEnrollRequest  true  "Request body login two factor enroll"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/auth/login/2fa/enroll [post]
+func (h *AuthTwoFactorHandler) LoginEnroll(c *gin.Context) {
+	var request dto.LoginTwoFactorEnrollRequest
+	if !bindAndValidate(c, &request) {
+		return
+	}
+	// Call handle to service
+	response, err := applicationService.GetAuthTwoFactorAuthService().LoginEnroll(
+		c,
+		&applicationModel.LoginTwoFactorEnrollInput{
+			ChallengeToken: request.ChallengeToken,
+			ClientIp:       c.ClientIP(),
+		},
+	)
+	if err != nil {
+		interfaceResponse.ErrorResponse(
+			c,
+			err.Code,
+			err.Message,
+		)
+		return
+	}
+	interfaceResponse.SuccessResponse(
+		c,
+		interfaceResponse.ErrCodeSuccess,
+		response,
+	)
+}
+
+// Get two factor status
+// @Summary      Two factor status
+// @Description  Get two factor status of current user
+// @Tags         Two Factor Auth
+// @Accept       json
+// @Produce      json
+// @Param        Authorization header string true "Authorization Bearer token"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/auth/2fa [get]
+func (h *AuthTwoFactorHandler) GetStatus(c *gin.Context) {
+	userId, role, ok := sessionUser(c)
+	if !ok {
+		return
+	}
+	// Call handle to service
+	response, err := applicationService.GetAuthTwoFactorAuthService().GetStatus(
+		c,
+		&applicationModel.TwoFactorEnrollInput{
+			UserId:    userId,
+			Role:      role,
+			ClientIp:  c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+		},
+	)
+	if err != nil {
+		interfaceResponse.ErrorResponse(
+			c,
+			err.Code,
+			err.Message,
+		)
+		return
+	}
+	interfaceResponse.SuccessResponse(
+		c,
+		interfaceResponse.ErrCodeSuccess,
+		response,
+	)
+}
+
+// Enroll two factor
+// @Summary      Two factor enroll
+// @Description  Create TOTP secret for current user, two factor is enabled after verify
+// @Tags         Two Factor Auth
+// @Accept       json
+// @Produce      json
+// @Param        Authorization header string true "Authorization Bearer token"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/auth/2fa/enroll [post]
+func (h *AuthTwoFactorHandler) Enroll(c *gin.Context) {
+	userId, role, ok := sessionUser(c)
+	if !ok {
+		return
+	}
+	// Call handle to service
+	response, err := applicationService.GetAuthTwoFactorAuthService().Enroll(
+		c,
+		&applicationModel.TwoFactorEnrollInput{
+			UserId:    userId,
+			Role:      role,
+			ClientIp:  c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+		},
+	)
+	if err != nil {
+		interfaceResponse.ErrorResponse(
+			c,
+			err.Code,
+			err.Message,
+		)
+		return
+	}
+	interfaceResponse.SuccessResponse(
+		c,
+		interfaceResponse.ErrCodeSuccess,
+		response,
+	)
+}
+
+// Verify two factor enrollment
+// @Summary      Two factor verify
+// @Description  Verify first TOTP code, enable two factor and return recovery codes
+// @Tags         Two Factor Auth
+// @Accept       json
+// @Produce      json
+// @Param        Authorization header string true "Authorization Bearer token"
+// @Param        request   body dto.TwoFactorCodeRequest  true  "Request body two factor code"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/auth/2fa/verify [post]
+func (h *AuthTwoFactorHandler) Verify(c *gin.Context) {
+	var request dto.TwoFactorCodeRequest
+	if !bindAndValidate(c, &request) {
+		return
+	}
+	userId, role, ok := sessionUser(c)
+	if !ok {
+		return
+	}
+	// Call handle to service
+	response, err := applicationService.GetAuthTwoFactorAuthService().Verify(
+		c,
+		&applicationModel.TwoFactorCodeInput{
+			UserId:    userId,
+			Role:      role,
+			Code:      request.Code,
+			ClientIp:  c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+		},
+	)
+	if err != nil {
+		interfaceResponse.ErrorResponse(
+			c,
+			err.Code,
+			err.Message,
+		)
+		return
+	}
+	interfaceResponse.SuccessResponse(
+		c,
+		interfaceResponse.ErrCodeSuccess,
+		response,
+	)
+}
+
+// Regenerate recovery codes
+// @Summary      Two factor recovery codes
+// @Description  Regenerate recovery codes, old codes are invalidated
+// @Tags         Two Factor Auth
+// @Accept       json
+// @Produce      json
+// @Param        Authorization header string true "Authorization Bearer token"
+// @Param        request   body dto.TwoFactorCodeRequest  true  "Request body two factor code"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/auth/2fa/recovery-codes [post]
+func (h *AuthTwoFactorHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	var request dto.TwoFactorCodeRequest
+	if !bindAndValidate(c, &request) {
+		return
+	}
+	userId, role, ok := sessionUser(c)
+	if !ok {
+		return
+	}
+	// Call handle to service
+	response, err := applicationService.GetAuthTwoFactorAuthService().RegenerateRecoveryCodes(
+		c,
+		&applicationModel.TwoFactorCodeInput{
+			UserId:    userId,
+			Role:      role,
+			Code:      request.Code,
+			ClientIp:  c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+		},
+	)
+	if err != nil {
+		interfaceResponse.ErrorResponse(
+			c,
+			err.Code,
+			err.Message,
+		)
+		return
+	}
+	interfaceResponse.SuccessResponse(
+		c,
+		interfaceResponse.ErrCodeSuccess,
+		response,
+	)
+}
+
+// Disable two factor
+// @Summary      Two factor disable
+// @Description  Disable two factor with TOTP code or recovery code, not allowed when required by policy
+// @Tags         Two Factor Auth
+// @Accept       json
+// @Produce      json
+// @Param        Authorization header string true "Authorization Bearer token"
+// @Param        request   body dto.TwoFactorCodeRequest  true  "Request body two factor code"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/auth/2fa/disable [post]
+func (h *AuthTwoFactorHandler) Disable(c *gin.Context) {
+	var request dto.TwoFactorCodeRequest
+	if !bindAndValidate(c, &request) {
+		return
+	}
+	userId, role, ok := sessionUser(c)
+	if !ok {
+		return
+	}
+	// Call handle to service
+	if err := applicationService.GetAuthTwoFactorAuthService().Disable(
+		c,
+		&applicationModel.TwoFactorCodeInput{
+			UserId:    userId,
+			Role:      role,
+			Code:      request.Code,
+			ClientIp:  c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+		},
+	); err != nil {
+		interfaceResponse.ErrorResponse(
+			c,
+			err.Code,
+			err.Message,
+		)
+		return
+	}
+	interfaceResponse.SuccessResponse(
+		c,
+		interfaceResponse.ErrCodeSuccess,
+		nil,
+	)
+}
+
+// bindAndValidate bind và validate request body, trả response lỗi khi không hợp lệ
+func bindAndValidate(c *gin.Context, request interface{}) bool {
+	if err := c.ShouldBindJSON(request); err != nil {
+		interfaceResponse.BadRequestResponse(
+			c,
+			interfaceResponse.ErrCodeParamInvalid,
+			"Invalid request parameters",
+		)
+		return false
+	}
+	validate := c.MustGet(constants.MIDDLEWARE_VALIDATE_SERVICE_NAME).(*validator.Validate)
+	if err := validate.Struct(request); err != nil {
+		var fieldErrors []string
+		for _, fieldError := range err.(validator.ValidationErrors) {
+			fieldErrors = append(fieldErrors, fieldError.Field())
+		}
+		interfaceResponse.BadRequestResponse(
+			c,
+			interfaceResponse.ErrCodeParamInvalid,
+			"Invalid request parameters: "+strings.Join(fieldErrors, ", "),
+		)
+		return false
+	}
+	return true
+}
+
+// sessionUser lấy user id và role từ session, trả response lỗi khi session không hợp lệ
+func sessionUser(c *gin.Context) (uuid.UUID, int, bool) {
+	userIdStr, _, role, exists := utilsContext.GetSessionFromContext(c)
+	if !exists {
+		interfaceResponse.BadRequestResponse(
+			c,
+			interfaceResponse.ErrCodeParamInvalid,
+			"Invalid request parameters",
+		)
+		return uuid.Nil, 0, false
+	}
+	userId, err := utilsUuid.ParseUUID(userIdStr)
+	if err != nil {
+		interfaceResponse.BadRequestResponse(
+			c,
+			interfaceResponse.ErrCodeParamInvalid,
+			"Invalid data session",
+		)
+		return uuid.Nil, 0, false
+	}
+	return userId, role, true
+}
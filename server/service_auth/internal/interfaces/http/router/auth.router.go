@@ -25,6 +25,16 @@ func (r *AuthRouter) InitializeCoreAuth(g *gin.RouterGroup) {
 		routerV1Public.POST("/login/admin", handler.GetAuthBaseHandler().LoginAdmin)
 		// Refresh token
 		routerV1Public.POST("/refresh", handler.GetAuthBaseHandler().RefreshToken)
+		// Login admin two factor
+		routerV1Public.POST("/login/2fa", handler.GetAuthTwoFactorHandler().LoginVerify)
+		// Login admin two factor enroll
+		routerV1Public.POST("/login/2fa/enroll", handler.GetAuthTwoFactorHandler().LoginEnroll)
+		// SSO login start
+		routerV1Public.GET("/oidc/authorize/:company_id", handler.GetAuthOAuthHandler().OidcAuthorize)
+		// SSO login callback
+		routerV1Public.POST("/oidc/callback", handler.GetAuthOAuthHandler().OidcCallback)
+		// Public keys verify token
+		routerV1Public.GET("/jwks", handler.GetAuthBaseHandler().GetJwks)
 	}
 	routerV1Private := g.Group("/v1/auth")
 	routerV1Private.Use(infraMiddleware.GetAuthAccessTokenJwtMiddleware().Apply())
@@ -37,6 +47,24 @@ func (r *AuthRouter) InitializeCoreAuth(g *gin.RouterGroup) {
 		routerV1Private.POST("/device", handler.GetAuthBaseHandler().UpdateDeviceSession)
 		// Delete device token
 		routerV1Private.DELETE("/device", handler.GetAuthBaseHandler().DeleteDeviceSession)
+		// Unlock user locked by failed login
+		routerV1Private.POST("/unlock", handler.GetAuthBaseHandler().UnlockUser)
+		// Two factor status
+		routerV1Private.GET("/2fa", handler.GetAuthTwoFactorHandler().GetStatus)
+		// Two factor enroll
+		routerV1Private.POST("/2fa/enroll", handler.GetAuthTwoFactorHandler().Enroll)
+		// Two factor verify enroll
+		routerV1Private.POST("/2fa/verify", handler.GetAuthTwoFactorHandler().Verify)
+		// Two factor regenerate recovery codes
+		routerV1Private.POST("/2fa/recovery-codes", handler.GetAuthTwoFactorHandler().RegenerateRecoveryCodes)
+		// Two factor disable
+		routerV1Private.POST("/2fa/disable", handler.GetAuthTwoFactorHandler().Disable)
+		// SSO config of company
+		routerV1Private.GET("/oidc/config", handler.GetAuthOAuthHandler().GetOidcConfig)
+		// Create or update SSO config of company
+		routerV1Private.PUT("/oidc/config", handler.GetAuthOAuthHandler().UpsertOidcConfig)
+		// Delete SSO config of company
+		routerV1Private.DELETE("/oidc/config", handler.GetAuthOAuthHandler().DeleteOidcConfig)
 	}
 }
 
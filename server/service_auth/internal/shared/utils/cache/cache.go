@@ -8,9 +8,9 @@ import "fmt"
 //
 // =================================
 
-// Key get status token device
-func GetKeyStatusTokenDevice(tokenHash string) string {
-	return fmt.Sprintf("device:token:status:%s", tokenHash)
+// Key get status token device, value "0" khi token id đã bị thu hồi
+func GetKeyStatusTokenDevice(tokenIdHash string) string {
+	return fmt.Sprintf("device:token:status:%s", tokenIdHash)
 }
 
 // Key get status token user
@@ -18,6 +18,36 @@ func GetKeyStatusTokenUser(tokenHash string) string {
 	return fmt.Sprintf("user:token:status:%s", tokenHash)
 }
 
+// Key count login fail of account
+func GetKeyCountLoginFail(mailHash string) string {
+	return fmt.Sprintf("user:login:fail:count:%s", mailHash)
+}
+
+// Key count login fail of client ip
+func GetKeyCountLoginFailIp(ipHash string) string {
+	return fmt.Sprintf("user:login:fail:ip:count:%s", ipHash)
+}
+
+// Key lock level of user, dùng để tăng dần thời gian khóa
+func GetKeyLoginLockLevel(userIdHash string) string {
+	return fmt.Sprintf("user:login:lock:level:%s", userIdHash)
+}
+
+// Key two factor login challenge
+func GetKeyTwoFactorChallenge(tokenHash string) string {
+	return fmt.Sprintf("user:login:2fa:challenge:%s", tokenHash)
+}
+
+// Key count verify two factor challenge fail
+func GetKeyCountTwoFactorChallengeFail(tokenHash string) string {
+	return fmt.Sprintf("user:login:2fa:challenge:fail:count:%s", tokenHash)
+}
+
+// Key oidc login state
+func GetKeyOidcState(stateHash string) string {
+	return fmt.Sprintf("user:login:oidc:state:%s", stateHash)
+}
+
 // Key user register OTP value
 func GetKeyUserRegisterOTP(mailHash string) string {
 	return fmt.Sprintf("user:register:otp:%s", mailHash)
@@ -3,6 +3,7 @@ package crypto
 import (
 	"crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/hex"
 
 )
@@ -33,8 +34,8 @@ func HashPasswordWithSalt(password, salt string) (string) {
 	return hex.EncodeToString(hassPassword[:])
 }
 
-// compare password with hashed password
+// compare password with hashed password (legacy), đăng nhập dùng domain password hasher
 func ComparePasswordWithHash(password, salt, hashedPasswordStore string) bool {
 	hassPassword := HashPasswordWithSalt(password, salt)
-	return hassPassword == hashedPasswordStore
+	return subtle.ConstantTimeCompare([]byte(hassPassword), []byte(hashedPasswordStore)) == 1
 }
\ No newline at end of file
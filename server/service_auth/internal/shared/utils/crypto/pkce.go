@@ -0,0 +1,12 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// PKCEChallengeS256 code_challenge của PKCE (RFC 7636): base64url(sha256(code_verifier)) không padding
+func PKCEChallengeS256(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
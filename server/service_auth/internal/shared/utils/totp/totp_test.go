@@ -0,0 +1,101 @@
+package totp
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+// Secret SHA1 của RFC 6238 phụ lục B: ASCII "12345678901234567890" dạng base32
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// Vector RFC 6238 (8 chữ số), mã 6 chữ số là 6 chữ số cuối
+var rfc6238Vectors = []struct {
+	unix int64
+	code string
+}{
+	{59, "287082"},          // 94287082
+	{1111111109, "081804"},  // 07081804
+	{1111111111, "050471"},  // 14050471
+	{1234567890, "005924"},  // 89005924
+	{2000000000, "279037"},  // 69279037
+	{20000000000, "353130"}, // 65353130
+}
+
+func TestGenerateCodeRFC6238(t *testing.T) {
+	for _, v := range rfc6238Vectors {
+		code, err := GenerateCode(rfc6238Secret, Step(time.Unix(v.unix, 0)))
+		if err != nil {
+			t.Fatalf("GenerateCode(%d) error = %v", v.unix, err)
+		}
+		if code != v.code {
+			t.Fatalf("GenerateCode(%d) = %s, want %s", v.unix, code, v.code)
+		}
+	}
+}
+
+func TestGenerateCodeLowercaseSecret(t *testing.T) {
+	code, err := GenerateCode(" gezdgnbvgy3tqojqgezdgnbvgy3tqojq ", Step(time.Unix(59, 0)))
+	if err != nil || code != "287082" {
+		t.Fatalf("GenerateCode() = %s, %v, want 287082", code, err)
+	}
+	if _, err := GenerateCode("not-base32!", 1); err == nil {
+		t.Fatal("GenerateCode() accepted an invalid secret")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	step := Step(now)
+	previous, _ := GenerateCode(rfc6238Secret, step-1)
+	next, _ := GenerateCode(rfc6238Secret, step+1)
+	tooOld, _ := GenerateCode(rfc6238Secret, step-2)
+
+	tests := []struct {
+		name     string
+		code     string
+		skew     int64
+		wantStep int64
+		wantOk   bool
+	}{
+		{"current step", "050471", 1, step, true},
+		{"trimmed", " 050471 ", 1, step, true},
+		{"previous step within skew", previous, 1, step - 1, true},
+		{"next step within skew", next, 1, step + 1, true},
+		{"outside skew", tooOld, 1, 0, false},
+		{"previous step without skew", previous, 0, 0, false},
+		{"wrong code", "000000", 1, 0, false},
+		{"wrong length", "05047", 1, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStep, gotOk := Validate(rfc6238Secret, tt.code, now, tt.skew)
+			if gotOk != tt.wantOk || gotStep != tt.wantStep {
+				t.Fatalf("Validate() = %d, %v, want %d, %v", gotStep, gotOk, tt.wantStep, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestGenerateSecretAndURI(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() error = %v", err)
+	}
+	key, err := encoding.DecodeString(secret)
+	if err != nil || len(key) != SecretSize {
+		t.Fatalf("GenerateSecret() = %q, decoded %d bytes, err %v", secret, len(key), err)
+	}
+
+	uri, err := url.Parse(URI("CIO Verify", "alice@example.com", secret))
+	if err != nil {
+		t.Fatalf("URI() not parseable: %v", err)
+	}
+	if uri.Scheme != "otpauth" || uri.Host != "totp" || uri.Path != "/CIO Verify:alice@example.com" {
+		t.Fatalf("URI() = %s", uri)
+	}
+	query := uri.Query()
+	if query.Get("secret") != secret || query.Get("issuer") != "CIO Verify" || query.Get("digits") != "6" || query.Get("period") != "30" {
+		t.Fatalf("URI() query = %v", query)
+	}
+}
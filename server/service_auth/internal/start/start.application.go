@@ -17,5 +17,15 @@ func initApplication() error {
 	if err := applicationService.SetTokenService(tokenServiceImpl); err != nil {
 		return err
 	}
+	// Init ITwoFactorAuthService
+	twoFactorAuthServiceImpl := applicationServiceImpl.NewTwoFactorAuthService()
+	if err := applicationService.SetAuthTwoFactorAuthService(twoFactorAuthServiceImpl); err != nil {
+		return err
+	}
+	// Init IOAuthService
+	oAuthServiceImpl := applicationServiceImpl.NewOAuthService()
+	if err := applicationService.SetAuthOAuthService(oAuthServiceImpl); err != nil {
+		return err
+	}
 	return nil
 }
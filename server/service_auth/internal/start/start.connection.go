@@ -2,6 +2,7 @@ package start
 
 import (
 	domainConfig "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/config"
+	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/global"
 	domainToken "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/token"
 	infraToken "github.com/youknow2509/cio_verify_face/server/service_auth/internal/infrastructure/token"
 	infraConn "github.com/youknow2509/cio_verify_face/server/service_auth/internal/infrastructure/conn"
@@ -23,12 +24,17 @@ func initConnectionToInfrastructure(setting *domainConfig.Setting) error {
 		return err
 	}
 	// initialize token service
-	_tokenService = infraToken.NewTokenService(
-		setting.JWT.Secret,
-		setting.JWT.Issuer,
-		setting.JWT.Subject,
-		setting.JWT.Audience,
-	)
+	tokenService, err := infraToken.NewTokenService(&setting.JWT)
+	if err != nil {
+		return err
+	}
+	if len(setting.JWT.Keys) == 0 {
+		global.Logger.Warn("jwt keys not configured, using ephemeral signing key - tokens are invalid after restart and across instances")
+	}
+	if setting.JWT.Secret != "" {
+		global.Logger.Warn("legacy HS256 jwt secret configured, remove it after secret_until", "secret_until", setting.JWT.SecretUntil)
+	}
+	_tokenService = tokenService
 	// v.v
 
 	return nil
@@ -1,10 +1,18 @@
 package start
 
 import (
+	"time"
+
+	domainConfig "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/config"
+	domainOidc "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/oidc"
+	domainPassword "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/password"
 	domainRepository "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/repository"
 	domainToken "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/token"
 	infraRepository "github.com/youknow2509/cio_verify_face/server/service_auth/internal/infrastructure/repository"
 	infraConn "github.com/youknow2509/cio_verify_face/server/service_auth/internal/infrastructure/conn"
+	infraOidc "github.com/youknow2509/cio_verify_face/server/service_auth/internal/infrastructure/oidc"
+	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/global"
+	"github.com/youknow2509/cio_verify_face/server/pkg/password"
 )
 
 func initDomain() error {
@@ -48,6 +56,49 @@ func initDomain() error {
 	); err != nil {
 		return err
 	}
+	// init ITwoFactorRepository
+	if err := domainRepository.SetTwoFactorRepository(
+		infraRepository.NewTwoFactorRepository(postgres),
+	); err != nil {
+		return err
+	}
+	// init IOidcRepository
+	if err := domainRepository.SetOidcRepository(
+		infraRepository.NewOidcRepository(postgres),
+	); err != nil {
+		return err
+	}
+	// init IOidcProvider
+	if err := domainOidc.SetOidcProvider(
+		infraOidc.NewOidcProvider(time.Duration(global.SettingServer.Oidc.HttpTimeout) * time.Second),
+	); err != nil {
+		return err
+	}
+	// init IPasswordHasher
+	hasher, err := password.New(toPasswordConfig(&global.SettingServer.Password))
+	if err != nil {
+		return err
+	}
+	if err := domainPassword.SetPasswordHasher(hasher); err != nil {
+		return err
+	}
 	// v.v
 	return nil
 }
+
+// toPasswordConfig map password setting to shared hasher config, trường bằng 0 dùng giá trị mặc định
+func toPasswordConfig(setting *domainConfig.PasswordSetting) password.Config {
+	return password.Config{
+		Algorithm: setting.Algorithm,
+		Argon2id: password.Argon2idConfig{
+			Memory:      setting.Argon2id.Memory,
+			Iterations:  setting.Argon2id.Iterations,
+			Parallelism: setting.Argon2id.Parallelism,
+			SaltLength:  setting.Argon2id.SaltLength,
+			KeyLength:   setting.Argon2id.KeyLength,
+		},
+		Bcrypt: password.BcryptConfig{
+			Cost: setting.Bcrypt.Cost,
+		},
+	}
+}
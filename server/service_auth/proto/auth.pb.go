@@ -2,7 +2,7 @@
 // versions:
 // 	protoc-gen-go v1.36.10
 // 	protoc        v6.33.0
-// source: proto/auth.proto
+// source: auth.proto
 
 package pb
 
@@ -32,7 +32,7 @@ type CreateUserTokenRequest struct {
 
 func (x *CreateUserTokenRequest) Reset() {
 	*x = CreateUserTokenRequest{}
-	mi := &file_proto_auth_proto_msgTypes[0]
+	mi := &file_auth_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -44,7 +44,7 @@ func (x *CreateUserTokenRequest) String() string {
 func (*CreateUserTokenRequest) ProtoMessage() {}
 
 func (x *CreateUserTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[0]
+	mi := &file_auth_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,7 +57,7 @@ func (x *CreateUserTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserTokenRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{0}
+	return file_auth_proto_rawDescGZIP(), []int{0}
 }
 
 func (x *CreateUserTokenRequest) GetUserId() string {
@@ -84,7 +84,7 @@ type CreateUserTokenResponse struct {
 
 func (x *CreateUserTokenResponse) Reset() {
 	*x = CreateUserTokenResponse{}
-	mi := &file_proto_auth_proto_msgTypes[1]
+	mi := &file_auth_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -96,7 +96,7 @@ func (x *CreateUserTokenResponse) String() string {
 func (*CreateUserTokenResponse) ProtoMessage() {}
 
 func (x *CreateUserTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[1]
+	mi := &file_auth_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -109,7 +109,7 @@ func (x *CreateUserTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserTokenResponse.ProtoReflect.Descriptor instead.
 func (*CreateUserTokenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{1}
+	return file_auth_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *CreateUserTokenResponse) GetAccessToken() string {
@@ -136,7 +136,7 @@ type CreateDeviceTokenRequest struct {
 
 func (x *CreateDeviceTokenRequest) Reset() {
 	*x = CreateDeviceTokenRequest{}
-	mi := &file_proto_auth_proto_msgTypes[2]
+	mi := &file_auth_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -148,7 +148,7 @@ func (x *CreateDeviceTokenRequest) String() string {
 func (*CreateDeviceTokenRequest) ProtoMessage() {}
 
 func (x *CreateDeviceTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[2]
+	mi := &file_auth_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -161,7 +161,7 @@ func (x *CreateDeviceTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDeviceTokenRequest.ProtoReflect.Descriptor instead.
 func (*CreateDeviceTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{2}
+	return file_auth_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateDeviceTokenRequest) GetDeviceId() string {
@@ -187,7 +187,7 @@ type CreateDeviceTokenResponse struct {
 
 func (x *CreateDeviceTokenResponse) Reset() {
 	*x = CreateDeviceTokenResponse{}
-	mi := &file_proto_auth_proto_msgTypes[3]
+	mi := &file_auth_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -199,7 +199,7 @@ func (x *CreateDeviceTokenResponse) String() string {
 func (*CreateDeviceTokenResponse) ProtoMessage() {}
 
 func (x *CreateDeviceTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[3]
+	mi := &file_auth_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,7 +212,7 @@ func (x *CreateDeviceTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDeviceTokenResponse.ProtoReflect.Descriptor instead.
 func (*CreateDeviceTokenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{3}
+	return file_auth_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateDeviceTokenResponse) GetToken() string {
@@ -231,7 +231,7 @@ type CreateServiceTokenRequest struct {
 
 func (x *CreateServiceTokenRequest) Reset() {
 	*x = CreateServiceTokenRequest{}
-	mi := &file_proto_auth_proto_msgTypes[4]
+	mi := &file_auth_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -243,7 +243,7 @@ func (x *CreateServiceTokenRequest) String() string {
 func (*CreateServiceTokenRequest) ProtoMessage() {}
 
 func (x *CreateServiceTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[4]
+	mi := &file_auth_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -256,7 +256,7 @@ func (x *CreateServiceTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateServiceTokenRequest.ProtoReflect.Descriptor instead.
 func (*CreateServiceTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{4}
+	return file_auth_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateServiceTokenRequest) GetServiceId() string {
@@ -275,7 +275,7 @@ type CreateServiceTokenResponse struct {
 
 func (x *CreateServiceTokenResponse) Reset() {
 	*x = CreateServiceTokenResponse{}
-	mi := &file_proto_auth_proto_msgTypes[5]
+	mi := &file_auth_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -287,7 +287,7 @@ func (x *CreateServiceTokenResponse) String() string {
 func (*CreateServiceTokenResponse) ProtoMessage() {}
 
 func (x *CreateServiceTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[5]
+	mi := &file_auth_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,7 +300,7 @@ func (x *CreateServiceTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateServiceTokenResponse.ProtoReflect.Descriptor instead.
 func (*CreateServiceTokenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{5}
+	return file_auth_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateServiceTokenResponse) GetToken() string {
@@ -319,7 +319,7 @@ type ParseUserTokenRequest struct {
 
 func (x *ParseUserTokenRequest) Reset() {
 	*x = ParseUserTokenRequest{}
-	mi := &file_proto_auth_proto_msgTypes[6]
+	mi := &file_auth_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -331,7 +331,7 @@ func (x *ParseUserTokenRequest) String() string {
 func (*ParseUserTokenRequest) ProtoMessage() {}
 
 func (x *ParseUserTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[6]
+	mi := &file_auth_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,7 +344,7 @@ func (x *ParseUserTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseUserTokenRequest.ProtoReflect.Descriptor instead.
 func (*ParseUserTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{6}
+	return file_auth_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ParseUserTokenRequest) GetToken() string {
@@ -367,7 +367,7 @@ type ParseUserTokenResponse struct {
 
 func (x *ParseUserTokenResponse) Reset() {
 	*x = ParseUserTokenResponse{}
-	mi := &file_proto_auth_proto_msgTypes[7]
+	mi := &file_auth_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -379,7 +379,7 @@ func (x *ParseUserTokenResponse) String() string {
 func (*ParseUserTokenResponse) ProtoMessage() {}
 
 func (x *ParseUserTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[7]
+	mi := &file_auth_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,7 +392,7 @@ func (x *ParseUserTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseUserTokenResponse.ProtoReflect.Descriptor instead.
 func (*ParseUserTokenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{7}
+	return file_auth_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ParseUserTokenResponse) GetUserId() string {
@@ -439,7 +439,7 @@ type ParseServiceTokenRequest struct {
 
 func (x *ParseServiceTokenRequest) Reset() {
 	*x = ParseServiceTokenRequest{}
-	mi := &file_proto_auth_proto_msgTypes[8]
+	mi := &file_auth_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -451,7 +451,7 @@ func (x *ParseServiceTokenRequest) String() string {
 func (*ParseServiceTokenRequest) ProtoMessage() {}
 
 func (x *ParseServiceTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[8]
+	mi := &file_auth_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -464,7 +464,7 @@ func (x *ParseServiceTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseServiceTokenRequest.ProtoReflect.Descriptor instead.
 func (*ParseServiceTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{8}
+	return file_auth_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ParseServiceTokenRequest) GetServiceId() string {
@@ -483,7 +483,7 @@ type ParseServiceTokenResponse struct {
 
 func (x *ParseServiceTokenResponse) Reset() {
 	*x = ParseServiceTokenResponse{}
-	mi := &file_proto_auth_proto_msgTypes[9]
+	mi := &file_auth_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -495,7 +495,7 @@ func (x *ParseServiceTokenResponse) String() string {
 func (*ParseServiceTokenResponse) ProtoMessage() {}
 
 func (x *ParseServiceTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[9]
+	mi := &file_auth_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,7 +508,7 @@ func (x *ParseServiceTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseServiceTokenResponse.ProtoReflect.Descriptor instead.
 func (*ParseServiceTokenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{9}
+	return file_auth_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ParseServiceTokenResponse) GetServiceId() string {
@@ -527,7 +527,7 @@ type ParseDeviceTokenRequest struct {
 
 func (x *ParseDeviceTokenRequest) Reset() {
 	*x = ParseDeviceTokenRequest{}
-	mi := &file_proto_auth_proto_msgTypes[10]
+	mi := &file_auth_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -539,7 +539,7 @@ func (x *ParseDeviceTokenRequest) String() string {
 func (*ParseDeviceTokenRequest) ProtoMessage() {}
 
 func (x *ParseDeviceTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[10]
+	mi := &file_auth_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +552,7 @@ func (x *ParseDeviceTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseDeviceTokenRequest.ProtoReflect.Descriptor instead.
 func (*ParseDeviceTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{10}
+	return file_auth_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ParseDeviceTokenRequest) GetToken() string {
@@ -574,7 +574,7 @@ type ParseDeviceTokenResponse struct {
 
 func (x *ParseDeviceTokenResponse) Reset() {
 	*x = ParseDeviceTokenResponse{}
-	mi := &file_proto_auth_proto_msgTypes[11]
+	mi := &file_auth_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -586,7 +586,7 @@ func (x *ParseDeviceTokenResponse) String() string {
 func (*ParseDeviceTokenResponse) ProtoMessage() {}
 
 func (x *ParseDeviceTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[11]
+	mi := &file_auth_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,7 +599,7 @@ func (x *ParseDeviceTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseDeviceTokenResponse.ProtoReflect.Descriptor instead.
 func (*ParseDeviceTokenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{11}
+	return file_auth_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ParseDeviceTokenResponse) GetDeviceId() string {
@@ -630,11 +630,216 @@ func (x *ParseDeviceTokenResponse) GetExpiresAt() int64 {
 	return 0
 }
 
-var File_proto_auth_proto protoreflect.FileDescriptor
+type BlockTokenDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	TokenId       string                 `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // unix seconds, 0 nếu không rõ
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockTokenDeviceRequest) Reset() {
+	*x = BlockTokenDeviceRequest{}
+	mi := &file_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockTokenDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockTokenDeviceRequest) ProtoMessage() {}
+
+func (x *BlockTokenDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockTokenDeviceRequest.ProtoReflect.Descriptor instead.
+func (*BlockTokenDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BlockTokenDeviceRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *BlockTokenDeviceRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *BlockTokenDeviceRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type JsonWebKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kty           string                 `protobuf:"bytes,1,opt,name=kty,proto3" json:"kty,omitempty"`
+	Kid           string                 `protobuf:"bytes,2,opt,name=kid,proto3" json:"kid,omitempty"`
+	Use           string                 `protobuf:"bytes,3,opt,name=use,proto3" json:"use,omitempty"`
+	Alg           string                 `protobuf:"bytes,4,opt,name=alg,proto3" json:"alg,omitempty"`
+	N             string                 `protobuf:"bytes,5,opt,name=n,proto3" json:"n,omitempty"`
+	E             string                 `protobuf:"bytes,6,opt,name=e,proto3" json:"e,omitempty"`
+	Crv           string                 `protobuf:"bytes,7,opt,name=crv,proto3" json:"crv,omitempty"`
+	X             string                 `protobuf:"bytes,8,opt,name=x,proto3" json:"x,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JsonWebKey) Reset() {
+	*x = JsonWebKey{}
+	mi := &file_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JsonWebKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JsonWebKey) ProtoMessage() {}
+
+func (x *JsonWebKey) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JsonWebKey.ProtoReflect.Descriptor instead.
+func (*JsonWebKey) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *JsonWebKey) GetKty() string {
+	if x != nil {
+		return x.Kty
+	}
+	return ""
+}
+
+func (x *JsonWebKey) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *JsonWebKey) GetUse() string {
+	if x != nil {
+		return x.Use
+	}
+	return ""
+}
+
+func (x *JsonWebKey) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *JsonWebKey) GetN() string {
+	if x != nil {
+		return x.N
+	}
+	return ""
+}
+
+func (x *JsonWebKey) GetE() string {
+	if x != nil {
+		return x.E
+	}
+	return ""
+}
+
+func (x *JsonWebKey) GetCrv() string {
+	if x != nil {
+		return x.Crv
+	}
+	return ""
+}
+
+func (x *JsonWebKey) GetX() string {
+	if x != nil {
+		return x.X
+	}
+	return ""
+}
+
+type GetJwksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*JsonWebKey          `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJwksResponse) Reset() {
+	*x = GetJwksResponse{}
+	mi := &file_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJwksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJwksResponse) ProtoMessage() {}
+
+func (x *GetJwksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJwksResponse.ProtoReflect.Descriptor instead.
+func (*GetJwksResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetJwksResponse) GetKeys() []*JsonWebKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
 
-const file_proto_auth_proto_rawDesc = "" +
+var File_auth_proto protoreflect.FileDescriptor
+
+const file_auth_proto_rawDesc = "" +
+	"\n" +
 	"\n" +
-	"\x10proto/auth.proto\x12\x04auth\x1a\x1bgoogle/protobuf/empty.proto\"G\n" +
+	"auth.proto\x12\x04auth\x1a\x1bgoogle/protobuf/empty.proto\"G\n" +
 	"\x16CreateUserTokenRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05roles\x18\x02 \x01(\x05R\x05roles\"a\n" +
@@ -676,30 +881,49 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\n" +
 	"company_id\x18\x03 \x01(\tR\tcompanyId\x12\x1d\n" +
 	"\n" +
-	"expires_at\x18\x04 \x01(\x03R\texpiresAt2\xc1\x04\n" +
+	"expires_at\x18\x04 \x01(\x03R\texpiresAt\"p\n" +
+	"\x17BlockTokenDeviceRequest\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\"\x90\x01\n" +
+	"\n" +
+	"JsonWebKey\x12\x10\n" +
+	"\x03kty\x18\x01 \x01(\tR\x03kty\x12\x10\n" +
+	"\x03kid\x18\x02 \x01(\tR\x03kid\x12\x10\n" +
+	"\x03use\x18\x03 \x01(\tR\x03use\x12\x10\n" +
+	"\x03alg\x18\x04 \x01(\tR\x03alg\x12\f\n" +
+	"\x01n\x18\x05 \x01(\tR\x01n\x12\f\n" +
+	"\x01e\x18\x06 \x01(\tR\x01e\x12\x10\n" +
+	"\x03crv\x18\a \x01(\tR\x03crv\x12\f\n" +
+	"\x01x\x18\b \x01(\tR\x01x\"7\n" +
+	"\x0fGetJwksResponse\x12$\n" +
+	"\x04keys\x18\x01 \x03(\v2\x10.auth.JsonWebKeyR\x04keys2\xc6\x05\n" +
 	"\vAuthService\x12N\n" +
 	"\x0fCreateUserToken\x12\x1c.auth.CreateUserTokenRequest\x1a\x1d.auth.CreateUserTokenResponse\x12W\n" +
 	"\x12CreateServiceToken\x12\x1f.auth.CreateServiceTokenRequest\x1a .auth.CreateServiceTokenResponse\x12T\n" +
 	"\x11CreateDeviceToken\x12\x1e.auth.CreateDeviceTokenRequest\x1a\x1f.auth.CreateDeviceTokenResponse\x12K\n" +
 	"\x0eParseUserToken\x12\x1b.auth.ParseUserTokenRequest\x1a\x1c.auth.ParseUserTokenResponse\x12T\n" +
 	"\x11ParseServiceToken\x12\x1e.auth.ParseServiceTokenRequest\x1a\x1f.auth.ParseServiceTokenResponse\x12Q\n" +
-	"\x10ParseDeviceToken\x12\x1d.auth.ParseDeviceTokenRequest\x1a\x1e.auth.ParseDeviceTokenResponse\x12=\n" +
+	"\x10ParseDeviceToken\x12\x1d.auth.ParseDeviceTokenRequest\x1a\x1e.auth.ParseDeviceTokenResponse\x12I\n" +
+	"\x10BlockTokenDevice\x12\x1d.auth.BlockTokenDeviceRequest\x1a\x16.google.protobuf.Empty\x128\n" +
+	"\aGetJwks\x12\x16.google.protobuf.Empty\x1a\x15.auth.GetJwksResponse\x12=\n" +
 	"\vHealthCheck\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.EmptyBEZCgithub.com/youknow2509/cio_verify_face/server/service_auth/proto/pbb\x06proto3"
 
 var (
-	file_proto_auth_proto_rawDescOnce sync.Once
-	file_proto_auth_proto_rawDescData []byte
+	file_auth_proto_rawDescOnce sync.Once
+	file_auth_proto_rawDescData []byte
 )
 
-func file_proto_auth_proto_rawDescGZIP() []byte {
-	file_proto_auth_proto_rawDescOnce.Do(func() {
-		file_proto_auth_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_auth_proto_rawDesc), len(file_proto_auth_proto_rawDesc)))
+func file_auth_proto_rawDescGZIP() []byte {
+	file_auth_proto_rawDescOnce.Do(func() {
+		file_auth_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_auth_proto_rawDesc), len(file_auth_proto_rawDesc)))
 	})
-	return file_proto_auth_proto_rawDescData
+	return file_auth_proto_rawDescData
 }
 
-var file_proto_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
-var file_proto_auth_proto_goTypes = []any{
+var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_auth_proto_goTypes = []any{
 	(*CreateUserTokenRequest)(nil),     // 0: auth.CreateUserTokenRequest
 	(*CreateUserTokenResponse)(nil),    // 1: auth.CreateUserTokenResponse
 	(*CreateDeviceTokenRequest)(nil),   // 2: auth.CreateDeviceTokenRequest
@@ -712,50 +936,58 @@ var file_proto_auth_proto_goTypes = []any{
 	(*ParseServiceTokenResponse)(nil),  // 9: auth.ParseServiceTokenResponse
 	(*ParseDeviceTokenRequest)(nil),    // 10: auth.ParseDeviceTokenRequest
 	(*ParseDeviceTokenResponse)(nil),   // 11: auth.ParseDeviceTokenResponse
-	(*emptypb.Empty)(nil),              // 12: google.protobuf.Empty
-}
-var file_proto_auth_proto_depIdxs = []int32{
-	0,  // 0: auth.AuthService.CreateUserToken:input_type -> auth.CreateUserTokenRequest
-	4,  // 1: auth.AuthService.CreateServiceToken:input_type -> auth.CreateServiceTokenRequest
-	2,  // 2: auth.AuthService.CreateDeviceToken:input_type -> auth.CreateDeviceTokenRequest
-	6,  // 3: auth.AuthService.ParseUserToken:input_type -> auth.ParseUserTokenRequest
-	8,  // 4: auth.AuthService.ParseServiceToken:input_type -> auth.ParseServiceTokenRequest
-	10, // 5: auth.AuthService.ParseDeviceToken:input_type -> auth.ParseDeviceTokenRequest
-	12, // 6: auth.AuthService.HealthCheck:input_type -> google.protobuf.Empty
-	1,  // 7: auth.AuthService.CreateUserToken:output_type -> auth.CreateUserTokenResponse
-	5,  // 8: auth.AuthService.CreateServiceToken:output_type -> auth.CreateServiceTokenResponse
-	3,  // 9: auth.AuthService.CreateDeviceToken:output_type -> auth.CreateDeviceTokenResponse
-	7,  // 10: auth.AuthService.ParseUserToken:output_type -> auth.ParseUserTokenResponse
-	9,  // 11: auth.AuthService.ParseServiceToken:output_type -> auth.ParseServiceTokenResponse
-	11, // 12: auth.AuthService.ParseDeviceToken:output_type -> auth.ParseDeviceTokenResponse
-	12, // 13: auth.AuthService.HealthCheck:output_type -> google.protobuf.Empty
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
-}
-
-func init() { file_proto_auth_proto_init() }
-func file_proto_auth_proto_init() {
-	if File_proto_auth_proto != nil {
+	(*BlockTokenDeviceRequest)(nil),    // 12: auth.BlockTokenDeviceRequest
+	(*JsonWebKey)(nil),                 // 13: auth.JsonWebKey
+	(*GetJwksResponse)(nil),            // 14: auth.GetJwksResponse
+	(*emptypb.Empty)(nil),              // 15: google.protobuf.Empty
+}
+var file_auth_proto_depIdxs = []int32{
+	13, // 0: auth.GetJwksResponse.keys:type_name -> auth.JsonWebKey
+	0,  // 1: auth.AuthService.CreateUserToken:input_type -> auth.CreateUserTokenRequest
+	4,  // 2: auth.AuthService.CreateServiceToken:input_type -> auth.CreateServiceTokenRequest
+	2,  // 3: auth.AuthService.CreateDeviceToken:input_type -> auth.CreateDeviceTokenRequest
+	6,  // 4: auth.AuthService.ParseUserToken:input_type -> auth.ParseUserTokenRequest
+	8,  // 5: auth.AuthService.ParseServiceToken:input_type -> auth.ParseServiceTokenRequest
+	10, // 6: auth.AuthService.ParseDeviceToken:input_type -> auth.ParseDeviceTokenRequest
+	12, // 7: auth.AuthService.BlockTokenDevice:input_type -> auth.BlockTokenDeviceRequest
+	15, // 8: auth.AuthService.GetJwks:input_type -> google.protobuf.Empty
+	15, // 9: auth.AuthService.HealthCheck:input_type -> google.protobuf.Empty
+	1,  // 10: auth.AuthService.CreateUserToken:output_type -> auth.CreateUserTokenResponse
+	5,  // 11: auth.AuthService.CreateServiceToken:output_type -> auth.CreateServiceTokenResponse
+	3,  // 12: auth.AuthService.CreateDeviceToken:output_type -> auth.CreateDeviceTokenResponse
+	7,  // 13: auth.AuthService.ParseUserToken:output_type -> auth.ParseUserTokenResponse
+	9,  // 14: auth.AuthService.ParseServiceToken:output_type -> auth.ParseServiceTokenResponse
+	11, // 15: auth.AuthService.ParseDeviceToken:output_type -> auth.ParseDeviceTokenResponse
+	15, // 16: auth.AuthService.BlockTokenDevice:output_type -> google.protobuf.Empty
+	14, // 17: auth.AuthService.GetJwks:output_type -> auth.GetJwksResponse
+	15, // 18: auth.AuthService.HealthCheck:output_type -> google.protobuf.Empty
+	10, // [10:19] is the sub-list for method output_type
+	1,  // [1:10] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_auth_proto_init() }
+func file_auth_proto_init() {
+	if File_auth_proto != nil {
 		return
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_auth_proto_rawDesc), len(file_proto_auth_proto_rawDesc)),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_proto_rawDesc), len(file_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
-		GoTypes:           file_proto_auth_proto_goTypes,
-		DependencyIndexes: file_proto_auth_proto_depIdxs,
-		MessageInfos:      file_proto_auth_proto_msgTypes,
+		GoTypes:           file_auth_proto_goTypes,
+		DependencyIndexes: file_auth_proto_depIdxs,
+		MessageInfos:      file_auth_proto_msgTypes,
 	}.Build()
-	File_proto_auth_proto = out.File
-	file_proto_auth_proto_goTypes = nil
-	file_proto_auth_proto_depIdxs = nil
+	File_auth_proto = out.File
+	file_auth_proto_goTypes = nil
+	file_auth_proto_depIdxs = nil
 }
@@ -2,7 +2,7 @@
 // versions:
 // - protoc-gen-go-grpc v1.6.0
 // - protoc             v6.33.0
-// source: proto/auth.proto
+// source: auth.proto
 
 package pb
 
@@ -26,6 +26,8 @@ const (
 	AuthService_ParseUserToken_FullMethodName     = "/auth.AuthService/ParseUserToken"
 	AuthService_ParseServiceToken_FullMethodName  = "/auth.AuthService/ParseServiceToken"
 	AuthService_ParseDeviceToken_FullMethodName   = "/auth.AuthService/ParseDeviceToken"
+	AuthService_BlockTokenDevice_FullMethodName   = "/auth.AuthService/BlockTokenDevice"
+	AuthService_GetJwks_FullMethodName            = "/auth.AuthService/GetJwks"
 	AuthService_HealthCheck_FullMethodName        = "/auth.AuthService/HealthCheck"
 )
 
@@ -41,6 +43,10 @@ type AuthServiceClient interface {
 	ParseUserToken(ctx context.Context, in *ParseUserTokenRequest, opts ...grpc.CallOption) (*ParseUserTokenResponse, error)
 	ParseServiceToken(ctx context.Context, in *ParseServiceTokenRequest, opts ...grpc.CallOption) (*ParseServiceTokenResponse, error)
 	ParseDeviceToken(ctx context.Context, in *ParseDeviceTokenRequest, opts ...grpc.CallOption) (*ParseDeviceTokenResponse, error)
+	// Thu hồi device token (token id bị chặn tới khi hết hạn)
+	BlockTokenDevice(ctx context.Context, in *BlockTokenDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Public keys used to verify tokens (JWK Set), service khác cache để tự xác thực token
+	GetJwks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetJwksResponse, error)
 	HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
@@ -112,6 +118,26 @@ func (c *authServiceClient) ParseDeviceToken(ctx context.Context, in *ParseDevic
 	return out, nil
 }
 
+func (c *authServiceClient) BlockTokenDevice(ctx context.Context, in *BlockTokenDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_BlockTokenDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetJwks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetJwksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJwksResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetJwks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -134,6 +160,10 @@ type AuthServiceServer interface {
 	ParseUserToken(context.Context, *ParseUserTokenRequest) (*ParseUserTokenResponse, error)
 	ParseServiceToken(context.Context, *ParseServiceTokenRequest) (*ParseServiceTokenResponse, error)
 	ParseDeviceToken(context.Context, *ParseDeviceTokenRequest) (*ParseDeviceTokenResponse, error)
+	// Thu hồi device token (token id bị chặn tới khi hết hạn)
+	BlockTokenDevice(context.Context, *BlockTokenDeviceRequest) (*emptypb.Empty, error)
+	// Public keys used to verify tokens (JWK Set), service khác cache để tự xác thực token
+	GetJwks(context.Context, *emptypb.Empty) (*GetJwksResponse, error)
 	HealthCheck(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
@@ -163,6 +193,12 @@ func (UnimplementedAuthServiceServer) ParseServiceToken(context.Context, *ParseS
 func (UnimplementedAuthServiceServer) ParseDeviceToken(context.Context, *ParseDeviceTokenRequest) (*ParseDeviceTokenResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ParseDeviceToken not implemented")
 }
+func (UnimplementedAuthServiceServer) BlockTokenDevice(context.Context, *BlockTokenDeviceRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method BlockTokenDevice not implemented")
+}
+func (UnimplementedAuthServiceServer) GetJwks(context.Context, *emptypb.Empty) (*GetJwksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetJwks not implemented")
+}
 func (UnimplementedAuthServiceServer) HealthCheck(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -295,6 +331,42 @@ func _AuthService_ParseDeviceToken_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_BlockTokenDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockTokenDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).BlockTokenDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_BlockTokenDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).BlockTokenDevice(ctx, req.(*BlockTokenDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetJwks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetJwks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetJwks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetJwks(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -344,11 +416,19 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ParseDeviceToken",
 			Handler:    _AuthService_ParseDeviceToken_Handler,
 		},
+		{
+			MethodName: "BlockTokenDevice",
+			Handler:    _AuthService_BlockTokenDevice_Handler,
+		},
+		{
+			MethodName: "GetJwks",
+			Handler:    _AuthService_GetJwks_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _AuthService_HealthCheck_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/auth.proto",
+	Metadata: "auth.proto",
 }
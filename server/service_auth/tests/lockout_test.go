@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	applicationErrors "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/errors"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/model"
+	applicationServiceImpl "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/service/impl"
+	constants "github.com/youknow2509/cio_verify_face/server/service_auth/internal/constants"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/model"
+	domainPassword "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/password"
+)
+
+const lockoutTestPassword = "correct-password"
+
+// newLockoutUser tạo user mới trong công ty test để bộ đếm sai không dùng chung giữa các test
+func newLockoutUser(t *testing.T, env *oidcEnv, email string) *domainModel.UserBaseInfoOutput {
+	t.Helper()
+	hash, err := domainPassword.GetPasswordHasher().Hash(lockoutTestPassword)
+	if err != nil {
+		t.Fatalf("Hash: %v", err)
+	}
+	user := &domainModel.UserBaseInfoOutput{
+		UserID:       uuid.NewString(),
+		UserEmail:    email,
+		UserPassword: hash,
+		Role:         domainModel.RoleUser,
+	}
+	env.users.addUser(user)
+	env.companies.companyOf[uuid.MustParse(user.UserID)] = oidcTestCompanyId
+	return user
+}
+
+func loginWithPassword(user *domainModel.UserBaseInfoOutput, password string, clientIp string) (*applicationModel.LoginOutput, *applicationErrors.Error) {
+	return applicationServiceImpl.NewCoreAuthService().Login(context.Background(), &applicationModel.LoginInput{
+		UserName:  user.UserEmail,
+		Password:  password,
+		ClientIp:  clientIp,
+		UserAgent: "lockout-test",
+	})
+}
+
+// failLogins đăng nhập sai n lần, tất cả phải trả về sai mật khẩu
+func failLogins(t *testing.T, user *domainModel.UserBaseInfoOutput, n int, clientIp string) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		_, errApp := loginWithPassword(user, "wrong-password", clientIp)
+		expectErrorCode(t, errApp, applicationErrors.UserPasswordIncorrectErrorCode)
+	}
+}
+
+// Test account is locked exactly at the threshold and stays locked for the correct password
+func TestLoginLockoutThreshold(t *testing.T) {
+	env := setupOidcEnv(t)
+	user := newLockoutUser(t, env, "lockout.threshold@example.com")
+	clientIp := "10.0.22.1"
+
+	failLogins(t, user, constants.RateLimitLoginFailPerAccount-1, clientIp)
+	_, errApp := loginWithPassword(user, "wrong-password", clientIp)
+	expectErrorCode(t, errApp, applicationErrors.UserBlockedErrorCode)
+
+	if !user.IsBlocked || user.LockExpiresAt == nil {
+		t.Fatalf("user lock = %v, %v, want locked with expiry", user.IsBlocked, user.LockExpiresAt)
+	}
+	wantExpiry := time.Now().Add(time.Duration(constants.TTL_LOGIN_LOCK_BASE) * time.Second)
+	if diff := user.LockExpiresAt.Sub(wantExpiry); diff > time.Minute || diff < -time.Minute {
+		t.Fatalf("lock expires at %v, want about %v", user.LockExpiresAt, wantExpiry)
+	}
+	_, errApp = loginWithPassword(user, lockoutTestPassword, clientIp)
+	expectErrorCode(t, errApp, applicationErrors.UserBlockedErrorCode)
+
+	if !slices.Contains(env.audits.actions(uuid.MustParse(user.UserID)), constants.AuditActionLockUser) {
+		t.Fatal("lock audit log not written")
+	}
+}
+
+// Test failed attempts outside the counting window do not add up to a lock
+func TestLoginLockoutWindowExpiry(t *testing.T) {
+	env := setupOidcEnv(t)
+	user := newLockoutUser(t, env, "lockout.window@example.com")
+	clientIp := "10.0.22.2"
+
+	failLogins(t, user, constants.RateLimitLoginFailPerAccount-1, clientIp)
+	env.cache.advance(time.Duration(constants.TTL_COUNT_LOGIN_FAIL) * time.Second)
+	failLogins(t, user, constants.RateLimitLoginFailPerAccount-1, clientIp)
+	if user.IsBlocked {
+		t.Fatal("user locked although failures were in different windows")
+	}
+
+	output, errApp := loginWithPassword(user, lockoutTestPassword, clientIp)
+	if errApp != nil {
+		t.Fatalf("Login: %d %s", errApp.Code, errApp.Message)
+	}
+	env.parseAccessToken(t, output)
+}
+
+// Test only admin or a manager of the user's company can unlock, unlock resets the counter
+func TestUnlockUser(t *testing.T) {
+	env := setupOidcEnv(t)
+	user := newLockoutUser(t, env, "lockout.unlock@example.com")
+	userId := uuid.MustParse(user.UserID)
+	clientIp := "10.0.22.3"
+	service := applicationServiceImpl.NewCoreAuthService()
+
+	failLogins(t, user, constants.RateLimitLoginFailPerAccount-1, clientIp)
+	_, errApp := loginWithPassword(user, "wrong-password", clientIp)
+	expectErrorCode(t, errApp, applicationErrors.UserBlockedErrorCode)
+
+	denied := []struct {
+		name   string
+		userId uuid.UUID
+		role   int
+	}{
+		{"employee", uuid.MustParse(env.employee.UserID), domainModel.RoleUser},
+		{"manager of other company", uuid.MustParse(env.outsider.UserID), domainModel.RoleManager},
+	}
+	for _, tt := range denied {
+		errApp := service.UnlockUser(context.Background(), &applicationModel.UnlockUserInput{
+			UserId:       tt.userId,
+			Role:         tt.role,
+			TargetUserId: userId,
+		})
+		expectErrorCode(t, errApp, applicationErrors.AuthDontHavePermissionErrorCode)
+		if !user.IsBlocked {
+			t.Fatalf("%s unlocked the user", tt.name)
+		}
+	}
+
+	if errApp := service.UnlockUser(context.Background(), &applicationModel.UnlockUserInput{
+		UserId:       uuid.MustParse(env.manager.UserID),
+		Role:         domainModel.RoleManager,
+		TargetUserId: userId,
+	}); errApp != nil {
+		t.Fatalf("UnlockUser: %d %s", errApp.Code, errApp.Message)
+	}
+	if user.IsBlocked || user.LockExpiresAt != nil {
+		t.Fatalf("user lock = %v, %v, want unlocked", user.IsBlocked, user.LockExpiresAt)
+	}
+	if !slices.Contains(env.audits.actions(userId), constants.AuditActionUnlockUser) {
+		t.Fatal("unlock audit log not written")
+	}
+
+	// Bộ đếm đã được xóa: lần sai tiếp theo chưa khóa lại tài khoản
+	failLogins(t, user, 1, clientIp)
+	output, errApp := loginWithPassword(user, lockoutTestPassword, clientIp)
+	if errApp != nil {
+		t.Fatalf("Login after unlock: %d %s", errApp.Code, errApp.Message)
+	}
+	env.parseAccessToken(t, output)
+
+	// Admin mở khóa được mọi tài khoản
+	failLogins(t, user, constants.RateLimitLoginFailPerAccount-1, clientIp)
+	_, errApp = loginWithPassword(user, "wrong-password", clientIp)
+	expectErrorCode(t, errApp, applicationErrors.UserBlockedErrorCode)
+	if errApp := service.UnlockUser(context.Background(), &applicationModel.UnlockUserInput{
+		UserId:       uuid.New(),
+		Role:         domainModel.RoleAdmin,
+		TargetUserId: userId,
+	}); errApp != nil {
+		t.Fatalf("admin UnlockUser: %d %s", errApp.Code, errApp.Message)
+	}
+	if user.IsBlocked {
+		t.Fatal("admin unlock did not unlock the user")
+	}
+}
@@ -0,0 +1,812 @@
+package tests
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/youknow2509/cio_verify_face/server/pkg/password"
+	applicationErrors "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/errors"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/model"
+	applicationService "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/service"
+	applicationServiceImpl "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/service/impl"
+	domainCache "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/cache"
+	domainConfig "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/config"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/model"
+	domainOidc "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/oidc"
+	domainPassword "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/password"
+	domainRepository "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/repository"
+	domainToken "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/token"
+	"github.com/youknow2509/cio_verify_face/server/service_auth/internal/global"
+	infraOidc "github.com/youknow2509/cio_verify_face/server/service_auth/internal/infrastructure/oidc"
+	infraToken "github.com/youknow2509/cio_verify_face/server/service_auth/internal/infrastructure/token"
+)
+
+const (
+	oidcTestClientId     = "cio-verify-face"
+	oidcTestClientSecret = "idp-client-secret"
+	oidcTestRedirectUri  = "http://localhost:3000/auth/oidc/callback"
+)
+
+var (
+	oidcTestCompanyId = uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	oidcTestOtherId   = uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	oidcTestEnv       *oidcEnv
+	oidcTestEnvOnce   sync.Once
+)
+
+// ======================================
+//
+//	Mock identity provider
+//
+// ======================================
+type mockIdpLogin struct {
+	Email  string
+	Groups []string
+	Nonce  string // Ghi đè nonce trong ID token, rỗng thì dùng nonce của request
+}
+
+type mockIdpCode struct {
+	login       mockIdpLogin
+	nonce       string
+	challenge   string
+	redirectUri string
+}
+
+type mockIdp struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	mu     sync.Mutex
+	login  mockIdpLogin
+	codes  map[string]mockIdpCode
+}
+
+func newMockIdp(t *testing.T) *mockIdp {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate idp key: %v", err)
+	}
+	idp := &mockIdp{key: key, codes: map[string]mockIdpCode{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.server.URL,
+			"authorization_endpoint": idp.server.URL + "/authorize",
+			"token_endpoint":         idp.server.URL + "/token",
+			"jwks_uri":               idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "test-key",
+				"use": "sig",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("response_type") != "code" || q.Get("client_id") != oidcTestClientId ||
+			q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" || q.Get("nonce") == "" {
+			http.Error(w, "invalid_request", http.StatusBadRequest)
+			return
+		}
+		code := uuid.NewString()
+		idp.mu.Lock()
+		idp.codes[code] = mockIdpCode{
+			login:       idp.login,
+			nonce:       q.Get("nonce"),
+			challenge:   q.Get("code_challenge"),
+			redirectUri: q.Get("redirect_uri"),
+		}
+		idp.mu.Unlock()
+		http.Redirect(w, r, q.Get("redirect_uri")+"?code="+code+"&state="+url.QueryEscape(q.Get("state")), http.StatusFound)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		idp.mu.Lock()
+		grant, ok := idp.codes[r.PostForm.Get("code")]
+		delete(idp.codes, r.PostForm.Get("code"))
+		idp.mu.Unlock()
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if !ok || r.PostForm.Get("client_id") != oidcTestClientId || r.PostForm.Get("client_secret") != oidcTestClientSecret ||
+			r.PostForm.Get("redirect_uri") != grant.redirectUri || base64.RawURLEncoding.EncodeToString(sum[:]) != grant.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		nonce := grant.nonce
+		if grant.login.Nonce != "" {
+			nonce = grant.login.Nonce
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":            idp.server.URL,
+			"aud":            oidcTestClientId,
+			"sub":            grant.login.Email,
+			"email":          grant.login.Email,
+			"email_verified": true,
+			"groups":         grant.login.Groups,
+			"nonce":          nonce,
+			"iat":            time.Now().Unix(),
+			"exp":            time.Now().Add(5 * time.Minute).Unix(),
+		})
+		token.Header["kid"] = "test-key"
+		idToken, _ := token.SignedString(key)
+		json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "idp-access-token",
+			"token_type":   "Bearer",
+			"id_token":     idToken,
+		})
+	})
+	idp.server = httptest.NewServer(mux)
+	return idp
+}
+
+// authorize giả lập trình duyệt: mở authorization url, IdP redirect về redirect_uri kèm code và state
+func (idp *mockIdp) authorize(t *testing.T, authorizationUrl string, login mockIdpLogin) (string, string) {
+	idp.mu.Lock()
+	idp.login = login
+	idp.mu.Unlock()
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get(authorizationUrl)
+	if err != nil {
+		t.Fatalf("authorize request: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("authorize status = %d, want 302", resp.StatusCode)
+	}
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatalf("authorize redirect: %v", err)
+	}
+	if got := location.Scheme + "://" + location.Host + location.Path; got != oidcTestRedirectUri {
+		t.Fatalf("redirect uri = %s, want %s", got, oidcTestRedirectUri)
+	}
+	return location.Query().Get("code"), location.Query().Get("state")
+}
+
+// ======================================
+//
+//	In-memory dependencies
+//
+// ======================================
+type nopLogger struct{}
+
+func (nopLogger) Info(msg string, fields ...interface{})  {}
+func (nopLogger) Error(msg string, fields ...interface{}) {}
+func (nopLogger) Warn(msg string, fields ...interface{})  {}
+func (nopLogger) Panic(msg string, fields ...interface{}) {}
+func (nopLogger) Fatal(msg string, fields ...interface{}) {}
+
+type memCache struct {
+	mu      sync.Mutex
+	data    map[string]string
+	expires map[string]time.Time
+	offset  time.Duration // Thời gian giả lập đã trôi qua, dùng để test TTL
+}
+
+func (m *memCache) now() time.Time {
+	return time.Now().Add(m.offset)
+}
+
+// advance giả lập thời gian trôi qua, key hết TTL bị xóa ở lần đọc tiếp theo
+func (m *memCache) advance(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.offset += d
+}
+
+// expireLocked xóa key đã hết TTL, gọi khi đang giữ mu
+func (m *memCache) expireLocked(key string) {
+	if at, ok := m.expires[key]; ok && !m.now().Before(at) {
+		delete(m.data, key)
+		delete(m.expires, key)
+	}
+}
+
+func (m *memCache) get(key string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expireLocked(key)
+	return m.data[key], nil
+}
+
+func (m *memCache) set(key string, value interface{}, ttl int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	switch v := value.(type) {
+	case string:
+		m.data[key] = v
+	default:
+		data, _ := json.Marshal(v)
+		m.data[key] = string(data)
+	}
+	m.setTTLLocked(key, ttl)
+	return nil
+}
+
+func (m *memCache) setTTLLocked(key string, ttl int64) {
+	if m.expires == nil {
+		m.expires = map[string]time.Time{}
+	}
+	if ttl > 0 {
+		m.expires[key] = m.now().Add(time.Duration(ttl) * time.Second)
+	} else {
+		delete(m.expires, key)
+	}
+}
+
+func (m *memCache) remove(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.data, key)
+	delete(m.expires, key)
+	return nil
+}
+
+type memDistributedCache struct {
+	domainCache.IDistributedCache
+	*memCache
+}
+
+func (m memDistributedCache) Get(ctx context.Context, key string) (string, error) { return m.get(key) }
+func (m memDistributedCache) SetTTL(ctx context.Context, key string, value interface{}, ttl int64) error {
+	return m.set(key, value, ttl)
+}
+func (m memDistributedCache) Delete(ctx context.Context, key string) error { return m.remove(key) }
+
+// LuaScript chỉ giả lập hai script của service: GET và DEL KEYS[1] (không có ARGV),
+// tăng bộ đếm có TTL: INCR KEYS[1], EXPIRE ARGV[1] khi key chưa có TTL
+func (m memDistributedCache) LuaScript(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	if len(keys) == 1 && len(args) == 0 {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.expireLocked(keys[0])
+		value, ok := m.data[keys[0]]
+		if !ok {
+			return nil, nil
+		}
+		delete(m.data, keys[0])
+		delete(m.expires, keys[0])
+		return value, nil
+	}
+	if len(keys) != 1 || len(args) != 1 {
+		return nil, fmt.Errorf("unsupported lua script in test cache")
+	}
+	ttl, ok := args[0].(int64)
+	if !ok {
+		return nil, fmt.Errorf("unsupported lua script ttl %T", args[0])
+	}
+	key := keys[0]
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expireLocked(key)
+	count, _ := strconv.ParseInt(m.data[key], 10, 64)
+	count++
+	m.data[key] = strconv.FormatInt(count, 10)
+	if _, hasTTL := m.expires[key]; !hasTTL {
+		m.setTTLLocked(key, ttl)
+	}
+	return count, nil
+}
+
+type memLocalCache struct {
+	domainCache.ILocalCache
+	*memCache
+}
+
+func (m memLocalCache) Get(ctx context.Context, key string) (string, error) { return m.get(key) }
+func (m memLocalCache) SetTTL(ctx context.Context, key string, value string, ttl int64) error {
+	return m.set(key, value, ttl)
+}
+func (m memLocalCache) Delete(ctx context.Context, key string) error { return m.remove(key) }
+
+type fakeUserRepository struct {
+	domainRepository.IUserRepository
+	mu       sync.Mutex
+	users    map[string]*domainModel.UserBaseInfoOutput
+	sessions int
+}
+
+func (f *fakeUserRepository) GetUserBaseByEmail(ctx context.Context, email string) (*domainModel.UserBaseInfoOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.users[email], nil
+}
+
+func (f *fakeUserRepository) GetUserBaseByID(ctx context.Context, userID uuid.UUID) (*domainModel.UserBaseInfoOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, user := range f.users {
+		if user.UserID == userID.String() {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeUserRepository) UpdateUserLock(ctx context.Context, data *domainModel.UpdateUserLockInput) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, user := range f.users {
+		if user.UserID == data.UserID.String() {
+			user.IsBlocked = data.IsLocked
+			user.LockExpiresAt = data.LockExpiresAt
+		}
+	}
+	return nil
+}
+
+func (f *fakeUserRepository) addUser(user *domainModel.UserBaseInfoOutput) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.users[user.UserEmail] = user
+}
+
+func (f *fakeUserRepository) CreateUserSession(ctx context.Context, data *domainModel.CreateUserSessionInput) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sessions++
+	return nil
+}
+
+type fakeCompanyRepository struct {
+	domainRepository.ICompanyRepository
+	companyOf map[uuid.UUID]uuid.UUID
+	managers  map[uuid.UUID]bool
+	settings  map[uuid.UUID]map[string]string // company_settings theo công ty
+}
+
+func (f *fakeCompanyRepository) GetCompanyUser(ctx context.Context, input *domainModel.GetCompanyUserInput) (*domainModel.GetCompanyUserOutput, error) {
+	companyId, ok := f.companyOf[input.UserID]
+	if !ok {
+		return nil, nil
+	}
+	return &domainModel.GetCompanyUserOutput{CompanyID: companyId}, nil
+}
+
+func (f *fakeCompanyRepository) CheckUserIsManagementInCompany(ctx context.Context, data *domainModel.CheckCompanyIsManagementInCompanyInput) (bool, error) {
+	companyId, ok := f.companyOf[data.UserID]
+	return ok && companyId == data.CompanyID && f.managers[data.UserID], nil
+}
+
+func (f *fakeCompanyRepository) GetCompanySetting(ctx context.Context, input *domainModel.GetCompanySettingInput) (*string, error) {
+	value, ok := f.settings[input.CompanyID][input.SettingKey]
+	if !ok {
+		return nil, nil
+	}
+	return &value, nil
+}
+
+func (f *fakeCompanyRepository) GetSystemSetting(ctx context.Context, key string) (*string, error) {
+	return nil, nil
+}
+
+type fakeOidcRepository struct {
+	mu      sync.Mutex
+	configs map[uuid.UUID]*domainModel.CompanyOidcConfigOutput
+}
+
+func (f *fakeOidcRepository) GetCompanyOidcConfig(ctx context.Context, companyID uuid.UUID) (*domainModel.CompanyOidcConfigOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.configs[companyID], nil
+}
+
+func (f *fakeOidcRepository) UpsertCompanyOidcConfig(ctx context.Context, data *domainModel.UpsertCompanyOidcConfigInput) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.configs[data.CompanyID] = &domainModel.CompanyOidcConfigOutput{
+		CompanyID:    data.CompanyID,
+		Issuer:       data.Issuer,
+		ClientID:     data.ClientID,
+		ClientSecret: data.ClientSecret,
+		Scopes:       data.Scopes,
+		EmailClaim:   data.EmailClaim,
+		RoleClaim:    data.RoleClaim,
+		RoleMapping:  data.RoleMapping,
+		IsEnabled:    data.IsEnabled,
+	}
+	return nil
+}
+
+func (f *fakeOidcRepository) DeleteCompanyOidcConfig(ctx context.Context, companyID uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.configs, companyID)
+	return nil
+}
+
+// fakeTwoFactorRepository lưu 2FA trong bộ nhớ theo đúng ràng buộc của câu SQL (không ghi đè 2FA đã bật, step không lùi, recovery code dùng một lần)
+type fakeTwoFactorRepository struct {
+	mu      sync.Mutex
+	records map[uuid.UUID]*fakeTwoFactorRecord
+}
+
+type fakeTwoFactorRecord struct {
+	secret        string
+	enabled       bool
+	lastUsedStep  int64
+	recoveryCodes []string
+	enabledAt     *time.Time
+}
+
+func (f *fakeTwoFactorRepository) GetUserTwoFactor(ctx context.Context, userID uuid.UUID) (*domainModel.UserTwoFactorOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	record, ok := f.records[userID]
+	if !ok {
+		return nil, nil
+	}
+	return &domainModel.UserTwoFactorOutput{
+		UserID:            userID,
+		Secret:            record.secret,
+		IsEnabled:         record.enabled,
+		LastUsedStep:      record.lastUsedStep,
+		RecoveryCodesLeft: len(record.recoveryCodes),
+		EnabledAt:         record.enabledAt,
+	}, nil
+}
+
+func (f *fakeTwoFactorRepository) UpsertUserTwoFactorSecret(ctx context.Context, data *domainModel.UpsertUserTwoFactorSecretInput) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if record, ok := f.records[data.UserID]; ok && record.enabled {
+		return nil
+	}
+	f.records[data.UserID] = &fakeTwoFactorRecord{secret: data.Secret}
+	return nil
+}
+
+func (f *fakeTwoFactorRepository) EnableUserTwoFactor(ctx context.Context, data *domainModel.EnableUserTwoFactorInput) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	record, ok := f.records[data.UserID]
+	if !ok || record.enabled {
+		return false, nil
+	}
+	now := time.Now()
+	record.enabled = true
+	record.enabledAt = &now
+	record.lastUsedStep = data.LastUsedStep
+	record.recoveryCodes = slices.Clone(data.RecoveryCodeHashes)
+	return true, nil
+}
+
+func (f *fakeTwoFactorRepository) UpdateTwoFactorLastUsedStep(ctx context.Context, data *domainModel.UpdateTwoFactorLastUsedStepInput) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	record, ok := f.records[data.UserID]
+	if !ok || !record.enabled || data.LastUsedStep <= record.lastUsedStep {
+		return false, nil
+	}
+	record.lastUsedStep = data.LastUsedStep
+	return true, nil
+}
+
+func (f *fakeTwoFactorRepository) UpdateTwoFactorRecoveryCodes(ctx context.Context, data *domainModel.UpdateTwoFactorRecoveryCodesInput) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if record, ok := f.records[data.UserID]; ok {
+		record.recoveryCodes = slices.Clone(data.RecoveryCodeHashes)
+	}
+	return nil
+}
+
+func (f *fakeTwoFactorRepository) UseTwoFactorRecoveryCode(ctx context.Context, data *domainModel.UseTwoFactorRecoveryCodeInput) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	record, ok := f.records[data.UserID]
+	if !ok || !record.enabled {
+		return false, nil
+	}
+	index := slices.Index(record.recoveryCodes, data.CodeHash)
+	if index < 0 {
+		return false, nil
+	}
+	record.recoveryCodes = slices.Delete(record.recoveryCodes, index, index+1)
+	return true, nil
+}
+
+func (f *fakeTwoFactorRepository) DeleteUserTwoFactor(ctx context.Context, userID uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.records, userID)
+	return nil
+}
+
+type fakeAuditRepository struct {
+	mu   sync.Mutex
+	logs []*domainModel.AuditLog
+}
+
+func (f *fakeAuditRepository) AddAuditLog(ctx context.Context, log *domainModel.AuditLog) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.logs = append(f.logs, log)
+	return nil
+}
+
+// actions danh sách action đã ghi theo user bị tác động
+func (f *fakeAuditRepository) actions(resourceId uuid.UUID) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var actions []string
+	for _, log := range f.logs {
+		if log.ResourceId == resourceId {
+			actions = append(actions, log.Action)
+		}
+	}
+	return actions
+}
+
+type oidcEnv struct {
+	idp          *mockIdp
+	cache        *memCache
+	users        *fakeUserRepository
+	companies    *fakeCompanyRepository
+	audits       *fakeAuditRepository
+	service      applicationService.IOAuthService
+	tokenService domainToken.ITokenService
+	employee     *domainModel.UserBaseInfoOutput
+	manager      *domainModel.UserBaseInfoOutput
+	outsider     *domainModel.UserBaseInfoOutput
+}
+
+// setupOidcEnv đăng ký dependency một lần cho cả package test (các registry không cho set lại)
+func setupOidcEnv(t *testing.T) *oidcEnv {
+	oidcTestEnvOnce.Do(func() {
+		env := &oidcEnv{idp: newMockIdp(t)}
+		global.Logger = nopLogger{}
+		global.SettingServer.Oidc.RedirectUri = oidcTestRedirectUri
+		global.SettingServer.Oidc.EncryptionKey = "oidc_test_encryption_key"
+		global.SettingServer.TwoFactor.Issuer = "cio_verify_face"
+		global.SettingServer.TwoFactor.EncryptionKey = "two_factor_test_encryption_key"
+		env.cache = &memCache{data: map[string]string{}, expires: map[string]time.Time{}}
+		domainCache.SetDistributedCache(memDistributedCache{memCache: env.cache})
+		domainCache.SetLocalCache(memLocalCache{memCache: env.cache})
+		env.employee = &domainModel.UserBaseInfoOutput{UserID: uuid.NewString(), UserEmail: "alice.acme@example.com", Role: domainModel.RoleUser}
+		env.manager = &domainModel.UserBaseInfoOutput{UserID: uuid.NewString(), UserEmail: "admin.acme@example.com", Role: domainModel.RoleManager}
+		env.outsider = &domainModel.UserBaseInfoOutput{UserID: uuid.NewString(), UserEmail: "charlie.beta@example.com", Role: domainModel.RoleUser}
+		env.users = &fakeUserRepository{users: map[string]*domainModel.UserBaseInfoOutput{}}
+		companies := &fakeCompanyRepository{
+			companyOf: map[uuid.UUID]uuid.UUID{},
+			managers:  map[uuid.UUID]bool{},
+			settings:  map[uuid.UUID]map[string]string{},
+		}
+		env.companies = companies
+		for _, user := range []*domainModel.UserBaseInfoOutput{env.employee, env.manager} {
+			env.users.users[user.UserEmail] = user
+			companies.companyOf[uuid.MustParse(user.UserID)] = oidcTestCompanyId
+		}
+		companies.managers[uuid.MustParse(env.manager.UserID)] = true
+		env.users.users[env.outsider.UserEmail] = env.outsider
+		companies.companyOf[uuid.MustParse(env.outsider.UserID)] = oidcTestOtherId
+		domainRepository.SetUserRepository(env.users)
+		domainRepository.SetCompanyRepository(companies)
+		domainRepository.SetOidcRepository(&fakeOidcRepository{configs: map[uuid.UUID]*domainModel.CompanyOidcConfigOutput{}})
+		domainRepository.SetTwoFactorRepository(&fakeTwoFactorRepository{records: map[uuid.UUID]*fakeTwoFactorRecord{}})
+		env.audits = &fakeAuditRepository{}
+		domainRepository.SetAuditRepository(env.audits)
+		hasher, err := password.New(password.Config{
+			Argon2id: password.Argon2idConfig{Memory: 1024, Iterations: 1, Parallelism: 1},
+		})
+		if err != nil {
+			t.Fatalf("password.New: %v", err)
+		}
+		domainPassword.SetPasswordHasher(hasher)
+		tokenService, err := infraToken.NewTokenService(&domainConfig.JWTSetting{
+			Issuer:            "cio_verify_face",
+			Subject:           "cio_verify_face",
+			Audience:          []string{"cio_verify_face"},
+			AllowEphemeralKey: true,
+		})
+		if err != nil {
+			t.Fatalf("NewTokenService: %v", err)
+		}
+		env.tokenService = tokenService
+		domainToken.SetTokenService(env.tokenService)
+		domainOidc.SetOidcProvider(infraOidc.NewOidcProvider(5 * time.Second))
+		env.service = applicationServiceImpl.NewOAuthService()
+		oidcTestEnv = env
+	})
+	if oidcTestEnv == nil {
+		t.Fatal("oidc test environment not initialized")
+	}
+	return oidcTestEnv
+}
+
+// configure lưu cấu hình SSO của công ty qua service như SYSTEM_ADMIN
+func (env *oidcEnv) configure(t *testing.T, roleClaim string, roleMapping map[string]int) {
+	_, errApp := env.service.UpsertOidcConfig(context.Background(), &applicationModel.UpsertOidcConfigInput{
+		UserId:       uuid.New(),
+		Role:         domainModel.RoleAdmin,
+		CompanyIdReq: oidcTestCompanyId,
+		Issuer:       env.idp.server.URL,
+		ClientId:     oidcTestClientId,
+		ClientSecret: oidcTestClientSecret,
+		RoleClaim:    roleClaim,
+		RoleMapping:  roleMapping,
+		IsEnabled:    true,
+	})
+	if errApp != nil {
+		t.Fatalf("UpsertOidcConfig: %d %s", errApp.Code, errApp.Message)
+	}
+}
+
+// login chạy toàn bộ flow: authorize -> đăng nhập tại IdP -> callback
+func (env *oidcEnv) login(t *testing.T, login mockIdpLogin) (*applicationModel.LoginOutput, *applicationErrors.Error, string, string) {
+	authorize, errApp := env.service.OidcAuthorize(context.Background(), &applicationModel.OidcAuthorizeInput{
+		CompanyId: oidcTestCompanyId,
+		ClientIp:  "127.0.0.1",
+	})
+	if errApp != nil {
+		t.Fatalf("OidcAuthorize: %d %s", errApp.Code, errApp.Message)
+	}
+	code, state := env.idp.authorize(t, authorize.AuthorizationUrl, login)
+	if state != authorize.State {
+		t.Fatalf("state = %s, want %s", state, authorize.State)
+	}
+	output, errApp := env.service.OidcCallback(context.Background(), &applicationModel.OidcCallbackInput{
+		Code:      code,
+		State:     state,
+		ClientIp:  "127.0.0.1",
+		UserAgent: "oidc-test",
+	})
+	return output, errApp, code, state
+}
+
+func (env *oidcEnv) parseAccessToken(t *testing.T, output *applicationModel.LoginOutput) *domainModel.TokenUserJwtOutput {
+	if output == nil || output.AccessToken == "" || output.RefreshToken == "" {
+		t.Fatalf("expected access/refresh token, got %+v", output)
+	}
+	claims, err := env.tokenService.ParseUserToken(context.Background(), output.AccessToken)
+	if err != nil {
+		t.Fatalf("ParseUserToken: %v", err.Message)
+	}
+	return claims
+}
+
+func expectErrorCode(t *testing.T, errApp *applicationErrors.Error, code int) {
+	t.Helper()
+	if errApp == nil {
+		t.Fatalf("expected error %d, got success", code)
+	}
+	if errApp.Code != code {
+		t.Fatalf("error code = %d (%s), want %d", errApp.Code, errApp.Message, code)
+	}
+}
+
+// ======================================
+//
+//	Tests
+//
+// ======================================
+// Test SSO login issues the same token pair as Login and state can only be used once
+func TestOidcLoginFlow(t *testing.T) {
+	env := setupOidcEnv(t)
+	env.configure(t, "", nil)
+
+	sessions := env.users.sessions
+	output, errApp, code, state := env.login(t, mockIdpLogin{Email: env.employee.UserEmail})
+	if errApp != nil {
+		t.Fatalf("OidcCallback: %d %s", errApp.Code, errApp.Message)
+	}
+	claims := env.parseAccessToken(t, output)
+	if claims.UserId != env.employee.UserID || claims.Role != domainModel.RoleUser || claims.CompanyId != oidcTestCompanyId.String() {
+		t.Fatalf("unexpected token claims %+v", claims)
+	}
+	if env.users.sessions != sessions+1 {
+		t.Fatalf("expected user session to be created")
+	}
+
+	// Replay state
+	_, errApp = env.service.OidcCallback(context.Background(), &applicationModel.OidcCallbackInput{
+		Code:  code,
+		State: state,
+	})
+	expectErrorCode(t, errApp, applicationErrors.AuthOidcStateInvalidErrorCode)
+
+	// Config output never exposes the client secret
+	config, errApp := env.service.GetOidcConfig(context.Background(), &applicationModel.OidcConfigInput{
+		Role:         domainModel.RoleAdmin,
+		CompanyIdReq: oidcTestCompanyId,
+	})
+	if errApp != nil {
+		t.Fatalf("GetOidcConfig: %d %s", errApp.Code, errApp.Message)
+	}
+	if !config.HasClientSecret || config.RedirectUri != oidcTestRedirectUri {
+		t.Fatalf("unexpected config output %+v", config)
+	}
+}
+
+// Test claim-to-role mapping never grants more than the role stored in users
+func TestOidcLoginRoleMapping(t *testing.T) {
+	env := setupOidcEnv(t)
+	env.configure(t, "groups", map[string]int{
+		"hr-admins": domainModel.RoleManager,
+		"staff":     domainModel.RoleUser,
+	})
+
+	output, errApp, _, _ := env.login(t, mockIdpLogin{Email: env.manager.UserEmail, Groups: []string{"staff", "hr-admins"}})
+	if errApp != nil {
+		t.Fatalf("manager OidcCallback: %d %s", errApp.Code, errApp.Message)
+	}
+	if claims := env.parseAccessToken(t, output); claims.Role != domainModel.RoleManager {
+		t.Fatalf("manager role = %d, want %d", claims.Role, domainModel.RoleManager)
+	}
+
+	output, errApp, _, _ = env.login(t, mockIdpLogin{Email: env.manager.UserEmail, Groups: []string{"staff"}})
+	if errApp != nil {
+		t.Fatalf("downgraded manager OidcCallback: %d %s", errApp.Code, errApp.Message)
+	}
+	if claims := env.parseAccessToken(t, output); claims.Role != domainModel.RoleUser {
+		t.Fatalf("downgraded manager role = %d, want %d", claims.Role, domainModel.RoleUser)
+	}
+
+	output, errApp, _, _ = env.login(t, mockIdpLogin{Email: env.employee.UserEmail, Groups: []string{"hr-admins"}})
+	if errApp != nil {
+		t.Fatalf("employee OidcCallback: %d %s", errApp.Code, errApp.Message)
+	}
+	if claims := env.parseAccessToken(t, output); claims.Role != domainModel.RoleUser {
+		t.Fatalf("employee role = %d, want %d", claims.Role, domainModel.RoleUser)
+	}
+
+	_, errApp, _, _ = env.login(t, mockIdpLogin{Email: env.employee.UserEmail, Groups: []string{"contractors"}})
+	expectErrorCode(t, errApp, applicationErrors.AuthOidcUserNotAllowedErrorCode)
+}
+
+// Test unknown users, users of other companies and ID tokens with a wrong nonce are rejected
+func TestOidcLoginRejected(t *testing.T) {
+	env := setupOidcEnv(t)
+	env.configure(t, "", nil)
+
+	_, errApp, _, _ := env.login(t, mockIdpLogin{Email: "nobody@example.com"})
+	expectErrorCode(t, errApp, applicationErrors.AuthOidcUserNotAllowedErrorCode)
+
+	_, errApp, _, _ = env.login(t, mockIdpLogin{Email: env.outsider.UserEmail})
+	expectErrorCode(t, errApp, applicationErrors.AuthOidcUserNotAllowedErrorCode)
+
+	_, errApp, _, _ = env.login(t, mockIdpLogin{Email: env.employee.UserEmail, Nonce: "replayed-nonce"})
+	expectErrorCode(t, errApp, applicationErrors.AuthOidcIdentityInvalidErrorCode)
+
+	// Authorization code không dùng được với state khác (PKCE verifier không khớp)
+	authorize, errApp := env.service.OidcAuthorize(context.Background(), &applicationModel.OidcAuthorizeInput{CompanyId: oidcTestCompanyId})
+	if errApp != nil {
+		t.Fatalf("OidcAuthorize: %d %s", errApp.Code, errApp.Message)
+	}
+	other, errApp := env.service.OidcAuthorize(context.Background(), &applicationModel.OidcAuthorizeInput{CompanyId: oidcTestCompanyId})
+	if errApp != nil {
+		t.Fatalf("OidcAuthorize: %d %s", errApp.Code, errApp.Message)
+	}
+	code, _ := env.idp.authorize(t, authorize.AuthorizationUrl, mockIdpLogin{Email: env.employee.UserEmail})
+	_, errApp = env.service.OidcCallback(context.Background(), &applicationModel.OidcCallbackInput{
+		Code:  code,
+		State: other.State,
+	})
+	expectErrorCode(t, errApp, applicationErrors.AuthOidcIdentityInvalidErrorCode)
+}
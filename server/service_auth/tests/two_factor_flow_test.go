@@ -0,0 +1,175 @@
+package tests
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	applicationErrors "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/errors"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/model"
+	applicationServiceImpl "github.com/youknow2509/cio_verify_face/server/service_auth/internal/application/service/impl"
+	constants "github.com/youknow2509/cio_verify_face/server/service_auth/internal/constants"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/model"
+	domainPassword "github.com/youknow2509/cio_verify_face/server/service_auth/internal/domain/password"
+	utilsTotp "github.com/youknow2509/cio_verify_face/server/service_auth/internal/shared/utils/totp"
+)
+
+// Công ty riêng bắt buộc 2FA cho COMPANY_ADMIN, không ảnh hưởng tới các test SSO
+var twoFactorTestCompanyId = uuid.MustParse("33333333-3333-3333-3333-333333333333")
+
+const twoFactorTestClientIp = "10.0.23.1"
+
+// newTwoFactorManager tạo COMPANY_ADMIN thuộc công ty bắt buộc 2FA
+func newTwoFactorManager(t *testing.T, env *oidcEnv, email string) *domainModel.UserBaseInfoOutput {
+	t.Helper()
+	hash, err := domainPassword.GetPasswordHasher().Hash(lockoutTestPassword)
+	if err != nil {
+		t.Fatalf("Hash: %v", err)
+	}
+	user := &domainModel.UserBaseInfoOutput{
+		UserID:       uuid.NewString(),
+		UserEmail:    email,
+		UserPassword: hash,
+		Role:         domainModel.RoleManager,
+	}
+	env.users.addUser(user)
+	env.companies.companyOf[uuid.MustParse(user.UserID)] = twoFactorTestCompanyId
+	env.companies.settings[twoFactorTestCompanyId] = map[string]string{
+		constants.TWO_FACTOR_REQUIRED_ROLES_SETTING: "[1]",
+	}
+	return user
+}
+
+// loginAdminChallenge đăng nhập bằng mật khẩu, phải nhận challenge 2FA thay vì token
+func loginAdminChallenge(t *testing.T, user *domainModel.UserBaseInfoOutput, wantSetup bool) string {
+	t.Helper()
+	output, errApp := applicationServiceImpl.NewCoreAuthService().LoginAdmin(context.Background(), &applicationModel.LoginInputAdmin{
+		UserName:  user.UserEmail,
+		Password:  lockoutTestPassword,
+		ClientIp:  twoFactorTestClientIp,
+		UserAgent: "two-factor-test",
+	})
+	if errApp != nil {
+		t.Fatalf("LoginAdmin: %d %s", errApp.Code, errApp.Message)
+	}
+	if !output.TwoFactorRequired || output.ChallengeToken == "" || output.AccessToken != "" {
+		t.Fatalf("LoginAdmin = %+v, want two factor challenge without token", output)
+	}
+	if output.TwoFactorSetupRequired != wantSetup {
+		t.Fatalf("TwoFactorSetupRequired = %v, want %v", output.TwoFactorSetupRequired, wantSetup)
+	}
+	return output.ChallengeToken
+}
+
+func loginVerify(challengeToken string, code string) (*applicationModel.LoginOutput, *applicationErrors.Error) {
+	return applicationServiceImpl.NewTwoFactorAuthService().LoginVerify(context.Background(), &applicationModel.LoginTwoFactorInput{
+		ChallengeToken: challengeToken,
+		Code:           code,
+		ClientIp:       twoFactorTestClientIp,
+		UserAgent:      "two-factor-test",
+	})
+}
+
+func currentTotpCode(t *testing.T, secret string) string {
+	t.Helper()
+	code, err := utilsTotp.GenerateCode(secret, utilsTotp.Step(time.Now()))
+	if err != nil {
+		t.Fatalf("GenerateCode: %v", err)
+	}
+	return code
+}
+
+// Test enroll -> challenge -> verify -> recovery code, mã TOTP và recovery code không dùng lại được
+func TestTwoFactorLoginFlow(t *testing.T) {
+	env := setupOidcEnv(t)
+	user := newTwoFactorManager(t, env, "two.factor.flow@example.com")
+	userId := uuid.MustParse(user.UserID)
+	service := applicationServiceImpl.NewTwoFactorAuthService()
+
+	// Chính sách bắt buộc 2FA: đăng nhập lần đầu phải enroll bằng challenge
+	challenge := loginAdminChallenge(t, user, true)
+	enroll, errApp := service.LoginEnroll(context.Background(), &applicationModel.LoginTwoFactorEnrollInput{
+		ChallengeToken: challenge,
+		ClientIp:       twoFactorTestClientIp,
+	})
+	if errApp != nil {
+		t.Fatalf("LoginEnroll: %d %s", errApp.Code, errApp.Message)
+	}
+	if enroll.Secret == "" || enroll.OtpauthUri == "" {
+		t.Fatalf("LoginEnroll = %+v, want secret and otpauth uri", enroll)
+	}
+
+	_, errApp = loginVerify(challenge, "000000")
+	expectErrorCode(t, errApp, applicationErrors.AuthTwoFactorCodeInvalidErrorCode)
+
+	firstCode := currentTotpCode(t, enroll.Secret)
+	output, errApp := loginVerify(challenge, firstCode)
+	if errApp != nil {
+		t.Fatalf("LoginVerify: %d %s", errApp.Code, errApp.Message)
+	}
+	claims := env.parseAccessToken(t, output)
+	if claims.Role != domainModel.RoleManager || claims.UserId != user.UserID {
+		t.Fatalf("claims = %+v, want manager %s", claims, user.UserID)
+	}
+	if len(output.RecoveryCodes) != constants.TWO_FACTOR_RECOVERY_CODES {
+		t.Fatalf("recovery codes = %d, want %d", len(output.RecoveryCodes), constants.TWO_FACTOR_RECOVERY_CODES)
+	}
+
+	// Challenge chỉ dùng được một lần
+	_, errApp = loginVerify(challenge, firstCode)
+	expectErrorCode(t, errApp, applicationErrors.AuthTwoFactorChallengeInvalidErrorCode)
+
+	// Đã bật 2FA: challenge mới, mã TOTP của time step đã dùng bị từ chối
+	challenge = loginAdminChallenge(t, user, false)
+	_, errApp = loginVerify(challenge, firstCode)
+	expectErrorCode(t, errApp, applicationErrors.AuthTwoFactorCodeInvalidErrorCode)
+
+	// Recovery code dùng được một lần, khoảng trắng thừa được bỏ qua
+	recoveryCodes := output.RecoveryCodes
+	recoveryCode := recoveryCodes[0]
+	output, errApp = loginVerify(challenge, " "+recoveryCode+" ")
+	if errApp != nil {
+		t.Fatalf("LoginVerify with recovery code: %d %s", errApp.Code, errApp.Message)
+	}
+	env.parseAccessToken(t, output)
+	if len(output.RecoveryCodes) != 0 {
+		t.Fatal("recovery codes must only be returned when two factor is enabled")
+	}
+
+	challenge = loginAdminChallenge(t, user, false)
+	_, errApp = loginVerify(challenge, recoveryCode)
+	expectErrorCode(t, errApp, applicationErrors.AuthTwoFactorCodeInvalidErrorCode)
+
+	status, errApp := service.GetStatus(context.Background(), &applicationModel.TwoFactorEnrollInput{
+		UserId: userId,
+		Role:   domainModel.RoleManager,
+	})
+	if errApp != nil {
+		t.Fatalf("GetStatus: %d %s", errApp.Code, errApp.Message)
+	}
+	if !status.Enabled || !status.Required || status.RecoveryCodesLeft != constants.TWO_FACTOR_RECOVERY_CODES-1 {
+		t.Fatalf("GetStatus = %+v, want enabled, required, %d recovery codes left", status, constants.TWO_FACTOR_RECOVERY_CODES-1)
+	}
+
+	// Chính sách bắt buộc: không được tắt 2FA
+	errApp = service.Disable(context.Background(), &applicationModel.TwoFactorCodeInput{
+		UserId: userId,
+		Role:   domainModel.RoleManager,
+		Code:   recoveryCodes[1],
+	})
+	expectErrorCode(t, errApp, applicationErrors.AuthTwoFactorRequiredByPolicyErrorCode)
+
+	actions := env.audits.actions(userId)
+	for _, action := range []string{constants.AuditActionEnableTwoFactor, constants.AuditActionUseRecoveryCode} {
+		if !slices.Contains(actions, action) {
+			t.Fatalf("audit actions = %v, missing %s", actions, action)
+		}
+	}
+	for _, log := range env.audits.logs {
+		if log.ResourceId == userId && log.Action == constants.AuditActionEnableTwoFactor && log.ResourceType != constants.AuditResourceTypeUserTwoFactor {
+			t.Fatalf("two factor audit resource type = %s, want %s", log.ResourceType, constants.AuditResourceTypeUserTwoFactor)
+		}
+	}
+}
@@ -1,9 +1,12 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"sync"
+	"syscall"
 
 	"github.com/youknow2509/cio_verify_face/server/service_device/internal/global"
 	"github.com/youknow2509/cio_verify_face/server/service_device/internal/start"
@@ -31,6 +34,10 @@ import (
 func main() {
 	// init wait group
 	global.WaitGroup = &sync.WaitGroup{}
+	// shutdown context, các worker nền và http server dừng khi nhận tín hiệu
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	global.ShutdownContext = ctx
 	// start
 	err := start.StartService()
 	if err != nil {
@@ -75,8 +75,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Update device by ID",
+            "post": {
+                "description": "Create new device",
                 "consumes": [
                     "application/json"
                 ],
@@ -86,7 +86,7 @@ const docTemplate = `{
                 "tags": [
                     "Core Device"
                 ],
-                "summary": "Update device by ID",
+                "summary": "Create new device",
                 "parameters": [
                     {
                         "type": "string",
@@ -96,12 +96,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Request body update device",
+                        "description": "Request body create device",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateDeviceRequest"
+                            "$ref": "#/definitions/dto.CreateDeviceRequest"
                         }
                     }
                 ],
@@ -119,9 +119,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/device/compromised/{device_id}": {
             "post": {
-                "description": "Create new device",
+                "description": "Revoke device token, set device status ERROR and disconnect its WebSocket session",
                 "consumes": [
                     "application/json"
                 ],
@@ -131,7 +133,7 @@ const docTemplate = `{
                 "tags": [
                     "Core Device"
                 ],
-                "summary": "Create new device",
+                "summary": "Mark device compromised",
                 "parameters": [
                     {
                         "type": "string",
@@ -141,12 +143,107 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Request body create device",
+                        "type": "string",
+                        "description": "Device ID",
+                        "name": "device_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/device/config/{device_id}": {
+            "get": {
+                "description": "Get device configuration and the version applied by the device",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Core Device"
+                ],
+                "summary": "Get device config",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003ctoken\u003e",
+                        "name": "authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Device ID",
+                        "name": "device_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace device configuration and push it to the device, version must match the current config version",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Core Device"
+                ],
+                "summary": "Update device config",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003ctoken\u003e",
+                        "name": "authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Device ID",
+                        "name": "device_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body update device config",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateDeviceRequest"
+                            "$ref": "#/definitions/dto.UpdateDeviceConfigRequest"
                         }
                     }
                 ],
@@ -361,6 +458,138 @@ const docTemplate = `{
                 }
             }
         },
+        "/v1/device/me/config": {
+            "get": {
+                "description": "Device get its current configuration",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Device Self"
+                ],
+                "summary": "Get device config (device self)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003ctoken\u003e",
+                        "name": "authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/device/me/config/ack": {
+            "post": {
+                "description": "Device acknowledge the configuration version it has applied",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Device Self"
+                ],
+                "summary": "Ack device config (device self)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003ctoken\u003e",
+                        "name": "authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body ack device config",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.AckDeviceConfigRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/device/me/heartbeat": {
+            "post": {
+                "description": "Device report heartbeat with CPU, memory, temperature and firmware version",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Device Self"
+                ],
+                "summary": "Device heartbeat",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003ctoken\u003e",
+                        "name": "authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body device heartbeat",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.HeartbeatDeviceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
+        },
         "/v1/device/name": {
             "post": {
                 "description": "Update name device",
@@ -582,6 +811,123 @@ const docTemplate = `{
                 }
             }
         },
+        "/v1/device/uptime/{device_id}": {
+            "get": {
+                "description": "Get device uptime and heartbeat history in a time range (default last 24 hours)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Core Device"
+                ],
+                "summary": "Get device uptime",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003ctoken\u003e",
+                        "name": "authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Device ID",
+                        "name": "device_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "int64",
+                        "description": "From time (unix seconds)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "int64",
+                        "description": "To time (unix seconds)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "int",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "int",
+                        "description": "Page size",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/device/usage": {
+            "get": {
+                "description": "Get company subscription plan with current and allowed number of devices and employees",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Core Device"
+                ],
+                "summary": "Get company usage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003ctoken\u003e",
+                        "name": "authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Company ID (system admin only)",
+                        "name": "company_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
+            }
+        },
         "/v1/device/{device_id}": {
             "get": {
                 "description": "Delete device by ID",
@@ -668,10 +1014,74 @@ const docTemplate = `{
                         }
                     }
                 }
+            },
+            "put": {
+                "description": "Update device by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Core Device"
+                ],
+                "summary": "Update device by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003ctoken\u003e",
+                        "name": "authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Device ID",
+                        "name": "device_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body update device",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateDeviceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResponseData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrResponseData"
+                        }
+                    }
+                }
             }
         }
     },
     "definitions": {
+        "dto.AckDeviceConfigRequest": {
+            "type": "object",
+            "required": [
+                "version"
+            ],
+            "properties": {
+                "version": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
         "dto.CreateDeviceRequest": {
             "type": "object",
             "required": [
@@ -709,6 +1119,31 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.DeviceWorkingHoursRequest": {
+            "type": "object",
+            "required": [
+                "days",
+                "end",
+                "start"
+            ],
+            "properties": {
+                "days": {
+                    "description": "0: Sunday ... 6: Saturday",
+                    "type": "array",
+                    "maxItems": 7,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "end": {
+                    "type": "string"
+                },
+                "start": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.ErrResponseData": {
             "type": "object",
             "properties": {
@@ -724,6 +1159,33 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.HeartbeatDeviceRequest": {
+            "type": "object",
+            "properties": {
+                "cpu_usage": {
+                    "description": "Percent",
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "firmware_version": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "memory_usage": {
+                    "description": "Percent",
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "temperature": {
+                    "description": "Celsius",
+                    "type": "number",
+                    "maximum": 150,
+                    "minimum": -50
+                }
+            }
+        },
         "dto.ResponseData": {
             "type": "object",
             "properties": {
@@ -740,6 +1202,47 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.UpdateDeviceConfigRequest": {
+            "type": "object",
+            "required": [
+                "ui_language",
+                "verification_methods",
+                "working_hours"
+            ],
+            "properties": {
+                "liveness_threshold": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0
+                },
+                "match_threshold": {
+                    "type": "number",
+                    "maximum": 1
+                },
+                "ui_language": {
+                    "type": "string",
+                    "enum": [
+                        "vi",
+                        "en"
+                    ]
+                },
+                "verification_methods": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "version": {
+                    "description": "Version cấu hình đang sửa",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "working_hours": {
+                    "$ref": "#/definitions/dto.DeviceWorkingHoursRequest"
+                }
+            }
+        },
         "dto.UpdateDeviceRequest": {
             "type": "object",
             "properties": {
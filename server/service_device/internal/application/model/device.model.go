@@ -54,6 +54,19 @@ type RefreshDeviceTokenInput struct {
 	CompanyId   uuid.UUID `json:"company_id"`
 }
 
+// Mark device compromised
+type CompromiseDeviceInput struct {
+	// Info req
+	DeviceId uuid.UUID `json:"device_id"`
+	// Info client req
+	UserId      uuid.UUID `json:"user_id"`
+	Role        int       `json:"role"` // 0: ADMIN, 1: Admin company, 2: STAFF
+	SessionId   uuid.UUID `json:"session_id"`
+	ClientIp    string    `json:"client_ip"`
+	ClientAgent string    `json:"client_agent"`
+	CompanyId   uuid.UUID `json:"company_id"`
+}
+
 // Refresh device token (self)
 type RefreshDeviceTokenSelfInput struct {
 	DeviceId    uuid.UUID `json:"device_id"`
@@ -156,13 +169,14 @@ type GetDeviceByIdOutput struct {
 // UpdateDevice
 type UpdateDeviceInput struct {
 	// Info req
+	DeviceId     uuid.UUID `json:"device_id"`
 	LocationId   uuid.UUID `json:"location_id" validate:"omitempty"`
 	DeviceName   string    `json:"device_name" validate:"omitempty,min=3,max=100"`
 	Address      string    `json:"address" validate:"omitempty,max=255"`
-	DeviceType   int       `json:"device_type" validate:"omitempty,oneof=0 1 2 3"` // 0: FACE_TERMINAL, 1: MOBILE_APP, 2: WEB_CAMERA, 3: IOT_SENSOR
+	DeviceType   *int      `json:"device_type" validate:"omitempty,oneof=0 1 2 3"` // 0: FACE_TERMINAL, 1: MOBILE_APP, 2: WEB_CAMERA, 3: IOT_SENSOR
 	SerialNumber string    `json:"serial_number" validate:"omitempty,max=100"`
 	MacAddress   string    `json:"mac_address" validate:"omitempty,mac"`
-	Status       int       `json:"status" validate:"omitempty,oneof=0 1 2 3"` // 0: OFFLINE, 1: ONLINE, 2: MAINTENANCE, 3: ERROR
+	Status       *int      `json:"status" validate:"omitempty,oneof=0 1 2 3"` // 0: OFFLINE, 1: ONLINE, 2: MAINTENANCE, 3: ERROR
 	// Info client req
 	UserId      uuid.UUID `json:"user_id"`
 	Role        int       `json:"role"` // 0: ADMIN, 1: Admin company, 2: STAFF
@@ -171,7 +185,10 @@ type UpdateDeviceInput struct {
 	ClientAgent string    `json:"client_agent"`
 	CompanyId   uuid.UUID `json:"company_id"`
 }
-type UpdateDeviceOutput struct{}
+type UpdateDeviceOutput struct {
+	DeviceId string `json:"device_id"`
+	Status   int    `json:"status"`
+}
 
 // DeleteDevice
 type DeleteDeviceInput struct {
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+// =================================================
+// Device config model
+// =================================================
+
+// DeviceConfig cấu hình device lưu trong devices.settings
+type DeviceConfig struct {
+	LivenessThreshold   float64            `json:"liveness_threshold"`
+	MatchThreshold      float64            `json:"match_threshold"`
+	WorkingHours        DeviceWorkingHours `json:"working_hours"`
+	UiLanguage          string             `json:"ui_language"`
+	VerificationMethods []string           `json:"verification_methods"`
+}
+
+// DeviceWorkingHours giờ làm việc của device, giờ theo định dạng HH:MM
+type DeviceWorkingHours struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+	Days  []int  `json:"days"` // 0: Sunday ... 6: Saturday
+}
+
+// Device config output, cũng là payload đẩy tới device qua ws
+type DeviceConfigOutput struct {
+	DeviceId       string       `json:"device_id"`
+	Version        int64        `json:"version"`
+	AppliedVersion int64        `json:"applied_version"` // Version device đã ack áp dụng
+	AppliedAt      int64        `json:"applied_at,omitempty"`
+	Config         DeviceConfig `json:"config"`
+}
+
+// Get device config (admin)
+type GetDeviceConfigInput struct {
+	// Info req
+	DeviceId uuid.UUID `json:"device_id"`
+	// Info client req
+	UserId      uuid.UUID `json:"user_id"`
+	Role        int       `json:"role"` // 0: ADMIN, 1: Admin company, 2: STAFF
+	SessionId   uuid.UUID `json:"session_id"`
+	ClientIp    string    `json:"client_ip"`
+	ClientAgent string    `json:"client_agent"`
+	CompanyId   uuid.UUID `json:"company_id"`
+}
+
+// Update device config (admin)
+type UpdateDeviceConfigInput struct {
+	// Info req
+	DeviceId uuid.UUID    `json:"device_id"`
+	Version  int64        `json:"version"` // Version cấu hình đang sửa
+	Config   DeviceConfig `json:"config"`
+	// Info client req
+	UserId      uuid.UUID `json:"user_id"`
+	Role        int       `json:"role"` // 0: ADMIN, 1: Admin company, 2: STAFF
+	SessionId   uuid.UUID `json:"session_id"`
+	ClientIp    string    `json:"client_ip"`
+	ClientAgent string    `json:"client_agent"`
+	CompanyId   uuid.UUID `json:"company_id"`
+}
+type UpdateDeviceConfigOutput struct {
+	DeviceId  string `json:"device_id"`
+	Version   int64  `json:"version"`
+	Delivered bool   `json:"delivered"` // false nếu device không kết nối, device nhận cấu hình khi kết nối lại
+}
+
+// Get device config (device self)
+type GetDeviceConfigSelfInput struct {
+	DeviceId  uuid.UUID `json:"device_id"`
+	CompanyId uuid.UUID `json:"company_id"`
+}
+
+// Ack device config (device self)
+type AckDeviceConfigInput struct {
+	DeviceId    uuid.UUID `json:"device_id"`
+	CompanyId   uuid.UUID `json:"company_id"`
+	Version     int64     `json:"version"`
+	ClientIp    string    `json:"client_ip"`
+	ClientAgent string    `json:"client_agent"`
+}
+type AckDeviceConfigOutput struct {
+	DeviceId       string `json:"device_id"`
+	AppliedVersion int64  `json:"applied_version"`
+}
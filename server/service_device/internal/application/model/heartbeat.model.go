@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// =================================================
+// Device heartbeat model
+// =================================================
+
+// Record heartbeat (device self)
+type RecordHeartbeatInput struct {
+	DeviceId        uuid.UUID `json:"device_id"`
+	CompanyId       uuid.UUID `json:"company_id"`
+	CpuUsage        *float32  `json:"cpu_usage"`
+	MemoryUsage     *float32  `json:"memory_usage"`
+	Temperature     *float32  `json:"temperature"`
+	FirmwareVersion string    `json:"firmware_version"`
+	ClientIp        string    `json:"client_ip"`
+	ClientAgent     string    `json:"client_agent"`
+}
+type RecordHeartbeatOutput struct {
+	Status          int   `json:"status"`           // 0: OFFLINE, 1: ONLINE, 2: MAINTENANCE, 3: ERROR
+	IntervalSeconds int   `json:"interval_seconds"` // Chu kỳ device gửi heartbeat tiếp theo
+	ServerTime      int64 `json:"server_time"`
+}
+
+// Get device uptime
+type GetDeviceUptimeInput struct {
+	// Info req
+	DeviceId uuid.UUID `json:"device_id"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	Page     int       `json:"page"`
+	Size     int       `json:"size"`
+	// Info client req
+	UserId      uuid.UUID `json:"user_id"`
+	Role        int       `json:"role"` // 0: ADMIN, 1: Admin company, 2: STAFF
+	SessionId   uuid.UUID `json:"session_id"`
+	ClientIp    string    `json:"client_ip"`
+	ClientAgent string    `json:"client_agent"`
+	CompanyId   uuid.UUID `json:"company_id"`
+}
+type GetDeviceUptimeOutput struct {
+	DeviceId        string                 `json:"device_id"`
+	From            int64                  `json:"from"`
+	To              int64                  `json:"to"`
+	TotalHeartbeats int64                  `json:"total_heartbeats"`
+	OnlineSeconds   int64                  `json:"online_seconds"`
+	WindowSeconds   int64                  `json:"window_seconds"`
+	UptimePercent   float64                `json:"uptime_percent"`
+	Heartbeats      []*DeviceHeartbeatItem `json:"heartbeats"`
+	Page            int                    `json:"page"`
+	Size            int                    `json:"size"`
+}
+type DeviceHeartbeatItem struct {
+	HeartbeatId     int64    `json:"heartbeat_id"`
+	CpuUsage        *float32 `json:"cpu_usage,omitempty"`
+	MemoryUsage     *float32 `json:"memory_usage,omitempty"`
+	Temperature     *float32 `json:"temperature,omitempty"`
+	FirmwareVersion string   `json:"firmware_version,omitempty"`
+	IpAddress       string   `json:"ip_address,omitempty"`
+	CreatedAt       int64    `json:"created_at"`
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+// =================================================
+// Company subscription model
+// =================================================
+
+// Get company usage
+type GetCompanyUsageInput struct {
+	// Info req
+	CompanyIdReq uuid.UUID `json:"company_id_req"` // Chỉ dùng cho ADMIN hệ thống
+	// Info client req
+	UserId      uuid.UUID `json:"user_id"`
+	Role        int       `json:"role"` // 0: ADMIN, 1: Admin company, 2: STAFF
+	SessionId   uuid.UUID `json:"session_id"`
+	ClientIp    string    `json:"client_ip"`
+	ClientAgent string    `json:"client_agent"`
+	CompanyId   uuid.UUID `json:"company_id"`
+}
+type GetCompanyUsageOutput struct {
+	CompanyId string      `json:"company_id"`
+	Plan      int         `json:"plan"` // 0: Basic, 1: Premium, 2: Enterprise
+	StartDate string      `json:"start_date,omitempty"`
+	EndDate   string      `json:"end_date,omitempty"`
+	Expired   bool        `json:"expired"`
+	ReadOnly  bool        `json:"read_only"`
+	Devices   *QuotaUsage `json:"devices"`
+	Employees *QuotaUsage `json:"employees"`
+}
+type QuotaUsage struct {
+	Used int64 `json:"used"`
+	Max  int64 `json:"max"`
+}
+
+// Company subscription (cache value)
+type CompanySubscription struct {
+	CompanyId    string `json:"company_id"`
+	Status       int    `json:"status"`
+	Plan         int    `json:"plan"`
+	StartDate    string `json:"start_date,omitempty"` // yyyy-mm-dd
+	EndDate      string `json:"end_date,omitempty"`   // yyyy-mm-dd
+	MaxDevices   int64  `json:"max_devices"`
+	MaxEmployees int64  `json:"max_employees"`
+}
@@ -24,8 +24,15 @@ type IDeviceService interface {
 	GetDeviceToken(ctx context.Context, input *model.GetDeviceTokenInput) (*model.GetDeviceTokenOutput, *applicationError.Error)
 	RefreshDeviceToken(ctx context.Context, input *model.RefreshDeviceTokenInput) (*model.RefreshDeviceTokenOutput, *applicationError.Error)
 	RefreshDeviceTokenSelf(ctx context.Context, input *model.RefreshDeviceTokenSelfInput) (*model.RefreshDeviceTokenOutput, *applicationError.Error)
+	CompromiseDevice(ctx context.Context, input *model.CompromiseDeviceInput) *applicationError.Error
 	UpdateStatusDevice(ctx context.Context, input *model.UpdateStatusDeviceInput) *applicationError.Error
 	VerifyFace(ctx context.Context, input *model.VerifyFaceInput) (*model.VerifyFaceOutput, *applicationError.Error)
+	RecordHeartbeat(ctx context.Context, input *model.RecordHeartbeatInput) (*model.RecordHeartbeatOutput, *applicationError.Error)
+	GetDeviceUptime(ctx context.Context, input *model.GetDeviceUptimeInput) (*model.GetDeviceUptimeOutput, *applicationError.Error)
+	GetDeviceConfig(ctx context.Context, input *model.GetDeviceConfigInput) (*model.DeviceConfigOutput, *applicationError.Error)
+	UpdateDeviceConfig(ctx context.Context, input *model.UpdateDeviceConfigInput) (*model.UpdateDeviceConfigOutput, *applicationError.Error)
+	GetDeviceConfigSelf(ctx context.Context, input *model.GetDeviceConfigSelfInput) (*model.DeviceConfigOutput, *applicationError.Error)
+	AckDeviceConfig(ctx context.Context, input *model.AckDeviceConfigInput) (*model.AckDeviceConfigOutput, *applicationError.Error)
 }
 
 /**
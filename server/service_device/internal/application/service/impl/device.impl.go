@@ -93,7 +93,7 @@ func (d *DeviceService) UpdateStatusDevice(ctx context.Context, input *model.Upd
 	}
 	// Check device exist
 	deviceRepo, _ := domainRepo.GetDeviceRepository()
-	ok, err := deviceRepo.DeviceExist(ctx, &domainModel.DeviceExistInput{DeviceId: input.DeviceId})
+	deviceInfo, err := deviceRepo.DeviceInfoBase(ctx, &domainModel.DeviceInfoBaseInput{DeviceId: input.DeviceId})
 	if err != nil {
 		global.Logger.Error("Error when get device by id", "err", err)
 		return &applicationError.Error{
@@ -101,7 +101,7 @@ func (d *DeviceService) UpdateStatusDevice(ctx context.Context, input *model.Upd
 			ErrorClient: "System is busy now. Please try again later.",
 		}
 	}
-	if !ok {
+	if deviceInfo == nil {
 		return &applicationError.Error{
 			ErrorSystem: nil,
 			ErrorClient: "Device not found.",
@@ -117,6 +117,7 @@ func (d *DeviceService) UpdateStatusDevice(ctx context.Context, input *model.Upd
 			}
 		}
 	} else {
+		priorToken := d.currentDeviceToken(ctx, input.DeviceId)
 		if err := deviceRepo.DisableDevice(ctx, &domainModel.DisableDeviceInput{DeviceId: input.DeviceId}); err != nil {
 			global.Logger.Error("Error when disable device", "err", err)
 			return &applicationError.Error{
@@ -124,25 +125,21 @@ func (d *DeviceService) UpdateStatusDevice(ctx context.Context, input *model.Upd
 				ErrorClient: "System is busy now. Please try again later.",
 			}
 		}
+		// Device bị vô hiệu hóa, thu hồi token và ngắt kết nối
+		d.revokeDeviceTokens(ctx, input.DeviceId, priorToken)
 	}
-	// Rm cache of device info
-	limit, offset := utils.GetPagination(constants.PageDefault, constants.SizeDefault)
-	key := []string{
-		sharedCache.GetKeyDeviceBase(sharedCrypto.GetHash(input.DeviceId.String())),
-		sharedCache.GetKeyListDeviceInCompany(
-			sharedCrypto.GetHash(input.CompanyId.String()),
-			limit,
-			offset,
-		),
+	// Rm cache of device info and notify status changed
+	status := domainModel.DeviceStatusOffline
+	if input.Status == 1 {
+		status = domainModel.DeviceStatusOnline
 	}
-	go func() {
-		cacheService, _ := domainCache.GetDistributedCache()
-		for _, k := range key {
-			if err := cacheService.Delete(context.Background(), k); err != nil {
-				global.Logger.Error("Error when delete device info cache", "err", err)
-			}
-		}
-	}()
+	d.publishDeviceStatusChanged(
+		ctx,
+		input.DeviceId,
+		deviceInfo.CompanyId,
+		status,
+		constants.DEVICE_STATUS_REASON_MANUAL,
+	)
 	return nil
 }
 
@@ -200,6 +197,7 @@ func (d *DeviceService) RefreshDeviceTokenSelf(ctx context.Context, input *model
 }
 
 func (d *DeviceService) refreshDeviceToken(ctx context.Context, deviceId uuid.UUID, companyId uuid.UUID) (*model.RefreshDeviceTokenOutput, *applicationError.Error) {
+	priorToken := d.currentDeviceToken(ctx, deviceId)
 	// Call to grpc service to refresh token and create new token
 	domainToken := domainToken.GetTokenService()
 	newToken, err := domainToken.CreateDeviceToken(ctx, &domainModel.TokenDeviceJwtInput{
@@ -219,7 +217,18 @@ func (d *DeviceService) refreshDeviceToken(ctx context.Context, deviceId uuid.UU
 			ErrorClient: "Failed to create new device token.",
 		}
 	}
-	// save new token to cache
+	// save new token to db and cache
+	deviceRepo, _ := domainRepo.GetDeviceRepository()
+	if err := deviceRepo.UpdateTokenDevice(ctx, &domainModel.UpdateTokenDeviceInput{
+		DeviceId: deviceId,
+		NewToken: newToken,
+	}); err != nil {
+		global.Logger.Error("Error when update device token", "err", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
 	distributedCacheService, _ := domainCache.GetDistributedCache()
 	key := sharedCache.GetKeyDeviceToken(sharedCrypto.GetHash(deviceId.String()))
 	distributedCacheService.SetTTL(
@@ -228,6 +237,9 @@ func (d *DeviceService) refreshDeviceToken(ctx context.Context, deviceId uuid.UU
 		newToken,
 		constants.TTL_DEVICE_TOKEN,
 	)
+	// Revoke old token, mark new token as active and notify ws delivery to drop connections using old token
+	d.revokeDeviceToken(ctx, deviceId, priorToken)
+	d.publishDeviceTokenRevoked(ctx, deviceId, sharedCrypto.GetHash(newToken))
 	// Rm cache of device info
 	limit, offset := utils.GetPagination(constants.PageDefault, constants.SizeDefault)
 	keyRm := []string{
@@ -252,6 +264,27 @@ func (d *DeviceService) refreshDeviceToken(ctx context.Context, deviceId uuid.UU
 	}, nil
 }
 
+// publishDeviceTokenRevoked lưu hash token hiện tại của device và gửi sự kiện để ws delivery ngắt các kết nối dùng token cũ.
+// Key hết hạn cùng với token để không tồn tại mãi cho device không còn dùng
+func (d *DeviceService) publishDeviceTokenRevoked(ctx context.Context, deviceId uuid.UUID, activeTokenHash string) {
+	distributedCacheService, _ := domainCache.GetDistributedCache()
+	if err := distributedCacheService.SetTTL(
+		ctx,
+		sharedCache.GetKeyDeviceActiveToken(sharedCrypto.GetHash(deviceId.String())),
+		activeTokenHash,
+		constants.TTL_TOKEN_DEVICE,
+	); err != nil {
+		global.Logger.Error("Error when save active device token", "err", err)
+	}
+	if err := distributedCacheService.Publish(
+		ctx,
+		constants.RedisChannelDeviceTokenRevoked,
+		domainModel.DeviceTokenRevokedEvent{DeviceId: deviceId.String()},
+	); err != nil {
+		global.Logger.Error("Error when publish device token revoked event", "err", err)
+	}
+}
+
 // GetDeviceToken implements service.IDeviceService.
 func (d *DeviceService) GetDeviceToken(ctx context.Context, input *model.GetDeviceTokenInput) (*model.GetDeviceTokenOutput, *applicationError.Error) {
 	// Check user have permission to get device token
@@ -607,6 +640,12 @@ func (d *DeviceService) CreateNewDevice(ctx context.Context, input *model.Create
 		// For admin
 		companyId = input.CompanyIdReq
 	}
+	// Check company subscription quota, giữ khóa quota đến khi tạo device xong
+	releaseQuota, errQuota := service.GetSubscriptionService().CheckDeviceQuota(ctx, companyId)
+	if errQuota != nil {
+		return nil, errQuota
+	}
+	defer releaseQuota()
 	// Create new device
 	deviceUuid := uuid.New()
 	deviceRepo, _ := domainRepo.GetDeviceRepository()
@@ -705,6 +744,7 @@ func (d *DeviceService) DeleteDeviceById(ctx context.Context, input *model.Delet
 		}
 	}
 	// Delete device
+	priorToken := d.currentDeviceToken(ctx, input.DeviceId)
 	if err := deviceRepo.DeleteDevice(
 		ctx,
 		&domainModel.DeleteDeviceInput{
@@ -717,6 +757,8 @@ func (d *DeviceService) DeleteDeviceById(ctx context.Context, input *model.Delet
 			ErrorClient: "System is busy now. Please try again later.",
 		}
 	}
+	// Device đã xóa, thu hồi token và ngắt kết nối
+	d.revokeDeviceTokens(ctx, input.DeviceId, priorToken)
 	// Rm cache of device info
 	limit, offset := utils.GetPagination(constants.PageDefault, constants.SizeDefault)
 	key := []string{
@@ -917,7 +959,94 @@ func (d *DeviceService) GetListDevices(ctx context.Context, input *model.ListDev
 
 // UpdateDeviceById implements service.IDeviceService.
 func (d *DeviceService) UpdateDeviceById(ctx context.Context, input *model.UpdateDeviceInput) (*model.UpdateDeviceOutput, *applicationError.Error) {
-	panic("unimplemented")
+	// Check permission
+	if input.Role > 1 {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "You don't have permission to update device info.",
+		}
+	}
+	domainUser, _ := domainRepo.GetUserRepository()
+	ok, err := domainUser.UserPermissionDevice(ctx, &domainModel.UserPermissionDeviceInput{
+		UserID:   input.UserId,
+		DeviceID: input.DeviceId,
+	})
+	if err != nil {
+		global.Logger.Error("Error when check user permission device", "err", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
+	if !ok && input.Role != 0 {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "You don't have permission to update device info.",
+		}
+	}
+	// Update device, field không truyền giữ nguyên
+	deviceRepo, _ := domainRepo.GetDeviceRepository()
+	priorToken := ""
+	if input.Status != nil && *input.Status == domainModel.DeviceStatusOffline {
+		priorToken = d.currentDeviceToken(ctx, input.DeviceId)
+	}
+	resp, err := deviceRepo.UpdateDevice(
+		ctx,
+		&domainModel.UpdateDeviceInput{
+			DeviceId:     input.DeviceId,
+			LocationId:   input.LocationId,
+			Name:         input.DeviceName,
+			Address:      input.Address,
+			DeviceType:   input.DeviceType,
+			SerialNumber: input.SerialNumber,
+			MacAddress:   input.MacAddress,
+			Status:       input.Status,
+		},
+	)
+	if err != nil {
+		global.Logger.Error("Error when update device", "err", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
+	if resp == nil {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Device not found.",
+		}
+	}
+	if input.Status != nil {
+		if *input.Status == domainModel.DeviceStatusOffline {
+			// Device bị vô hiệu hóa, thu hồi token và ngắt kết nối
+			d.revokeDeviceTokens(ctx, input.DeviceId, priorToken)
+		}
+		// Xóa cache và báo phiên admin trạng thái mới
+		d.publishDeviceStatusChanged(ctx, input.DeviceId, resp.CompanyId, resp.Status, constants.DEVICE_STATUS_REASON_MANUAL)
+	} else {
+		// Rm cache of device info
+		limit, offset := utils.GetPagination(constants.PageDefault, constants.SizeDefault)
+		key := []string{
+			sharedCache.GetKeyDeviceBase(sharedCrypto.GetHash(input.DeviceId.String())),
+			sharedCache.GetKeyListDeviceInCompany(
+				sharedCrypto.GetHash(resp.CompanyId.String()),
+				limit,
+				offset,
+			),
+		}
+		go func() {
+			cacheService, _ := domainCache.GetDistributedCache()
+			for _, k := range key {
+				if err := cacheService.Delete(context.Background(), k); err != nil {
+					global.Logger.Error("Error when delete device info cache", "err", err)
+				}
+			}
+		}()
+	}
+	return &model.UpdateDeviceOutput{
+		DeviceId: input.DeviceId.String(),
+		Status:   resp.Status,
+	}, nil
 }
 
 // VerifyFace implements service.IDeviceService.
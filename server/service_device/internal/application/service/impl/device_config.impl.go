@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	applicationError "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/error"
+	model "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/model"
+	constants "github.com/youknow2509/cio_verify_face/server/service_device/internal/constants"
+	domainDelivery "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/delivery"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/model"
+	domainRepo "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/repository"
+	global "github.com/youknow2509/cio_verify_face/server/service_device/internal/global"
+)
+
+// GetDeviceConfig implements service.IDeviceService.
+func (d *DeviceService) GetDeviceConfig(ctx context.Context, input *model.GetDeviceConfigInput) (*model.DeviceConfigOutput, *applicationError.Error) {
+	if errPermission := d.checkPermissionDeviceConfig(ctx, input.Role, input.UserId, input.DeviceId); errPermission != nil {
+		return nil, errPermission
+	}
+	return d.getDeviceConfig(ctx, input.DeviceId)
+}
+
+// UpdateDeviceConfig implements service.IDeviceService.
+func (d *DeviceService) UpdateDeviceConfig(ctx context.Context, input *model.UpdateDeviceConfigInput) (*model.UpdateDeviceConfigOutput, *applicationError.Error) {
+	if errPermission := d.checkPermissionDeviceConfig(ctx, input.Role, input.UserId, input.DeviceId); errPermission != nil {
+		return nil, errPermission
+	}
+	if !validWorkingHours(input.Config.WorkingHours) {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Working hours start must be before end.",
+		}
+	}
+	settings, err := json.Marshal(input.Config)
+	if err != nil {
+		global.Logger.Error("Error when marshal device config", "err", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
+	configRepo, _ := domainRepo.GetDeviceConfigRepository()
+	resp, err := configRepo.UpdateDeviceConfig(
+		ctx,
+		&domainModel.UpdateDeviceConfigInput{
+			DeviceId:        input.DeviceId,
+			Settings:        settings,
+			ExpectedVersion: input.Version,
+		},
+	)
+	if err != nil {
+		global.Logger.Error("Error when update device config", "err", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
+	if resp == nil {
+		// Device không tồn tại hoặc cấu hình đã bị người khác cập nhật
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Device config has been changed or device not found. Please reload and try again.",
+		}
+	}
+	// Đẩy cấu hình mới tới device, lỗi không ảnh hưởng cập nhật vì device lấy lại cấu hình qua /device/me/config
+	config, errConfig := d.getDeviceConfig(ctx, input.DeviceId)
+	if errConfig != nil {
+		return &model.UpdateDeviceConfigOutput{
+			DeviceId: input.DeviceId.String(),
+			Version:  resp.Version,
+		}, nil
+	}
+	return &model.UpdateDeviceConfigOutput{
+		DeviceId:  input.DeviceId.String(),
+		Version:   resp.Version,
+		Delivered: d.pushDeviceConfig(ctx, input.DeviceId, config),
+	}, nil
+}
+
+// GetDeviceConfigSelf implements service.IDeviceService.
+func (d *DeviceService) GetDeviceConfigSelf(ctx context.Context, input *model.GetDeviceConfigSelfInput) (*model.DeviceConfigOutput, *applicationError.Error) {
+	return d.getDeviceConfig(ctx, input.DeviceId)
+}
+
+// AckDeviceConfig implements service.IDeviceService.
+func (d *DeviceService) AckDeviceConfig(ctx context.Context, input *model.AckDeviceConfigInput) (*model.AckDeviceConfigOutput, *applicationError.Error) {
+	configRepo, _ := domainRepo.GetDeviceConfigRepository()
+	ok, err := configRepo.AckDeviceConfig(
+		ctx,
+		&domainModel.AckDeviceConfigInput{
+			DeviceId: input.DeviceId,
+			Version:  input.Version,
+		},
+	)
+	if err != nil {
+		global.Logger.Error("Error when ack device config", "err", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
+	if !ok {
+		// Ack lặp lại cùng version không phải lỗi
+		config, errConfig := d.getDeviceConfig(ctx, input.DeviceId)
+		if errConfig != nil {
+			return nil, errConfig
+		}
+		if config.AppliedVersion != input.Version {
+			return nil, &applicationError.Error{
+				ErrorSystem: nil,
+				ErrorClient: "Invalid config version.",
+			}
+		}
+	}
+	return &model.AckDeviceConfigOutput{
+		DeviceId:       input.DeviceId.String(),
+		AppliedVersion: input.Version,
+	}, nil
+}
+
+// getDeviceConfig lấy cấu hình device, field chưa cấu hình dùng giá trị mặc định
+func (d *DeviceService) getDeviceConfig(ctx context.Context, deviceId uuid.UUID) (*model.DeviceConfigOutput, *applicationError.Error) {
+	configRepo, _ := domainRepo.GetDeviceConfigRepository()
+	resp, err := configRepo.GetDeviceConfig(
+		ctx,
+		&domainModel.GetDeviceConfigInput{
+			DeviceId: deviceId,
+		},
+	)
+	if err != nil {
+		global.Logger.Error("Error when get device config", "err", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
+	if resp == nil {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Device not found.",
+		}
+	}
+	config := defaultDeviceConfig()
+	if len(resp.Settings) > 0 {
+		if err := json.Unmarshal(resp.Settings, &config); err != nil {
+			global.Logger.Error("Error when unmarshal device config, use default config", "deviceID", deviceId, "err", err)
+			config = defaultDeviceConfig()
+		}
+	}
+	output := &model.DeviceConfigOutput{
+		DeviceId:       deviceId.String(),
+		Version:        resp.Version,
+		AppliedVersion: resp.AppliedVersion,
+		Config:         config,
+	}
+	if resp.AppliedAt != nil {
+		output.AppliedAt = resp.AppliedAt.Unix()
+	}
+	return output, nil
+}
+
+// pushDeviceConfig gửi cấu hình mới tới device qua service ws delivery, trả về true nếu device đang kết nối và đã nhận
+func (d *DeviceService) pushDeviceConfig(ctx context.Context, deviceId uuid.UUID, config *model.DeviceConfigOutput) bool {
+	deliveryService := domainDelivery.GetDeviceDeliveryService()
+	if deliveryService == nil {
+		return false
+	}
+	payload, err := json.Marshal(config)
+	if err != nil {
+		global.Logger.Error("Error when marshal device config payload", "err", err)
+		return false
+	}
+	ctxPush, cancel := context.WithTimeout(ctx, constants.DEVICE_CONFIG_PUSH_TIMEOUT_SECONDS*time.Second)
+	defer cancel()
+	resp, err := deliveryService.SendToDevice(
+		ctxPush,
+		&domainModel.SendToDeviceInput{
+			DeviceId: deviceId,
+			Type:     constants.WS_EVENT_DEVICE_CONFIG,
+			Payload:  payload,
+		},
+	)
+	if err != nil {
+		global.Logger.Error("Error when push device config to ws delivery", "deviceID", deviceId, "err", err)
+		return false
+	}
+	return resp.Delivered
+}
+
+// checkPermissionDeviceConfig chỉ admin hoặc quản lý công ty sở hữu device được xem/sửa cấu hình
+func (d *DeviceService) checkPermissionDeviceConfig(ctx context.Context, role int, userId uuid.UUID, deviceId uuid.UUID) *applicationError.Error {
+	if role > 1 {
+		return &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "You don't have permission to manage device config.",
+		}
+	}
+	if role != domainModel.RoleManager {
+		return nil
+	}
+	userRepo, _ := domainRepo.GetUserRepository()
+	ok, err := userRepo.UserPermissionDevice(ctx, &domainModel.UserPermissionDeviceInput{
+		UserID:   userId,
+		DeviceID: deviceId,
+	})
+	if err != nil {
+		global.Logger.Error("Error when check user permission device", "err", err)
+		return &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
+	if !ok {
+		return &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "You don't have permission to manage device config.",
+		}
+	}
+	return nil
+}
+
+func defaultDeviceConfig() model.DeviceConfig {
+	return model.DeviceConfig{
+		LivenessThreshold: constants.DEFAULT_DEVICE_LIVENESS_THRESHOLD,
+		MatchThreshold:    constants.DEFAULT_DEVICE_MATCH_THRESHOLD,
+		WorkingHours: model.DeviceWorkingHours{
+			Start: constants.DEFAULT_DEVICE_WORKING_START,
+			End:   constants.DEFAULT_DEVICE_WORKING_END,
+			Days:  []int{0, 1, 2, 3, 4, 5, 6},
+		},
+		UiLanguage:          constants.DEFAULT_DEVICE_UI_LANGUAGE,
+		VerificationMethods: []string{constants.DEVICE_VERIFICATION_METHOD_FACE},
+	}
+}
+
+// validWorkingHours giờ đã được validate định dạng HH:MM ở tầng interface
+func validWorkingHours(workingHours model.DeviceWorkingHours) bool {
+	start, errStart := time.Parse("15:04", workingHours.Start)
+	end, errEnd := time.Parse("15:04", workingHours.End)
+	if errStart != nil || errEnd != nil {
+		return false
+	}
+	return start.Before(end)
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	applicationError "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/error"
+	model "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/model"
+	constants "github.com/youknow2509/cio_verify_face/server/service_device/internal/constants"
+	domainCache "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/cache"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/model"
+	domainRepo "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/repository"
+	domainToken "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/token"
+	global "github.com/youknow2509/cio_verify_face/server/service_device/internal/global"
+	sharedCache "github.com/youknow2509/cio_verify_face/server/service_device/internal/shared/utils/cache"
+	sharedCrypto "github.com/youknow2509/cio_verify_face/server/service_device/internal/shared/utils/crypto"
+	sharedRandom "github.com/youknow2509/cio_verify_face/server/service_device/internal/shared/utils/random"
+)
+
+// CompromiseDevice implements service.IDeviceService.
+// Thu hồi token hiện tại, đánh device sang ERROR và ngắt kết nối WebSocket; device cần được refresh token để dùng lại.
+func (d *DeviceService) CompromiseDevice(ctx context.Context, input *model.CompromiseDeviceInput) *applicationError.Error {
+	// Check permission
+	if input.Role > 1 {
+		return &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "You don't have permission to mark device compromised.",
+		}
+	}
+	if input.Role == domainModel.RoleManager {
+		// Check user in company
+		userRepo, _ := domainRepo.GetUserRepository()
+		ok, err := userRepo.UserPermissionDevice(ctx, &domainModel.UserPermissionDeviceInput{
+			UserID:   input.UserId,
+			DeviceID: input.DeviceId,
+		})
+		if err != nil {
+			global.Logger.Error("Error when check user permission device", "err", err)
+			return &applicationError.Error{
+				ErrorSystem: err,
+				ErrorClient: "System is busy now. Please try again later.",
+			}
+		}
+		if !ok {
+			return &applicationError.Error{
+				ErrorSystem: nil,
+				ErrorClient: "You don't have permission to mark device compromised.",
+			}
+		}
+	}
+	// Check device exist
+	deviceRepo, _ := domainRepo.GetDeviceRepository()
+	deviceInfo, err := deviceRepo.DeviceInfoBase(ctx, &domainModel.DeviceInfoBaseInput{DeviceId: input.DeviceId})
+	if err != nil {
+		global.Logger.Error("Error when get device by id", "err", err)
+		return &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
+	if deviceInfo == nil {
+		return &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Device not found.",
+		}
+	}
+	priorToken := d.currentDeviceToken(ctx, input.DeviceId)
+	// Thay token lưu trong DB để token cũ không còn được trả về cho admin
+	if err := deviceRepo.UpdateTokenDevice(ctx, &domainModel.UpdateTokenDeviceInput{
+		DeviceId: input.DeviceId,
+		NewToken: sharedRandom.RandomString(32),
+	}); err != nil {
+		global.Logger.Error("Error when update device token", "err", err)
+		return &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
+	status := domainModel.DeviceStatusError
+	if _, err := deviceRepo.UpdateDevice(ctx, &domainModel.UpdateDeviceInput{
+		DeviceId: input.DeviceId,
+		Status:   &status,
+	}); err != nil {
+		global.Logger.Error("Error when update device status", "err", err)
+		return &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
+	d.revokeDeviceTokens(ctx, input.DeviceId, priorToken)
+	d.publishDeviceStatusChanged(
+		ctx,
+		input.DeviceId,
+		deviceInfo.CompanyId,
+		status,
+		constants.DEVICE_STATUS_REASON_COMPROMISED,
+	)
+	global.Logger.Warn("Device marked compromised", "deviceId", input.DeviceId, "userId", input.UserId, "clientIp", input.ClientIp)
+	return nil
+}
+
+// currentDeviceToken lấy token hiện tại của device từ cache hoặc DB, trả về rỗng nếu không có
+func (d *DeviceService) currentDeviceToken(ctx context.Context, deviceId uuid.UUID) string {
+	distributedCacheService, _ := domainCache.GetDistributedCache()
+	token, err := distributedCacheService.Get(ctx, sharedCache.GetKeyDeviceToken(sharedCrypto.GetHash(deviceId.String())))
+	if err != nil {
+		global.Logger.Error("Error when get device token from cache", "err", err)
+	}
+	if token != "" {
+		return token
+	}
+	deviceRepo, _ := domainRepo.GetDeviceRepository()
+	resp, err := deviceRepo.GetDeviceToken(ctx, &domainModel.GetDeviceTokenInput{DeviceId: deviceId})
+	if err != nil {
+		global.Logger.Error("Error when get device token", "err", err)
+		return ""
+	}
+	if resp == nil {
+		return ""
+	}
+	return resp.Token
+}
+
+// revokeDeviceToken gọi auth thu hồi token id của token cũ đến khi token hết hạn, auth và ws delivery từ chối token này
+func (d *DeviceService) revokeDeviceToken(ctx context.Context, deviceId uuid.UUID, token string) {
+	if token == "" {
+		return
+	}
+	tokenInfo, errToken := domainToken.GetTokenService().ParseDeviceToken(ctx, token)
+	if errToken != nil {
+		// Token khởi tạo khi tạo device không phải jwt, không có gì để thu hồi
+		global.Logger.Warn("Skip revoke device token, parse token failed", "deviceId", deviceId, "err", errToken.Message)
+		return
+	}
+	if tokenInfo.DeviceId != deviceId.String() {
+		global.Logger.Warn("Skip revoke device token, token not belong to device", "deviceId", deviceId)
+		return
+	}
+	if err := domainToken.GetTokenService().BlockTokenDevice(ctx, &domainModel.BlockTokenDeviceInput{
+		DeviceId:  tokenInfo.DeviceId,
+		TokenId:   tokenInfo.TokenId,
+		ExpiresAt: tokenInfo.ExpiresAt,
+	}); err != nil {
+		global.Logger.Error("Error when block device token", "deviceId", deviceId, "err", err)
+	}
+}
+
+// revokeDeviceTokens thu hồi token cũ, xóa token khỏi cache và ngắt mọi kết nối của device (xóa, vô hiệu hóa, bị xâm phạm)
+func (d *DeviceService) revokeDeviceTokens(ctx context.Context, deviceId uuid.UUID, priorToken string) {
+	d.revokeDeviceToken(ctx, deviceId, priorToken)
+	distributedCacheService, _ := domainCache.GetDistributedCache()
+	if err := distributedCacheService.Delete(ctx, sharedCache.GetKeyDeviceToken(sharedCrypto.GetHash(deviceId.String()))); err != nil {
+		global.Logger.Error("Error when delete device token cache", "err", err)
+	}
+	d.publishDeviceTokenRevoked(ctx, deviceId, constants.RedisDeviceActiveTokenNone)
+}
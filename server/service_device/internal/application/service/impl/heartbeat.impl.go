@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	applicationError "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/error"
+	model "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/model"
+	constants "github.com/youknow2509/cio_verify_face/server/service_device/internal/constants"
+	domainCache "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/cache"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/model"
+	domainRepo "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/repository"
+	global "github.com/youknow2509/cio_verify_face/server/service_device/internal/global"
+	utils "github.com/youknow2509/cio_verify_face/server/service_device/internal/shared/utils"
+	sharedCache "github.com/youknow2509/cio_verify_face/server/service_device/internal/shared/utils/cache"
+	sharedCrypto "github.com/youknow2509/cio_verify_face/server/service_device/internal/shared/utils/crypto"
+)
+
+// RecordHeartbeat implements service.IDeviceService.
+func (d *DeviceService) RecordHeartbeat(ctx context.Context, input *model.RecordHeartbeatInput) (*model.RecordHeartbeatOutput, *applicationError.Error) {
+	heartbeatRepo, _ := domainRepo.GetDeviceHeartbeatRepository()
+	resp, err := heartbeatRepo.RecordHeartbeat(
+		ctx,
+		&domainModel.RecordHeartbeatInput{
+			DeviceId:        input.DeviceId,
+			CpuUsage:        input.CpuUsage,
+			MemoryUsage:     input.MemoryUsage,
+			Temperature:     input.Temperature,
+			FirmwareVersion: input.FirmwareVersion,
+			IpAddress:       input.ClientIp,
+		},
+	)
+	if err != nil {
+		global.Logger.Error("Error when record device heartbeat", "err", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
+	if resp == nil {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Device not found.",
+		}
+	}
+	// Device quay lại sau khi bị sweeper đánh OFFLINE
+	if resp.Recovered && resp.Status == domainModel.DeviceStatusOnline {
+		d.publishDeviceStatusChanged(
+			ctx,
+			input.DeviceId,
+			resp.CompanyId,
+			resp.Status,
+			constants.DEVICE_STATUS_REASON_HEARTBEAT,
+		)
+	}
+	interval := global.SettingServer.DeviceHeartbeat.IntervalSeconds
+	if interval <= 0 {
+		interval = constants.DEFAULT_HEARTBEAT_INTERVAL_SECONDS
+	}
+	return &model.RecordHeartbeatOutput{
+		Status:          resp.Status,
+		IntervalSeconds: interval,
+		ServerTime:      time.Now().Unix(),
+	}, nil
+}
+
+// GetDeviceUptime implements service.IDeviceService.
+func (d *DeviceService) GetDeviceUptime(ctx context.Context, input *model.GetDeviceUptimeInput) (*model.GetDeviceUptimeOutput, *applicationError.Error) {
+	// Check user have permission to get device uptime
+	if input.Role > 1 {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "You don't have permission to get device uptime.",
+		}
+	}
+	if !input.From.Before(input.To) {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Invalid time range.",
+		}
+	}
+	if input.To.Sub(input.From) > constants.HEARTBEAT_MAX_REPORT_DAYS*24*time.Hour {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Time range is too large.",
+		}
+	}
+	if input.Role == domainModel.RoleManager {
+		// Check user in company
+		userRepo, _ := domainRepo.GetUserRepository()
+		userInfo, err := userRepo.UserPermissionDevice(ctx, &domainModel.UserPermissionDeviceInput{
+			UserID:   input.UserId,
+			DeviceID: input.DeviceId,
+		})
+		if err != nil {
+			global.Logger.Error("Error when check user permission device", "err", err)
+			return nil, &applicationError.Error{
+				ErrorSystem: err,
+				ErrorClient: "System is busy now. Please try again later.",
+			}
+		}
+		if !userInfo {
+			return nil, &applicationError.Error{
+				ErrorSystem: nil,
+				ErrorClient: "You don't have permission to get device uptime.",
+			}
+		}
+	}
+	// Khoảng cách giữa hai heartbeat vượt quá thời gian offline thì không tính là online
+	maxGap := global.SettingServer.DeviceHeartbeat.OfflineTimeoutSeconds
+	if maxGap <= 0 {
+		maxGap = constants.DEFAULT_HEARTBEAT_OFFLINE_TIMEOUT_SECONDS
+	}
+	heartbeatRepo, _ := domainRepo.GetDeviceHeartbeatRepository()
+	uptime, err := heartbeatRepo.DeviceUptime(
+		ctx,
+		&domainModel.DeviceUptimeInput{
+			DeviceId:      input.DeviceId,
+			From:          input.From,
+			To:            input.To,
+			MaxGapSeconds: maxGap,
+		},
+	)
+	if err != nil {
+		global.Logger.Error("Error when get device uptime", "err", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
+	limit, offset := utils.GetPagination(input.Page, input.Size)
+	heartbeats, err := heartbeatRepo.ListDeviceHeartbeats(
+		ctx,
+		&domainModel.ListDeviceHeartbeatsInput{
+			DeviceId: input.DeviceId,
+			From:     input.From,
+			To:       input.To,
+			Limit:    limit,
+			Offset:   offset,
+		},
+	)
+	if err != nil {
+		global.Logger.Error("Error when get list device heartbeats", "err", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
+	items := make([]*model.DeviceHeartbeatItem, 0, len(heartbeats))
+	for _, heartbeat := range heartbeats {
+		items = append(items, &model.DeviceHeartbeatItem{
+			HeartbeatId:     heartbeat.HeartbeatId,
+			CpuUsage:        heartbeat.CpuUsage,
+			MemoryUsage:     heartbeat.MemoryUsage,
+			Temperature:     heartbeat.Temperature,
+			FirmwareVersion: heartbeat.FirmwareVersion,
+			IpAddress:       heartbeat.IpAddress,
+			CreatedAt:       heartbeat.CreatedAt,
+		})
+	}
+	windowSeconds := int64(input.To.Sub(input.From).Seconds())
+	uptimePercent := math.Min(100, float64(uptime.OnlineSeconds)*100/float64(windowSeconds))
+	return &model.GetDeviceUptimeOutput{
+		DeviceId:        input.DeviceId.String(),
+		From:            input.From.Unix(),
+		To:              input.To.Unix(),
+		TotalHeartbeats: uptime.TotalHeartbeats,
+		OnlineSeconds:   uptime.OnlineSeconds,
+		WindowSeconds:   windowSeconds,
+		UptimePercent:   math.Round(uptimePercent*100) / 100,
+		Heartbeats:      items,
+		Page:            offset/limit + 1,
+		Size:            limit,
+	}, nil
+}
+
+// publishDeviceStatusChanged xóa cache trạng thái device và gửi sự kiện để ws delivery báo cho phiên admin
+func (d *DeviceService) publishDeviceStatusChanged(ctx context.Context, deviceId uuid.UUID, companyId uuid.UUID, status int, reason string) {
+	limit, offset := utils.GetPagination(constants.PageDefault, constants.SizeDefault)
+	keys := []string{
+		sharedCache.GetKeyDeviceBase(sharedCrypto.GetHash(deviceId.String())),
+		sharedCache.GetKeyListDeviceInCompany(sharedCrypto.GetHash(companyId.String()), limit, offset),
+	}
+	distributedCacheService, _ := domainCache.GetDistributedCache()
+	for _, k := range keys {
+		if err := distributedCacheService.Delete(ctx, k); err != nil {
+			global.Logger.Error("Error when delete device info cache", "err", err)
+		}
+	}
+	if err := distributedCacheService.Publish(
+		ctx,
+		constants.RedisChannelDeviceStatusChanged,
+		domainModel.DeviceStatusChangedEvent{
+			DeviceId:  deviceId.String(),
+			CompanyId: companyId.String(),
+			Status:    status,
+			Reason:    reason,
+			Timestamp: time.Now().Unix(),
+		},
+	); err != nil {
+		global.Logger.Error("Error when publish device status changed event", "err", err)
+	}
+}
@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	applicationError "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/error"
+	model "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/model"
+	service "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/service"
+	constants "github.com/youknow2509/cio_verify_face/server/service_device/internal/constants"
+	domainCache "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/cache"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/model"
+	domainRepo "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/repository"
+	global "github.com/youknow2509/cio_verify_face/server/service_device/internal/global"
+	sharedCache "github.com/youknow2509/cio_verify_face/server/service_device/internal/shared/utils/cache"
+	sharedCrypto "github.com/youknow2509/cio_verify_face/server/service_device/internal/shared/utils/crypto"
+)
+
+const (
+	luaAcquireQuotaLock = `
+		if redis.call("SET", KEYS[1], ARGV[1], "NX", "EX", ARGV[2]) then
+			return 1
+		end
+		return 0
+	`
+	luaReleaseQuotaLock = `
+		if redis.call("GET", KEYS[1]) == ARGV[1] then
+			return redis.call("DEL", KEYS[1])
+		end
+		return 0
+	`
+	// Số lần thử lấy khóa quota khi request khác của công ty đang giữ khóa
+	quotaLockRetries    = 20
+	quotaLockRetryDelay = 50 * time.Millisecond
+)
+
+// =================================================
+// Company subscription application service
+// =================================================
+type SubscriptionService struct{}
+
+// GetCompanyUsage implements service.ISubscriptionService.
+func (s *SubscriptionService) GetCompanyUsage(ctx context.Context, input *model.GetCompanyUsageInput) (*model.GetCompanyUsageOutput, *applicationError.Error) {
+	// Check permission
+	if input.Role > 1 {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "You don't have permission to get company usage.",
+		}
+	}
+	// Get company id
+	var companyId uuid.UUID
+	if input.Role == domainModel.RoleManager {
+		companyId = input.CompanyId
+	} else {
+		// For admin
+		companyId = input.CompanyIdReq
+	}
+	if companyId == uuid.Nil {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Company id is required.",
+		}
+	}
+	subscription, errApp := s.getCompanySubscription(ctx, companyId)
+	if errApp != nil {
+		return nil, errApp
+	}
+	subscriptionRepo, _ := domainRepo.GetSubscriptionRepository()
+	devices, err := subscriptionRepo.CountDevicesInCompany(ctx, &domainModel.CountDevicesInCompanyInput{
+		CompanyId: companyId,
+	})
+	if err != nil {
+		global.Logger.Error("Error when count devices in company", "err", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
+	employees, err := subscriptionRepo.CountEmployeesInCompany(ctx, &domainModel.CountEmployeesInCompanyInput{
+		CompanyId: companyId,
+	})
+	if err != nil {
+		global.Logger.Error("Error when count employees in company", "err", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
+	expired := subscriptionExpired(subscription, time.Now())
+	return &model.GetCompanyUsageOutput{
+		CompanyId: subscription.CompanyId,
+		Plan:      subscription.Plan,
+		StartDate: subscription.StartDate,
+		EndDate:   subscription.EndDate,
+		Expired:   expired,
+		ReadOnly:  expired,
+		Devices: &model.QuotaUsage{
+			Used: devices,
+			Max:  subscription.MaxDevices,
+		},
+		Employees: &model.QuotaUsage{
+			Used: employees,
+			Max:  subscription.MaxEmployees,
+		},
+	}, nil
+}
+
+// CheckCompanyWritable implements service.ISubscriptionService.
+func (s *SubscriptionService) CheckCompanyWritable(ctx context.Context, companyId uuid.UUID) *applicationError.Error {
+	subscription, errApp := s.getCompanySubscription(ctx, companyId)
+	if errApp != nil {
+		return errApp
+	}
+	if subscriptionExpired(subscription, time.Now()) {
+		return &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Company subscription has expired, company is in read-only mode.",
+		}
+	}
+	return nil
+}
+
+// CheckDeviceQuota implements service.ISubscriptionService.
+// Khi thành công, khóa quota của công ty được giữ đến khi caller gọi release sau khi tạo device xong,
+// tránh hai request đồng thời cùng vượt qua kiểm tra.
+func (s *SubscriptionService) CheckDeviceQuota(ctx context.Context, companyId uuid.UUID) (func(), *applicationError.Error) {
+	subscription, errApp := s.getCompanySubscription(ctx, companyId)
+	if errApp != nil {
+		return nil, errApp
+	}
+	if subscriptionExpired(subscription, time.Now()) {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Company subscription has expired, company is in read-only mode.",
+		}
+	}
+	release, errApp := s.acquireQuotaLock(ctx, companyId)
+	if errApp != nil {
+		return nil, errApp
+	}
+	// Số lượng device luôn đọc từ DB, chỉ giới hạn được cache
+	subscriptionRepo, _ := domainRepo.GetSubscriptionRepository()
+	devices, err := subscriptionRepo.CountDevicesInCompany(ctx, &domainModel.CountDevicesInCompanyInput{
+		CompanyId: companyId,
+	})
+	if err != nil {
+		release()
+		global.Logger.Error("Error when count devices in company", "err", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
+	if devices >= subscription.MaxDevices {
+		release()
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: fmt.Sprintf("Company has reached the maximum number of devices (%d).", subscription.MaxDevices),
+		}
+	}
+	return release, nil
+}
+
+// acquireQuotaLock lấy khóa quota device của công ty, trả về hàm nhả khóa
+func (s *SubscriptionService) acquireQuotaLock(ctx context.Context, companyId uuid.UUID) (func(), *applicationError.Error) {
+	distributedCache, _ := domainCache.GetDistributedCache()
+	key := sharedCache.GetKeyCompanyDeviceQuotaLock(sharedCrypto.GetHash(companyId.String()))
+	token := uuid.NewString()
+	for attempt := 0; attempt < quotaLockRetries; attempt++ {
+		result, err := distributedCache.LuaScript(ctx, luaAcquireQuotaLock, []string{key}, token, constants.TTL_COMPANY_DEVICE_QUOTA_LOCK)
+		if err != nil {
+			global.Logger.Error("Error when acquire company device quota lock", "err", err)
+			return nil, &applicationError.Error{
+				ErrorSystem: err,
+				ErrorClient: "System is busy now. Please try again later.",
+			}
+		}
+		if acquired, ok := result.(int64); ok && acquired == 1 {
+			return func() {
+				if _, err := distributedCache.LuaScript(context.WithoutCancel(ctx), luaReleaseQuotaLock, []string{key}, token); err != nil {
+					global.Logger.Error("Error when release company device quota lock", "err", err)
+				}
+			}, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, &applicationError.Error{
+				ErrorSystem: ctx.Err(),
+				ErrorClient: "System is busy now. Please try again later.",
+			}
+		case <-time.After(quotaLockRetryDelay):
+		}
+	}
+	return nil, &applicationError.Error{
+		ErrorSystem: nil,
+		ErrorClient: "Another request is creating a device for this company. Please try again later.",
+	}
+}
+
+// ListenSubscriptionChanged implements service.ISubscriptionService.
+// Service identity gửi sự kiện khi cập nhật gói dịch vụ công ty, mọi replica xóa cache quota của công ty đó.
+func (s *SubscriptionService) ListenSubscriptionChanged(ctx context.Context) error {
+	distributedCache, err := domainCache.GetDistributedCache()
+	if err != nil {
+		return err
+	}
+	messages, err := distributedCache.Subscribe(ctx, constants.RedisChannelCompanySubscriptionChanged)
+	if err != nil {
+		return err
+	}
+	global.WaitGroup.Add(1)
+	go func() {
+		defer global.WaitGroup.Done()
+		for msg := range messages {
+			event, ok := msg.(map[string]interface{})
+			if !ok {
+				global.Logger.Warn("Invalid company subscription changed event", "msg", msg)
+				continue
+			}
+			companyIdStr, _ := event["company_id"].(string)
+			companyId, err := uuid.Parse(companyIdStr)
+			if err != nil {
+				global.Logger.Warn("Invalid company id in subscription changed event", "companyId", companyIdStr)
+				continue
+			}
+			s.invalidateCompanySubscription(context.Background(), companyId)
+		}
+	}()
+	return nil
+}
+
+// getCompanySubscription đọc gói dịch vụ công ty theo thứ tự local cache -> distributed cache -> DB
+func (s *SubscriptionService) getCompanySubscription(ctx context.Context, companyId uuid.UUID) (*model.CompanySubscription, *applicationError.Error) {
+	key := sharedCache.GetKeyCompanySubscription(sharedCrypto.GetHash(companyId.String()))
+	localCache, _ := domainCache.GetLocalCache()
+	distributedCache, _ := domainCache.GetDistributedCache()
+	// Local cache
+	if data, err := localCache.Get(ctx, key); err == nil && data != "" {
+		var subscription model.CompanySubscription
+		if err := json.Unmarshal([]byte(data), &subscription); err == nil {
+			return &subscription, nil
+		}
+	}
+	// Distributed cache
+	data, err := distributedCache.Get(ctx, key)
+	if err != nil {
+		global.Logger.Error("Error when get company subscription from cache", "err", err)
+	}
+	if data != "" {
+		var subscription model.CompanySubscription
+		if err := json.Unmarshal([]byte(data), &subscription); err == nil {
+			if err := localCache.SetTTL(ctx, key, data, constants.TTL_LOCAL_COMPANY_SUBSCRIPTION); err != nil {
+				global.Logger.Error("Error when set company subscription in local cache", "err", err)
+			}
+			return &subscription, nil
+		}
+	}
+	// Database
+	subscriptionRepo, _ := domainRepo.GetSubscriptionRepository()
+	resp, err := subscriptionRepo.GetCompanySubscription(ctx, &domainModel.GetCompanySubscriptionInput{
+		CompanyId: companyId,
+	})
+	if err != nil {
+		global.Logger.Error("Error when get company subscription", "err", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "System is busy now. Please try again later.",
+		}
+	}
+	if resp == nil {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Company not found.",
+		}
+	}
+	subscription := &model.CompanySubscription{
+		CompanyId:    resp.CompanyId.String(),
+		Status:       resp.Status,
+		Plan:         resp.Plan,
+		MaxDevices:   constants.DEFAULT_COMPANY_MAX_DEVICES,
+		MaxEmployees: constants.DEFAULT_COMPANY_MAX_EMPLOYEES,
+	}
+	if resp.StartDate != nil {
+		subscription.StartDate = resp.StartDate.Format(time.DateOnly)
+	}
+	if resp.EndDate != nil {
+		subscription.EndDate = resp.EndDate.Format(time.DateOnly)
+	}
+	if resp.MaxDevices != nil {
+		subscription.MaxDevices = int64(*resp.MaxDevices)
+	}
+	if resp.MaxEmployees != nil {
+		subscription.MaxEmployees = int64(*resp.MaxEmployees)
+	}
+	// Save in cache, not return error if cache error
+	if dataBytes, err := json.Marshal(subscription); err == nil {
+		if err := distributedCache.SetTTL(ctx, key, string(dataBytes), constants.TTL_COMPANY_SUBSCRIPTION); err != nil {
+			global.Logger.Error("Error when set company subscription in cache", "err", err)
+		}
+		if err := localCache.SetTTL(ctx, key, string(dataBytes), constants.TTL_LOCAL_COMPANY_SUBSCRIPTION); err != nil {
+			global.Logger.Error("Error when set company subscription in local cache", "err", err)
+		}
+	}
+	return subscription, nil
+}
+
+// invalidateCompanySubscription xóa cache gói dịch vụ công ty ở cả hai tầng
+func (s *SubscriptionService) invalidateCompanySubscription(ctx context.Context, companyId uuid.UUID) {
+	key := sharedCache.GetKeyCompanySubscription(sharedCrypto.GetHash(companyId.String()))
+	localCache, _ := domainCache.GetLocalCache()
+	if err := localCache.Delete(ctx, key); err != nil {
+		global.Logger.Error("Error when delete company subscription local cache", "err", err)
+	}
+	distributedCache, _ := domainCache.GetDistributedCache()
+	if err := distributedCache.Delete(ctx, key); err != nil {
+		global.Logger.Error("Error when delete company subscription cache", "err", err)
+	}
+}
+
+// subscriptionExpired gói dịch vụ hết hạn khi ngày kết thúc đã qua, không có ngày kết thúc là không giới hạn
+func subscriptionExpired(subscription *model.CompanySubscription, now time.Time) bool {
+	if subscription.EndDate == "" {
+		return false
+	}
+	return subscription.EndDate < now.Format(time.DateOnly)
+}
+
+// NewSubscriptionService create new instance and implement ISubscriptionService
+func NewSubscriptionService() service.ISubscriptionService {
+	return &SubscriptionService{}
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	applicationError "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/error"
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/application/model"
+)
+
+// =================================================
+// Company subscription application interface service
+// =================================================
+type ISubscriptionService interface {
+	GetCompanyUsage(ctx context.Context, input *model.GetCompanyUsageInput) (*model.GetCompanyUsageOutput, *applicationError.Error)
+	CheckCompanyWritable(ctx context.Context, companyId uuid.UUID) *applicationError.Error
+	CheckDeviceQuota(ctx context.Context, companyId uuid.UUID) (func(), *applicationError.Error)
+	ListenSubscriptionChanged(ctx context.Context) error
+}
+
+/**
+ * Managet instance
+ */
+var _vISubscriptionService ISubscriptionService
+
+/**
+ * Getter and setter instance
+ */
+func GetSubscriptionService() ISubscriptionService {
+	return _vISubscriptionService
+}
+func SetSubscriptionService(s ISubscriptionService) error {
+	if s == nil {
+		return errors.New("invalid subscription service")
+	}
+	if _vISubscriptionService != nil {
+		return errors.New("subscription service already set")
+	}
+	_vISubscriptionService = s
+	return nil
+}
@@ -0,0 +1,24 @@
+package constants
+
+// ================================================
+//
+//	Constants for device configuration
+//
+// ================================================
+
+// Cấu hình mặc định khi devices.settings chưa có giá trị
+const (
+	DEFAULT_DEVICE_LIVENESS_THRESHOLD = 0.8
+	DEFAULT_DEVICE_MATCH_THRESHOLD    = 0.6
+	DEFAULT_DEVICE_WORKING_START      = "00:00"
+	DEFAULT_DEVICE_WORKING_END        = "23:59"
+	DEFAULT_DEVICE_UI_LANGUAGE        = "vi"
+	DEVICE_VERIFICATION_METHOD_FACE   = "face"
+)
+
+const (
+	// Loại message ws gửi cấu hình tới device, trùng với WSEventDeviceConfig của service ws delivery
+	WS_EVENT_DEVICE_CONFIG = 5
+	// Thời gian chờ service ws delivery nhận message cấu hình (giây)
+	DEVICE_CONFIG_PUSH_TIMEOUT_SECONDS = 5
+)
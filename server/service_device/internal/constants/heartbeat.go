@@ -0,0 +1,25 @@
+package constants
+
+// ================================================
+//
+//	Constants for device heartbeat
+//
+// ================================================
+const (
+	DEFAULT_HEARTBEAT_INTERVAL_SECONDS        = 30
+	DEFAULT_HEARTBEAT_OFFLINE_TIMEOUT_SECONDS = 90
+	DEFAULT_HEARTBEAT_SWEEP_INTERVAL_SECONDS  = 30
+	DEFAULT_HEARTBEAT_RETENTION_DAYS          = 30
+
+	HEARTBEAT_DEFAULT_REPORT_HOURS  = 24      // Khoảng thời gian báo cáo uptime mặc định
+	HEARTBEAT_MAX_REPORT_DAYS       = 31      // Khoảng thời gian báo cáo uptime tối đa
+	HEARTBEAT_RETENTION_SWEEP_EVERY = 60 * 60 // Chu kỳ xóa lịch sử heartbeat cũ (giây)
+)
+
+// Lý do trạng thái device thay đổi
+const (
+	DEVICE_STATUS_REASON_HEARTBEAT   = "heartbeat"         // Device gửi heartbeat sau khi bị đánh OFFLINE
+	DEVICE_STATUS_REASON_TIMEOUT     = "heartbeat_timeout" // Sweeper đánh OFFLINE do quá hạn heartbeat
+	DEVICE_STATUS_REASON_MANUAL      = "manual"            // Admin cập nhật trạng thái
+	DEVICE_STATUS_REASON_COMPROMISED = "compromised"       // Admin đánh dấu device bị xâm phạm
+)
@@ -14,3 +14,18 @@ const (
 const (
 	RedisPrefixRateLimiter = "ratelimiter:"
 )
+
+// Pub/sub channel
+const (
+	// Channel thông báo token device được refresh hoặc thu hồi, service ws delivery ngắt kết nối dùng token cũ
+	RedisChannelDeviceTokenRevoked = "device:token:revoked"
+	// Channel thông báo trạng thái device thay đổi, service ws delivery gửi tới phiên admin của công ty
+	RedisChannelDeviceStatusChanged = "device:status:changed"
+	// Channel thông báo gói dịch vụ công ty thay đổi (service identity gửi khi cập nhật companies), các replica xóa cache quota
+	RedisChannelCompanySubscriptionChanged = "company:subscription:changed"
+)
+
+const (
+	// Giá trị token active khi device bị thu hồi mọi token (xóa, vô hiệu hóa, bị xâm phạm), không hash token nào khớp
+	RedisDeviceActiveTokenNone = "none"
+)
@@ -0,0 +1,13 @@
+package constants
+
+// ================================================
+//
+//	Constants for company subscription
+//
+// ================================================
+
+// Giới hạn mặc định khi companies.max_devices / max_employees NULL, trùng với DEFAULT của bảng companies
+const (
+	DEFAULT_COMPANY_MAX_DEVICES   = 10
+	DEFAULT_COMPANY_MAX_EMPLOYEES = 100
+)
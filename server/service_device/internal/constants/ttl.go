@@ -30,6 +30,11 @@ const (
 	// Additional cache TTLs
 	TTL_USER_PERMISSION = 60 * 10 // 10 minutes
 	TTL_DEVICE_CHECK    = 60 * 5  // 5 minutes
+
+	// Company subscription quota
+	TTL_COMPANY_SUBSCRIPTION       = 60 * 10 // 10 minutes
+	TTL_LOCAL_COMPANY_SUBSCRIPTION = 30      // 30 seconds
+	TTL_COMPANY_DEVICE_QUOTA_LOCK  = 10      // 10 seconds, giữ trong lúc kiểm tra quota và tạo device
 )
 
 // For spam and count spam
@@ -7,26 +7,28 @@ package config
 // ==========================================================
 type (
 	Setting struct {
-		AuthService       AuthServiceSetting   `mapstructure:"service_auth"`
-		FaceService       FaceServiceSetting   `mapstructure:"service_face"`
-		GrpcServer        GrpcSetting          `mapstructure:"grpc"`
-		Server            ServerSetting        `mapstructure:"server"`
-		WsServer          WsSetting            `mapstructure:"ws"`
-		Observability     ObservabilitySetting `mapstructure:"observability"`
-		Cassandra         CassandraSetting     `mapstructure:"cassandra"`
-		Elasticsearch     ElasticsearchSetting `mapstructure:"elasticsearch"`
-		Jaeger            JaegerSetting        `mapstructure:"jaeger"`
-		Kafka             KafkaSetting         `mapstructure:"kafka"`
-		Memcached         MemcachedSetting     `mapstructure:"memcached"`
-		Minio             MinioSetting         `mapstructure:"minio"`
-		Postgres          PostgresSetting      `mapstructure:"postgres"`
-		Redis             RedisSetting         `mapstructure:"redis"`
-		ScyllaDb          ScyllaDbSetting      `mapstructure:"scylladb"`
-		Logstash          LogstashSetting      `mapstructure:"logstash"`
-		SMTP              SMTPSetting          `mapstructure:"smtp"`
-		JWT               JWTSetting           `mapstructure:"jwt"`
-		Logger            LoggerSetting        `mapstructure:"logger"`
-		RateLimitPolicies []RateLimitPolicy    `mapstructure:"policy_rate_limit"`
+		AuthService       AuthServiceSetting       `mapstructure:"service_auth"`
+		FaceService       FaceServiceSetting       `mapstructure:"service_face"`
+		WsDeliveryService WsDeliveryServiceSetting `mapstructure:"service_ws_delivery"`
+		DeviceHeartbeat   DeviceHeartbeatSetting   `mapstructure:"device_heartbeat"`
+		GrpcServer        GrpcSetting              `mapstructure:"grpc"`
+		Server            ServerSetting            `mapstructure:"server"`
+		WsServer          WsSetting                `mapstructure:"ws"`
+		Observability     ObservabilitySetting     `mapstructure:"observability"`
+		Cassandra         CassandraSetting         `mapstructure:"cassandra"`
+		Elasticsearch     ElasticsearchSetting     `mapstructure:"elasticsearch"`
+		Jaeger            JaegerSetting            `mapstructure:"jaeger"`
+		Kafka             KafkaSetting             `mapstructure:"kafka"`
+		Memcached         MemcachedSetting         `mapstructure:"memcached"`
+		Minio             MinioSetting             `mapstructure:"minio"`
+		Postgres          PostgresSetting          `mapstructure:"postgres"`
+		Redis             RedisSetting             `mapstructure:"redis"`
+		ScyllaDb          ScyllaDbSetting          `mapstructure:"scylladb"`
+		Logstash          LogstashSetting          `mapstructure:"logstash"`
+		SMTP              SMTPSetting              `mapstructure:"smtp"`
+		JWT               JWTSetting               `mapstructure:"jwt"`
+		Logger            LoggerSetting            `mapstructure:"logger"`
+		RateLimitPolicies []RateLimitPolicy        `mapstructure:"policy_rate_limit"`
 	}
 )
 
@@ -76,6 +78,32 @@ type FaceServiceTLSSetting struct {
 	KeyFile  string `mapstructure:"key_file"`
 }
 
+// WsDeliveryServiceSetting - đẩy cấu hình mới tới device qua service ws delivery
+type WsDeliveryServiceSetting struct {
+	Enabled                     bool                        `mapstructure:"enabled"`
+	GrpcAddr                    string                      `mapstructure:"grpc_addr"`
+	KeepaliveTimeMs             int                         `mapstructure:"keepalive_time_ms"`
+	KeepaliveTimeoutMs          int                         `mapstructure:"keepalive_timeout_ms"`
+	KeepalivePermitWithoutCalls bool                        `mapstructure:"keepalive_permit_without_calls"`
+	Tls                         WsDeliveryServiceTLSSetting `mapstructure:"tls"`
+}
+
+// Sub struct for TLS settings in WsDeliveryServiceSetting
+type WsDeliveryServiceTLSSetting struct {
+	Enabled  bool   `mapstructure:"enabled"`
+	CertFile string `mapstructure:"cert_file"`
+	KeyFile  string `mapstructure:"key_file"`
+}
+
+// DeviceHeartbeatSetting - heartbeat của device gửi lên, khác với heartbeat_interval_ms của kafka consumer
+type DeviceHeartbeatSetting struct {
+	Enabled               bool `mapstructure:"enabled"`                 // Bật sweeper chuyển device quá hạn heartbeat sang OFFLINE
+	IntervalSeconds       int  `mapstructure:"interval_seconds"`        // Chu kỳ device gửi heartbeat, trả về cho device
+	OfflineTimeoutSeconds int  `mapstructure:"offline_timeout_seconds"` // Quá thời gian này không có heartbeat thì device OFFLINE
+	SweepIntervalSeconds  int  `mapstructure:"sweep_interval_seconds"`  // Chu kỳ quét device quá hạn
+	HistoryRetentionDays  int  `mapstructure:"history_retention_days"`  // Thời gian giữ lịch sử heartbeat
+}
+
 // RateLimitPolicySetting
 type RateLimitPolicySetting struct {
 	Policies []RateLimitPolicy
@@ -351,7 +379,6 @@ type SMTPSetting struct {
 
 // jwt
 type JWTSetting struct {
-	Secret   string   `mapstructure:"secret"`
 	Issuer   string   `mapstructure:"issuer"`
 	Subject  string   `mapstructure:"subject"`
 	Audience []string `mapstructure:"audience"`
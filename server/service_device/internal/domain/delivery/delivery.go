@@ -0,0 +1,32 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/model"
+)
+
+// IDeviceDeliveryService gửi message realtime tới device qua service ws delivery.
+type IDeviceDeliveryService interface {
+	SendToDevice(ctx context.Context, input *domainModel.SendToDeviceInput) (*domainModel.SendToDeviceOutput, error)
+}
+
+var deviceDeliveryService IDeviceDeliveryService
+
+// GetDeviceDeliveryService returns the current device delivery service implementation, nil if disabled.
+func GetDeviceDeliveryService() IDeviceDeliveryService {
+	return deviceDeliveryService
+}
+
+// SetDeviceDeliveryService sets the device delivery service implementation once.
+func SetDeviceDeliveryService(s IDeviceDeliveryService) error {
+	if s == nil {
+		return errors.New("device delivery service cannot be nil")
+	}
+	if deviceDeliveryService != nil {
+		return errors.New("device delivery service already set")
+	}
+	deviceDeliveryService = s
+	return nil
+}
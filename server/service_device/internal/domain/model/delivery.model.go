@@ -0,0 +1,14 @@
+package model
+
+import "github.com/google/uuid"
+
+// SendToDevice - gửi message qua service ws delivery
+type SendToDeviceInput struct {
+	DeviceId uuid.UUID `json:"device_id"`
+	Type     int       `json:"type"`
+	Payload  []byte    `json:"payload"` // JSON
+}
+type SendToDeviceOutput struct {
+	Delivered bool   `json:"delivered"` // false nếu device không kết nối, message nằm trong outbox
+	Message   string `json:"message"`
+}
@@ -54,6 +54,22 @@ type UpdateDeviceLocationInput struct {
 	Address    string    `json:"address"`
 }
 
+// UpdateDevice - field rỗng/nil giữ nguyên giá trị cũ
+type UpdateDeviceInput struct {
+	DeviceId     uuid.UUID `json:"device_id"`
+	LocationId   uuid.UUID `json:"location_id"`
+	Name         string    `json:"name"`
+	Address      string    `json:"address"`
+	DeviceType   *int      `json:"device_type"`
+	SerialNumber string    `json:"serial_number"`
+	MacAddress   string    `json:"mac_address"`
+	Status       *int      `json:"status"`
+}
+type UpdateDeviceOutput struct {
+	CompanyId uuid.UUID `json:"company_id"`
+	Status    int       `json:"status"`
+}
+
 // UpdateDeviceName
 type UpdateDeviceNameInput struct {
 	DeviceId uuid.UUID `json:"device_id"`
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GetDeviceConfig
+type GetDeviceConfigInput struct {
+	DeviceId uuid.UUID `json:"device_id"`
+}
+type GetDeviceConfigOutput struct {
+	CompanyId      uuid.UUID  `json:"company_id"`
+	Settings       []byte     `json:"settings"` // JSON cấu hình device lưu trong devices.settings
+	Version        int64      `json:"version"`
+	AppliedVersion int64      `json:"applied_version"`
+	AppliedAt      *time.Time `json:"applied_at"`
+}
+
+// UpdateDeviceConfig
+type UpdateDeviceConfigInput struct {
+	DeviceId        uuid.UUID `json:"device_id"`
+	Settings        []byte    `json:"settings"`
+	ExpectedVersion int64     `json:"expected_version"` // Version admin đang sửa, khác version hiện tại thì không cập nhật
+}
+type UpdateDeviceConfigOutput struct {
+	Version int64 `json:"version"`
+}
+
+// AckDeviceConfig
+type AckDeviceConfigInput struct {
+	DeviceId uuid.UUID `json:"device_id"`
+	Version  int64     `json:"version"`
+}
@@ -8,3 +8,11 @@ const (
 	RoleManager = 1
 	RoleUser    = 2
 )
+
+// Device status
+const (
+	DeviceStatusOffline     = 0
+	DeviceStatusOnline      = 1
+	DeviceStatusMaintenance = 2
+	DeviceStatusError       = 3
+)
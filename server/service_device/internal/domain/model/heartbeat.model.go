@@ -0,0 +1,83 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecordHeartbeat
+type RecordHeartbeatInput struct {
+	DeviceId        uuid.UUID `json:"device_id"`
+	CpuUsage        *float32  `json:"cpu_usage"`
+	MemoryUsage     *float32  `json:"memory_usage"`
+	Temperature     *float32  `json:"temperature"`
+	FirmwareVersion string    `json:"firmware_version"`
+	IpAddress       string    `json:"ip_address"`
+}
+type RecordHeartbeatOutput struct {
+	CompanyId uuid.UUID `json:"company_id"`
+	Status    int       `json:"status"`
+	Recovered bool      `json:"recovered"` // true nếu device vừa được chuyển lại ONLINE sau khi bị sweeper đánh OFFLINE
+}
+
+// MarkStaleDevicesOffline
+type MarkStaleDevicesOfflineInput struct {
+	LastHeartbeatBefore time.Time `json:"last_heartbeat_before"`
+}
+type StaleDeviceOutput struct {
+	DeviceId      uuid.UUID `json:"device_id"`
+	CompanyId     uuid.UUID `json:"company_id"`
+	LastHeartbeat time.Time `json:"last_heartbeat"`
+}
+
+// DeleteHeartbeatsBefore
+type DeleteHeartbeatsBeforeInput struct {
+	Before time.Time `json:"before"`
+}
+
+// ListDeviceHeartbeats
+type ListDeviceHeartbeatsInput struct {
+	DeviceId uuid.UUID `json:"device_id"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	Limit    int       `json:"limit"`
+	Offset   int       `json:"offset"`
+}
+type DeviceHeartbeatOutput struct {
+	HeartbeatId     int64    `json:"heartbeat_id"`
+	CpuUsage        *float32 `json:"cpu_usage,omitempty"`
+	MemoryUsage     *float32 `json:"memory_usage,omitempty"`
+	Temperature     *float32 `json:"temperature,omitempty"`
+	FirmwareVersion string   `json:"firmware_version,omitempty"`
+	IpAddress       string   `json:"ip_address,omitempty"`
+	CreatedAt       int64    `json:"created_at"`
+}
+
+// DeviceUptime
+type DeviceUptimeInput struct {
+	DeviceId      uuid.UUID `json:"device_id"`
+	From          time.Time `json:"from"`
+	To            time.Time `json:"to"`
+	MaxGapSeconds int       `json:"max_gap_seconds"` // Khoảng cách tối đa giữa hai heartbeat vẫn tính là online
+}
+type DeviceUptimeOutput struct {
+	TotalHeartbeats int64 `json:"total_heartbeats"`
+	OnlineSeconds   int64 `json:"online_seconds"`
+}
+
+// ========================================
+//
+//	Device status event model
+//
+// ========================================
+type (
+	// DeviceStatusChangedEvent sự kiện trạng thái device thay đổi (heartbeat, sweeper hoặc admin cập nhật)
+	DeviceStatusChangedEvent struct {
+		DeviceId  string `json:"device_id"`
+		CompanyId string `json:"company_id"`
+		Status    int    `json:"status"`
+		Reason    string `json:"reason"`
+		Timestamp int64  `json:"timestamp"`
+	}
+)
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GetCompanySubscription
+type GetCompanySubscriptionInput struct {
+	CompanyId uuid.UUID `json:"company_id"`
+}
+type GetCompanySubscriptionOutput struct {
+	CompanyId    uuid.UUID  `json:"company_id"`
+	Status       int        `json:"status"` // 0: Inactive, 1: Active, 2: Suspended
+	Plan         int        `json:"plan"`   // 0: Basic, 1: Premium, 2: Enterprise
+	StartDate    *time.Time `json:"start_date"`
+	EndDate      *time.Time `json:"end_date"`
+	MaxEmployees *int       `json:"max_employees"` // nil nếu công ty chưa cấu hình
+	MaxDevices   *int       `json:"max_devices"`   // nil nếu công ty chưa cấu hình
+}
+
+// CountDevicesInCompany
+type CountDevicesInCompanyInput struct {
+	CompanyId uuid.UUID `json:"company_id"`
+}
+
+// CountEmployeesInCompany - nhân viên của công ty, không tính nhân viên đã nghỉ việc (inactive)
+type CountEmployeesInCompanyInput struct {
+	CompanyId uuid.UUID `json:"company_id"`
+}
+
+// ========================================
+//
+//	Company subscription event model
+//
+// ========================================
+type (
+	// CompanySubscriptionChangedEvent sự kiện gói dịch vụ của công ty thay đổi, các replica xóa cache quota
+	CompanySubscriptionChangedEvent struct {
+		CompanyId string `json:"company_id"`
+		Timestamp int64  `json:"timestamp"`
+	}
+)
@@ -86,4 +86,23 @@ type (
 		NotBefore time.Time `json:"nbf,omitempty"`
 		IssuedAt  time.Time `json:"iat,omitempty"`
 	}
+
+	// BlockTokenDeviceInput thu hồi token id của device, ExpiresAt zero nếu không rõ thời hạn
+	BlockTokenDeviceInput struct {
+		DeviceId  string    `json:"device_id"`
+		TokenId   string    `json:"token_id"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+)
+
+// ========================================
+//
+//	Token event model
+//
+// ========================================
+type (
+	// DeviceTokenRevokedEvent sự kiện token của device được refresh hoặc bị thu hồi
+	DeviceTokenRevokedEvent struct {
+		DeviceId string `json:"device_id"`
+	}
 )
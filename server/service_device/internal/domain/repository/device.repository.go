@@ -20,6 +20,7 @@ type IDeviceRepository interface {
 	DeleteDevice(ctx context.Context, input *model.DeleteDeviceInput) error
 	DisableDevice(ctx context.Context, input *model.DisableDeviceInput) error
 	EnableDevice(ctx context.Context, input *model.EnableDeviceInput) error
+	UpdateDevice(ctx context.Context, input *model.UpdateDeviceInput) (*model.UpdateDeviceOutput, error)
 	UpdateDeviceName(ctx context.Context, input *model.UpdateDeviceNameInput) error
 	UpdateDeviceLocation(ctx context.Context, input *model.UpdateDeviceLocationInput) error
 	UpdateDeviceInfo(ctx context.Context, input *model.UpdateDeviceInfoInput) error
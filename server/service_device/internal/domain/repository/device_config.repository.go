@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/model"
+)
+
+/**
+ * Interface for device config repository
+ */
+type IDeviceConfigRepository interface {
+	GetDeviceConfig(ctx context.Context, input *model.GetDeviceConfigInput) (*model.GetDeviceConfigOutput, error)
+	UpdateDeviceConfig(ctx context.Context, input *model.UpdateDeviceConfigInput) (*model.UpdateDeviceConfigOutput, error)
+	AckDeviceConfig(ctx context.Context, input *model.AckDeviceConfigInput) (bool, error)
+}
+
+/**
+ * Variable for device config repository instance
+ */
+var _vDeviceConfigRepository IDeviceConfigRepository
+
+/**
+ * Set the device config repository instance
+ */
+func SetDeviceConfigRepository(v IDeviceConfigRepository) error {
+	if _vDeviceConfigRepository != nil {
+		return errors.New("device config repository initialization failed, not nil")
+	}
+	_vDeviceConfigRepository = v
+	return nil
+}
+
+/**
+ * Get the device config repository instance
+ */
+func GetDeviceConfigRepository() (IDeviceConfigRepository, error) {
+	if _vDeviceConfigRepository == nil {
+		return nil, errors.New("device config repository not initialized")
+	}
+	return _vDeviceConfigRepository, nil
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/model"
+)
+
+/**
+ * Interface for device heartbeat repository
+ */
+type IDeviceHeartbeatRepository interface {
+	// Cập nhật last_heartbeat của device và lưu lịch sử heartbeat, trả về nil nếu device không tồn tại
+	RecordHeartbeat(ctx context.Context, input *model.RecordHeartbeatInput) (*model.RecordHeartbeatOutput, error)
+	// Chuyển các device ONLINE quá hạn heartbeat sang OFFLINE, trả về danh sách device bị chuyển
+	MarkStaleDevicesOffline(ctx context.Context, input *model.MarkStaleDevicesOfflineInput) ([]*model.StaleDeviceOutput, error)
+	DeleteHeartbeatsBefore(ctx context.Context, input *model.DeleteHeartbeatsBeforeInput) error
+	ListDeviceHeartbeats(ctx context.Context, input *model.ListDeviceHeartbeatsInput) ([]*model.DeviceHeartbeatOutput, error)
+	DeviceUptime(ctx context.Context, input *model.DeviceUptimeInput) (*model.DeviceUptimeOutput, error)
+}
+
+/**
+ * Variable for device heartbeat repository instance
+ */
+var _vDeviceHeartbeatRepository IDeviceHeartbeatRepository
+
+/**
+ * Set the device heartbeat repository instance
+ */
+func SetDeviceHeartbeatRepository(v IDeviceHeartbeatRepository) error {
+	if _vDeviceHeartbeatRepository != nil {
+		return errors.New("device heartbeat repository initialization failed, not nil")
+	}
+	_vDeviceHeartbeatRepository = v
+	return nil
+}
+
+/**
+ * Get the device heartbeat repository instance
+ */
+func GetDeviceHeartbeatRepository() (IDeviceHeartbeatRepository, error) {
+	if _vDeviceHeartbeatRepository == nil {
+		return nil, errors.New("device heartbeat repository not initialized")
+	}
+	return _vDeviceHeartbeatRepository, nil
+}
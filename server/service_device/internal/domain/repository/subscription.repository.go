@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/model"
+)
+
+/**
+ * Interface for company subscription repository
+ */
+type ISubscriptionRepository interface {
+	GetCompanySubscription(ctx context.Context, input *model.GetCompanySubscriptionInput) (*model.GetCompanySubscriptionOutput, error)
+	CountDevicesInCompany(ctx context.Context, input *model.CountDevicesInCompanyInput) (int64, error)
+	CountEmployeesInCompany(ctx context.Context, input *model.CountEmployeesInCompanyInput) (int64, error)
+}
+
+/**
+ * Variable for subscription repository instance
+ */
+var _vSubscriptionRepository ISubscriptionRepository
+
+/**
+ * Set the subscription repository instance
+ */
+func SetSubscriptionRepository(v ISubscriptionRepository) error {
+	if _vSubscriptionRepository != nil {
+		return errors.New("subscription repository initialization failed, not nil")
+	}
+	_vSubscriptionRepository = v
+	return nil
+}
+
+/**
+ * Get the subscription repository instance
+ */
+func GetSubscriptionRepository() (ISubscriptionRepository, error) {
+	if _vSubscriptionRepository == nil {
+		return nil, errors.New("subscription repository not initialized")
+	}
+	return _vSubscriptionRepository, nil
+}
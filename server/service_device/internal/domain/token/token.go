@@ -54,6 +54,14 @@ type ITokenService interface {
 	 * @return *model.TokenDeviceJwtOutput, *domainErrors.TokenValidationError
 	 */
 	CheckDeviceToken(ctx context.Context, token string) (bool, *domainErrors.TokenValidationError)
+
+	/**
+	 * Block device token - token id bị thu hồi đến khi hết hạn
+	 * @param ctx context.Context
+	 * @param input *model.BlockTokenDeviceInput
+	 * @return error
+	 */
+	BlockTokenDevice(ctx context.Context, input *model.BlockTokenDeviceInput) error
 	// v.v
 }
 
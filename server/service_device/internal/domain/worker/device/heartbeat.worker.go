@@ -0,0 +1,138 @@
+package device
+
+import (
+	"context"
+	"time"
+
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/constants"
+	domainCache "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/cache"
+	domainConfig "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/config"
+	domainLogger "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/logger"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/model"
+	domainRepo "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/repository"
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/worker"
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/global"
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/shared/utils"
+	sharedCache "github.com/youknow2509/cio_verify_face/server/service_device/internal/shared/utils/cache"
+	sharedCrypto "github.com/youknow2509/cio_verify_face/server/service_device/internal/shared/utils/crypto"
+)
+
+// ============================================
+// Worker sweep device quá hạn heartbeat
+// ============================================
+
+type HeartbeatSweeperWorker struct {
+	logger           domainLogger.ILogger
+	heartbeatRepo    domainRepo.IDeviceHeartbeatRepository
+	distributedCache domainCache.IDistributedCache
+	config           domainConfig.DeviceHeartbeatSetting
+	lastPrune        time.Time
+}
+
+// Running sweeper to mark devices without recent heartbeat as OFFLINE, stop when ctx is cancelled
+func (w *HeartbeatSweeperWorker) RunHeartbeatSweeper(ctx context.Context) error {
+	if !w.config.Enabled {
+		w.logger.Warn("device heartbeat sweeper is disabled, skipping sweeper startup")
+		return nil
+	}
+	interval := time.Duration(w.config.SweepIntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = constants.DEFAULT_HEARTBEAT_SWEEP_INTERVAL_SECONDS * time.Second
+	}
+	global.WaitGroup.Add(1)
+	go func() {
+		defer global.WaitGroup.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				w.logger.Info("device heartbeat sweeper stopped")
+				return
+			case <-ticker.C:
+				w.RunSweep(ctx, time.Now())
+			}
+		}
+	}()
+	return nil
+}
+
+// RunSweep chuyển device ONLINE quá hạn heartbeat sang OFFLINE và xóa lịch sử heartbeat cũ.
+// Câu UPDATE chỉ trả về device thực sự được chuyển nên nhiều replica chạy cùng lúc không gửi trùng sự kiện.
+func (w *HeartbeatSweeperWorker) RunSweep(ctx context.Context, now time.Time) {
+	timeout := time.Duration(w.config.OfflineTimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = constants.DEFAULT_HEARTBEAT_OFFLINE_TIMEOUT_SECONDS * time.Second
+	}
+	devices, err := w.heartbeatRepo.MarkStaleDevicesOffline(ctx, &domainModel.MarkStaleDevicesOfflineInput{
+		LastHeartbeatBefore: now.Add(-timeout),
+	})
+	if err != nil {
+		w.logger.Error("heartbeat sweeper: mark stale devices offline", "error", err)
+	} else {
+		for _, device := range devices {
+			w.onDeviceOffline(ctx, device, now)
+		}
+	}
+	if now.Sub(w.lastPrune) >= constants.HEARTBEAT_RETENTION_SWEEP_EVERY*time.Second {
+		w.pruneHistory(ctx, now)
+	}
+}
+
+// onDeviceOffline xóa cache trạng thái device và gửi sự kiện trạng thái thay đổi
+func (w *HeartbeatSweeperWorker) onDeviceOffline(ctx context.Context, device *domainModel.StaleDeviceOutput, now time.Time) {
+	limit, offset := utils.GetPagination(constants.PageDefault, constants.SizeDefault)
+	keys := []string{
+		sharedCache.GetKeyDeviceBase(sharedCrypto.GetHash(device.DeviceId.String())),
+		sharedCache.GetKeyListDeviceInCompany(sharedCrypto.GetHash(device.CompanyId.String()), limit, offset),
+	}
+	for _, key := range keys {
+		if err := w.distributedCache.Delete(ctx, key); err != nil {
+			w.logger.Error("heartbeat sweeper: delete device cache", "deviceID", device.DeviceId, "error", err)
+		}
+	}
+	if err := w.distributedCache.Publish(
+		ctx,
+		constants.RedisChannelDeviceStatusChanged,
+		domainModel.DeviceStatusChangedEvent{
+			DeviceId:  device.DeviceId.String(),
+			CompanyId: device.CompanyId.String(),
+			Status:    domainModel.DeviceStatusOffline,
+			Reason:    constants.DEVICE_STATUS_REASON_TIMEOUT,
+			Timestamp: now.Unix(),
+		},
+	); err != nil {
+		w.logger.Error("heartbeat sweeper: publish device status changed", "deviceID", device.DeviceId, "error", err)
+	}
+	w.logger.Info("heartbeat sweeper: device marked offline", "deviceID", device.DeviceId, "lastHeartbeat", device.LastHeartbeat)
+}
+
+// pruneHistory xóa lịch sử heartbeat quá thời gian lưu
+func (w *HeartbeatSweeperWorker) pruneHistory(ctx context.Context, now time.Time) {
+	retentionDays := w.config.HistoryRetentionDays
+	if retentionDays <= 0 {
+		retentionDays = constants.DEFAULT_HEARTBEAT_RETENTION_DAYS
+	}
+	if err := w.heartbeatRepo.DeleteHeartbeatsBefore(ctx, &domainModel.DeleteHeartbeatsBeforeInput{
+		Before: now.AddDate(0, 0, -retentionDays),
+	}); err != nil {
+		w.logger.Error("heartbeat sweeper: delete heartbeat history", "error", err)
+		return
+	}
+	w.lastPrune = now
+}
+
+// NewHeartbeatSweeperWorker create new instance and implement IWorkerHeartbeatSweeper
+func NewHeartbeatSweeperWorker(
+	config domainConfig.DeviceHeartbeatSetting,
+	logger domainLogger.ILogger,
+	heartbeatRepo domainRepo.IDeviceHeartbeatRepository,
+	distributedCache domainCache.IDistributedCache,
+) worker.IWorkerHeartbeatSweeper {
+	return &HeartbeatSweeperWorker{
+		logger:           logger,
+		heartbeatRepo:    heartbeatRepo,
+		distributedCache: distributedCache,
+		config:           config,
+	}
+}
@@ -0,0 +1,32 @@
+package worker
+
+import (
+	"context"
+	"errors"
+)
+
+// ============================================
+// Worker
+// ============================================
+
+// For device heartbeat sweeper
+type IWorkerHeartbeatSweeper interface {
+	RunHeartbeatSweeper(ctx context.Context) error
+}
+
+var _vIWorkerHeartbeatSweeper IWorkerHeartbeatSweeper
+
+func GetWorkerHeartbeatSweeper() IWorkerHeartbeatSweeper {
+	return _vIWorkerHeartbeatSweeper
+}
+
+func SetWorkerHeartbeatSweeper(worker IWorkerHeartbeatSweeper) error {
+	if worker == nil {
+		return errors.New("worker heartbeat sweeper is nil")
+	}
+	if _vIWorkerHeartbeatSweeper != nil {
+		return errors.New("worker heartbeat sweeper is already set")
+	}
+	_vIWorkerHeartbeatSweeper = worker
+	return nil
+}
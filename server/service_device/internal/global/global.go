@@ -1,6 +1,7 @@
 package global
 
 import (
+	"context"
 	"sync"
 
 	domainConfig "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/config"
@@ -8,7 +9,8 @@ import (
 )
 
 var (
-	WaitGroup     *sync.WaitGroup
-	Logger        domainLogger.ILogger
-	SettingServer domainConfig.Setting
+	WaitGroup       *sync.WaitGroup
+	ShutdownContext context.Context // Bị hủy khi service nhận tín hiệu dừng (SIGINT/SIGTERM)
+	Logger          domainLogger.ILogger
+	SettingServer   domainConfig.Setting
 )
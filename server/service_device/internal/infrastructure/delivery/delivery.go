@@ -0,0 +1,35 @@
+package delivery
+
+import (
+	"context"
+
+	domainDelivery "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/delivery"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/model"
+	pb "github.com/youknow2509/cio_verify_face/server/service_device/proto"
+)
+
+// DeviceDeliveryService bridges to the ws delivery dispatcher gRPC service.
+type DeviceDeliveryService struct {
+	client pb.DispatcherClient
+}
+
+// SendToDevice gửi message tới device ở bất kỳ replica ws delivery nào, device offline nhận lại khi kết nối.
+func (s *DeviceDeliveryService) SendToDevice(ctx context.Context, input *domainModel.SendToDeviceInput) (*domainModel.SendToDeviceOutput, error) {
+	resp, err := s.client.SendToDevice(ctx, &pb.SendToDeviceRequest{
+		DeviceId: input.DeviceId.String(),
+		Type:     int32(input.Type),
+		Payload:  input.Payload,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &domainModel.SendToDeviceOutput{
+		Delivered: resp.GetDelivered(),
+		Message:   resp.GetMessage(),
+	}, nil
+}
+
+// NewDeviceDeliveryService builds a device delivery service implementation.
+func NewDeviceDeliveryService(client pb.DispatcherClient) domainDelivery.IDeviceDeliveryService {
+	return &DeviceDeliveryService{client: client}
+}
@@ -77,7 +77,7 @@ func (q *Queries) DeleteDevice(ctx context.Context, deviceID pgtype.UUID) error
 
 const disableDevice = `-- name: DisableDevice :exec
 UPDATE devices
-SET status = 0, updated_at = NOW()
+SET status = 0, auto_offline = FALSE, updated_at = NOW()
 WHERE device_id = $1
 `
 
@@ -88,7 +88,7 @@ func (q *Queries) DisableDevice(ctx context.Context, deviceID pgtype.UUID) error
 
 const enableDevice = `-- name: EnableDevice :exec
 UPDATE devices
-SET status = 1, updated_at = NOW()
+SET status = 1, auto_offline = FALSE, updated_at = NOW()
 WHERE device_id = $1
 `
 
@@ -325,6 +325,53 @@ func (q *Queries) GetListDeviceInCompany(ctx context.Context, arg GetListDeviceI
 	return items, nil
 }
 
+const updateDevice = `-- name: UpdateDevice :one
+UPDATE devices
+SET location_id = COALESCE($1, location_id),
+    name = COALESCE($2, name),
+    address = COALESCE($3, address),
+    device_type = COALESCE($4, device_type),
+    serial_number = COALESCE($5, serial_number),
+    mac_address = COALESCE($6, mac_address),
+    status = COALESCE($7, status),
+    auto_offline = CASE WHEN $7 IS NULL THEN auto_offline ELSE FALSE END,
+    updated_at = NOW()
+WHERE device_id = $8
+RETURNING company_id, status
+`
+
+type UpdateDeviceParams struct {
+	LocationID   pgtype.UUID
+	Name         pgtype.Text
+	Address      pgtype.Text
+	DeviceType   pgtype.Int2
+	SerialNumber pgtype.Text
+	MacAddress   pgtype.Text
+	Status       pgtype.Int2
+	DeviceID     pgtype.UUID
+}
+
+type UpdateDeviceRow struct {
+	CompanyID pgtype.UUID
+	Status    pgtype.Int2
+}
+
+func (q *Queries) UpdateDevice(ctx context.Context, arg UpdateDeviceParams) (UpdateDeviceRow, error) {
+	row := q.db.QueryRow(ctx, updateDevice,
+		arg.LocationID,
+		arg.Name,
+		arg.Address,
+		arg.DeviceType,
+		arg.SerialNumber,
+		arg.MacAddress,
+		arg.Status,
+		arg.DeviceID,
+	)
+	var i UpdateDeviceRow
+	err := row.Scan(&i.CompanyID, &i.Status)
+	return i, err
+}
+
 const updateDeviceInfo = `-- name: UpdateDeviceInfo :exec
 UPDATE devices
 SET serial_number = $2,
@@ -383,3 +430,19 @@ func (q *Queries) UpdateDeviceName(ctx context.Context, arg UpdateDeviceNamePara
 	_, err := q.db.Exec(ctx, updateDeviceName, arg.DeviceID, arg.Name)
 	return err
 }
+
+const updateDeviceToken = `-- name: UpdateDeviceToken :exec
+UPDATE devices
+SET token = $2, updated_at = NOW()
+WHERE device_id = $1
+`
+
+type UpdateDeviceTokenParams struct {
+	DeviceID pgtype.UUID
+	Token    string
+}
+
+func (q *Queries) UpdateDeviceToken(ctx context.Context, arg UpdateDeviceTokenParams) error {
+	_, err := q.db.Exec(ctx, updateDeviceToken, arg.DeviceID, arg.Token)
+	return err
+}
@@ -53,22 +53,38 @@ type CompanySetting struct {
 }
 
 type Device struct {
+	DeviceID             pgtype.UUID
+	CompanyID            pgtype.UUID
+	LocationID           pgtype.UUID
+	Name                 string
+	Address              pgtype.Text
+	DeviceType           pgtype.Int2
+	SerialNumber         pgtype.Text
+	MacAddress           pgtype.Text
+	IpAddress            *netip.Addr
+	FirmwareVersion      pgtype.Text
+	Status               pgtype.Int2
+	Token                string
+	LastHeartbeat        pgtype.Timestamptz
+	Settings             []byte
+	CreatedAt            pgtype.Timestamptz
+	UpdatedAt            pgtype.Timestamptz
+	AutoOffline          bool
+	ConfigVersion        int64
+	AppliedConfigVersion int64
+	ConfigAppliedAt      pgtype.Timestamptz
+}
+
+type DeviceHeartbeat struct {
+	HeartbeatID     int64
 	DeviceID        pgtype.UUID
 	CompanyID       pgtype.UUID
-	LocationID      pgtype.UUID
-	Name            string
-	Address         pgtype.Text
-	DeviceType      pgtype.Int2
-	SerialNumber    pgtype.Text
-	MacAddress      pgtype.Text
-	IpAddress       *netip.Addr
+	CpuUsage        pgtype.Float4
+	MemoryUsage     pgtype.Float4
+	Temperature     pgtype.Float4
 	FirmwareVersion pgtype.Text
-	Status          pgtype.Int2
-	Token           string
-	LastHeartbeat   pgtype.Timestamptz
-	Settings        []byte
+	IpAddress       *netip.Addr
 	CreatedAt       pgtype.Timestamptz
-	UpdatedAt       pgtype.Timestamptz
 }
 
 type Employee struct {
@@ -27,3 +27,10 @@ func GetValidateMiddleware() *ValidateMiddleware {
 func GetAuthAccessTokenJwtMiddleware() *AuthAccessTokenJwtMiddleware {
 	return &AuthAccessTokenJwtMiddleware{}
 }
+
+/**
+ * Get subscription read-only middleware instance
+ */
+func GetSubscriptionReadOnlyMiddleware() *SubscriptionReadOnlyMiddleware {
+	return &SubscriptionReadOnlyMiddleware{}
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -68,7 +69,13 @@ func (d *DeviceRepository) GetDeviceToken(ctx context.Context, input *model.GetD
 
 // UpdateTokenDevice implements repository.IDeviceRepository.
 func (d *DeviceRepository) UpdateTokenDevice(ctx context.Context, input *model.UpdateTokenDeviceInput) error {
-	panic("unimplemented")
+	return d.db.UpdateDeviceToken(
+		ctx,
+		database.UpdateDeviceTokenParams{
+			DeviceID: pgtype.UUID{Valid: true, Bytes: input.DeviceId},
+			Token:    input.NewToken,
+		},
+	)
 }
 
 // DeviceExist implements repository.IDeviceRepository.
@@ -240,6 +247,35 @@ func (d *DeviceRepository) UpdateDeviceLocation(ctx context.Context, input *mode
 	)
 }
 
+// UpdateDevice implements repository.IDeviceRepository.
+func (d *DeviceRepository) UpdateDevice(ctx context.Context, input *model.UpdateDeviceInput) (*model.UpdateDeviceOutput, error) {
+	params := database.UpdateDeviceParams{
+		DeviceID:     pgtype.UUID{Valid: true, Bytes: input.DeviceId},
+		LocationID:   pgtype.UUID{Valid: input.LocationId != uuid.Nil, Bytes: input.LocationId},
+		Name:         pgtype.Text{Valid: input.Name != "", String: input.Name},
+		Address:      pgtype.Text{Valid: input.Address != "", String: input.Address},
+		SerialNumber: pgtype.Text{Valid: input.SerialNumber != "", String: input.SerialNumber},
+		MacAddress:   pgtype.Text{Valid: input.MacAddress != "", String: input.MacAddress},
+	}
+	if input.DeviceType != nil {
+		params.DeviceType = pgtype.Int2{Valid: true, Int16: int16(*input.DeviceType)}
+	}
+	if input.Status != nil {
+		params.Status = pgtype.Int2{Valid: true, Int16: int16(*input.Status)}
+	}
+	resp, err := d.db.UpdateDevice(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &model.UpdateDeviceOutput{
+		CompanyId: resp.CompanyID.Bytes,
+		Status:    int(resp.Status.Int16),
+	}, nil
+}
+
 // UpdateDeviceName implements repository.IDeviceRepository.
 func (d *DeviceRepository) UpdateDeviceName(ctx context.Context, input *model.UpdateDeviceNameInput) error {
 	return d.db.UpdateDeviceName(
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/model"
+	domainRepo "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/repository"
+	database "github.com/youknow2509/cio_verify_face/server/service_device/internal/infrastructure/gen"
+)
+
+/**
+ * Device config repository implementation
+ */
+type DeviceConfigRepository struct {
+	db *database.Queries
+}
+
+// GetDeviceConfig implements repository.IDeviceConfigRepository.
+func (d *DeviceConfigRepository) GetDeviceConfig(ctx context.Context, input *model.GetDeviceConfigInput) (*model.GetDeviceConfigOutput, error) {
+	resp, err := d.db.GetDeviceConfig(ctx, pgtype.UUID{Valid: true, Bytes: input.DeviceId})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	output := &model.GetDeviceConfigOutput{
+		CompanyId:      resp.CompanyID.Bytes,
+		Settings:       resp.Settings,
+		Version:        resp.ConfigVersion,
+		AppliedVersion: resp.AppliedConfigVersion,
+	}
+	if resp.ConfigAppliedAt.Valid {
+		output.AppliedAt = &resp.ConfigAppliedAt.Time
+	}
+	return output, nil
+}
+
+// UpdateDeviceConfig implements repository.IDeviceConfigRepository.
+// Trả về nil nếu version không khớp (admin khác đã cập nhật trước) hoặc device không tồn tại.
+func (d *DeviceConfigRepository) UpdateDeviceConfig(ctx context.Context, input *model.UpdateDeviceConfigInput) (*model.UpdateDeviceConfigOutput, error) {
+	version, err := d.db.UpdateDeviceConfig(
+		ctx,
+		database.UpdateDeviceConfigParams{
+			DeviceID:        pgtype.UUID{Valid: true, Bytes: input.DeviceId},
+			Settings:        input.Settings,
+			ExpectedVersion: input.ExpectedVersion,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &model.UpdateDeviceConfigOutput{
+		Version: version,
+	}, nil
+}
+
+// AckDeviceConfig implements repository.IDeviceConfigRepository.
+// Trả về false nếu version không hợp lệ hoặc cũ hơn version device đã ack.
+func (d *DeviceConfigRepository) AckDeviceConfig(ctx context.Context, input *model.AckDeviceConfigInput) (bool, error) {
+	rows, err := d.db.AckDeviceConfig(
+		ctx,
+		database.AckDeviceConfigParams{
+			DeviceID:             pgtype.UUID{Valid: true, Bytes: input.DeviceId},
+			AppliedConfigVersion: input.Version,
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// NewDeviceConfigRepository create new instance and implement IDeviceConfigRepository
+func NewDeviceConfigRepository(
+	postgresConnect *pgxpool.Pool,
+) domainRepo.IDeviceConfigRepository {
+	return &DeviceConfigRepository{
+		db: database.New(postgresConnect),
+	}
+}
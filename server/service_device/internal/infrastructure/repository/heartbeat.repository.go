@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/model"
+	domainRepo "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/repository"
+	database "github.com/youknow2509/cio_verify_face/server/service_device/internal/infrastructure/gen"
+)
+
+/**
+ * Device heartbeat repository implementation
+ */
+type DeviceHeartbeatRepository struct {
+	pool *pgxpool.Pool
+	db   *database.Queries
+}
+
+// RecordHeartbeat implements repository.IDeviceHeartbeatRepository.
+// Cập nhật trạng thái device và ghi lịch sử heartbeat trong cùng transaction,
+// tránh device đã được đánh dấu ONLINE nhưng thiếu bản ghi heartbeat tương ứng.
+func (d *DeviceHeartbeatRepository) RecordHeartbeat(ctx context.Context, input *model.RecordHeartbeatInput) (*model.RecordHeartbeatOutput, error) {
+	ipAddress := parseIpAddress(input.IpAddress)
+	firmwareVersion := pgtype.Text{Valid: input.FirmwareVersion != "", String: input.FirmwareVersion}
+	var output *model.RecordHeartbeatOutput
+	err := pgx.BeginFunc(ctx, d.pool, func(tx pgx.Tx) error {
+		qtx := d.db.WithTx(tx)
+		resp, err := qtx.UpdateDeviceHeartbeat(
+			ctx,
+			database.UpdateDeviceHeartbeatParams{
+				DeviceID:        pgtype.UUID{Valid: true, Bytes: input.DeviceId},
+				IpAddress:       ipAddress,
+				FirmwareVersion: firmwareVersion,
+			},
+		)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+		if err := qtx.InsertDeviceHeartbeat(
+			ctx,
+			database.InsertDeviceHeartbeatParams{
+				DeviceID:        pgtype.UUID{Valid: true, Bytes: input.DeviceId},
+				CompanyID:       resp.CompanyID,
+				CpuUsage:        toFloat4(input.CpuUsage),
+				MemoryUsage:     toFloat4(input.MemoryUsage),
+				Temperature:     toFloat4(input.Temperature),
+				FirmwareVersion: firmwareVersion,
+				IpAddress:       ipAddress,
+			},
+		); err != nil {
+			return err
+		}
+		output = &model.RecordHeartbeatOutput{
+			CompanyId: resp.CompanyID.Bytes,
+			Status:    int(resp.Status.Int16),
+			Recovered: resp.Recovered,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// MarkStaleDevicesOffline implements repository.IDeviceHeartbeatRepository.
+func (d *DeviceHeartbeatRepository) MarkStaleDevicesOffline(ctx context.Context, input *model.MarkStaleDevicesOfflineInput) ([]*model.StaleDeviceOutput, error) {
+	resp, err := d.db.MarkStaleDevicesOffline(
+		ctx,
+		pgtype.Timestamptz{Valid: true, Time: input.LastHeartbeatBefore},
+	)
+	if err != nil {
+		return nil, err
+	}
+	devices := make([]*model.StaleDeviceOutput, 0, len(resp))
+	for _, device := range resp {
+		devices = append(devices, &model.StaleDeviceOutput{
+			DeviceId:      device.DeviceID.Bytes,
+			CompanyId:     device.CompanyID.Bytes,
+			LastHeartbeat: device.LastHeartbeat.Time,
+		})
+	}
+	return devices, nil
+}
+
+// DeleteHeartbeatsBefore implements repository.IDeviceHeartbeatRepository.
+func (d *DeviceHeartbeatRepository) DeleteHeartbeatsBefore(ctx context.Context, input *model.DeleteHeartbeatsBeforeInput) error {
+	return d.db.DeleteDeviceHeartbeatsBefore(
+		ctx,
+		pgtype.Timestamptz{Valid: true, Time: input.Before},
+	)
+}
+
+// ListDeviceHeartbeats implements repository.IDeviceHeartbeatRepository.
+func (d *DeviceHeartbeatRepository) ListDeviceHeartbeats(ctx context.Context, input *model.ListDeviceHeartbeatsInput) ([]*model.DeviceHeartbeatOutput, error) {
+	resp, err := d.db.GetListDeviceHeartbeats(
+		ctx,
+		database.GetListDeviceHeartbeatsParams{
+			DeviceID: pgtype.UUID{Valid: true, Bytes: input.DeviceId},
+			FromTime: pgtype.Timestamptz{Valid: true, Time: input.From},
+			ToTime:   pgtype.Timestamptz{Valid: true, Time: input.To},
+			Limit:    int32(input.Limit),
+			Offset:   int32(input.Offset),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	heartbeats := make([]*model.DeviceHeartbeatOutput, 0, len(resp))
+	for _, heartbeat := range resp {
+		item := &model.DeviceHeartbeatOutput{
+			HeartbeatId:     heartbeat.HeartbeatID,
+			CpuUsage:        fromFloat4(heartbeat.CpuUsage),
+			MemoryUsage:     fromFloat4(heartbeat.MemoryUsage),
+			Temperature:     fromFloat4(heartbeat.Temperature),
+			FirmwareVersion: heartbeat.FirmwareVersion.String,
+			CreatedAt:       heartbeat.CreatedAt.Time.Unix(),
+		}
+		if heartbeat.IpAddress != nil {
+			item.IpAddress = heartbeat.IpAddress.String()
+		}
+		heartbeats = append(heartbeats, item)
+	}
+	return heartbeats, nil
+}
+
+// DeviceUptime implements repository.IDeviceHeartbeatRepository.
+func (d *DeviceHeartbeatRepository) DeviceUptime(ctx context.Context, input *model.DeviceUptimeInput) (*model.DeviceUptimeOutput, error) {
+	resp, err := d.db.GetDeviceUptime(
+		ctx,
+		database.GetDeviceUptimeParams{
+			DeviceID:      pgtype.UUID{Valid: true, Bytes: input.DeviceId},
+			FromTime:      pgtype.Timestamptz{Valid: true, Time: input.From},
+			ToTime:        pgtype.Timestamptz{Valid: true, Time: input.To},
+			MaxGapSeconds: float64(input.MaxGapSeconds),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &model.DeviceUptimeOutput{
+		TotalHeartbeats: resp.TotalHeartbeats,
+		OnlineSeconds:   resp.OnlineSeconds,
+	}, nil
+}
+
+// parseIpAddress trả về nil nếu địa chỉ không hợp lệ, giữ nguyên ip cũ của device
+func parseIpAddress(ip string) *netip.Addr {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return nil
+	}
+	return &addr
+}
+
+func toFloat4(v *float32) pgtype.Float4 {
+	if v == nil {
+		return pgtype.Float4{}
+	}
+	return pgtype.Float4{Valid: true, Float32: *v}
+}
+
+func fromFloat4(v pgtype.Float4) *float32 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Float32
+}
+
+// NewDeviceHeartbeatRepository create new instance and implement IDeviceHeartbeatRepository
+func NewDeviceHeartbeatRepository(
+	postgresConnect *pgxpool.Pool,
+) domainRepo.IDeviceHeartbeatRepository {
+	return &DeviceHeartbeatRepository{
+		pool: postgresConnect,
+		db:   database.New(postgresConnect),
+	}
+}
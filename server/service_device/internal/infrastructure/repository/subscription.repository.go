@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/model"
+	domainRepo "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/repository"
+	database "github.com/youknow2509/cio_verify_face/server/service_device/internal/infrastructure/gen"
+)
+
+/**
+ * Company subscription repository implementation
+ */
+type SubscriptionRepository struct {
+	db *database.Queries
+}
+
+// GetCompanySubscription implements repository.ISubscriptionRepository.
+func (s *SubscriptionRepository) GetCompanySubscription(ctx context.Context, input *model.GetCompanySubscriptionInput) (*model.GetCompanySubscriptionOutput, error) {
+	resp, err := s.db.GetCompanySubscription(ctx, pgtype.UUID{Valid: true, Bytes: input.CompanyId})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &model.GetCompanySubscriptionOutput{
+		CompanyId:    resp.CompanyID.Bytes,
+		Status:       int(resp.Status.Int16),
+		Plan:         int(resp.SubscriptionPlan.Int16),
+		StartDate:    fromDate(resp.SubscriptionStartDate),
+		EndDate:      fromDate(resp.SubscriptionEndDate),
+		MaxEmployees: fromInt4(resp.MaxEmployees),
+		MaxDevices:   fromInt4(resp.MaxDevices),
+	}, nil
+}
+
+// CountDevicesInCompany implements repository.ISubscriptionRepository.
+func (s *SubscriptionRepository) CountDevicesInCompany(ctx context.Context, input *model.CountDevicesInCompanyInput) (int64, error) {
+	return s.db.CountDevicesInCompany(ctx, pgtype.UUID{Valid: true, Bytes: input.CompanyId})
+}
+
+// CountEmployeesInCompany implements repository.ISubscriptionRepository.
+func (s *SubscriptionRepository) CountEmployeesInCompany(ctx context.Context, input *model.CountEmployeesInCompanyInput) (int64, error) {
+	return s.db.CountEmployeesInCompany(ctx, pgtype.UUID{Valid: true, Bytes: input.CompanyId})
+}
+
+func fromDate(v pgtype.Date) *time.Time {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Time
+}
+
+func fromInt4(v pgtype.Int4) *int {
+	if !v.Valid {
+		return nil
+	}
+	value := int(v.Int32)
+	return &value
+}
+
+// NewSubscriptionRepository create new instance and implement ISubscriptionRepository
+func NewSubscriptionRepository(
+	postgresConnect *pgxpool.Pool,
+) domainRepo.ISubscriptionRepository {
+	return &SubscriptionRepository{
+		db: database.New(postgresConnect),
+	}
+}
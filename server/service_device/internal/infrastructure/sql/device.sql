@@ -26,6 +26,20 @@ FROM devices
 WHERE device_id = $1
 LIMIT 1;
 
+-- name: UpdateDevice :one
+UPDATE devices
+SET location_id = COALESCE(sqlc.narg('location_id'), location_id),
+    name = COALESCE(sqlc.narg('name'), name),
+    address = COALESCE(sqlc.narg('address'), address),
+    device_type = COALESCE(sqlc.narg('device_type'), device_type),
+    serial_number = COALESCE(sqlc.narg('serial_number'), serial_number),
+    mac_address = COALESCE(sqlc.narg('mac_address'), mac_address),
+    status = COALESCE(sqlc.narg('status'), status),
+    auto_offline = CASE WHEN sqlc.narg('status') IS NULL THEN auto_offline ELSE FALSE END,
+    updated_at = NOW()
+WHERE device_id = sqlc.arg('device_id')
+RETURNING company_id, status;
+
 -- name: UpdateDeviceInfo :exec
 UPDATE devices
 SET serial_number = $2,
@@ -44,14 +58,19 @@ UPDATE devices
 SET name = $2, updated_at = NOW()
 WHERE device_id = $1;
 
+-- name: UpdateDeviceToken :exec
+UPDATE devices
+SET token = $2, updated_at = NOW()
+WHERE device_id = $1;
+
 -- name: EnableDevice :exec
 UPDATE devices
-SET status = 1, updated_at = NOW()
+SET status = 1, auto_offline = FALSE, updated_at = NOW()
 WHERE device_id = $1;
 
 -- name: DisableDevice :exec
 UPDATE devices
-SET status = 0, updated_at = NOW()
+SET status = 0, auto_offline = FALSE, updated_at = NOW()
 WHERE device_id = $1;
 
 -- name: DeleteDevice :exec
@@ -0,0 +1,27 @@
+-- name: GetDeviceConfig :one
+SELECT
+    company_id,
+    settings,
+    config_version,
+    applied_config_version,
+    config_applied_at
+FROM devices
+WHERE device_id = $1
+LIMIT 1;
+
+-- name: UpdateDeviceConfig :one
+UPDATE devices
+SET settings = $2,
+    config_version = config_version + 1,
+    updated_at = NOW()
+WHERE device_id = $1
+    AND config_version = sqlc.arg('expected_version')
+RETURNING config_version;
+
+-- name: AckDeviceConfig :execrows
+UPDATE devices
+SET applied_config_version = $2,
+    config_applied_at = NOW()
+WHERE device_id = $1
+    AND $2 <= config_version
+    AND $2 > applied_config_version;
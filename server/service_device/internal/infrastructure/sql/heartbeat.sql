@@ -0,0 +1,74 @@
+-- name: UpdateDeviceHeartbeat :one
+WITH prev AS (
+    SELECT device_id, auto_offline
+    FROM devices
+    WHERE device_id = $1
+    FOR UPDATE
+)
+UPDATE devices d
+SET last_heartbeat = NOW(),
+    ip_address = COALESCE(sqlc.narg('ip_address'), d.ip_address),
+    firmware_version = COALESCE(sqlc.narg('firmware_version'), d.firmware_version),
+    status = CASE WHEN d.auto_offline THEN 1 ELSE d.status END,
+    auto_offline = FALSE
+FROM prev
+WHERE d.device_id = prev.device_id
+RETURNING d.company_id, d.status, prev.auto_offline AS recovered;
+
+-- name: InsertDeviceHeartbeat :exec
+INSERT INTO device_heartbeats (
+    device_id,
+    company_id,
+    cpu_usage,
+    memory_usage,
+    temperature,
+    firmware_version,
+    ip_address,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, NOW()
+);
+
+-- name: MarkStaleDevicesOffline :many
+UPDATE devices
+SET status = 0, auto_offline = TRUE, updated_at = NOW()
+WHERE status = 1
+    AND last_heartbeat IS NOT NULL
+    AND last_heartbeat < $1
+RETURNING device_id, company_id, last_heartbeat;
+
+-- name: DeleteDeviceHeartbeatsBefore :exec
+DELETE FROM device_heartbeats
+WHERE created_at < $1;
+
+-- name: GetListDeviceHeartbeats :many
+SELECT
+    heartbeat_id,
+    cpu_usage,
+    memory_usage,
+    temperature,
+    firmware_version,
+    ip_address,
+    created_at
+FROM device_heartbeats
+WHERE device_id = sqlc.arg('device_id')
+    AND created_at >= sqlc.arg('from_time')
+    AND created_at < sqlc.arg('to_time')
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: GetDeviceUptime :one
+WITH beats AS (
+    SELECT created_at - LAG(created_at) OVER (ORDER BY created_at) AS gap
+    FROM device_heartbeats
+    WHERE device_id = sqlc.arg('device_id')
+        AND created_at >= sqlc.arg('from_time')
+        AND created_at < sqlc.arg('to_time')
+)
+SELECT
+    COUNT(*)::BIGINT AS total_heartbeats,
+    COALESCE(
+        SUM(EXTRACT(EPOCH FROM gap)) FILTER (WHERE gap <= make_interval(secs => sqlc.arg('max_gap_seconds')::FLOAT8)),
+        0
+    )::BIGINT AS online_seconds
+FROM beats;
@@ -0,0 +1,23 @@
+-- name: GetCompanySubscription :one
+SELECT
+    company_id,
+    status,
+    subscription_plan,
+    subscription_start_date,
+    subscription_end_date,
+    max_employees,
+    max_devices
+FROM companies
+WHERE company_id = $1
+LIMIT 1;
+
+-- name: CountDevicesInCompany :one
+SELECT COUNT(*)
+FROM devices
+WHERE company_id = $1;
+
+-- name: CountEmployeesInCompany :one
+SELECT COUNT(*)
+FROM employees
+WHERE company_id = $1
+    AND status <> 1;
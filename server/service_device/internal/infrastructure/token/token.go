@@ -85,6 +85,19 @@ func (t *TokenService) ParseDeviceToken(ctx context.Context, token string) (*dom
 	}, nil
 }
 
+// BlockTokenDevice implements token.ITokenService.
+func (t *TokenService) BlockTokenDevice(ctx context.Context, input *domainModel.BlockTokenDeviceInput) error {
+	req := &pb.BlockTokenDeviceRequest{
+		DeviceId: input.DeviceId,
+		TokenId:  input.TokenId,
+	}
+	if !input.ExpiresAt.IsZero() {
+		req.ExpiresAt = input.ExpiresAt.Unix()
+	}
+	_, err := t.grpc.BlockTokenDevice(ctx, req)
+	return err
+}
+
 // CreateDeviceToken implements token.ITokenService.
 func (t *TokenService) CreateDeviceToken(ctx context.Context, input *domainModel.TokenDeviceJwtInput) (string, error) {
 	token, err := t.grpc.CreateDeviceToken(ctx, &pb.CreateDeviceTokenRequest{
@@ -30,10 +30,10 @@ type UpdateDeviceRequest struct {
 	LocationId   string `json:"location_id" validate:"omitempty"`
 	DeviceName   string `json:"device_name" validate:"omitempty,min=3,max=100"`
 	Address      string `json:"address" validate:"omitempty,max=255"`
-	DeviceType   int    `json:"device_type" validate:"omitempty,oneof=0 1 2 3"` // 0: FACE_TERMINAL, 1: MOBILE_APP, 2: WEB_CAMERA, 3: IOT_SENSOR
+	DeviceType   *int   `json:"device_type" validate:"omitempty,oneof=0 1 2 3"` // 0: FACE_TERMINAL, 1: MOBILE_APP, 2: WEB_CAMERA, 3: IOT_SENSOR
 	SerialNumber string `json:"serial_number" validate:"omitempty,max=100"`
 	MacAddress   string `json:"mac_address" validate:"omitempty,mac"`
-	Status       int    `json:"status" validate:"omitempty,oneof=0 1 2 3"` // 0: OFFLINE, 1: ONLINE, 2: MAINTENANCE, 3: ERROR
+	Status       *int   `json:"status" validate:"omitempty,oneof=0 1 2 3"` // 0: OFFLINE, 1: ONLINE, 2: MAINTENANCE, 3: ERROR
 }
 
 // Get device by ID request
@@ -68,3 +68,33 @@ type UpdateInfoDeviceRequest struct {
 	NewSerialNumber string `json:"serial_number" validate:"omitempty,max=100"`
 	NewMacAddress   string `json:"mac_address" validate:"omitempty,mac"`
 }
+
+// Heartbeat device request (device self)
+type HeartbeatDeviceRequest struct {
+	CpuUsage        *float32 `json:"cpu_usage" validate:"omitempty,min=0,max=100"`     // Percent
+	MemoryUsage     *float32 `json:"memory_usage" validate:"omitempty,min=0,max=100"`  // Percent
+	Temperature     *float32 `json:"temperature" validate:"omitempty,min=-50,max=150"` // Celsius
+	FirmwareVersion string   `json:"firmware_version" validate:"omitempty,max=20"`
+}
+
+// Device working hours request, giờ theo định dạng HH:MM
+type DeviceWorkingHoursRequest struct {
+	Start string `json:"start" validate:"required,datetime=15:04"`
+	End   string `json:"end" validate:"required,datetime=15:04"`
+	Days  []int  `json:"days" validate:"required,min=1,max=7,unique,dive,min=0,max=6"` // 0: Sunday ... 6: Saturday
+}
+
+// Update device config request, thay thế toàn bộ cấu hình
+type UpdateDeviceConfigRequest struct {
+	Version             int64                     `json:"version" validate:"min=0"` // Version cấu hình đang sửa
+	LivenessThreshold   float64                   `json:"liveness_threshold" validate:"min=0,max=1"`
+	MatchThreshold      float64                   `json:"match_threshold" validate:"gt=0,max=1"`
+	WorkingHours        DeviceWorkingHoursRequest `json:"working_hours" validate:"required"`
+	UiLanguage          string                    `json:"ui_language" validate:"required,oneof=vi en"`
+	VerificationMethods []string                  `json:"verification_methods" validate:"required,min=1,unique,dive,oneof=face pin card qr_code"`
+}
+
+// Ack device config request (device self)
+type AckDeviceConfigRequest struct {
+	Version int64 `json:"version" validate:"required,min=1"`
+}
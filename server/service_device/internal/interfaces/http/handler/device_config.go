@@ -0,0 +1,259 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/model"
+	applicationService "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/service"
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/constants"
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/interfaces/dto"
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/interfaces/response"
+	contextShared "github.com/youknow2509/cio_verify_face/server/service_device/internal/shared/utils/context"
+	uuidShared "github.com/youknow2509/cio_verify_face/server/service_device/internal/shared/utils/uuid"
+)
+
+// GetDeviceConfig implements iHandler.
+// @Summary      Get device config
+// @Description  Get device configuration and the version applied by the device
+// @Tags         Core Device
+// @Accept       json
+// @Produce      json
+// @Param		 authorization header string true "Bearer <token>"
+// @Param        device_id   path string  true  "Device ID"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/device/config/{device_id} [get]
+func (h *Handler) GetDeviceConfig(c *gin.Context) {
+	// Get id device from path
+	idDevice, err := uuidShared.ParseUUID(c.Param("device_id"))
+	if err != nil {
+		response.ErrorResponse(c, response.ErrorCodeValidateRequest, "Invalid device ID")
+		return
+	}
+	// Get data auth from token
+	userId, sessionId, userRole, companyId, ok := contextShared.GetSessionFromContext(c)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	userUuid, _ := uuidShared.ParseUUID(userId)
+	sessionUuid, _ := uuidShared.ParseUUID(sessionId)
+	var companyUuid uuid.UUID
+	if companyId != "" {
+		companyUuid, _ = uuidShared.ParseUUID(companyId)
+	}
+	// Call to application handler
+	resp, errReq := applicationService.GetDeviceService().GetDeviceConfig(
+		c,
+		&applicationModel.GetDeviceConfigInput{
+			DeviceId:    idDevice,
+			UserId:      userUuid,
+			Role:        userRole,
+			ClientIp:    c.ClientIP(),
+			ClientAgent: c.Request.UserAgent(),
+			SessionId:   sessionUuid,
+			CompanyId:   companyUuid,
+		},
+	)
+	if errReq != nil {
+		if errReq.ErrorClient == "" {
+			response.ErrorResponse(c, 500, "Internal server error")
+			return
+		}
+		response.ErrorResponse(c, 400, errReq.ErrorClient)
+		return
+	}
+	response.SuccessResponse(c, 200, resp)
+}
+
+// UpdateDeviceConfig implements iHandler.
+// @Summary      Update device config
+// @Description  Replace device configuration and push it to the device, version must match the current config version
+// @Tags         Core Device
+// @Accept       json
+// @Produce      json
+// @Param		 authorization header string true "Bearer <token>"
+// @Param        device_id   path string  true  "Device ID"
+// @Param        request   body dto.UpdateDeviceConfigRequest  true  "Request body update device config"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/device/config/{device_id} [put]
+func (h *Handler) UpdateDeviceConfig(c *gin.Context) {
+	// Get id device from path
+	idDevice, err := uuidShared.ParseUUID(c.Param("device_id"))
+	if err != nil {
+		response.ErrorResponse(c, response.ErrorCodeValidateRequest, "Invalid device ID")
+		return
+	}
+	// Get req and parse
+	var req dto.UpdateDeviceConfigRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponse(c, response.ErrorCodeBindRequest, "Invalid request body")
+		return
+	}
+	// Validate req
+	validateMiddleware, ok := c.Get(constants.MIDDLEWARE_VALIDATE_SERVICE_NAME)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	validate, ok := validateMiddleware.(*validator.Validate)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		validationErrors := err.(validator.ValidationErrors)
+		response.ErrorResponse(c, response.ErrorCodeValidateRequest, validationErrors.Error())
+		return
+	}
+	// Get data auth from token
+	userId, sessionId, userRole, companyId, ok := contextShared.GetSessionFromContext(c)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	userUuid, _ := uuidShared.ParseUUID(userId)
+	sessionUuid, _ := uuidShared.ParseUUID(sessionId)
+	var companyUuid uuid.UUID
+	if companyId != "" {
+		companyUuid, _ = uuidShared.ParseUUID(companyId)
+	}
+	// Call to application handler
+	resp, errReq := applicationService.GetDeviceService().UpdateDeviceConfig(
+		c,
+		&applicationModel.UpdateDeviceConfigInput{
+			DeviceId: idDevice,
+			Version:  req.Version,
+			Config: applicationModel.DeviceConfig{
+				LivenessThreshold: req.LivenessThreshold,
+				MatchThreshold:    req.MatchThreshold,
+				WorkingHours: applicationModel.DeviceWorkingHours{
+					Start: req.WorkingHours.Start,
+					End:   req.WorkingHours.End,
+					Days:  req.WorkingHours.Days,
+				},
+				UiLanguage:          req.UiLanguage,
+				VerificationMethods: req.VerificationMethods,
+			},
+			UserId:      userUuid,
+			Role:        userRole,
+			ClientIp:    c.ClientIP(),
+			ClientAgent: c.Request.UserAgent(),
+			SessionId:   sessionUuid,
+			CompanyId:   companyUuid,
+		},
+	)
+	if errReq != nil {
+		if errReq.ErrorClient == "" {
+			response.ErrorResponse(c, 500, "Internal server error")
+			return
+		}
+		response.ErrorResponse(c, 400, errReq.ErrorClient)
+		return
+	}
+	response.SuccessResponse(c, 200, resp)
+}
+
+// GetDeviceConfigSelf implements iHandler.
+// @Summary      Get device config (device self)
+// @Description  Device get its current configuration
+// @Tags         Device Self
+// @Accept       json
+// @Produce      json
+// @Param		 authorization header string true "Bearer <token>"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/device/me/config [get]
+func (h *Handler) GetDeviceConfigSelf(c *gin.Context) {
+	// Get device session
+	deviceId, companyId, ok := contextShared.GetDeviceSessionFromContext(c)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	deviceUuid, _ := uuidShared.ParseUUID(deviceId)
+	companyUuid, _ := uuidShared.ParseUUID(companyId)
+	// Call to application handler
+	resp, errReq := applicationService.GetDeviceService().GetDeviceConfigSelf(
+		c,
+		&applicationModel.GetDeviceConfigSelfInput{
+			DeviceId:  deviceUuid,
+			CompanyId: companyUuid,
+		},
+	)
+	if errReq != nil {
+		if errReq.ErrorClient == "" {
+			response.ErrorResponse(c, 500, "Internal server error")
+			return
+		}
+		response.ErrorResponse(c, 400, errReq.ErrorClient)
+		return
+	}
+	response.SuccessResponse(c, 200, resp)
+}
+
+// AckDeviceConfig implements iHandler.
+// @Summary      Ack device config (device self)
+// @Description  Device acknowledge the configuration version it has applied
+// @Tags         Device Self
+// @Accept       json
+// @Produce      json
+// @Param		 authorization header string true "Bearer <token>"
+// @Param        request   body dto.AckDeviceConfigRequest  true  "Request body ack device config"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/device/me/config/ack [post]
+func (h *Handler) AckDeviceConfig(c *gin.Context) {
+	// Get req and parse
+	var req dto.AckDeviceConfigRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponse(c, response.ErrorCodeBindRequest, "Invalid request body")
+		return
+	}
+	// Validate req
+	validateMiddleware, ok := c.Get(constants.MIDDLEWARE_VALIDATE_SERVICE_NAME)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	validate, ok := validateMiddleware.(*validator.Validate)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		validationErrors := err.(validator.ValidationErrors)
+		response.ErrorResponse(c, response.ErrorCodeValidateRequest, validationErrors.Error())
+		return
+	}
+	// Get device session
+	deviceId, companyId, ok := contextShared.GetDeviceSessionFromContext(c)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	deviceUuid, _ := uuidShared.ParseUUID(deviceId)
+	companyUuid, _ := uuidShared.ParseUUID(companyId)
+	// Call to application handler
+	resp, errReq := applicationService.GetDeviceService().AckDeviceConfig(
+		c,
+		&applicationModel.AckDeviceConfigInput{
+			DeviceId:    deviceUuid,
+			CompanyId:   companyUuid,
+			Version:     req.Version,
+			ClientIp:    c.ClientIP(),
+			ClientAgent: c.Request.UserAgent(),
+		},
+	)
+	if errReq != nil {
+		if errReq.ErrorClient == "" {
+			response.ErrorResponse(c, 500, "Internal server error")
+			return
+		}
+		response.ErrorResponse(c, 400, errReq.ErrorClient)
+		return
+	}
+	response.SuccessResponse(c, 200, resp)
+}
@@ -34,9 +34,17 @@ type iHandler interface {
 	GetDeviceToken(c *gin.Context)
 	RefreshDeviceToken(c *gin.Context)
 	RefreshDeviceTokenSelf(c *gin.Context)
+	CompromiseDevice(c *gin.Context)
 	UpdateStatusDevice(c *gin.Context)
 	GetInfoDevice(c *gin.Context)
 	VerifyFace(c *gin.Context)
+	HeartbeatDevice(c *gin.Context)
+	GetDeviceUptime(c *gin.Context)
+	GetDeviceConfig(c *gin.Context)
+	UpdateDeviceConfig(c *gin.Context)
+	GetDeviceConfigSelf(c *gin.Context)
+	AckDeviceConfig(c *gin.Context)
+	GetCompanyUsage(c *gin.Context)
 }
 
 /**
@@ -353,6 +361,57 @@ func (h *Handler) RefreshDeviceToken(c *gin.Context) {
 	response.SuccessResponse(c, 200, resp)
 }
 
+// CompromiseDevice implements iHandler.
+// @Summary      Mark device compromised
+// @Description  Revoke device token, set device status ERROR and disconnect its WebSocket session
+// @Tags         Core Device
+// @Accept       json
+// @Produce      json
+// @Param		 authorization header string true "Bearer <token>"
+// @Param        device_id   path string  true  "Device ID"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/device/compromised/{device_id} [post]
+func (h *Handler) CompromiseDevice(c *gin.Context) {
+	// Get id device from path
+	idDeviceStr := c.Param("device_id")
+	idDevice, err := uuidShared.ParseUUID(idDeviceStr)
+	if err != nil {
+		response.ErrorResponse(c, response.ErrorCodeValidateRequest, "Invalid device ID")
+		return
+	}
+	// Get data auth from token
+	userId, sessionId, userRole, companyId, ok := contextShared.GetSessionFromContext(c)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	userUuid, _ := uuidShared.ParseUUID(userId)
+	sessionUuid, _ := uuidShared.ParseUUID(sessionId)
+	var companyUuid uuid.UUID
+	if companyId != "" {
+		companyUuid, _ = uuidShared.ParseUUID(companyId)
+	}
+	// Call to application handler
+	errReq := applicationService.GetDeviceService().CompromiseDevice(
+		c,
+		&applicationModel.CompromiseDeviceInput{
+			DeviceId:    idDevice,
+			UserId:      userUuid,
+			Role:        userRole,
+			ClientIp:    c.ClientIP(),
+			ClientAgent: c.Request.UserAgent(),
+			SessionId:   sessionUuid,
+			CompanyId:   companyUuid,
+		},
+	)
+	if errReq != nil {
+		response.ErrorResponse(c, 400, errReq.ErrorClient)
+		return
+	}
+	response.SuccessResponse(c, 200, "Device marked compromised")
+}
+
 // RefreshDeviceTokenSelf implements iHandler.
 // @Summary      Refresh device access token (self)
 // @Description  Device refreshes its own access token
@@ -717,11 +776,18 @@ func (h *Handler) GetListDevices(c *gin.Context) {
 // @Accept       json
 // @Produce      json
 // @Param		 authorization header string true "Bearer <token>"
+// @Param        device_id   path string  true  "Device ID"
 // @Param        request   body dto.UpdateDeviceRequest  true  "Request body update device"
 // @Success      200  {object}  dto.ResponseData
 // @Failure      400  {object}  dto.ErrResponseData
-// @Router       /v1/device [put]
+// @Router       /v1/device/{device_id} [put]
 func (h *Handler) UpdateDeviceById(c *gin.Context) {
+	// Get id device from path
+	idDevice, err := uuidShared.ParseUUID(c.Param("device_id"))
+	if err != nil {
+		response.ErrorResponse(c, response.ErrorCodeValidateRequest, "Invalid device ID")
+		return
+	}
 	// Get req and parse
 	var req dto.UpdateDeviceRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -739,7 +805,7 @@ func (h *Handler) UpdateDeviceById(c *gin.Context) {
 		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
 		return
 	}
-	err := validate.Struct(req)
+	err = validate.Struct(req)
 	if err != nil {
 		validationErrors := err.(validator.ValidationErrors)
 		response.ErrorResponse(c, response.ErrorCodeValidateRequest, validationErrors.Error())
@@ -767,6 +833,7 @@ func (h *Handler) UpdateDeviceById(c *gin.Context) {
 	resp, errReq := applicationService.GetDeviceService().UpdateDeviceById(
 		c,
 		&applicationModel.UpdateDeviceInput{
+			DeviceId:     idDevice,
 			LocationId:   locationUuid,
 			DeviceName:   req.DeviceName,
 			Address:      req.Address,
@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/model"
+	applicationService "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/service"
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/constants"
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/interfaces/dto"
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/interfaces/response"
+	contextShared "github.com/youknow2509/cio_verify_face/server/service_device/internal/shared/utils/context"
+	uuidShared "github.com/youknow2509/cio_verify_face/server/service_device/internal/shared/utils/uuid"
+)
+
+// HeartbeatDevice implements iHandler.
+// @Summary      Device heartbeat
+// @Description  Device report heartbeat with CPU, memory, temperature and firmware version
+// @Tags         Device Self
+// @Accept       json
+// @Produce      json
+// @Param		 authorization header string true "Bearer <token>"
+// @Param        request   body dto.HeartbeatDeviceRequest  true  "Request body device heartbeat"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/device/me/heartbeat [post]
+func (h *Handler) HeartbeatDevice(c *gin.Context) {
+	// Get req and parse
+	var req dto.HeartbeatDeviceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponse(c, response.ErrorCodeBindRequest, "Invalid request body")
+		return
+	}
+	// Validate req
+	validateMiddleware, ok := c.Get(constants.MIDDLEWARE_VALIDATE_SERVICE_NAME)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	validate, ok := validateMiddleware.(*validator.Validate)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		validationErrors := err.(validator.ValidationErrors)
+		response.ErrorResponse(c, response.ErrorCodeValidateRequest, validationErrors.Error())
+		return
+	}
+	// Get device session
+	deviceId, companyId, ok := contextShared.GetDeviceSessionFromContext(c)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	deviceUuid, _ := uuidShared.ParseUUID(deviceId)
+	companyUuid, _ := uuidShared.ParseUUID(companyId)
+	// Call to application handler
+	resp, errReq := applicationService.GetDeviceService().RecordHeartbeat(
+		c,
+		&applicationModel.RecordHeartbeatInput{
+			DeviceId:        deviceUuid,
+			CompanyId:       companyUuid,
+			CpuUsage:        req.CpuUsage,
+			MemoryUsage:     req.MemoryUsage,
+			Temperature:     req.Temperature,
+			FirmwareVersion: req.FirmwareVersion,
+			ClientIp:        c.ClientIP(),
+			ClientAgent:     c.Request.UserAgent(),
+		},
+	)
+	if errReq != nil {
+		if errReq.ErrorClient == "" {
+			response.ErrorResponse(c, 500, "Internal server error")
+			return
+		}
+		response.ErrorResponse(c, 400, errReq.ErrorClient)
+		return
+	}
+	response.SuccessResponse(c, 200, resp)
+}
+
+// GetDeviceUptime implements iHandler.
+// @Summary      Get device uptime
+// @Description  Get device uptime and heartbeat history in a time range (default last 24 hours)
+// @Tags         Core Device
+// @Accept       json
+// @Produce      json
+// @Param		 authorization header string true "Bearer <token>"
+// @Param        device_id   path string  true  "Device ID"
+// @Param        from    query     string  false  "From time (unix seconds)"  Format(int64)
+// @Param        to      query     string  false  "To time (unix seconds)"  Format(int64)
+// @Param        page    query     string  false  "Page number"  Format(int)
+// @Param        size    query     string  false  "Page size"  Format(int)
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/device/uptime/{device_id} [get]
+func (h *Handler) GetDeviceUptime(c *gin.Context) {
+	// Get id device from path
+	idDevice, err := uuidShared.ParseUUID(c.Param("device_id"))
+	if err != nil {
+		response.ErrorResponse(c, response.ErrorCodeValidateRequest, "Invalid device ID")
+		return
+	}
+	// Get query params
+	to := time.Now()
+	if toStr := c.Query("to"); toStr != "" {
+		toUnix, err := strconv.ParseInt(toStr, 10, 64)
+		if err != nil || toUnix <= 0 {
+			response.ErrorResponse(c, response.ErrorCodeValidateRequest, "Invalid to")
+			return
+		}
+		to = time.Unix(toUnix, 0)
+	}
+	from := to.Add(-constants.HEARTBEAT_DEFAULT_REPORT_HOURS * time.Hour)
+	if fromStr := c.Query("from"); fromStr != "" {
+		fromUnix, err := strconv.ParseInt(fromStr, 10, 64)
+		if err != nil || fromUnix <= 0 {
+			response.ErrorResponse(c, response.ErrorCodeValidateRequest, "Invalid from")
+			return
+		}
+		from = time.Unix(fromUnix, 0)
+	}
+	pageInt, err := strconv.Atoi(c.DefaultQuery("page", strconv.Itoa(constants.PageDefault)))
+	if err != nil || pageInt <= 0 {
+		response.ErrorResponse(c, response.ErrorCodeValidateRequest, "Invalid page")
+		return
+	}
+	sizeInt, err := strconv.Atoi(c.DefaultQuery("size", strconv.Itoa(constants.SizeDefault)))
+	if err != nil || sizeInt <= 0 || sizeInt > 100 {
+		response.ErrorResponse(c, response.ErrorCodeValidateRequest, "Invalid size")
+		return
+	}
+	// Get data auth from token
+	userId, sessionId, userRole, companyId, ok := contextShared.GetSessionFromContext(c)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	userUuid, _ := uuidShared.ParseUUID(userId)
+	sessionUuid, _ := uuidShared.ParseUUID(sessionId)
+	var companyUuid uuid.UUID
+	if companyId != "" {
+		companyUuid, _ = uuidShared.ParseUUID(companyId)
+	}
+	// Call to application handler
+	resp, errReq := applicationService.GetDeviceService().GetDeviceUptime(
+		c,
+		&applicationModel.GetDeviceUptimeInput{
+			DeviceId:    idDevice,
+			From:        from,
+			To:          to,
+			Page:        pageInt,
+			Size:        sizeInt,
+			UserId:      userUuid,
+			Role:        userRole,
+			ClientIp:    c.ClientIP(),
+			ClientAgent: c.Request.UserAgent(),
+			SessionId:   sessionUuid,
+			CompanyId:   companyUuid,
+		},
+	)
+	if errReq != nil {
+		response.ErrorResponse(c, 400, errReq.ErrorClient)
+		return
+	}
+	response.SuccessResponse(c, 200, resp)
+}
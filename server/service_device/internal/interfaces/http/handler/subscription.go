@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/model"
+	applicationService "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/service"
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/interfaces/response"
+	contextShared "github.com/youknow2509/cio_verify_face/server/service_device/internal/shared/utils/context"
+	uuidShared "github.com/youknow2509/cio_verify_face/server/service_device/internal/shared/utils/uuid"
+)
+
+// GetCompanyUsage implements iHandler.
+// @Summary      Get company usage
+// @Description  Get company subscription plan with current and allowed number of devices and employees
+// @Tags         Core Device
+// @Accept       json
+// @Produce      json
+// @Param		 authorization header string true "Bearer <token>"
+// @Param        company_id    query     string  false  "Company ID (system admin only)"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/device/usage [get]
+func (h *Handler) GetCompanyUsage(c *gin.Context) {
+	// Get query params
+	var companyUuidReq uuid.UUID
+	if companyIdReq := c.Query("company_id"); companyIdReq != "" {
+		var err error
+		companyUuidReq, err = uuidShared.ParseUUID(companyIdReq)
+		if err != nil {
+			response.ErrorResponse(c, response.ErrorCodeValidateRequest, "Invalid company_id")
+			return
+		}
+	}
+	// Get data auth from token
+	userId, sessionId, userRole, companyId, ok := contextShared.GetSessionFromContext(c)
+	if !ok {
+		response.ErrorResponse(c, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	userUuid, _ := uuidShared.ParseUUID(userId)
+	sessionUuid, _ := uuidShared.ParseUUID(sessionId)
+	var companyUuid uuid.UUID
+	if companyId != "" {
+		companyUuid, _ = uuidShared.ParseUUID(companyId)
+	}
+	// Call to application handler
+	resp, errReq := applicationService.GetSubscriptionService().GetCompanyUsage(
+		c,
+		&applicationModel.GetCompanyUsageInput{
+			CompanyIdReq: companyUuidReq,
+			UserId:       userUuid,
+			Role:         userRole,
+			ClientIp:     c.ClientIP(),
+			ClientAgent:  c.Request.UserAgent(),
+			SessionId:    sessionUuid,
+			CompanyId:    companyUuid,
+		},
+	)
+	if errReq != nil {
+		if errReq.ErrorClient == "" {
+			response.ErrorResponse(c, 500, "Internal server error")
+			return
+		}
+		response.ErrorResponse(c, 400, errReq.ErrorClient)
+		return
+	}
+	response.SuccessResponse(c, 200, resp)
+}
@@ -18,23 +18,34 @@ type HttpRouterManager struct {
 func (r *HttpRouterManager) InitRoutes(group *gin.RouterGroup) {
 	deviceV1 := group.Group("/v1/device")
 	deviceV1.Use(infraMiddleware.GetAuthAdminAccessTokenJwtMiddleware().Apply())
+	// Chặn thao tác ghi khi gói dịch vụ của công ty đã hết hạn
+	readOnly := infraMiddleware.GetSubscriptionReadOnlyMiddleware().Apply()
 	{
 		deviceV1.GET("", handler.NewHandler().GetListDevices)
-		deviceV1.POST("", handler.NewHandler().CreateNewDevice)
+		deviceV1.POST("", readOnly, handler.NewHandler().CreateNewDevice)
 		deviceV1.GET("/:device_id", handler.NewHandler().GetDeviceById)
 		deviceV1.GET("/token/:device_id", handler.NewHandler().GetDeviceToken)
-		deviceV1.POST("/token/refresh/:device_id", handler.NewHandler().RefreshDeviceToken)
-		deviceV1.PUT("/:device_id", handler.NewHandler().UpdateDeviceById)
-		deviceV1.DELETE("/:device_id", handler.NewHandler().DeleteDeviceById)
-		deviceV1.POST("/location", handler.NewHandler().UpdateLocationDevice)
-		deviceV1.POST("/name", handler.NewHandler().UpdateNameDevice)
-		deviceV1.POST("/info", handler.NewHandler().UpdateInfoDevice)
-		deviceV1.POST("/status", handler.NewHandler().UpdateStatusDevice)
+		deviceV1.POST("/token/refresh/:device_id", readOnly, handler.NewHandler().RefreshDeviceToken)
+		// Thu hồi token device bị xâm phạm luôn được phép kể cả khi công ty ở chế độ chỉ đọc
+		deviceV1.POST("/compromised/:device_id", handler.NewHandler().CompromiseDevice)
+		deviceV1.PUT("/:device_id", readOnly, handler.NewHandler().UpdateDeviceById)
+		deviceV1.DELETE("/:device_id", readOnly, handler.NewHandler().DeleteDeviceById)
+		deviceV1.POST("/location", readOnly, handler.NewHandler().UpdateLocationDevice)
+		deviceV1.POST("/name", readOnly, handler.NewHandler().UpdateNameDevice)
+		deviceV1.POST("/info", readOnly, handler.NewHandler().UpdateInfoDevice)
+		deviceV1.POST("/status", readOnly, handler.NewHandler().UpdateStatusDevice)
+		deviceV1.GET("/usage", handler.NewHandler().GetCompanyUsage)
+		deviceV1.GET("/uptime/:device_id", handler.NewHandler().GetDeviceUptime)
+		deviceV1.GET("/config/:device_id", handler.NewHandler().GetDeviceConfig)
+		deviceV1.PUT("/config/:device_id", readOnly, handler.NewHandler().UpdateDeviceConfig)
 	}
 	deviceSelf := group.Group("/v1/device")
 	deviceSelf.Use(infraMiddleware.GetAuthDeviceAccessTokenJwtMiddleware().Apply())
 	{
 		deviceSelf.GET("/me", handler.NewHandler().GetInfoDevice)
+		deviceSelf.POST("/me/heartbeat", handler.NewHandler().HeartbeatDevice)
+		deviceSelf.GET("/me/config", handler.NewHandler().GetDeviceConfigSelf)
+		deviceSelf.POST("/me/config/ack", handler.NewHandler().AckDeviceConfig)
 		deviceSelf.POST("/token/refresh", handler.NewHandler().RefreshDeviceTokenSelf)
 		deviceSelf.POST("/face/verify", handler.NewHandler().VerifyFace)
 	}
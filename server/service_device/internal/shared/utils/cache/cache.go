@@ -12,6 +12,16 @@ func GetKeyDeviceToken(deviceHashId string) string {
 	return fmt.Sprintf("device:token:%s", deviceHashId)
 }
 
+// Key hash token hiện tại của device, service ws delivery so sánh khi kiểm tra kết nối
+func GetKeyDeviceActiveToken(deviceHashId string) string {
+	return fmt.Sprintf("device:token:active:%s", deviceHashId)
+}
+
+// Key status token device, value "0" khi token id đã bị thu hồi (service auth và ws delivery kiểm tra)
+func GetKeyStatusTokenDevice(tokenIdHash string) string {
+	return fmt.Sprintf("device:token:status:%s", tokenIdHash)
+}
+
 // Key info list device in company
 func GetKeyListDeviceInCompany(companyHashId string, size int, page int) string {
 	return fmt.Sprintf("company:device:list:%s:%d:%d", companyHashId, size, page)
@@ -22,6 +32,16 @@ func GetKeyDeviceBase(deviceHashId string) string {
 	return fmt.Sprintf("device:info:base:%s", deviceHashId)
 }
 
+// Key gói dịch vụ và giới hạn của công ty
+func GetKeyCompanySubscription(companyHashId string) string {
+	return fmt.Sprintf("company:subscription:%s", companyHashId)
+}
+
+// Key lock kiểm tra giới hạn device của công ty
+func GetKeyCompanyDeviceQuotaLock(companyHashId string) string {
+	return fmt.Sprintf("company:device:quota:lock:%s", companyHashId)
+}
+
 // Key user register OTP value
 func GetKeyUserRegisterOTP(mailHash string) string {
 	return fmt.Sprintf("user:register:otp:%s", mailHash)
@@ -1,6 +1,8 @@
 package start
 
 import (
+	"context"
+
 	applicationService "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/service"
 	applicationServiceImpl "github.com/youknow2509/cio_verify_face/server/service_device/internal/application/service/impl"
 )
@@ -12,5 +14,13 @@ func initApplication() error {
 	if err := applicationService.SetDeviceService(deviceServiceImpl); err != nil {
 		return err
 	}
+	// Init ISubscriptionService
+	subscriptionServiceImpl := applicationServiceImpl.NewSubscriptionService()
+	if err := applicationService.SetSubscriptionService(subscriptionServiceImpl); err != nil {
+		return err
+	}
+	if err := subscriptionServiceImpl.ListenSubscriptionChanged(context.Background()); err != nil {
+		return err
+	}
 	return nil
 }
@@ -1,10 +1,12 @@
 package start
 
 import (
+	domainDelivery "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/delivery"
 	domainFace "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/face"
 	domainRepository "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/repository"
 	domainToken "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/token"
 	infraConn "github.com/youknow2509/cio_verify_face/server/service_device/internal/infrastructure/conn"
+	infraDelivery "github.com/youknow2509/cio_verify_face/server/service_device/internal/infrastructure/delivery"
 	infraFace "github.com/youknow2509/cio_verify_face/server/service_device/internal/infrastructure/face"
 	infraRepository "github.com/youknow2509/cio_verify_face/server/service_device/internal/infrastructure/repository"
 	infraToken "github.com/youknow2509/cio_verify_face/server/service_device/internal/infrastructure/token"
@@ -33,6 +35,24 @@ func initDomain() error {
 	); err != nil {
 		return err
 	}
+	// initialize IDeviceHeartbeatRepository
+	if err := domainRepository.SetDeviceHeartbeatRepository(
+		infraRepository.NewDeviceHeartbeatRepository(postgres),
+	); err != nil {
+		return err
+	}
+	// initialize IDeviceConfigRepository
+	if err := domainRepository.SetDeviceConfigRepository(
+		infraRepository.NewDeviceConfigRepository(postgres),
+	); err != nil {
+		return err
+	}
+	// initialize ISubscriptionRepository
+	if err := domainRepository.SetSubscriptionRepository(
+		infraRepository.NewSubscriptionRepository(postgres),
+	); err != nil {
+		return err
+	}
 	// initialize token service
 	if err := domainToken.SetTokenService(
 		infraToken.NewTokenService(authGrpcClient),
@@ -45,6 +65,14 @@ func initDomain() error {
 	); err != nil {
 		return err
 	}
+	// initialize device delivery service
+	if wsDeliveryGrpcClient != nil {
+		if err := domainDelivery.SetDeviceDeliveryService(
+			infraDelivery.NewDeviceDeliveryService(wsDeliveryGrpcClient),
+		); err != nil {
+			return err
+		}
+	}
 	// ============================================
 
 	// v.v
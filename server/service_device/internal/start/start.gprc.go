@@ -14,6 +14,8 @@ import (
 var (
 	authGrpcClient pb.AuthServiceClient
 	faceGrpcClient pb.FaceVerificationServiceClient
+	// nil nếu service_ws_delivery bị tắt, cấu hình device chỉ cập nhật khi device lấy lại
+	wsDeliveryGrpcClient pb.DispatcherClient
 )
 
 // init client grpc
@@ -24,6 +26,9 @@ func initClientGrpc() error {
 	if err := initFaceClientGrpc(); err != nil {
 		return err
 	}
+	if err := initWsDeliveryClientGrpc(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -58,6 +63,24 @@ func initFaceClientGrpc() error {
 	return nil
 }
 
+func initWsDeliveryClientGrpc() error {
+	config := global.SettingServer.WsDeliveryService
+	if !config.Enabled {
+		global.Logger.Warn("ws delivery gRPC client is disabled, device config will not be pushed live")
+		return nil
+	}
+	opts, err := buildGrpcDialOptions(config.Tls.Enabled, config.Tls.CertFile, config.KeepaliveTimeMs, config.KeepaliveTimeoutMs, config.KeepalivePermitWithoutCalls)
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.Dial(config.GrpcAddr, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to ws delivery gRPC server: %w", err)
+	}
+	wsDeliveryGrpcClient = pb.NewDispatcherClient(conn)
+	return nil
+}
+
 func buildGrpcDialOptions(enableTLS bool, certFile string, keepaliveTimeMs, keepaliveTimeoutMs int, permitWithoutCalls bool) ([]grpc.DialOption, error) {
 	var opts []grpc.DialOption
 	if enableTLS {
@@ -0,0 +1,45 @@
+package start
+
+import (
+	domainCache "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/cache"
+	domainConfig "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/config"
+	domainLogger "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/logger"
+	domainRepo "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/repository"
+	domainWorker "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/worker"
+	domainWorkerDevice "github.com/youknow2509/cio_verify_face/server/service_device/internal/domain/worker/device"
+	"github.com/youknow2509/cio_verify_face/server/service_device/internal/global"
+)
+
+// ============================================
+// Start workers
+// ============================================
+func initWorker(setting *domainConfig.Setting) error {
+	if err := InitHeartbeatSweeperWorker(&setting.DeviceHeartbeat); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ============================================
+// Start device heartbeat sweeper worker
+// ============================================
+func InitHeartbeatSweeperWorker(config *domainConfig.DeviceHeartbeatSetting) error {
+	distributedCache, err := domainCache.GetDistributedCache()
+	if err != nil {
+		return err
+	}
+	heartbeatRepo, err := domainRepo.GetDeviceHeartbeatRepository()
+	if err != nil {
+		return err
+	}
+	worker := domainWorkerDevice.NewHeartbeatSweeperWorker(
+		*config,
+		domainLogger.GetLogger(),
+		heartbeatRepo,
+		distributedCache,
+	)
+	if err := domainWorker.SetWorkerHeartbeatSweeper(worker); err != nil {
+		return err
+	}
+	return worker.RunHeartbeatSweeper(global.ShutdownContext)
+}
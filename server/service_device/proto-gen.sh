@@ -12,6 +12,7 @@ mkdir -p proto/pb
 protoc --go_out=. --go_opt=paths=source_relative \
     --go-grpc_out=. --go-grpc_opt=paths=source_relative \
     proto/auth.proto \
-    proto/face_service.proto
+    proto/face_service.proto \
+    proto/ws_delivery.proto
 
 echo "gRPC code generation completed!"
\ No newline at end of file
@@ -0,0 +1,442 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.0
+// source: proto/ws_delivery.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnId        string                 `protobuf:"bytes,1,opt,name=connId,proto3" json:"connId,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageRequest) Reset() {
+	*x = MessageRequest{}
+	mi := &file_proto_ws_delivery_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageRequest) ProtoMessage() {}
+
+func (x *MessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_delivery_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageRequest.ProtoReflect.Descriptor instead.
+func (*MessageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ws_delivery_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MessageRequest) GetConnId() string {
+	if x != nil {
+		return x.ConnId
+	}
+	return ""
+}
+
+func (x *MessageRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type SendMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageResponse) Reset() {
+	*x = SendMessageResponse{}
+	mi := &file_proto_ws_delivery_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageResponse) ProtoMessage() {}
+
+func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_delivery_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ws_delivery_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SendMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SendMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// payload là JSON, được gửi cho client dưới dạng {"type": type, "payload": payload}
+type SendToDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendToDeviceRequest) Reset() {
+	*x = SendToDeviceRequest{}
+	mi := &file_proto_ws_delivery_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendToDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendToDeviceRequest) ProtoMessage() {}
+
+func (x *SendToDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_delivery_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendToDeviceRequest.ProtoReflect.Descriptor instead.
+func (*SendToDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ws_delivery_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SendToDeviceRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *SendToDeviceRequest) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *SendToDeviceRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type SendToDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivered     bool                   `protobuf:"varint,1,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendToDeviceResponse) Reset() {
+	*x = SendToDeviceResponse{}
+	mi := &file_proto_ws_delivery_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendToDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendToDeviceResponse) ProtoMessage() {}
+
+func (x *SendToDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_delivery_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendToDeviceResponse.ProtoReflect.Descriptor instead.
+func (*SendToDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ws_delivery_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SendToDeviceResponse) GetDelivered() bool {
+	if x != nil {
+		return x.Delivered
+	}
+	return false
+}
+
+func (x *SendToDeviceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BroadcastToCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastToCompanyRequest) Reset() {
+	*x = BroadcastToCompanyRequest{}
+	mi := &file_proto_ws_delivery_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastToCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastToCompanyRequest) ProtoMessage() {}
+
+func (x *BroadcastToCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_delivery_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastToCompanyRequest.ProtoReflect.Descriptor instead.
+func (*BroadcastToCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ws_delivery_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BroadcastToCompanyRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *BroadcastToCompanyRequest) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *BroadcastToCompanyRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type BroadcastToCompanyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastToCompanyResponse) Reset() {
+	*x = BroadcastToCompanyResponse{}
+	mi := &file_proto_ws_delivery_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastToCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastToCompanyResponse) ProtoMessage() {}
+
+func (x *BroadcastToCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_delivery_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastToCompanyResponse.ProtoReflect.Descriptor instead.
+func (*BroadcastToCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ws_delivery_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BroadcastToCompanyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BroadcastToCompanyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_proto_ws_delivery_proto protoreflect.FileDescriptor
+
+const file_proto_ws_delivery_proto_rawDesc = "" +
+	"\n" +
+	"\x17proto/ws_delivery.proto\x12\x02pb\"B\n" +
+	"\x0eMessageRequest\x12\x16\n" +
+	"\x06connId\x18\x01 \x01(\tR\x06connId\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload\"I\n" +
+	"\x13SendMessageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"`\n" +
+	"\x13SendToDeviceRequest\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\x05R\x04type\x12\x18\n" +
+	"\apayload\x18\x03 \x01(\fR\apayload\"N\n" +
+	"\x14SendToDeviceResponse\x12\x1c\n" +
+	"\tdelivered\x18\x01 \x01(\bR\tdelivered\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"h\n" +
+	"\x19BroadcastToCompanyRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\x05R\x04type\x12\x18\n" +
+	"\apayload\x18\x03 \x01(\fR\apayload\"P\n" +
+	"\x1aBroadcastToCompanyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\xe0\x01\n" +
+	"\n" +
+	"Dispatcher\x12:\n" +
+	"\vSendMessage\x12\x12.pb.MessageRequest\x1a\x17.pb.SendMessageResponse\x12A\n" +
+	"\fSendToDevice\x12\x17.pb.SendToDeviceRequest\x1a\x18.pb.SendToDeviceResponse\x12S\n" +
+	"\x12BroadcastToCompany\x12\x1d.pb.BroadcastToCompanyRequest\x1a\x1e.pb.BroadcastToCompanyResponseBGZEgithub.com/youknow2509/cio_verify_face/server/service_device/proto;pbb\x06proto3"
+
+var (
+	file_proto_ws_delivery_proto_rawDescOnce sync.Once
+	file_proto_ws_delivery_proto_rawDescData []byte
+)
+
+func file_proto_ws_delivery_proto_rawDescGZIP() []byte {
+	file_proto_ws_delivery_proto_rawDescOnce.Do(func() {
+		file_proto_ws_delivery_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_ws_delivery_proto_rawDesc), len(file_proto_ws_delivery_proto_rawDesc)))
+	})
+	return file_proto_ws_delivery_proto_rawDescData
+}
+
+var file_proto_ws_delivery_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_ws_delivery_proto_goTypes = []any{
+	(*MessageRequest)(nil),             // 0: pb.MessageRequest
+	(*SendMessageResponse)(nil),        // 1: pb.SendMessageResponse
+	(*SendToDeviceRequest)(nil),        // 2: pb.SendToDeviceRequest
+	(*SendToDeviceResponse)(nil),       // 3: pb.SendToDeviceResponse
+	(*BroadcastToCompanyRequest)(nil),  // 4: pb.BroadcastToCompanyRequest
+	(*BroadcastToCompanyResponse)(nil), // 5: pb.BroadcastToCompanyResponse
+}
+var file_proto_ws_delivery_proto_depIdxs = []int32{
+	0, // 0: pb.Dispatcher.SendMessage:input_type -> pb.MessageRequest
+	2, // 1: pb.Dispatcher.SendToDevice:input_type -> pb.SendToDeviceRequest
+	4, // 2: pb.Dispatcher.BroadcastToCompany:input_type -> pb.BroadcastToCompanyRequest
+	1, // 3: pb.Dispatcher.SendMessage:output_type -> pb.SendMessageResponse
+	3, // 4: pb.Dispatcher.SendToDevice:output_type -> pb.SendToDeviceResponse
+	5, // 5: pb.Dispatcher.BroadcastToCompany:output_type -> pb.BroadcastToCompanyResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_ws_delivery_proto_init() }
+func file_proto_ws_delivery_proto_init() {
+	if File_proto_ws_delivery_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_ws_delivery_proto_rawDesc), len(file_proto_ws_delivery_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_ws_delivery_proto_goTypes,
+		DependencyIndexes: file_proto_ws_delivery_proto_depIdxs,
+		MessageInfos:      file_proto_ws_delivery_proto_msgTypes,
+	}.Build()
+	File_proto_ws_delivery_proto = out.File
+	file_proto_ws_delivery_proto_goTypes = nil
+	file_proto_ws_delivery_proto_depIdxs = nil
+}
@@ -0,0 +1,201 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.0
+// source: proto/ws_delivery.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Dispatcher_SendMessage_FullMethodName        = "/pb.Dispatcher/SendMessage"
+	Dispatcher_SendToDevice_FullMethodName       = "/pb.Dispatcher/SendToDevice"
+	Dispatcher_BroadcastToCompany_FullMethodName = "/pb.Dispatcher/BroadcastToCompany"
+)
+
+// DispatcherClient is the client API for Dispatcher service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DispatcherClient interface {
+	SendMessage(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
+	// Gửi tới device dù device đang kết nối ở replica nào
+	SendToDevice(ctx context.Context, in *SendToDeviceRequest, opts ...grpc.CallOption) (*SendToDeviceResponse, error)
+	// Gửi tới tất cả device đang kết nối của công ty
+	BroadcastToCompany(ctx context.Context, in *BroadcastToCompanyRequest, opts ...grpc.CallOption) (*BroadcastToCompanyResponse, error)
+}
+
+type dispatcherClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDispatcherClient(cc grpc.ClientConnInterface) DispatcherClient {
+	return &dispatcherClient{cc}
+}
+
+func (c *dispatcherClient) SendMessage(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendMessageResponse)
+	err := c.cc.Invoke(ctx, Dispatcher_SendMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherClient) SendToDevice(ctx context.Context, in *SendToDeviceRequest, opts ...grpc.CallOption) (*SendToDeviceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendToDeviceResponse)
+	err := c.cc.Invoke(ctx, Dispatcher_SendToDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherClient) BroadcastToCompany(ctx context.Context, in *BroadcastToCompanyRequest, opts ...grpc.CallOption) (*BroadcastToCompanyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BroadcastToCompanyResponse)
+	err := c.cc.Invoke(ctx, Dispatcher_BroadcastToCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DispatcherServer is the server API for Dispatcher service.
+// All implementations must embed UnimplementedDispatcherServer
+// for forward compatibility.
+type DispatcherServer interface {
+	SendMessage(context.Context, *MessageRequest) (*SendMessageResponse, error)
+	// Gửi tới device dù device đang kết nối ở replica nào
+	SendToDevice(context.Context, *SendToDeviceRequest) (*SendToDeviceResponse, error)
+	// Gửi tới tất cả device đang kết nối của công ty
+	BroadcastToCompany(context.Context, *BroadcastToCompanyRequest) (*BroadcastToCompanyResponse, error)
+	mustEmbedUnimplementedDispatcherServer()
+}
+
+// UnimplementedDispatcherServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDispatcherServer struct{}
+
+func (UnimplementedDispatcherServer) SendMessage(context.Context, *MessageRequest) (*SendMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+}
+func (UnimplementedDispatcherServer) SendToDevice(context.Context, *SendToDeviceRequest) (*SendToDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendToDevice not implemented")
+}
+func (UnimplementedDispatcherServer) BroadcastToCompany(context.Context, *BroadcastToCompanyRequest) (*BroadcastToCompanyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastToCompany not implemented")
+}
+func (UnimplementedDispatcherServer) mustEmbedUnimplementedDispatcherServer() {}
+func (UnimplementedDispatcherServer) testEmbeddedByValue()                    {}
+
+// UnsafeDispatcherServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DispatcherServer will
+// result in compilation errors.
+type UnsafeDispatcherServer interface {
+	mustEmbedUnimplementedDispatcherServer()
+}
+
+func RegisterDispatcherServer(s grpc.ServiceRegistrar, srv DispatcherServer) {
+	// If the following call pancis, it indicates UnimplementedDispatcherServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Dispatcher_ServiceDesc, srv)
+}
+
+func _Dispatcher_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).SendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dispatcher_SendMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).SendMessage(ctx, req.(*MessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dispatcher_SendToDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendToDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).SendToDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dispatcher_SendToDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).SendToDevice(ctx, req.(*SendToDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dispatcher_BroadcastToCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastToCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).BroadcastToCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dispatcher_BroadcastToCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).BroadcastToCompany(ctx, req.(*BroadcastToCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Dispatcher_ServiceDesc is the grpc.ServiceDesc for Dispatcher service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Dispatcher_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Dispatcher",
+	HandlerType: (*DispatcherServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendMessage",
+			Handler:    _Dispatcher_SendMessage_Handler,
+		},
+		{
+			MethodName: "SendToDevice",
+			Handler:    _Dispatcher_SendToDevice_Handler,
+		},
+		{
+			MethodName: "BroadcastToCompany",
+			Handler:    _Dispatcher_BroadcastToCompany_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/ws_delivery.proto",
+}
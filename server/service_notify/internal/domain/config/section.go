@@ -316,10 +316,11 @@ type SMTPSetting struct {
 
 // jwt
 type JWTSetting struct {
-	Secret   string   `mapstructure:"secret"`
-	Issuer   string   `mapstructure:"issuer"`
-	Subject  string   `mapstructure:"subject"`
-	Audience []string `mapstructure:"audience"`
+	Issuer       string   `mapstructure:"issuer"`
+	Subject      string   `mapstructure:"subject"`
+	Audience     []string `mapstructure:"audience"`
+	JwksUrl      string   `mapstructure:"jwks_url"`       // JWKS của service_auth, token chỉ được xác thực bằng public key
+	JwksCacheTtl int      `mapstructure:"jwks_cache_ttl"` // Thời gian cache public key (giây)
 }
 
 // logger
@@ -0,0 +1,218 @@
+package token
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// Thời gian cache public key mặc định
+	defaultJwksCacheTtl = 5 * time.Minute
+	// Khoảng cách tối thiểu giữa 2 lần tải lại JWKS khi gặp kid lạ, tránh bị spam bằng token giả
+	minJwksRefreshInterval = 10 * time.Second
+	// Timeout khi gọi service_auth
+	jwksRequestTimeout = 5 * time.Second
+	// Giới hạn kích thước response JWKS
+	maxJwksResponseSize = 1 << 20
+)
+
+// Thuật toán ký được chấp nhận, không chấp nhận HS* và none
+var allowedSigningMethods = []string{"RS256", "EdDSA"}
+
+type (
+	jsonWebKey struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		Alg string `json:"alg"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+	}
+
+	verifyKey struct {
+		alg string
+		key any
+	}
+)
+
+// =======================================================
+//
+//	JWKS cache - public key của service_auth
+//
+// =======================================================
+type jwksCache struct {
+	url         string
+	ttl         time.Duration
+	client      *http.Client
+	mu          sync.Mutex
+	keys        map[string]verifyKey
+	expiresAt   time.Time
+	refreshedAt time.Time
+	refreshing  chan struct{} // Khác nil khi đang tải JWKS, đóng lại khi tải xong
+}
+
+func newJwksCache(url string, ttl time.Duration) *jwksCache {
+	if ttl <= 0 {
+		ttl = defaultJwksCacheTtl
+	}
+	return &jwksCache{
+		url:    url,
+		ttl:    ttl,
+		client: &http.Client{Timeout: jwksRequestTimeout},
+		keys:   map[string]verifyKey{},
+	}
+}
+
+// keyFunc chọn public key theo kid trong header, thuật toán của token phải khớp với khóa
+func (c *jwksCache) keyFunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, errors.New("missing kid")
+	}
+	key, err := c.get(kid)
+	if err != nil {
+		return nil, err
+	}
+	if token.Method.Alg() != key.alg {
+		return nil, fmt.Errorf("signing method %s does not match key %q", token.Method.Alg(), kid)
+	}
+	return key.key, nil
+}
+
+// get đọc key từ cache, tải lại JWKS khi cache hết hạn hoặc gặp kid mới (service_auth xoay khóa)
+// Không giữ lock khi gọi service_auth để request khác vẫn đọc được key còn trong cache
+func (c *jwksCache) get(kid string) (verifyKey, error) {
+	c.mu.Lock()
+	key, ok := c.keys[kid]
+	now := time.Now()
+	if ok && now.Before(c.expiresAt) {
+		c.mu.Unlock()
+		return key, nil
+	}
+	if refreshing := c.refreshing; refreshing != nil {
+		// Đã có request khác đang tải, chờ kết quả thay vì gọi service_auth thêm lần nữa
+		c.mu.Unlock()
+		<-refreshing
+		c.mu.Lock()
+		key, ok = c.keys[kid]
+		c.mu.Unlock()
+	} else if now.Sub(c.refreshedAt) >= minJwksRefreshInterval {
+		c.refreshedAt = now
+		refreshing := make(chan struct{})
+		c.refreshing = refreshing
+		c.mu.Unlock()
+
+		keys, err := c.fetch()
+
+		c.mu.Lock()
+		if err == nil {
+			c.keys = keys
+			c.expiresAt = time.Now().Add(c.ttl)
+			key, ok = keys[kid]
+		}
+		c.refreshing = nil
+		close(refreshing)
+		c.mu.Unlock()
+		if err != nil && !ok {
+			return verifyKey{}, fmt.Errorf("jwks: %w", err)
+		}
+		// Không tải được JWKS thì dùng tạm key cũ trong cache
+	} else {
+		c.mu.Unlock()
+	}
+	if !ok {
+		return verifyKey{}, fmt.Errorf("jwks: key %q not found", kid)
+	}
+	return key, nil
+}
+
+// fetch tải JWKS từ service_auth
+func (c *jwksCache) fetch() (map[string]verifyKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), jwksRequestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxJwksResponseSize)).Decode(&jwks); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]verifyKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.verifyKey()
+		if err != nil {
+			// Bỏ qua key không hỗ trợ, các key còn lại vẫn dùng được
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+// verifyKey chuyển JWK (RSA, Ed25519) sang public key
+func (k *jsonWebKey) verifyKey() (verifyKey, error) {
+	switch k.Kty {
+	case "RSA":
+		if k.Alg != "" && k.Alg != "RS256" {
+			return verifyKey{}, fmt.Errorf("unsupported alg %q", k.Alg)
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return verifyKey{}, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return verifyKey{}, err
+		}
+		if len(n) == 0 || len(e) == 0 || len(e) > 4 {
+			return verifyKey{}, errors.New("invalid rsa key")
+		}
+		return verifyKey{
+			alg: "RS256",
+			key: &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())},
+		}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" || (k.Alg != "" && k.Alg != "EdDSA") {
+			return verifyKey{}, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return verifyKey{}, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return verifyKey{}, errors.New("invalid ed25519 key")
+		}
+		return verifyKey{alg: "EdDSA", key: ed25519.PublicKey(x)}, nil
+	default:
+		return verifyKey{}, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
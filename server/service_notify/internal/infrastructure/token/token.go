@@ -3,14 +3,13 @@ package token
 import (
 	"context"
 	"errors"
-	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	domainConfig "github.com/youknow2509/cio_verify_face/server/service_notify/internal/domain/config"
 	domainErrors "github.com/youknow2509/cio_verify_face/server/service_notify/internal/domain/errors"
 	domainModel "github.com/youknow2509/cio_verify_face/server/service_notify/internal/domain/model"
 	domainToken "github.com/youknow2509/cio_verify_face/server/service_notify/internal/domain/token"
-	utilsRandom "github.com/youknow2509/cio_verify_face/server/service_notify/internal/shared/utils/random"
 )
 
 // =======================================================
@@ -47,10 +46,10 @@ type (
 // Define token infrastructure implementation in domain
 // =======================================================
 type TokenService struct {
-	secret   string
 	issuer   string
 	subject  string
 	audience []string
+	jwks     *jwksCache
 }
 
 // ParseUserRefreshToken implements token.ITokenService.
@@ -58,9 +57,8 @@ func (t *TokenService) ParseUserRefreshToken(ctx context.Context, token string)
 	parsedToken, err := jwt.ParseWithClaims(
 		token,
 		&TokenUserRefreshJwtClaim{},
-		func(token *jwt.Token) (any, error) {
-			return []byte(t.secret), nil
-		},
+		t.jwks.keyFunc,
+		jwt.WithValidMethods(allowedSigningMethods),
 	)
 	if e := handleError(err); e != nil {
 		return nil, e
@@ -83,57 +81,13 @@ func (t *TokenService) ParseUserRefreshToken(ctx context.Context, token string)
 	return output, nil
 }
 
-// CreateDeviceRefreshToken implements token.ITokenService.
-func (t *TokenService) CreateDeviceRefreshToken(ctx context.Context, input *domainModel.TokenDeviceRefreshInput) (string, error) {
-	token := fmt.Sprintf(
-		"%s.%s.%s.%s",
-		utilsRandom.RandomString(8),
-		utilsRandom.RandomString(8),
-		utilsRandom.RandomString(8),
-		utilsRandom.RandomString(8),
-	)
-	return token, nil
-}
-
-// CreateDeviceToken implements token.ITokenService.
-func (t *TokenService) CreateDeviceToken(ctx context.Context, input *domainModel.TokenDeviceJwtInput) (string, error) {
-	tokenClaims := &TokenDeviceJwtClaim{
-		RegisteredClaims: jwt.RegisteredClaims{
-			Issuer:    t.issuer,
-			Subject:   t.subject,
-			Audience:  t.audience,
-			ExpiresAt: jwt.NewNumericDate(input.Expires),
-			NotBefore: jwt.NewNumericDate(time.Now()),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			ID:        input.TokenId,
-		},
-		DeviceId:  input.DeviceId,
-		CompanyId: input.CompanyId,
-	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaims)
-	return token.SignedString([]byte(t.secret))
-}
-
-// CreateServiceRefreshToken implements token.ITokenService.
-func (t *TokenService) CreateServiceRefreshToken(ctx context.Context, input *domainModel.ServiceRefreshTokenInput) (string, error) {
-	token := fmt.Sprintf(
-		"%s.%s.%s.%s",
-		utilsRandom.RandomString(8),
-		utilsRandom.RandomString(8),
-		utilsRandom.RandomString(8),
-		utilsRandom.RandomString(8),
-	)
-	return token, nil
-}
-
 // ParseDeviceToken implements token.ITokenService.
 func (t *TokenService) ParseDeviceToken(ctx context.Context, token string) (*domainModel.TokenDeviceJwtOutput, *domainErrors.TokenValidationError) {
 	parsedToken, err := jwt.ParseWithClaims(
 		token,
 		&TokenDeviceJwtClaim{},
-		func(token *jwt.Token) (any, error) {
-			return []byte(t.secret), nil
-		},
+		t.jwks.keyFunc,
+		jwt.WithValidMethods(allowedSigningMethods),
 	)
 	if e := handleError(err); e != nil {
 		return nil, e
@@ -159,69 +113,13 @@ func (t *TokenService) ParseDeviceToken(ctx context.Context, token string) (*dom
 	return output, nil
 }
 
-// CreateServiceToken implements token.ITokenService.
-func (t *TokenService) CreateServiceToken(ctx context.Context, input *domainModel.TokenServiceJwtInput) (string, error) {
-	tokenClaims := &TokenServiceJwtClaim{
-		RegisteredClaims: jwt.RegisteredClaims{
-			Issuer:    t.issuer,
-			Subject:   t.subject,
-			Audience:  t.audience,
-			ExpiresAt: jwt.NewNumericDate(input.Expires),
-			NotBefore: jwt.NewNumericDate(time.Now()),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			ID:        input.TokenId,
-		},
-		ServiceName: input.ServiceName,
-		Type:        input.Type,
-	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaims)
-	return token.SignedString([]byte(t.secret))
-}
-
-// CreateUserToken implements token.ITokenService.
-func (t *TokenService) CreateUserToken(ctx context.Context, input *domainModel.TokenUserJwtInput) (string, error) {
-	tokenClaim := &TokenUserJwtClaim{
-		RegisteredClaims: jwt.RegisteredClaims{
-			Issuer:    t.issuer,
-			Subject:   t.subject,
-			Audience:  t.audience,
-			ExpiresAt: jwt.NewNumericDate(input.Expires),
-			NotBefore: jwt.NewNumericDate(time.Now()),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			ID:        input.TokenId,
-		},
-		UserId: input.UserId,
-		Role:   input.Role,
-	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaim)
-	return token.SignedString([]byte(t.secret))
-}
-
-// CreateUserRefreshToken implements token.ITokenService.
-func (t *TokenService) CreateUserRefreshToken(ctx context.Context, input *domainModel.TokenUserRefreshInput) (string, error) {
-	tokenClaim := &TokenUserRefreshJwtClaim{
-		RegisteredClaims: jwt.RegisteredClaims{
-			Issuer:    t.issuer,
-			Subject:   t.subject,
-			Audience:  t.audience,
-			ExpiresAt: jwt.NewNumericDate(input.Expires),
-			NotBefore: jwt.NewNumericDate(time.Now()),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			ID:        input.TokenId,
-		},
-	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaim)
-	return token.SignedString([]byte(t.secret))
-}
-
 // ParseServiceToken implements token.ITokenService.
 func (t *TokenService) ParseServiceToken(ctx context.Context, token string) (*domainModel.TokenServiceJwtOutput, *domainErrors.TokenValidationError) {
 	parsedToken, err := jwt.ParseWithClaims(
 		token,
 		&TokenServiceJwtClaim{},
-		func(token *jwt.Token) (any, error) {
-			return []byte(t.secret), nil
-		},
+		t.jwks.keyFunc,
+		jwt.WithValidMethods(allowedSigningMethods),
 	)
 	if e := handleError(err); e != nil {
 		return nil, e
@@ -252,9 +150,8 @@ func (t *TokenService) ParseUserToken(ctx context.Context, tokenString string) (
 	token, err := jwt.ParseWithClaims(
 		tokenString,
 		&TokenUserJwtClaim{},
-		func(token *jwt.Token) (any, error) {
-			return []byte(t.secret), nil
-		},
+		t.jwks.keyFunc,
+		jwt.WithValidMethods(allowedSigningMethods),
 	)
 	if e := handleError(err); e != nil {
 		return nil, e
@@ -281,19 +178,14 @@ func (t *TokenService) ParseUserToken(ctx context.Context, tokenString string) (
 }
 
 /**
- * New token implementation
+ * New token implementation, verify token signed by service_auth with public keys from JWKS
  */
-func NewTokenService(
-	secret string,
-	issuer string,
-	subject string,
-	audience []string,
-) domainToken.ITokenService {
+func NewTokenService(setting *domainConfig.JWTSetting) domainToken.ITokenService {
 	return &TokenService{
-		secret:   secret,
-		issuer:   issuer,
-		subject:  subject,
-		audience: audience,
+		issuer:   setting.Issuer,
+		subject:  setting.Subject,
+		audience: setting.Audience,
+		jwks:     newJwksCache(setting.JwksUrl, time.Duration(setting.JwksCacheTtl)*time.Second),
 	}
 }
 
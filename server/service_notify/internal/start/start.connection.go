@@ -23,12 +23,7 @@ func initConnectionToInfrastructure(setting *domainConfig.Setting) error {
 		return err
 	}
 	// initialize token service
-	_tokenService = infraToken.NewTokenService(
-		setting.JWT.Secret,
-		setting.JWT.Issuer,
-		setting.JWT.Subject,
-		setting.JWT.Audience,
-	)
+	_tokenService = infraToken.NewTokenService(&setting.JWT)
 	// initialize smtp
 	if err := initSmtpClient(&setting.SMTP); err != nil {
 		return err
@@ -4,6 +4,8 @@ go 1.24.3
 
 replace github.com/youknow2509/cio_verify_face/server/pkg/observability => ../pkg/observability
 
+replace github.com/youknow2509/cio_verify_face/server/pkg/password => ../pkg/password
+
 require (
 	github.com/gin-gonic/gin v1.11.0
 	github.com/go-playground/validator/v10 v10.28.0
@@ -15,6 +17,7 @@ require (
 	github.com/swaggo/gin-swagger v1.6.1
 	github.com/swaggo/swag v1.16.6
 	github.com/youknow2509/cio_verify_face/server/pkg/observability v0.0.0
+	github.com/youknow2509/cio_verify_face/server/pkg/password v0.0.0
 	google.golang.org/grpc v1.77.0
 )
 
@@ -3,7 +3,6 @@ package impl
 import (
 	"context"
 	"crypto/rand"
-	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -18,6 +17,7 @@ import (
 	domainCache "github.com/youknow2509/cio_verify_face/server/service_profile_update/internal/domain/cache"
 	domainModel "github.com/youknow2509/cio_verify_face/server/service_profile_update/internal/domain/model"
 	domainMQ "github.com/youknow2509/cio_verify_face/server/service_profile_update/internal/domain/mq"
+	domainPassword "github.com/youknow2509/cio_verify_face/server/service_profile_update/internal/domain/password"
 	domainRepo "github.com/youknow2509/cio_verify_face/server/service_profile_update/internal/domain/repository"
 	"github.com/youknow2509/cio_verify_face/server/service_profile_update/internal/global"
 )
@@ -105,8 +105,13 @@ func (s *PasswordResetServiceImpl) ResetEmployeePassword(ctx context.Context, in
 
 	// Generate new random password (will be set when user clicks reset link)
 	newPassword := s.generateRandomPassword(12)
-	salt := s.generateSalt()
-	passwordHash := s.hashPassword(newPassword, salt)
+	passwordHash, err := s.hashPassword(newPassword)
+	if err != nil {
+		global.Logger.Error("Failed to hash new password", err)
+		return nil, appErrors.ErrServiceUnavailable
+	}
+	// Hash mới tự chứa salt, cột salt chỉ còn dùng cho hash legacy
+	salt := ""
 
 	// Generate reset token
 	resetToken := s.generateSecureToken()
@@ -260,20 +265,13 @@ func (s *PasswordResetServiceImpl) generateRandomPassword(length int) string {
 	return string(password)
 }
 
-func (s *PasswordResetServiceImpl) generateSalt() string {
-	bytes := make([]byte, 16)
-	if _, err := rand.Read(bytes); err != nil {
-		return uuid.New().String()[:16]
+func (s *PasswordResetServiceImpl) hashPassword(password string) (string, error) {
+	// Use the shared password hasher (argon2id/bcrypt), same as the auth service
+	hasher, err := domainPassword.GetPasswordHasher()
+	if err != nil {
+		return "", err
 	}
-	return fmt.Sprintf("%x", bytes)
-}
-
-func (s *PasswordResetServiceImpl) hashPassword(password, salt string) string {
-	// Use the same SHA-256 hashing as the existing auth service
-	// Hash: SHA256(password + salt)
-	saltedPassword := password + salt
-	hash := sha256.Sum256([]byte(saltedPassword))
-	return hex.EncodeToString(hash[:])
+	return hasher.Hash(password)
 }
 
 func (s *PasswordResetServiceImpl) sendPasswordResetNotification(ctx context.Context, employee *domainModel.UserInfo, resetToken string, requestID uuid.UUID) (string, error) {
@@ -25,6 +25,7 @@ type (
 		Logstash          LogstashSetting      `mapstructure:"logstash"`
 		SMTP              SMTPSetting          `mapstructure:"smtp"`
 		JWT               JWTSetting           `mapstructure:"jwt"`
+		Password          PasswordSetting      `mapstructure:"password"`
 		Logger            LoggerSetting        `mapstructure:"logger"`
 		RateLimitPolicies []RateLimitPolicy    `mapstructure:"policy_rate_limit"`
 	}
@@ -321,6 +322,21 @@ type JWTSetting struct {
 	Audience []string `mapstructure:"audience"`
 }
 
+// password hashing
+type PasswordSetting struct {
+	Algorithm string `mapstructure:"algorithm"` // argon2id | bcrypt, thuật toán dùng cho hash mới
+	Argon2id  struct {
+		Memory      uint32 `mapstructure:"memory"`      // KiB
+		Iterations  uint32 `mapstructure:"iterations"`  // Số vòng lặp
+		Parallelism uint8  `mapstructure:"parallelism"` // Số luồng
+		SaltLength  uint32 `mapstructure:"salt_length"` // Bytes
+		KeyLength   uint32 `mapstructure:"key_length"`  // Bytes
+	} `mapstructure:"argon2id"`
+	Bcrypt struct {
+		Cost int `mapstructure:"cost"`
+	} `mapstructure:"bcrypt"`
+}
+
 // logger
 type LoggerSetting struct {
 	FolderStore    string `mapstructure:"folder_store"`     // Folder to store log files
@@ -0,0 +1,35 @@
+package password
+
+import "errors"
+
+// =================================
+// Password hasher interface
+// =================================
+type IPasswordHasher interface {
+	// Hash password, result is self-describing (algorithm and cost params)
+	Hash(password string) (string, error)
+	// Verify password in constant time, salt only used for legacy sha256(password + salt) hash
+	Verify(password, salt, encoded string) (bool, error)
+	// NeedsRehash check stored hash is legacy or use outdated algorithm/cost params
+	NeedsRehash(encoded string) bool
+}
+
+var _passwordHasher IPasswordHasher
+
+// =================================
+// Setters and Getters:
+// =================================
+func SetPasswordHasher(hasher IPasswordHasher) error {
+	if _passwordHasher != nil {
+		return errors.New("password hasher already initialized")
+	}
+	_passwordHasher = hasher
+	return nil
+}
+
+func GetPasswordHasher() (IPasswordHasher, error) {
+	if _passwordHasher == nil {
+		return nil, errors.New("password hasher not initialized")
+	}
+	return _passwordHasher, nil
+}
@@ -3,6 +3,9 @@ package start
 import (
 	"fmt"
 
+	"github.com/youknow2509/cio_verify_face/server/pkg/password"
+	domainConfig "github.com/youknow2509/cio_verify_face/server/service_profile_update/internal/domain/config"
+	domainPassword "github.com/youknow2509/cio_verify_face/server/service_profile_update/internal/domain/password"
 	"github.com/youknow2509/cio_verify_face/server/service_profile_update/internal/domain/repository"
 	"github.com/youknow2509/cio_verify_face/server/service_profile_update/internal/global"
 	"github.com/youknow2509/cio_verify_face/server/service_profile_update/internal/infrastructure/conn"
@@ -37,6 +40,33 @@ func initDomain() error {
 	}
 	global.Logger.Info("Password reset request repository initialized")
 
+	// Initialize Password Hasher
+	hasher, err := password.New(toPasswordConfig(&global.SettingServer.Password))
+	if err != nil {
+		return fmt.Errorf("failed to create password hasher: %w", err)
+	}
+	if err := domainPassword.SetPasswordHasher(hasher); err != nil {
+		return fmt.Errorf("failed to set password hasher: %w", err)
+	}
+	global.Logger.Info("Password hasher initialized")
+
 	global.Logger.Info("Domain layer initialized successfully")
 	return nil
 }
+
+// toPasswordConfig map password setting to shared hasher config, zero fields use defaults
+func toPasswordConfig(setting *domainConfig.PasswordSetting) password.Config {
+	return password.Config{
+		Algorithm: setting.Algorithm,
+		Argon2id: password.Argon2idConfig{
+			Memory:      setting.Argon2id.Memory,
+			Iterations:  setting.Argon2id.Iterations,
+			Parallelism: setting.Argon2id.Parallelism,
+			SaltLength:  setting.Argon2id.SaltLength,
+			KeyLength:   setting.Argon2id.KeyLength,
+		},
+		Bcrypt: password.BcryptConfig{
+			Cost: setting.Bcrypt.Cost,
+		},
+	}
+}
@@ -0,0 +1,183 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// =================================================
+// Leave and holiday application model
+// =================================================
+
+// For create company holiday
+type CreateHolidayInput struct {
+	// User info
+	UserId      uuid.UUID `json:"user_id"`
+	SessionId   uuid.UUID `json:"session_id"`
+	Role        int       `json:"role"`
+	ClientIp    string    `json:"client_ip"`
+	ClientAgent string    `json:"client_agent"`
+	CompanyId   uuid.UUID `json:"company_id"`
+	//
+	TargetCompanyId uuid.UUID `json:"target_company_id"` // Admin thao tác cho công ty khác
+	HolidayDate     time.Time `json:"holiday_date"`
+	Name            string    `json:"name"`
+	Description     string    `json:"description"`
+}
+
+type CreateHolidayOutput struct {
+	HolidayId uuid.UUID `json:"holiday_id"`
+}
+
+// For delete company holiday
+type DeleteHolidayInput struct {
+	// User info
+	UserId      uuid.UUID `json:"user_id"`
+	SessionId   uuid.UUID `json:"session_id"`
+	Role        int       `json:"role"`
+	ClientIp    string    `json:"client_ip"`
+	ClientAgent string    `json:"client_agent"`
+	CompanyId   uuid.UUID `json:"company_id"`
+	//
+	TargetCompanyId uuid.UUID `json:"target_company_id"`
+	HolidayId       uuid.UUID `json:"holiday_id"`
+}
+
+// For get list company holiday
+type GetListHolidayInput struct {
+	// User info
+	UserId      uuid.UUID `json:"user_id"`
+	SessionId   uuid.UUID `json:"session_id"`
+	Role        int       `json:"role"`
+	ClientIp    string    `json:"client_ip"`
+	ClientAgent string    `json:"client_agent"`
+	CompanyId   uuid.UUID `json:"company_id"`
+	//
+	TargetCompanyId uuid.UUID `json:"target_company_id"`
+	From            time.Time `json:"from"`
+	To              time.Time `json:"to"`
+}
+
+type HolidayInfo struct {
+	HolidayId   uuid.UUID `json:"holiday_id"`
+	HolidayDate string    `json:"holiday_date"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+}
+
+type GetListHolidayOutput struct {
+	Holidays []*HolidayInfo `json:"holidays"`
+}
+
+// For create leave request (employee)
+type CreateLeaveRequestInput struct {
+	// User info
+	UserId      uuid.UUID `json:"user_id"`
+	SessionId   uuid.UUID `json:"session_id"`
+	Role        int       `json:"role"`
+	ClientIp    string    `json:"client_ip"`
+	ClientAgent string    `json:"client_agent"`
+	CompanyId   uuid.UUID `json:"company_id"`
+	//
+	LeaveType int       `json:"leave_type"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	Reason    string    `json:"reason"`
+}
+
+type CreateLeaveRequestOutput struct {
+	RequestId uuid.UUID `json:"request_id"`
+	Status    int       `json:"status"`
+}
+
+// For get list leave request of current employee
+type GetListMyLeaveRequestInput struct {
+	// User info
+	UserId      uuid.UUID `json:"user_id"`
+	SessionId   uuid.UUID `json:"session_id"`
+	Role        int       `json:"role"`
+	ClientIp    string    `json:"client_ip"`
+	ClientAgent string    `json:"client_agent"`
+	CompanyId   uuid.UUID `json:"company_id"`
+	//
+	Page int `json:"page"`
+	Size int `json:"size"`
+}
+
+// For get list pending leave request of company (manager)
+type GetListPendingLeaveRequestInput struct {
+	// User info
+	UserId      uuid.UUID `json:"user_id"`
+	SessionId   uuid.UUID `json:"session_id"`
+	Role        int       `json:"role"`
+	ClientIp    string    `json:"client_ip"`
+	ClientAgent string    `json:"client_agent"`
+	CompanyId   uuid.UUID `json:"company_id"`
+	//
+	TargetCompanyId uuid.UUID `json:"target_company_id"`
+	Page            int       `json:"page"`
+	Size            int       `json:"size"`
+}
+
+type LeaveRequestInfo struct {
+	RequestId       uuid.UUID  `json:"request_id"`
+	EmployeeId      uuid.UUID  `json:"employee_id"`
+	LeaveType       int        `json:"leave_type"`
+	StartDate       string     `json:"start_date"`
+	EndDate         string     `json:"end_date"`
+	Reason          string     `json:"reason"`
+	Status          int        `json:"status"`
+	ApprovedBy      *uuid.UUID `json:"approved_by,omitempty"`
+	ApprovedAt      *time.Time `json:"approved_at,omitempty"`
+	RejectionReason string     `json:"rejection_reason,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+type GetListLeaveRequestOutput struct {
+	Page     int                 `json:"page"`
+	Size     int                 `json:"size"`
+	Requests []*LeaveRequestInfo `json:"requests"`
+}
+
+// For cancel leave request (employee)
+type CancelLeaveRequestInput struct {
+	// User info
+	UserId      uuid.UUID `json:"user_id"`
+	SessionId   uuid.UUID `json:"session_id"`
+	Role        int       `json:"role"`
+	ClientIp    string    `json:"client_ip"`
+	ClientAgent string    `json:"client_agent"`
+	CompanyId   uuid.UUID `json:"company_id"`
+	//
+	RequestId uuid.UUID `json:"request_id"`
+}
+
+// For approve leave request (manager)
+type ApproveLeaveRequestInput struct {
+	// User info
+	UserId      uuid.UUID `json:"user_id"`
+	SessionId   uuid.UUID `json:"session_id"`
+	Role        int       `json:"role"`
+	ClientIp    string    `json:"client_ip"`
+	ClientAgent string    `json:"client_agent"`
+	CompanyId   uuid.UUID `json:"company_id"`
+	//
+	TargetCompanyId uuid.UUID `json:"target_company_id"`
+	RequestId       uuid.UUID `json:"request_id"`
+}
+
+// For reject leave request (manager)
+type RejectLeaveRequestInput struct {
+	// User info
+	UserId      uuid.UUID `json:"user_id"`
+	SessionId   uuid.UUID `json:"session_id"`
+	Role        int       `json:"role"`
+	ClientIp    string    `json:"client_ip"`
+	ClientAgent string    `json:"client_agent"`
+	CompanyId   uuid.UUID `json:"company_id"`
+	//
+	TargetCompanyId uuid.UUID `json:"target_company_id"`
+	RequestId       uuid.UUID `json:"request_id"`
+	Reason          string    `json:"reason"`
+}
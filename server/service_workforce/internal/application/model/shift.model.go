@@ -61,16 +61,20 @@ type EditShiftInput struct {
 	ClientAgent string    `json:"client_agent"`
 	CompanyId   uuid.UUID `json:"company_id"`
 	//
-	ShiftId               uuid.UUID `json:"shift_id"`
-	CompanyIdReq          uuid.UUID `json:"company_id_req"`
-	Name                  string    `json:"name"`
-	Description           string    `json:"description"`
-	StartTime             time.Time `json:"start_time"`
-	EndTime               time.Time `json:"end_time"`
-	BreakDurationMinutes  int       `json:"break_duration_minutes"`
-	GracePeriodMinutes    int       `json:"grace_period_minutes"`
-	EarlyDepartureMinutes int       `json:"early_departure_minutes"`
-	WorkDays              []int     `json:"work_days"`
+	ShiftId               uuid.UUID  `json:"shift_id"`
+	CompanyIdReq          uuid.UUID  `json:"company_id_req"`
+	Name                  string     `json:"name"`
+	Description           string     `json:"description"`
+	StartTime             time.Time  `json:"start_time"`
+	EndTime               time.Time  `json:"end_time"`
+	BreakDurationMinutes  int        `json:"break_duration_minutes"`
+	GracePeriodMinutes    int        `json:"grace_period_minutes"`
+	EarlyDepartureMinutes int        `json:"early_departure_minutes"`
+	WorkDays              []int      `json:"work_days"`
+	IsFlexible            bool       `json:"is_flexible"`
+	CoreStartTime         *time.Time `json:"core_start_time,omitempty"`
+	CoreEndTime           *time.Time `json:"core_end_time,omitempty"`
+	RequiredWorkMinutes   int        `json:"required_work_minutes"`
 }
 
 // For CreateShift
@@ -83,15 +87,19 @@ type CreateShiftInput struct {
 	ClientAgent string    `json:"client_agent"`
 	CompanyId   uuid.UUID `json:"company_id"`
 	//
-	CompanyIdReq          uuid.UUID `json:"company_id_req"`
-	Name                  string    `json:"name"`
-	Description           string    `json:"description"`
-	StartTime             time.Time `json:"start_time"`
-	EndTime               time.Time `json:"end_time"`
-	BreakDurationMinutes  int       `json:"break_duration_minutes"`
-	GracePeriodMinutes    int       `json:"grace_period_minutes"`
-	EarlyDepartureMinutes int       `json:"early_departure_minutes"`
-	WorkDays              []int     `json:"work_days"`
+	CompanyIdReq          uuid.UUID  `json:"company_id_req"`
+	Name                  string     `json:"name"`
+	Description           string     `json:"description"`
+	StartTime             time.Time  `json:"start_time"`
+	EndTime               time.Time  `json:"end_time"`
+	BreakDurationMinutes  int        `json:"break_duration_minutes"`
+	GracePeriodMinutes    int        `json:"grace_period_minutes"`
+	EarlyDepartureMinutes int        `json:"early_departure_minutes"`
+	WorkDays              []int      `json:"work_days"`
+	IsFlexible            bool       `json:"is_flexible"`
+	CoreStartTime         *time.Time `json:"core_start_time,omitempty"`
+	CoreEndTime           *time.Time `json:"core_end_time,omitempty"`
+	RequiredWorkMinutes   int        `json:"required_work_minutes"`
 }
 
 type CreateShiftOutput struct {
@@ -112,16 +120,20 @@ type GetDetailShiftInput struct {
 }
 
 type GetDetailShiftOutput struct {
-	ShiftId               string    `json:"shift_id"`
-	CompanyId             string    `json:"company_id"`
-	Name                  string    `json:"name"`
-	Description           string    `json:"description"`
-	StartTime             time.Time `json:"start_time"`
-	EndTime               time.Time `json:"end_time"`
-	BreakDurationMinutes  int       `json:"break_duration_minutes"`
-	GracePeriodMinutes    int       `json:"grace_period_minutes"`
-	EarlyDepartureMinutes int       `json:"early_departure_minutes"`
-	WorkDays              []int     `json:"work_days"`
-	IsActive              bool      `json:"is_active"`
+	ShiftId               string     `json:"shift_id"`
+	CompanyId             string     `json:"company_id"`
+	Name                  string     `json:"name"`
+	Description           string     `json:"description"`
+	StartTime             time.Time  `json:"start_time"`
+	EndTime               time.Time  `json:"end_time"`
+	BreakDurationMinutes  int        `json:"break_duration_minutes"`
+	GracePeriodMinutes    int        `json:"grace_period_minutes"`
+	EarlyDepartureMinutes int        `json:"early_departure_minutes"`
+	WorkDays              []int      `json:"work_days"`
+	IsFlexible            bool       `json:"is_flexible"`
+	CoreStartTime         *time.Time `json:"core_start_time,omitempty"`
+	CoreEndTime           *time.Time `json:"core_end_time,omitempty"`
+	RequiredWorkMinutes   int        `json:"required_work_minutes"`
+	IsActive              bool       `json:"is_active"`
 	EmployeeCount         int64      `json:"employee_count"`
 }
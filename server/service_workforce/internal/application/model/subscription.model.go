@@ -0,0 +1,16 @@
+package model
+
+// =================================================
+// Company subscription model
+// =================================================
+
+// Company subscription (cache value)
+type CompanySubscription struct {
+	CompanyId    string `json:"company_id"`
+	Status       int    `json:"status"`
+	Plan         int    `json:"plan"`
+	StartDate    string `json:"start_date,omitempty"` // yyyy-mm-dd
+	EndDate      string `json:"end_date,omitempty"`   // yyyy-mm-dd
+	MaxDevices   int64  `json:"max_devices"`
+	MaxEmployees int64  `json:"max_employees"`
+}
@@ -0,0 +1,619 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	applicationError "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/application/error"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/application/model"
+	service "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/application/service"
+	"github.com/youknow2509/cio_verify_face/server/service_workforce/internal/constants"
+	"github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/attendance"
+	"github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/cache"
+	"github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/logger"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/model"
+	"github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/repository"
+	"github.com/youknow2509/cio_verify_face/server/service_workforce/internal/global"
+	utilsCache "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/shared/utils/cache"
+)
+
+const (
+	// Khoảng ngày tối đa của một job tính lại bên service_attendance
+	recomputeMaxDays = 93
+	// Số lần gọi tính lại tối đa, lỗi tạm thời được thử lại với backoff tăng dần
+	recomputeMaxAttempts    = 5
+	recomputeRetryBaseDelay = 2 * time.Second
+	// Thời gian chờ mỗi lần gọi, service_attendance tính xong mới trả về
+	recomputeAttemptTimeout = 2 * time.Minute
+)
+
+// =================================================
+// Leave service implementation interface
+// =================================================
+type LeaveService struct {
+	leaveRepo        repository.ILeaveRepository
+	userRepo         repository.IUserRepository
+	logger           logger.ILogger
+	distributedCache cache.IDistributedCache
+	// nil nếu service_attendance bị tắt
+	attendanceService attendance.IAttendanceService
+}
+
+// CreateHoliday implements service.ILeaveService.
+func (s *LeaveService) CreateHoliday(ctx context.Context, input *applicationModel.CreateHolidayInput) (*applicationModel.CreateHolidayOutput, *applicationError.Error) {
+	if input == nil || input.Name == "" || input.HolidayDate.IsZero() {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Invalid input data",
+		}
+	}
+	companyId, errPerm := s.resolveCompany(input.Role, input.CompanyId, input.TargetCompanyId)
+	if errPerm != nil {
+		s.logger.Error("CreateHoliday - User does not have permission", "user_id", input.UserId, "company_user_id", input.CompanyId, "company_id", input.TargetCompanyId)
+		return nil, errPerm
+	}
+	holidayId, err := s.leaveRepo.CreateCompanyHoliday(ctx, &domainModel.CreateCompanyHolidayInput{
+		CompanyID:   companyId,
+		HolidayDate: toDateOnly(input.HolidayDate),
+		Name:        input.Name,
+		Description: input.Description,
+		CreatedBy:   input.UserId,
+	})
+	if err != nil {
+		s.logger.Error("CreateHoliday - Failed to create company holiday", "error", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "Failed to create holiday",
+		}
+	}
+	s.logger.Info("CreateHoliday - Success", "company_id", companyId, "holiday_id", holidayId)
+	// Các bản tổng hợp vắng mặt đã ghi của ngày lễ được tính lại thành nghỉ lễ
+	s.recomputeDailySummaries(ctx, &domainModel.RecomputeDailySummariesInput{
+		UserId:      input.UserId,
+		SessionId:   input.SessionId,
+		Role:        input.Role,
+		UserCompany: input.CompanyId,
+		ClientIp:    input.ClientIp,
+		ClientAgent: input.ClientAgent,
+		CompanyID:   companyId,
+		FromDate:    toDateOnly(input.HolidayDate),
+		ToDate:      toDateOnly(input.HolidayDate),
+	})
+	return &applicationModel.CreateHolidayOutput{HolidayId: holidayId}, nil
+}
+
+// DeleteHoliday implements service.ILeaveService.
+func (s *LeaveService) DeleteHoliday(ctx context.Context, input *applicationModel.DeleteHolidayInput) *applicationError.Error {
+	if input == nil {
+		return &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Invalid input data",
+		}
+	}
+	companyId, errPerm := s.resolveCompany(input.Role, input.CompanyId, input.TargetCompanyId)
+	if errPerm != nil {
+		s.logger.Error("DeleteHoliday - User does not have permission", "user_id", input.UserId, "company_user_id", input.CompanyId, "company_id", input.TargetCompanyId)
+		return errPerm
+	}
+	deleted, err := s.leaveRepo.DeleteCompanyHoliday(ctx, &domainModel.DeleteCompanyHolidayInput{
+		HolidayID: input.HolidayId,
+		CompanyID: companyId,
+	})
+	if err != nil {
+		s.logger.Error("DeleteHoliday - Failed to delete company holiday", "error", err)
+		return &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "Failed to delete holiday",
+		}
+	}
+	if !deleted {
+		return &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Holiday not found",
+		}
+	}
+	return nil
+}
+
+// GetListHoliday implements service.ILeaveService.
+func (s *LeaveService) GetListHoliday(ctx context.Context, input *applicationModel.GetListHolidayInput) (*applicationModel.GetListHolidayOutput, *applicationError.Error) {
+	if input == nil || input.To.Before(input.From) {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Invalid input data",
+		}
+	}
+	companyId, errPerm := s.resolveCompany(input.Role, input.CompanyId, input.TargetCompanyId)
+	if errPerm != nil {
+		s.logger.Error("GetListHoliday - User does not have permission", "user_id", input.UserId, "company_user_id", input.CompanyId, "company_id", input.TargetCompanyId)
+		return nil, errPerm
+	}
+	holidays, err := s.leaveRepo.ListCompanyHolidays(ctx, &domainModel.ListCompanyHolidaysInput{
+		CompanyID: companyId,
+		From:      toDateOnly(input.From),
+		To:        toDateOnly(input.To),
+	})
+	if err != nil {
+		s.logger.Error("GetListHoliday - Failed to get company holidays", "error", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "Failed to get holidays",
+		}
+	}
+	output := &applicationModel.GetListHolidayOutput{
+		Holidays: make([]*applicationModel.HolidayInfo, 0, len(holidays)),
+	}
+	for _, h := range holidays {
+		output.Holidays = append(output.Holidays, &applicationModel.HolidayInfo{
+			HolidayId:   h.HolidayID,
+			HolidayDate: h.HolidayDate.Format("2006-01-02"),
+			Name:        h.Name,
+			Description: h.Description,
+		})
+	}
+	return output, nil
+}
+
+// CreateLeaveRequest implements service.ILeaveService.
+func (s *LeaveService) CreateLeaveRequest(ctx context.Context, input *applicationModel.CreateLeaveRequestInput) (*applicationModel.CreateLeaveRequestOutput, *applicationError.Error) {
+	if input == nil || input.CompanyId == uuid.Nil {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Invalid input data",
+		}
+	}
+	startDate := toDateOnly(input.StartDate)
+	endDate := toDateOnly(input.EndDate)
+	if endDate.Before(startDate) {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "End date must be after or equal to start date",
+		}
+	}
+	if int(endDate.Sub(startDate).Hours()/24)+1 > constants.MAX_LEAVE_DAYS_PER_REQUEST {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: fmt.Sprintf("Leave request cannot exceed %d days", constants.MAX_LEAVE_DAYS_PER_REQUEST),
+		}
+	}
+	if input.LeaveType < domainModel.LeaveTypeAnnual || input.LeaveType > domainModel.LeaveTypeOther {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Invalid leave type",
+		}
+	}
+	// Check employee belongs to company
+	exists, err := s.userRepo.UserExistsInCompany(ctx, &domainModel.UserExistsInCompanyInput{
+		CompanyID: input.CompanyId,
+		UserID:    input.UserId,
+	})
+	if err != nil {
+		s.logger.Error("CreateLeaveRequest - Failed to check employee in company", "error", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "Failed to create leave request",
+		}
+	}
+	if !exists {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "You are not an employee of this company",
+		}
+	}
+	// Reject overlapping pending/approved requests
+	overlapping, err := s.leaveRepo.CountOverlappingLeaveRequests(ctx, &domainModel.CountOverlappingLeaveRequestsInput{
+		EmployeeID: input.UserId,
+		StartDate:  startDate,
+		EndDate:    endDate,
+	})
+	if err != nil {
+		s.logger.Error("CreateLeaveRequest - Failed to check overlapping leave requests", "error", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "Failed to create leave request",
+		}
+	}
+	if overlapping > 0 {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Leave request overlaps with an existing request",
+		}
+	}
+	requestId, err := s.leaveRepo.CreateLeaveRequest(ctx, &domainModel.CreateLeaveRequestInput{
+		CompanyID:  input.CompanyId,
+		EmployeeID: input.UserId,
+		LeaveType:  input.LeaveType,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		Reason:     input.Reason,
+	})
+	if err != nil {
+		s.logger.Error("CreateLeaveRequest - Failed to create leave request", "error", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "Failed to create leave request",
+		}
+	}
+	s.logger.Info("CreateLeaveRequest - Success", "user_id", input.UserId, "request_id", requestId)
+	return &applicationModel.CreateLeaveRequestOutput{
+		RequestId: requestId,
+		Status:    domainModel.LeaveStatusPending,
+	}, nil
+}
+
+// GetListMyLeaveRequest implements service.ILeaveService.
+func (s *LeaveService) GetListMyLeaveRequest(ctx context.Context, input *applicationModel.GetListMyLeaveRequestInput) (*applicationModel.GetListLeaveRequestOutput, *applicationError.Error) {
+	if input == nil {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Invalid input data",
+		}
+	}
+	page, size := normalizePaging(input.Page, input.Size)
+	requests, err := s.leaveRepo.ListLeaveRequestsByEmployee(ctx, &domainModel.ListLeaveRequestsByEmployeeInput{
+		EmployeeID: input.UserId,
+		Limit:      int32(size),
+		Offset:     int32((page - 1) * size),
+	})
+	if err != nil {
+		s.logger.Error("GetListMyLeaveRequest - Failed to get leave requests", "error", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "Failed to get leave requests",
+		}
+	}
+	return toLeaveRequestListOutput(requests, page, size), nil
+}
+
+// CancelLeaveRequest implements service.ILeaveService.
+func (s *LeaveService) CancelLeaveRequest(ctx context.Context, input *applicationModel.CancelLeaveRequestInput) *applicationError.Error {
+	if input == nil {
+		return &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Invalid input data",
+		}
+	}
+	cancelled, err := s.leaveRepo.CancelLeaveRequest(ctx, &domainModel.CancelLeaveRequestInput{
+		RequestID:  input.RequestId,
+		EmployeeID: input.UserId,
+	})
+	if err != nil {
+		s.logger.Error("CancelLeaveRequest - Failed to cancel leave request", "error", err)
+		return &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "Failed to cancel leave request",
+		}
+	}
+	if !cancelled {
+		return &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Leave request not found or can no longer be cancelled",
+		}
+	}
+	return nil
+}
+
+// GetListPendingLeaveRequest implements service.ILeaveService.
+func (s *LeaveService) GetListPendingLeaveRequest(ctx context.Context, input *applicationModel.GetListPendingLeaveRequestInput) (*applicationModel.GetListLeaveRequestOutput, *applicationError.Error) {
+	if input == nil {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Invalid input data",
+		}
+	}
+	companyId, errPerm := s.resolveCompany(input.Role, input.CompanyId, input.TargetCompanyId)
+	if errPerm != nil {
+		s.logger.Error("GetListPendingLeaveRequest - User does not have permission", "user_id", input.UserId, "company_user_id", input.CompanyId, "company_id", input.TargetCompanyId)
+		return nil, errPerm
+	}
+	page, size := normalizePaging(input.Page, input.Size)
+	requests, err := s.leaveRepo.ListPendingLeaveRequests(ctx, &domainModel.ListPendingLeaveRequestsInput{
+		CompanyID: companyId,
+		Limit:     int32(size),
+		Offset:    int32((page - 1) * size),
+	})
+	if err != nil {
+		s.logger.Error("GetListPendingLeaveRequest - Failed to get pending leave requests", "error", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "Failed to get leave requests",
+		}
+	}
+	return toLeaveRequestListOutput(requests, page, size), nil
+}
+
+// ApproveLeaveRequest implements service.ILeaveService.
+func (s *LeaveService) ApproveLeaveRequest(ctx context.Context, input *applicationModel.ApproveLeaveRequestInput) *applicationError.Error {
+	if input == nil {
+		return &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Invalid input data",
+		}
+	}
+	companyId, errPerm := s.resolveCompany(input.Role, input.CompanyId, input.TargetCompanyId)
+	if errPerm != nil {
+		s.logger.Error("ApproveLeaveRequest - User does not have permission", "user_id", input.UserId, "company_user_id", input.CompanyId, "company_id", input.TargetCompanyId)
+		return errPerm
+	}
+	// Check for duplicate approval using distributed lock
+	if !s.acquireApprovalLock(ctx, input.RequestId) {
+		return &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Leave request is being processed",
+		}
+	}
+	defer s.releaseApprovalLock(ctx, input.RequestId)
+
+	request, errCheck := s.checkPendingRequest(ctx, input.RequestId, companyId)
+	if errCheck != nil {
+		return errCheck
+	}
+	updated, err := s.leaveRepo.ApproveLeaveRequest(ctx, &domainModel.ApproveLeaveRequestInput{
+		RequestID:  input.RequestId,
+		CompanyID:  companyId,
+		ApprovedBy: input.UserId,
+	})
+	if err != nil {
+		s.logger.Error("ApproveLeaveRequest - Failed to approve leave request", "error", err)
+		return &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "Failed to approve leave request",
+		}
+	}
+	if !updated {
+		return &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Leave request already processed",
+		}
+	}
+	s.logger.Info("ApproveLeaveRequest - Success", "request_id", input.RequestId, "approved_by", input.UserId)
+	// Các bản tổng hợp vắng mặt đã ghi trong khoảng nghỉ được tính lại thành nghỉ phép
+	s.recomputeDailySummaries(ctx, &domainModel.RecomputeDailySummariesInput{
+		UserId:      input.UserId,
+		SessionId:   input.SessionId,
+		Role:        input.Role,
+		UserCompany: input.CompanyId,
+		ClientIp:    input.ClientIp,
+		ClientAgent: input.ClientAgent,
+		CompanyID:   companyId,
+		FromDate:    request.StartDate,
+		ToDate:      request.EndDate,
+		EmployeeIDs: []uuid.UUID{request.EmployeeID},
+	})
+	return nil
+}
+
+// RejectLeaveRequest implements service.ILeaveService.
+func (s *LeaveService) RejectLeaveRequest(ctx context.Context, input *applicationModel.RejectLeaveRequestInput) *applicationError.Error {
+	if input == nil {
+		return &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Invalid input data",
+		}
+	}
+	companyId, errPerm := s.resolveCompany(input.Role, input.CompanyId, input.TargetCompanyId)
+	if errPerm != nil {
+		s.logger.Error("RejectLeaveRequest - User does not have permission", "user_id", input.UserId, "company_user_id", input.CompanyId, "company_id", input.TargetCompanyId)
+		return errPerm
+	}
+	if !s.acquireApprovalLock(ctx, input.RequestId) {
+		return &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Leave request is being processed",
+		}
+	}
+	defer s.releaseApprovalLock(ctx, input.RequestId)
+
+	if _, errCheck := s.checkPendingRequest(ctx, input.RequestId, companyId); errCheck != nil {
+		return errCheck
+	}
+	updated, err := s.leaveRepo.RejectLeaveRequest(ctx, &domainModel.RejectLeaveRequestInput{
+		RequestID:       input.RequestId,
+		CompanyID:       companyId,
+		ApprovedBy:      input.UserId,
+		RejectionReason: input.Reason,
+	})
+	if err != nil {
+		s.logger.Error("RejectLeaveRequest - Failed to reject leave request", "error", err)
+		return &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "Failed to reject leave request",
+		}
+	}
+	if !updated {
+		return &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Leave request already processed",
+		}
+	}
+	s.logger.Info("RejectLeaveRequest - Success", "request_id", input.RequestId, "rejected_by", input.UserId)
+	return nil
+}
+
+// checkPendingRequest kiểm tra đơn tồn tại trong công ty và đang chờ duyệt, trả về đơn nghỉ
+func (s *LeaveService) checkPendingRequest(ctx context.Context, requestId uuid.UUID, companyId uuid.UUID) (*domainModel.LeaveRequest, *applicationError.Error) {
+	request, err := s.leaveRepo.GetLeaveRequest(ctx, &domainModel.GetLeaveRequestInput{
+		RequestID: requestId,
+		CompanyID: companyId,
+	})
+	if err != nil {
+		s.logger.Error("checkPendingRequest - Failed to get leave request", "error", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "Failed to get leave request",
+		}
+	}
+	if request == nil {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Leave request not found",
+		}
+	}
+	if request.Status != domainModel.LeaveStatusPending {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: fmt.Sprintf("Leave request already processed, status: %d", request.Status),
+		}
+	}
+	return request, nil
+}
+
+// resolveCompany trả về công ty cần thao tác, chỉ admin hệ thống được thao tác công ty khác
+func (s *LeaveService) resolveCompany(role int, companyId uuid.UUID, targetCompanyId uuid.UUID) (uuid.UUID, *applicationError.Error) {
+	if targetCompanyId == uuid.Nil {
+		targetCompanyId = companyId
+	}
+	if targetCompanyId == uuid.Nil || (targetCompanyId != companyId && role != domainModel.RoleAdmin) {
+		return uuid.Nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "You do not have permission to access this company",
+		}
+	}
+	return targetCompanyId, nil
+}
+
+func (s *LeaveService) acquireApprovalLock(ctx context.Context, requestId uuid.UUID) bool {
+	key := utilsCache.GetKeyLeaveApprovalLock(requestId.String())
+	// Use Lua script for atomic check-and-set
+	script := `
+		if redis.call("EXISTS", KEYS[1]) == 0 then
+			redis.call("SET", KEYS[1], "1", "EX", ARGV[1])
+			return 1
+		end
+		return 0
+	`
+	result, err := s.distributedCache.LuaScript(ctx, script, []string{key}, constants.TTL_Leave_Approval_Lock)
+	if err != nil {
+		// Không lấy được khóa thì từ chối, tránh hai người duyệt cùng một đơn
+		s.logger.Warn("acquireApprovalLock - Failed to run lua script", "error", err)
+		return false
+	}
+	val, ok := result.(int64)
+	return ok && val == 1
+}
+
+// recomputeDailySummaries yêu cầu service_attendance tính lại ngay bản tổng hợp trong khoảng ngày nghỉ.
+// Tính ngay không phụ thuộc khóa job của công ty nên không bị bỏ qua khi quản lý đang chạy job tính lại.
+// Ngày nghỉ đã được lưu nên chạy nền và thử lại khi lỗi tạm thời, không làm chậm request duyệt.
+func (s *LeaveService) recomputeDailySummaries(ctx context.Context, input *domainModel.RecomputeDailySummariesInput) {
+	if s.attendanceService == nil {
+		return
+	}
+	// Chỉ các ngày đã kết thúc mới có bản tổng hợp, ngày hôm nay và tương lai do luồng chấm công xử lý
+	now := time.Now()
+	yesterday := time.Date(now.Year(), now.Month(), now.Day()-1, 0, 0, 0, 0, time.UTC)
+	fromDate, toDate := toDateOnly(input.FromDate), toDateOnly(input.ToDate)
+	if toDate.After(yesterday) {
+		toDate = yesterday
+	}
+	if toDate.Before(fromDate) {
+		return
+	}
+	if maxFrom := toDate.AddDate(0, 0, -(recomputeMaxDays - 1)); fromDate.Before(maxFrom) {
+		fromDate = maxFrom
+	}
+	input.FromDate, input.ToDate = fromDate, toDate
+	input.Immediate = true
+	ctx = context.WithoutCancel(ctx)
+	global.WaitGroup.Add(1)
+	go func() {
+		defer global.WaitGroup.Done()
+		delay := recomputeRetryBaseDelay
+		for attempt := 1; ; attempt++ {
+			attemptCtx, cancel := context.WithTimeout(ctx, recomputeAttemptTimeout)
+			output, err := s.attendanceService.RecomputeDailySummaries(attemptCtx, input)
+			cancel()
+			if err == nil {
+				s.logger.Info("recomputeDailySummaries - Recompute completed", "company_id", input.CompanyID, "job_id", output.JobID, "from_date", fromDate, "to_date", toDate)
+				return
+			}
+			if errors.Is(err, attendance.ErrRecomputeRejected) || attempt >= recomputeMaxAttempts {
+				s.logger.Error("recomputeDailySummaries - Failed to recompute", "company_id", input.CompanyID, "from_date", fromDate, "to_date", toDate, "attempt", attempt, "error", err)
+				return
+			}
+			s.logger.Warn("recomputeDailySummaries - Recompute failed, retrying", "company_id", input.CompanyID, "attempt", attempt, "retry_in", delay, "error", err)
+			time.Sleep(delay)
+			delay *= 2
+		}
+	}()
+}
+
+func (s *LeaveService) releaseApprovalLock(ctx context.Context, requestId uuid.UUID) {
+	if err := s.distributedCache.Delete(ctx, utilsCache.GetKeyLeaveApprovalLock(requestId.String())); err != nil {
+		s.logger.Warn("releaseApprovalLock - Failed to delete lock", "error", err)
+	}
+}
+
+func normalizePaging(page int, size int) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 || size > constants.DEFAULT_PAGE_SIZE*5 {
+		size = constants.DEFAULT_PAGE_SIZE
+	}
+	return page, size
+}
+
+func toLeaveRequestListOutput(requests []*domainModel.LeaveRequest, page int, size int) *applicationModel.GetListLeaveRequestOutput {
+	output := &applicationModel.GetListLeaveRequestOutput{
+		Page:     page,
+		Size:     size,
+		Requests: make([]*applicationModel.LeaveRequestInfo, 0, len(requests)),
+	}
+	for _, r := range requests {
+		output.Requests = append(output.Requests, &applicationModel.LeaveRequestInfo{
+			RequestId:       r.RequestID,
+			EmployeeId:      r.EmployeeID,
+			LeaveType:       r.LeaveType,
+			StartDate:       r.StartDate.Format("2006-01-02"),
+			EndDate:         r.EndDate.Format("2006-01-02"),
+			Reason:          r.Reason,
+			Status:          r.Status,
+			ApprovedBy:      r.ApprovedBy,
+			ApprovedAt:      r.ApprovedAt,
+			RejectionReason: r.RejectionReason,
+			CreatedAt:       r.CreatedAt,
+		})
+	}
+	return output
+}
+
+// toDateOnly bỏ phần giờ, giữ nguyên ngày theo múi giờ của giá trị đầu vào
+func toDateOnly(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// NewLeaveService create new instance and implement ILeaveService
+func NewLeaveService() service.ILeaveService {
+	leaveRepo, err := repository.GetLeaveRepository()
+	if err != nil {
+		panic(fmt.Sprintf("Failed to get leave repository: %v", err))
+	}
+
+	userRepo, err := repository.GetUserRepository()
+	if err != nil {
+		panic(fmt.Sprintf("Failed to get user repository: %v", err))
+	}
+
+	log := logger.GetLogger()
+	if log == nil {
+		panic("Failed to get logger instance")
+	}
+
+	distributedCache, err := cache.GetDistributedCache()
+	if err != nil {
+		panic(fmt.Sprintf("Failed to get distributed cache: %v", err))
+	}
+
+	return &LeaveService{
+		leaveRepo:         leaveRepo,
+		userRepo:          userRepo,
+		logger:            log,
+		distributedCache:  distributedCache,
+		attendanceService: attendance.GetAttendanceService(),
+	}
+}
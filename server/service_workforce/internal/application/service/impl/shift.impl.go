@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	applicationError "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/application/error"
@@ -207,6 +208,10 @@ func (s *ShiftService) GetListShift(ctx context.Context, input *applicationModel
 			GracePeriodMinutes:    int(shift.GracePeriodMinutes),
 			EarlyDepartureMinutes: int(shift.EarlyDepartureMinutes),
 			WorkDays:              workDays,
+			IsFlexible:            shift.IsFlexible,
+			CoreStartTime:         shift.CoreStartTime,
+			CoreEndTime:           shift.CoreEndTime,
+			RequiredWorkMinutes:   int(shift.RequiredWorkMinutes),
 			IsActive:              shift.IsActive,
 			EmployeeCount:         listEmployeeCount[idx],
 		})
@@ -246,6 +251,12 @@ func (s *ShiftService) CreateShift(ctx context.Context, input *applicationModel.
 		companyId = input.CompanyIdReq
 	}
 	s.logger.Info("CreateShift - Start", "user_id", input.UserId, "company_id", companyId)
+	if errMsg := validateFlexibleShift(input.IsFlexible, input.StartTime, input.EndTime, input.CoreStartTime, input.CoreEndTime, input.RequiredWorkMinutes); errMsg != "" {
+		s.logger.Warn("CreateShift - Invalid flexible shift settings", "error", errMsg)
+		return nil, &applicationError.Error{
+			ErrorClient: errMsg,
+		}
+	}
 
 	// Convert work days from []int to []int32
 	workDays := make([]int32, len(input.WorkDays))
@@ -264,6 +275,10 @@ func (s *ShiftService) CreateShift(ctx context.Context, input *applicationModel.
 		GracePeriodMinutes:    int32(input.GracePeriodMinutes),
 		EarlyDepartureMinutes: int32(input.EarlyDepartureMinutes),
 		WorkDays:              workDays,
+		IsFlexible:            input.IsFlexible,
+		CoreStartTime:         input.CoreStartTime,
+		CoreEndTime:           input.CoreEndTime,
+		RequiredWorkMinutes:   int32(input.RequiredWorkMinutes),
 	}
 
 	// Call repository
@@ -357,6 +372,12 @@ func (s *ShiftService) EditShift(ctx context.Context, input *applicationModel.Ed
 	}
 
 	s.logger.Info("EditShift - Start", "user_id", input.UserId, "shift_id", input.ShiftId)
+	if errMsg := validateFlexibleShift(input.IsFlexible, input.StartTime, input.EndTime, input.CoreStartTime, input.CoreEndTime, input.RequiredWorkMinutes); errMsg != "" {
+		s.logger.Warn("EditShift - Invalid flexible shift settings", "error", errMsg)
+		return &applicationError.Error{
+			ErrorClient: errMsg,
+		}
+	}
 
 	// Convert work days from []int to []int32
 	workDays := make([]int32, len(input.WorkDays))
@@ -373,6 +394,10 @@ func (s *ShiftService) EditShift(ctx context.Context, input *applicationModel.Ed
 		GracePeriodMinutes:    int32(input.GracePeriodMinutes),
 		EarlyDepartureMinutes: int32(input.EarlyDepartureMinutes),
 		WorkDays:              workDays,
+		IsFlexible:            input.IsFlexible,
+		CoreStartTime:         input.CoreStartTime,
+		CoreEndTime:           input.CoreEndTime,
+		RequiredWorkMinutes:   int32(input.RequiredWorkMinutes),
 	}
 
 	// Call repository
@@ -484,6 +509,10 @@ func (s *ShiftService) GetDetailShift(ctx context.Context, input *applicationMod
 		GracePeriodMinutes:    int(shift.GracePeriodMinutes),
 		EarlyDepartureMinutes: int(shift.EarlyDepartureMinutes),
 		WorkDays:              workDays,
+		IsFlexible:            shift.IsFlexible,
+		CoreStartTime:         shift.CoreStartTime,
+		CoreEndTime:           shift.CoreEndTime,
+		RequiredWorkMinutes:   int(shift.RequiredWorkMinutes),
 		IsActive:              shift.IsActive,
 	}
 
@@ -504,6 +533,54 @@ func (s *ShiftService) GetDetailShift(ctx context.Context, input *applicationMod
 	return output, nil
 }
 
+// validateFlexibleShift kiểm tra cấu hình ca linh hoạt, trả về thông báo lỗi cho client (rỗng nếu hợp lệ)
+func validateFlexibleShift(isFlexible bool, startTime, endTime time.Time, coreStart, coreEnd *time.Time, requiredWorkMinutes int) string {
+	if !isFlexible {
+		return ""
+	}
+	if requiredWorkMinutes <= 0 {
+		return "Flexible shift requires required_work_minutes"
+	}
+	windowMinutes := minutesBetween(startTime, endTime)
+	if windowMinutes == 0 {
+		windowMinutes = 24 * 60
+	}
+	if requiredWorkMinutes > windowMinutes {
+		return "Required work minutes exceed shift window"
+	}
+	if (coreStart == nil) != (coreEnd == nil) {
+		return "Core start time and core end time must be set together"
+	}
+	if coreStart == nil {
+		return ""
+	}
+	// Giờ lõi được dựng theo ngày bắt đầu ca giống service_attendance (buildCoreBounds):
+	// giờ lõi có thể qua nửa đêm nhưng phải nằm trong khung giờ của ca
+	coreMinutes := minutesBetween(*coreStart, *coreEnd)
+	if coreMinutes == 0 {
+		return "Core start time must be before core end time"
+	}
+	if minutesBetween(startTime, *coreStart)+coreMinutes > windowMinutes {
+		return "Core hours must be within shift window"
+	}
+	return ""
+}
+
+// minutesBetween trả về số phút từ mốc from đến mốc to trong ngày, to trước from được tính sang ngày hôm sau
+func minutesBetween(from, to time.Time) int {
+	minutes := (secondsOfDay(to) - secondsOfDay(from)) / 60
+	if minutes < 0 {
+		minutes += 24 * 60
+	}
+	return minutes
+}
+
+// secondsOfDay trả về số giây tính từ đầu ngày của mốc thời gian
+func secondsOfDay(t time.Time) int {
+	h, m, sec := t.Clock()
+	return h*3600 + m*60 + sec
+}
+
 // New instance
 func NewShiftService() service.IShiftService {
 	shiftRepo, err := repository.GetShiftRepository()
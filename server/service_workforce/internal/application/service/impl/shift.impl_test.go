@@ -0,0 +1,47 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func clock(hour, minute int) time.Time {
+	return time.Date(0, 1, 1, hour, minute, 0, 0, time.UTC)
+}
+
+func clockPtr(hour, minute int) *time.Time {
+	t := clock(hour, minute)
+	return &t
+}
+
+// Test kiểm tra cấu hình ca linh hoạt, gồm ca và giờ lõi qua nửa đêm
+func TestValidateFlexibleShift(t *testing.T) {
+	cases := []struct {
+		name         string
+		start, end   time.Time
+		coreStart    *time.Time
+		coreEnd      *time.Time
+		requiredMins int
+		wantErr      bool
+	}{
+		{"day shift with core hours", clock(7, 0), clock(19, 0), clockPtr(10, 0), clockPtr(15, 0), 480, false},
+		{"day shift without core hours", clock(7, 0), clock(19, 0), nil, nil, 480, false},
+		{"overnight shift with core hours before midnight", clock(20, 0), clock(8, 0), clockPtr(21, 0), clockPtr(23, 30), 480, false},
+		{"overnight shift with core hours across midnight", clock(20, 0), clock(8, 0), clockPtr(23, 0), clockPtr(3, 0), 480, false},
+		{"overnight shift with core hours after midnight", clock(20, 0), clock(8, 0), clockPtr(1, 0), clockPtr(5, 0), 480, false},
+		{"required minutes exceed window", clock(8, 0), clock(17, 0), nil, nil, 600, true},
+		{"missing required minutes", clock(8, 0), clock(17, 0), nil, nil, 0, true},
+		{"only core start set", clock(8, 0), clock(17, 0), clockPtr(10, 0), nil, 480, true},
+		{"empty core window", clock(8, 0), clock(17, 0), clockPtr(10, 0), clockPtr(10, 0), 480, true},
+		{"core hours outside day shift", clock(8, 0), clock(17, 0), clockPtr(16, 0), clockPtr(18, 0), 480, true},
+		{"core hours outside overnight shift", clock(20, 0), clock(8, 0), clockPtr(6, 0), clockPtr(9, 0), 480, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			errMsg := validateFlexibleShift(true, tc.start, tc.end, tc.coreStart, tc.coreEnd, tc.requiredMins)
+			if (errMsg != "") != tc.wantErr {
+				t.Fatalf("validateFlexibleShift() = %q, wantErr %v", errMsg, tc.wantErr)
+			}
+		})
+	}
+}
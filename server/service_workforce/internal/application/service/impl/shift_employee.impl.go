@@ -29,6 +29,7 @@ type ShiftEmployeeService struct {
 	logger           logger.ILogger
 	distributedCache cache.IDistributedCache
 	localCache       cache.ILocalCache
+	subscription     service.ISubscriptionService
 }
 
 // GetListEmployeeDonotInShift implements service.IShiftEmployeeService.
@@ -449,6 +450,12 @@ func (s *ShiftEmployeeService) AddListShiftEmployee(ctx context.Context, input *
 		}
 	}
 	s.logger.Info("AddListShiftEmployee - Start", "user_id", input.UserId, "number_of_employees", len(input.EmployeeIDs))
+	// Check company employee quota, giữ khóa quota đến khi phân ca xong
+	releaseQuota, errQuota := s.subscription.CheckEmployeeQuota(ctx, companyId, input.EmployeeIDs)
+	if errQuota != nil {
+		return errQuota
+	}
+	defer releaseQuota()
 	// Check user exist shift in time range
 	for _, empId := range input.EmployeeIDs {
 		checkInput := &domainModel.CheckUserExistShiftInput{
@@ -532,6 +539,13 @@ func (s *ShiftEmployeeService) AddShiftEmployee(ctx context.Context, input *appl
 
 	s.logger.Info("AddShiftEmployee - Start", "user_id", input.UserId, "employee_id", input.EmployeeId, "shift_id", input.ShiftId)
 
+	// Check company employee quota, giữ khóa quota đến khi phân ca xong
+	releaseQuota, errQuota := s.checkEmployeeQuotaForShift(ctx, input.ShiftId, []uuid.UUID{input.EmployeeId})
+	if errQuota != nil {
+		return errQuota
+	}
+	defer releaseQuota()
+
 	// Check if user already has a shift in the time range
 	checkInput := &domainModel.CheckUserExistShiftInput{
 		EmployeeID:    input.EmployeeId,
@@ -851,6 +865,12 @@ func (s *ShiftEmployeeService) EnableShiftUser(ctx context.Context, input *appli
 			ErrorClient: "You do not have permission to delete this shift assignment",
 		}
 	}
+	// Check company employee quota, giữ khóa quota đến khi bật lại phân ca
+	releaseQuota, errQuota := s.checkEmployeeQuotaForShift(ctx, input.ShiftId, []uuid.UUID{input.UserIdReq})
+	if errQuota != nil {
+		return errQuota
+	}
+	defer releaseQuota()
 	// Call repository
 	if err := s.shiftUserRepo.EnableEmployeeShift(ctx,
 		&domainModel.EnableEmployeeShiftIInput{
@@ -1018,6 +1038,25 @@ func (s *ShiftEmployeeService) GetShiftForUserWithEffectiveDate(ctx context.Cont
 }
 
 // New instance
+// checkEmployeeQuotaForShift kiểm tra giới hạn nhân viên của công ty sở hữu ca làm việc
+func (s *ShiftEmployeeService) checkEmployeeQuotaForShift(ctx context.Context, shiftId uuid.UUID, employeeIds []uuid.UUID) (func(), *applicationError.Error) {
+	shift, err := s.shiftRepo.GetShiftByID(ctx, shiftId)
+	if err != nil {
+		s.logger.Error("checkEmployeeQuotaForShift - Failed to get shift by ID", "shift_id", shiftId, "error", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "Failed to get shift information",
+		}
+	}
+	if shift == nil {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Shift not found",
+		}
+	}
+	return s.subscription.CheckEmployeeQuota(ctx, shift.CompanyID, employeeIds)
+}
+
 func NewShiftEmployeeService() service.IShiftEmployeeService {
 	shiftUserRepo, err := repository.GetShiftUserRepository()
 	if err != nil {
@@ -1049,6 +1088,11 @@ func NewShiftEmployeeService() service.IShiftEmployeeService {
 		panic(fmt.Sprintf("Failed to get local cache: %v", err))
 	}
 
+	subscriptionService := service.GetSubscriptionService()
+	if subscriptionService == nil {
+		panic("Failed to get subscription service")
+	}
+
 	return &ShiftEmployeeService{
 		shiftUserRepo:    shiftUserRepo,
 		shiftRepo:        shiftRepo,
@@ -1056,5 +1100,6 @@ func NewShiftEmployeeService() service.IShiftEmployeeService {
 		logger:           log,
 		distributedCache: distributedCache,
 		localCache:       localCache,
+		subscription:     subscriptionService,
 	}
 }
@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	applicationError "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/application/error"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/application/model"
+	service "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/application/service"
+	"github.com/youknow2509/cio_verify_face/server/service_workforce/internal/constants"
+	"github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/cache"
+	"github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/logger"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/model"
+	"github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/repository"
+	"github.com/youknow2509/cio_verify_face/server/service_workforce/internal/global"
+	utilsCache "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/shared/utils/cache"
+	utilsCrypto "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/shared/utils/crypto"
+)
+
+const (
+	luaAcquireQuotaLock = `
+		if redis.call("SET", KEYS[1], ARGV[1], "NX", "EX", ARGV[2]) then
+			return 1
+		end
+		return 0
+	`
+	luaReleaseQuotaLock = `
+		if redis.call("GET", KEYS[1]) == ARGV[1] then
+			return redis.call("DEL", KEYS[1])
+		end
+		return 0
+	`
+	// Số lần thử lấy khóa quota khi request khác của công ty đang giữ khóa
+	quotaLockRetries    = 20
+	quotaLockRetryDelay = 50 * time.Millisecond
+)
+
+// =================================================
+// Company subscription service implementation interface
+// =================================================
+type SubscriptionService struct {
+	subscriptionRepo repository.ISubscriptionRepository
+	logger           logger.ILogger
+	distributedCache cache.IDistributedCache
+	localCache       cache.ILocalCache
+}
+
+// CheckCompanyWritable implements service.ISubscriptionService.
+func (s *SubscriptionService) CheckCompanyWritable(ctx context.Context, companyId uuid.UUID) *applicationError.Error {
+	subscription, errApp := s.getCompanySubscription(ctx, companyId)
+	if errApp != nil {
+		return errApp
+	}
+	if subscriptionExpired(subscription, time.Now()) {
+		return &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Company subscription has expired, company is in read-only mode",
+		}
+	}
+	return nil
+}
+
+// CheckEmployeeQuota implements service.ISubscriptionService.
+// Nhân viên trong danh sách chỉ tính một lần dù đã thuộc công ty hay chưa.
+// Khi thành công, khóa quota của công ty được giữ đến khi caller gọi release sau khi ghi xong,
+// tránh hai request đồng thời cùng vượt qua kiểm tra.
+func (s *SubscriptionService) CheckEmployeeQuota(ctx context.Context, companyId uuid.UUID, employeeIds []uuid.UUID) (func(), *applicationError.Error) {
+	subscription, errApp := s.getCompanySubscription(ctx, companyId)
+	if errApp != nil {
+		return nil, errApp
+	}
+	if subscriptionExpired(subscription, time.Now()) {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Company subscription has expired, company is in read-only mode",
+		}
+	}
+	release, errApp := s.acquireQuotaLock(ctx, companyId)
+	if errApp != nil {
+		return nil, errApp
+	}
+	uniqueIds := make([]uuid.UUID, 0, len(employeeIds))
+	seen := make(map[uuid.UUID]struct{}, len(employeeIds))
+	for _, id := range employeeIds {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		uniqueIds = append(uniqueIds, id)
+	}
+	// Số lượng nhân viên luôn đọc từ DB, chỉ giới hạn được cache
+	employees, err := s.subscriptionRepo.CountEmployeesInCompany(ctx, &domainModel.CountEmployeesInCompanyInput{
+		CompanyID:          companyId,
+		ExcludeEmployeeIDs: uniqueIds,
+	})
+	if err != nil {
+		release()
+		s.logger.Error("CheckEmployeeQuota - Failed to count employees in company", "company_id", companyId, "error", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "Failed to check company employee quota",
+		}
+	}
+	if employees+int64(len(uniqueIds)) > subscription.MaxEmployees {
+		release()
+		s.logger.Warn("CheckEmployeeQuota - Company reached maximum employees", "company_id", companyId, "employees", employees, "max_employees", subscription.MaxEmployees)
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: fmt.Sprintf("Company has reached the maximum number of employees (%d)", subscription.MaxEmployees),
+		}
+	}
+	return release, nil
+}
+
+// acquireQuotaLock lấy khóa quota nhân viên của công ty, trả về hàm nhả khóa
+func (s *SubscriptionService) acquireQuotaLock(ctx context.Context, companyId uuid.UUID) (func(), *applicationError.Error) {
+	key := utilsCache.GetKeyCompanyEmployeeQuotaLock(
+		utilsCrypto.GetHash(companyId.String()),
+	)
+	token := uuid.NewString()
+	for attempt := 0; attempt < quotaLockRetries; attempt++ {
+		result, err := s.distributedCache.LuaScript(ctx, luaAcquireQuotaLock, []string{key}, token, constants.TTL_Company_Employee_Quota_Lock)
+		if err != nil {
+			s.logger.Error("acquireQuotaLock - Failed to run lua script", "company_id", companyId, "error", err)
+			return nil, &applicationError.Error{
+				ErrorSystem: err,
+				ErrorClient: "Failed to check company employee quota",
+			}
+		}
+		if acquired, ok := result.(int64); ok && acquired == 1 {
+			return func() {
+				if _, err := s.distributedCache.LuaScript(context.WithoutCancel(ctx), luaReleaseQuotaLock, []string{key}, token); err != nil {
+					s.logger.Warn("acquireQuotaLock - Failed to release quota lock", "company_id", companyId, "error", err)
+				}
+			}, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, &applicationError.Error{
+				ErrorSystem: ctx.Err(),
+				ErrorClient: "Failed to check company employee quota",
+			}
+		case <-time.After(quotaLockRetryDelay):
+		}
+	}
+	s.logger.Warn("acquireQuotaLock - Quota lock is busy", "company_id", companyId)
+	return nil, &applicationError.Error{
+		ErrorSystem: nil,
+		ErrorClient: "Another request is updating company employees, please try again",
+	}
+}
+
+// ListenSubscriptionChanged implements service.ISubscriptionService.
+// Service identity gửi sự kiện khi cập nhật gói dịch vụ công ty, mọi replica xóa cache quota của công ty đó.
+func (s *SubscriptionService) ListenSubscriptionChanged(ctx context.Context) error {
+	messages, err := s.distributedCache.Subscribe(ctx, constants.RedisChannelCompanySubscriptionChanged)
+	if err != nil {
+		return err
+	}
+	global.WaitGroup.Add(1)
+	go func() {
+		defer global.WaitGroup.Done()
+		for msg := range messages {
+			event, ok := msg.(map[string]interface{})
+			if !ok {
+				s.logger.Warn("ListenSubscriptionChanged - Invalid event", "msg", msg)
+				continue
+			}
+			companyIdStr, _ := event["company_id"].(string)
+			companyId, err := uuid.Parse(companyIdStr)
+			if err != nil {
+				s.logger.Warn("ListenSubscriptionChanged - Invalid company id", "company_id", companyIdStr)
+				continue
+			}
+			s.invalidateCompanySubscription(context.Background(), companyId)
+		}
+	}()
+	return nil
+}
+
+// getCompanySubscription đọc gói dịch vụ công ty theo thứ tự local cache -> distributed cache -> DB
+func (s *SubscriptionService) getCompanySubscription(ctx context.Context, companyId uuid.UUID) (*applicationModel.CompanySubscription, *applicationError.Error) {
+	key := utilsCache.GetKeyCompanySubscription(
+		utilsCrypto.GetHash(companyId.String()),
+	)
+	var subscription applicationModel.CompanySubscription
+	// Try to get from local cache first
+	if cachedData, err := s.localCache.Get(ctx, key); err == nil && cachedData != "" {
+		if err := json.Unmarshal([]byte(cachedData), &subscription); err == nil {
+			return &subscription, nil
+		}
+	}
+	// Try distributed cache
+	if cachedData, err := s.distributedCache.Get(ctx, key); err == nil && cachedData != "" {
+		if err := json.Unmarshal([]byte(cachedData), &subscription); err == nil {
+			if err := s.localCache.SetTTL(ctx, key, cachedData, constants.TTL_Local_Company_Subscription_Cache); err != nil {
+				s.logger.Warn("getCompanySubscription - Failed to set local cache", "error", err)
+			}
+			return &subscription, nil
+		}
+	}
+	// Fetch from repository
+	resp, err := s.subscriptionRepo.GetCompanySubscription(ctx, companyId)
+	if err != nil {
+		s.logger.Error("getCompanySubscription - Failed to get company subscription", "company_id", companyId, "error", err)
+		return nil, &applicationError.Error{
+			ErrorSystem: err,
+			ErrorClient: "Failed to get company subscription",
+		}
+	}
+	if resp == nil {
+		return nil, &applicationError.Error{
+			ErrorSystem: nil,
+			ErrorClient: "Company not found",
+		}
+	}
+	subscription = applicationModel.CompanySubscription{
+		CompanyId:    resp.CompanyID.String(),
+		Status:       resp.Status,
+		Plan:         resp.Plan,
+		MaxDevices:   constants.DEFAULT_COMPANY_MAX_DEVICES,
+		MaxEmployees: constants.DEFAULT_COMPANY_MAX_EMPLOYEES,
+	}
+	if resp.StartDate != nil {
+		subscription.StartDate = resp.StartDate.Format(time.DateOnly)
+	}
+	if resp.EndDate != nil {
+		subscription.EndDate = resp.EndDate.Format(time.DateOnly)
+	}
+	if resp.MaxDevices != nil {
+		subscription.MaxDevices = int64(*resp.MaxDevices)
+	}
+	if resp.MaxEmployees != nil {
+		subscription.MaxEmployees = int64(*resp.MaxEmployees)
+	}
+	// Cache the subscription
+	if data, err := json.Marshal(subscription); err == nil {
+		if err := s.distributedCache.SetTTL(ctx, key, string(data), int64(constants.TTL_Company_Subscription_Cache)); err != nil {
+			s.logger.Warn("getCompanySubscription - Failed to set distributed cache", "error", err)
+		}
+		if err := s.localCache.SetTTL(ctx, key, string(data), constants.TTL_Local_Company_Subscription_Cache); err != nil {
+			s.logger.Warn("getCompanySubscription - Failed to set local cache", "error", err)
+		}
+	}
+	return &subscription, nil
+}
+
+// invalidateCompanySubscription xóa cache gói dịch vụ công ty ở cả hai tầng
+func (s *SubscriptionService) invalidateCompanySubscription(ctx context.Context, companyId uuid.UUID) {
+	key := utilsCache.GetKeyCompanySubscription(
+		utilsCrypto.GetHash(companyId.String()),
+	)
+	if err := s.localCache.Delete(ctx, key); err != nil {
+		s.logger.Warn("invalidateCompanySubscription - Failed to delete from local cache", "error", err)
+	}
+	if err := s.distributedCache.Delete(ctx, key); err != nil {
+		s.logger.Warn("invalidateCompanySubscription - Failed to delete from distributed cache", "error", err)
+	}
+}
+
+// subscriptionExpired gói dịch vụ hết hạn khi ngày kết thúc đã qua, không có ngày kết thúc là không giới hạn
+func subscriptionExpired(subscription *applicationModel.CompanySubscription, now time.Time) bool {
+	if subscription.EndDate == "" {
+		return false
+	}
+	return subscription.EndDate < now.Format(time.DateOnly)
+}
+
+// NewSubscriptionService creates a new instance of SubscriptionService
+func NewSubscriptionService() service.ISubscriptionService {
+	subscriptionRepo, err := repository.GetSubscriptionRepository()
+	if err != nil {
+		panic(fmt.Sprintf("Failed to get subscription repository: %v", err))
+	}
+
+	log := logger.GetLogger()
+	if log == nil {
+		panic("Failed to get logger instance")
+	}
+
+	distributedCache, err := cache.GetDistributedCache()
+	if err != nil {
+		panic(fmt.Sprintf("Failed to get distributed cache: %v", err))
+	}
+
+	localCache, err := cache.GetLocalCache()
+	if err != nil {
+		panic(fmt.Sprintf("Failed to get local cache: %v", err))
+	}
+
+	return &SubscriptionService{
+		subscriptionRepo: subscriptionRepo,
+		logger:           log,
+		distributedCache: distributedCache,
+		localCache:       localCache,
+	}
+}
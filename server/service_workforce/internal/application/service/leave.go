@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	applicationError "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/application/error"
+	model "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/application/model"
+)
+
+// =================================================
+// Leave application interface service
+// =================================================
+type ILeaveService interface {
+	// Company holiday calendar
+	CreateHoliday(ctx context.Context, input *model.CreateHolidayInput) (*model.CreateHolidayOutput, *applicationError.Error)
+	DeleteHoliday(ctx context.Context, input *model.DeleteHolidayInput) *applicationError.Error
+	GetListHoliday(ctx context.Context, input *model.GetListHolidayInput) (*model.GetListHolidayOutput, *applicationError.Error)
+	// Leave request
+	CreateLeaveRequest(ctx context.Context, input *model.CreateLeaveRequestInput) (*model.CreateLeaveRequestOutput, *applicationError.Error)
+	GetListMyLeaveRequest(ctx context.Context, input *model.GetListMyLeaveRequestInput) (*model.GetListLeaveRequestOutput, *applicationError.Error)
+	CancelLeaveRequest(ctx context.Context, input *model.CancelLeaveRequestInput) *applicationError.Error
+	GetListPendingLeaveRequest(ctx context.Context, input *model.GetListPendingLeaveRequestInput) (*model.GetListLeaveRequestOutput, *applicationError.Error)
+	ApproveLeaveRequest(ctx context.Context, input *model.ApproveLeaveRequestInput) *applicationError.Error
+	RejectLeaveRequest(ctx context.Context, input *model.RejectLeaveRequestInput) *applicationError.Error
+}
+
+/**
+ * Managet instance
+ */
+var _vILeaveService ILeaveService
+
+/**
+ * Getter and setter instance
+ */
+func GetLeaveService() ILeaveService {
+	return _vILeaveService
+}
+func SetLeaveService(s ILeaveService) error {
+	if s == nil {
+		return errors.New("invalid leave service")
+	}
+	if _vILeaveService != nil {
+		return errors.New("leave service already set")
+	}
+	_vILeaveService = s
+	return nil
+}
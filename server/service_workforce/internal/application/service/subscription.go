@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	applicationError "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/application/error"
+)
+
+// =================================================
+// Company subscription application interface service
+// =================================================
+type ISubscriptionService interface {
+	CheckCompanyWritable(ctx context.Context, companyId uuid.UUID) *applicationError.Error
+	CheckEmployeeQuota(ctx context.Context, companyId uuid.UUID, employeeIds []uuid.UUID) (func(), *applicationError.Error)
+	ListenSubscriptionChanged(ctx context.Context) error
+}
+
+/**
+ * Managet instance
+ */
+var _vISubscriptionService ISubscriptionService
+
+/**
+ * Getter and setter instance
+ */
+func GetSubscriptionService() ISubscriptionService {
+	return _vISubscriptionService
+}
+func SetSubscriptionService(s ISubscriptionService) error {
+	if s == nil {
+		return errors.New("invalid subscription service")
+	}
+	if _vISubscriptionService != nil {
+		return errors.New("subscription service already set")
+	}
+	_vISubscriptionService = s
+	return nil
+}
@@ -32,3 +32,7 @@ const (
 	DeviceTypeMobile  = 2
 	DeviceTypeDesktop = 3
 )
+
+const (
+	MAX_LEAVE_DAYS_PER_REQUEST = 60 // Số ngày tối đa của một đơn nghỉ phép
+)
@@ -14,3 +14,9 @@ const (
 const (
 	RedisPrefixRateLimiter = "ratelimiter:"
 )
+
+// Pub/sub channel
+const (
+	// Channel thông báo gói dịch vụ công ty thay đổi (service identity gửi khi cập nhật companies), các replica xóa cache quota
+	RedisChannelCompanySubscriptionChanged = "company:subscription:changed"
+)
@@ -66,3 +66,15 @@ const (
 
 	// v.v
 )
+
+const (
+	// Leave
+	TTL_Leave_Approval_Lock = 30 // 30 seconds
+)
+
+const (
+	// Company subscription quota
+	TTL_Company_Subscription_Cache       = 60 * 10 // 10 minutes
+	TTL_Local_Company_Subscription_Cache = 30      // 30 seconds
+	TTL_Company_Employee_Quota_Lock      = 10      // 10 seconds, giữ trong lúc kiểm tra quota và phân ca
+)
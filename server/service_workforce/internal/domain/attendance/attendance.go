@@ -0,0 +1,35 @@
+package attendance
+
+import (
+	"context"
+	"errors"
+
+	"github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/model"
+)
+
+// IAttendanceService gọi service_attendance, dùng để tính lại bản tổng hợp khi ngày nghỉ thay đổi.
+type IAttendanceService interface {
+	RecomputeDailySummaries(ctx context.Context, input *model.RecomputeDailySummariesInput) (*model.RecomputeDailySummariesOutput, error)
+}
+
+// ErrRecomputeRejected service_attendance từ chối yêu cầu (quyền, khoảng ngày), gọi lại cũng không thành công
+var ErrRecomputeRejected = errors.New("recompute request rejected")
+
+var attendanceService IAttendanceService
+
+// GetAttendanceService returns the current attendance service implementation, nil if disabled.
+func GetAttendanceService() IAttendanceService {
+	return attendanceService
+}
+
+// SetAttendanceService sets the attendance service implementation once.
+func SetAttendanceService(s IAttendanceService) error {
+	if s == nil {
+		return errors.New("attendance service cannot be nil")
+	}
+	if attendanceService != nil {
+		return errors.New("attendance service already set")
+	}
+	attendanceService = s
+	return nil
+}
@@ -7,25 +7,26 @@ package config
 // ==========================================================
 type (
 	Setting struct {
-		AuthService       AuthServiceSetting   `mapstructure:"service_auth"`
-		GrpcServer        GrpcSetting          `mapstructure:"grpc"`
-		Server            ServerSetting        `mapstructure:"server"`
-		WsServer          WsSetting            `mapstructure:"ws"`
-		Observability     ObservabilitySetting `mapstructure:"observability"`
-		Cassandra         CassandraSetting     `mapstructure:"cassandra"`
-		Elasticsearch     ElasticsearchSetting `mapstructure:"elasticsearch"`
-		Jaeger            JaegerSetting        `mapstructure:"jaeger"`
-		Kafka             KafkaSetting         `mapstructure:"kafka"`
-		Memcached         MemcachedSetting     `mapstructure:"memcached"`
-		Minio             MinioSetting         `mapstructure:"minio"`
-		Postgres          PostgresSetting      `mapstructure:"postgres"`
-		Redis             RedisSetting         `mapstructure:"redis"`
-		ScyllaDb          ScyllaDbSetting      `mapstructure:"scylladb"`
-		Logstash          LogstashSetting      `mapstructure:"logstash"`
-		SMTP              SMTPSetting          `mapstructure:"smtp"`
-		JWT               JWTSetting           `mapstructure:"jwt"`
-		Logger            LoggerSetting        `mapstructure:"logger"`
-		RateLimitPolicies []RateLimitPolicy    `mapstructure:"policy_rate_limit"`
+		AuthService       AuthServiceSetting       `mapstructure:"service_auth"`
+		AttendanceService AttendanceServiceSetting `mapstructure:"service_attendance"`
+		GrpcServer        GrpcSetting              `mapstructure:"grpc"`
+		Server            ServerSetting            `mapstructure:"server"`
+		WsServer          WsSetting                `mapstructure:"ws"`
+		Observability     ObservabilitySetting     `mapstructure:"observability"`
+		Cassandra         CassandraSetting         `mapstructure:"cassandra"`
+		Elasticsearch     ElasticsearchSetting     `mapstructure:"elasticsearch"`
+		Jaeger            JaegerSetting            `mapstructure:"jaeger"`
+		Kafka             KafkaSetting             `mapstructure:"kafka"`
+		Memcached         MemcachedSetting         `mapstructure:"memcached"`
+		Minio             MinioSetting             `mapstructure:"minio"`
+		Postgres          PostgresSetting          `mapstructure:"postgres"`
+		Redis             RedisSetting             `mapstructure:"redis"`
+		ScyllaDb          ScyllaDbSetting          `mapstructure:"scylladb"`
+		Logstash          LogstashSetting          `mapstructure:"logstash"`
+		SMTP              SMTPSetting              `mapstructure:"smtp"`
+		JWT               JWTSetting               `mapstructure:"jwt"`
+		Logger            LoggerSetting            `mapstructure:"logger"`
+		RateLimitPolicies []RateLimitPolicy        `mapstructure:"policy_rate_limit"`
 	}
 )
 
@@ -55,6 +56,23 @@ type AuthServiceTLSSetting struct {
 	KeyFile  string `mapstructure:"key_file"`
 }
 
+// AttendanceServiceSetting - tính lại bản tổng hợp chấm công khi duyệt nghỉ phép/tạo ngày nghỉ lễ
+type AttendanceServiceSetting struct {
+	Enabled                     bool                        `mapstructure:"enabled"`
+	GrpcAddr                    string                      `mapstructure:"grpc_addr"`
+	KeepaliveTimeMs             int                         `mapstructure:"keepalive_time_ms"`
+	KeepaliveTimeoutMs          int                         `mapstructure:"keepalive_timeout_ms"`
+	KeepalivePermitWithoutCalls bool                        `mapstructure:"keepalive_permit_without_calls"`
+	Tls                         AttendanceServiceTLSSetting `mapstructure:"tls"`
+}
+
+// Sub struct for TLS settings in AttendanceServiceSetting
+type AttendanceServiceTLSSetting struct {
+	Enabled  bool   `mapstructure:"enabled"`
+	CertFile string `mapstructure:"cert_file"`
+	KeyFile  string `mapstructure:"key_file"`
+}
+
 // RateLimitPolicySetting
 type RateLimitPolicySetting struct {
 	Policies []RateLimitPolicy
@@ -330,7 +348,6 @@ type SMTPSetting struct {
 
 // jwt
 type JWTSetting struct {
-	Secret   string   `mapstructure:"secret"`
 	Issuer   string   `mapstructure:"issuer"`
 	Subject  string   `mapstructure:"subject"`
 	Audience []string `mapstructure:"audience"`
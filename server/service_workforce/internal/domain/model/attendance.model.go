@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// For RecomputeDailySummaries - yêu cầu service_attendance tính lại bản tổng hợp chấm công
+type RecomputeDailySummariesInput struct {
+	// Phiên của người thao tác, service_attendance kiểm tra quyền quản lý công ty
+	UserId      uuid.UUID
+	SessionId   uuid.UUID
+	Role        int
+	UserCompany uuid.UUID
+	ClientIp    string
+	ClientAgent string
+	//
+	CompanyID   uuid.UUID
+	FromDate    time.Time
+	ToDate      time.Time
+	EmployeeIDs []uuid.UUID // Rỗng: toàn bộ nhân viên có ca trong khoảng ngày
+	Immediate   bool        // Tính ngay, không chờ khóa job của công ty, chỉ cho danh sách nhân viên hoặc một ngày
+}
+
+type RecomputeDailySummariesOutput struct {
+	JobID  string
+	Status string
+}
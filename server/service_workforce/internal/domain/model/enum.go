@@ -8,3 +8,19 @@ const (
 	RoleManager = 1
 	RoleUser    = 2
 )
+
+// Leave request status
+const (
+	LeaveStatusPending   = 0
+	LeaveStatusApproved  = 1
+	LeaveStatusRejected  = 2
+	LeaveStatusCancelled = 3
+)
+
+// Leave type
+const (
+	LeaveTypeAnnual = 0
+	LeaveTypeSick   = 1
+	LeaveTypeUnpaid = 2
+	LeaveTypeOther  = 3
+)
@@ -0,0 +1,108 @@
+package model
+
+// =========================================================
+// Leave and holiday model for repository
+// ==================================================
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// For company holiday calendar
+type CreateCompanyHolidayInput struct {
+	CompanyID   uuid.UUID
+	HolidayDate time.Time
+	Name        string
+	Description string
+	CreatedBy   uuid.UUID
+}
+
+type DeleteCompanyHolidayInput struct {
+	HolidayID uuid.UUID
+	CompanyID uuid.UUID
+}
+
+type ListCompanyHolidaysInput struct {
+	CompanyID uuid.UUID
+	From      time.Time
+	To        time.Time
+}
+
+type CompanyHoliday struct {
+	HolidayID   uuid.UUID
+	CompanyID   uuid.UUID
+	HolidayDate time.Time
+	Name        string
+	Description string
+	CreatedBy   uuid.UUID
+	CreatedAt   time.Time
+}
+
+// For leave requests
+type CreateLeaveRequestInput struct {
+	CompanyID  uuid.UUID
+	EmployeeID uuid.UUID
+	LeaveType  int
+	StartDate  time.Time
+	EndDate    time.Time
+	Reason     string
+}
+
+type CountOverlappingLeaveRequestsInput struct {
+	EmployeeID uuid.UUID
+	StartDate  time.Time
+	EndDate    time.Time
+}
+
+type GetLeaveRequestInput struct {
+	RequestID uuid.UUID
+	CompanyID uuid.UUID
+}
+
+type ListLeaveRequestsByEmployeeInput struct {
+	EmployeeID uuid.UUID
+	Limit      int32
+	Offset     int32
+}
+
+type ListPendingLeaveRequestsInput struct {
+	CompanyID uuid.UUID
+	Limit     int32
+	Offset    int32
+}
+
+type ApproveLeaveRequestInput struct {
+	RequestID  uuid.UUID
+	CompanyID  uuid.UUID
+	ApprovedBy uuid.UUID
+}
+
+type RejectLeaveRequestInput struct {
+	RequestID       uuid.UUID
+	CompanyID       uuid.UUID
+	ApprovedBy      uuid.UUID
+	RejectionReason string
+}
+
+type CancelLeaveRequestInput struct {
+	RequestID  uuid.UUID
+	EmployeeID uuid.UUID
+}
+
+type LeaveRequest struct {
+	RequestID       uuid.UUID
+	CompanyID       uuid.UUID
+	EmployeeID      uuid.UUID
+	LeaveType       int
+	StartDate       time.Time
+	EndDate         time.Time
+	Reason          string
+	Status          int
+	ApprovedBy      *uuid.UUID
+	ApprovedAt      *time.Time
+	RejectionReason string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
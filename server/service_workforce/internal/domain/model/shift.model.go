@@ -59,6 +59,9 @@ type Shift struct {
 	EarlyDepartureMinutes int32
 	WorkDays              []int32
 	IsFlexible            bool
+	CoreStartTime         *time.Time // Flexible shift core hours (nil if not set)
+	CoreEndTime           *time.Time
+	RequiredWorkMinutes   int32
 	OvertimeAfterMinutes  int32
 	IsActive              bool
 	CreatedAt             time.Time
@@ -76,6 +79,10 @@ type CreateShiftInput struct {
 	GracePeriodMinutes    int32
 	EarlyDepartureMinutes int32
 	WorkDays              []int32
+	IsFlexible            bool
+	CoreStartTime         *time.Time
+	CoreEndTime           *time.Time
+	RequiredWorkMinutes   int32
 }
 
 // ListShiftsInput filters and paginates shifts
@@ -94,4 +101,8 @@ type UpdateTimeShiftInput struct {
 	GracePeriodMinutes    int32
 	EarlyDepartureMinutes int32
 	WorkDays              []int32
+	IsFlexible            bool
+	CoreStartTime         *time.Time
+	CoreEndTime           *time.Time
+	RequiredWorkMinutes   int32
 }
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GetCompanySubscription
+type GetCompanySubscriptionOutput struct {
+	CompanyID    uuid.UUID  `json:"company_id"`
+	Status       int        `json:"status"` // 0: Inactive, 1: Active, 2: Suspended
+	Plan         int        `json:"plan"`   // 0: Basic, 1: Premium, 2: Enterprise
+	StartDate    *time.Time `json:"start_date"`
+	EndDate      *time.Time `json:"end_date"`
+	MaxEmployees *int       `json:"max_employees"` // nil nếu công ty chưa cấu hình
+	MaxDevices   *int       `json:"max_devices"`   // nil nếu công ty chưa cấu hình
+}
+
+// CountEmployeesInCompany - nhân viên của công ty, không tính nhân viên đã nghỉ việc (inactive), bỏ qua danh sách nhân viên sắp được phân ca
+type CountEmployeesInCompanyInput struct {
+	CompanyID          uuid.UUID   `json:"company_id"`
+	ExcludeEmployeeIDs []uuid.UUID `json:"exclude_employee_ids"`
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	model "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/model"
+)
+
+/**
+ * Interface for Leave repository (leave requests and company holidays)
+ */
+type ILeaveRepository interface {
+	// Company holidays
+	CreateCompanyHoliday(ctx context.Context, input *model.CreateCompanyHolidayInput) (uuid.UUID, error)
+	DeleteCompanyHoliday(ctx context.Context, input *model.DeleteCompanyHolidayInput) (bool, error)
+	ListCompanyHolidays(ctx context.Context, input *model.ListCompanyHolidaysInput) ([]*model.CompanyHoliday, error)
+	// Leave requests
+	CreateLeaveRequest(ctx context.Context, input *model.CreateLeaveRequestInput) (uuid.UUID, error)
+	CountOverlappingLeaveRequests(ctx context.Context, input *model.CountOverlappingLeaveRequestsInput) (int64, error)
+	GetLeaveRequest(ctx context.Context, input *model.GetLeaveRequestInput) (*model.LeaveRequest, error)
+	ListLeaveRequestsByEmployee(ctx context.Context, input *model.ListLeaveRequestsByEmployeeInput) ([]*model.LeaveRequest, error)
+	ListPendingLeaveRequests(ctx context.Context, input *model.ListPendingLeaveRequestsInput) ([]*model.LeaveRequest, error)
+	ApproveLeaveRequest(ctx context.Context, input *model.ApproveLeaveRequestInput) (bool, error)
+	RejectLeaveRequest(ctx context.Context, input *model.RejectLeaveRequestInput) (bool, error)
+	CancelLeaveRequest(ctx context.Context, input *model.CancelLeaveRequestInput) (bool, error)
+}
+
+/**
+ * Variable for Leave repository instance
+ */
+var _vLeaveRepository ILeaveRepository
+
+/**
+ * Set the Leave repository instance
+ */
+func SetLeaveRepository(v ILeaveRepository) error {
+	if _vLeaveRepository != nil {
+		return errors.New("leave repository initialization failed, not nil")
+	}
+	_vLeaveRepository = v
+	return nil
+}
+
+/**
+ * Get the Leave repository instance
+ */
+func GetLeaveRepository() (ILeaveRepository, error) {
+	if _vLeaveRepository == nil {
+		return nil, errors.New("leave repository not initialized")
+	}
+	return _vLeaveRepository, nil
+}
@@ -0,0 +1,62 @@
+package attendance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	domainAttendance "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/attendance"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/model"
+	pb "github.com/youknow2509/cio_verify_face/server/service_workforce/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AttendanceService bridges to the service_attendance gRPC service.
+type AttendanceService struct {
+	client pb.AttendanceServiceClient
+}
+
+// RecomputeDailySummaries tạo job tính lại bản tổng hợp, job chạy nền bên service_attendance.
+// Immediate: service_attendance tính xong mới trả về.
+func (s *AttendanceService) RecomputeDailySummaries(ctx context.Context, input *domainModel.RecomputeDailySummariesInput) (*domainModel.RecomputeDailySummariesOutput, error) {
+	employeeIds := make([]string, 0, len(input.EmployeeIDs))
+	for _, employeeID := range input.EmployeeIDs {
+		employeeIds = append(employeeIds, employeeID.String())
+	}
+	var userCompany string
+	if input.UserCompany != uuid.Nil {
+		userCompany = input.UserCompany.String()
+	}
+	resp, err := s.client.RecomputeDailySummaries(ctx, &pb.RecomputeDailySummariesInput{
+		CompanyId:   input.CompanyID.String(),
+		FromDate:    input.FromDate.Format("2006-01-02"),
+		ToDate:      input.ToDate.Format("2006-01-02"),
+		EmployeeIds: employeeIds,
+		Immediate:   input.Immediate,
+		Session: &pb.SessionInfo{
+			UserId:      input.UserId.String(),
+			Role:        int32(input.Role),
+			SessionId:   input.SessionId.String(),
+			CompanyId:   userCompany,
+			ClientIp:    input.ClientIp,
+			ClientAgent: input.ClientAgent,
+		},
+	})
+	if err != nil {
+		// service_attendance trả mã 400 cho lỗi nghiệp vụ
+		if st, ok := status.FromError(err); ok && st.Code() == codes.Code(400) {
+			return nil, fmt.Errorf("%w: %s", domainAttendance.ErrRecomputeRejected, st.Message())
+		}
+		return nil, err
+	}
+	return &domainModel.RecomputeDailySummariesOutput{
+		JobID:  resp.GetJobId(),
+		Status: resp.GetStatus(),
+	}, nil
+}
+
+// NewAttendanceService builds an attendance service implementation.
+func NewAttendanceService(client pb.AttendanceServiceClient) domainAttendance.IAttendanceService {
+	return &AttendanceService{client: client}
+}
@@ -68,6 +68,17 @@ type Company struct {
 	UpdatedAt             pgtype.Timestamptz
 }
 
+type CompanyHoliday struct {
+	HolidayID   pgtype.UUID
+	CompanyID   pgtype.UUID
+	HolidayDate pgtype.Date
+	Name        string
+	Description pgtype.Text
+	CreatedBy   pgtype.UUID
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
+
 type CompanySetting struct {
 	SettingID    pgtype.UUID
 	CompanyID    pgtype.UUID
@@ -143,6 +154,23 @@ type EmployeeShift struct {
 	CreatedAt     pgtype.Timestamptz
 }
 
+type LeaveRequest struct {
+	RequestID       pgtype.UUID
+	CompanyID       pgtype.UUID
+	EmployeeID      pgtype.UUID
+	LeaveType       int16
+	StartDate       pgtype.Date
+	EndDate         pgtype.Date
+	Reason          pgtype.Text
+	Status          int16
+	ApprovedBy      pgtype.UUID
+	ApprovedAt      pgtype.Timestamptz
+	RejectionReason pgtype.Text
+	MetaData        []byte
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+}
+
 type SystemSetting struct {
 	SettingID    pgtype.UUID
 	SettingKey   string
@@ -198,4 +226,7 @@ type WorkShift struct {
 	IsActive              pgtype.Bool
 	CreatedAt             pgtype.Timestamptz
 	UpdatedAt             pgtype.Timestamptz
+	CoreStartTime         pgtype.Time
+	CoreEndTime           pgtype.Time
+	RequiredWorkMinutes   pgtype.Int4
 }
@@ -67,11 +67,13 @@ const createShift = `-- name: CreateShift :one
 INSERT INTO work_shifts (
   company_id, name, description, start_time, end_time,
   break_duration_minutes, grace_period_minutes, early_departure_minutes,
-  work_days, is_active
+  work_days, is_flexible, core_start_time, core_end_time,
+  required_work_minutes, is_active
 ) VALUES (
     $1, $2, $3, $4, $5,
     $6, $7, $8,
-    $9, TRUE
+    $9, $10, $11, $12,
+    $13, TRUE
 )
 RETURNING shift_id
 `
@@ -86,6 +88,10 @@ type CreateShiftParams struct {
 	GracePeriodMinutes    pgtype.Int4
 	EarlyDepartureMinutes pgtype.Int4
 	WorkDays              []int32
+	IsFlexible            pgtype.Bool
+	CoreStartTime         pgtype.Time
+	CoreEndTime           pgtype.Time
+	RequiredWorkMinutes   pgtype.Int4
 }
 
 func (q *Queries) CreateShift(ctx context.Context, arg CreateShiftParams) (pgtype.UUID, error) {
@@ -99,6 +105,10 @@ func (q *Queries) CreateShift(ctx context.Context, arg CreateShiftParams) (pgtyp
 		arg.GracePeriodMinutes,
 		arg.EarlyDepartureMinutes,
 		arg.WorkDays,
+		arg.IsFlexible,
+		arg.CoreStartTime,
+		arg.CoreEndTime,
+		arg.RequiredWorkMinutes,
 	)
 	var shift_id pgtype.UUID
 	err := row.Scan(&shift_id)
@@ -382,7 +392,7 @@ func (q *Queries) GetListEmployeeInShift(ctx context.Context, arg GetListEmploye
 }
 
 const getShiftByID = `-- name: GetShiftByID :one
-SELECT shift_id, company_id, name, description, start_time, end_time, break_duration_minutes, grace_period_minutes, early_departure_minutes, work_days, is_flexible, overtime_after_minutes, is_active, created_at, updated_at
+SELECT shift_id, company_id, name, description, start_time, end_time, break_duration_minutes, grace_period_minutes, early_departure_minutes, work_days, is_flexible, overtime_after_minutes, is_active, created_at, updated_at, core_start_time, core_end_time, required_work_minutes
 FROM work_shifts
 WHERE shift_id = $1
 `
@@ -406,6 +416,9 @@ func (q *Queries) GetShiftByID(ctx context.Context, shiftID pgtype.UUID) (WorkSh
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CoreStartTime,
+		&i.CoreEndTime,
+		&i.RequiredWorkMinutes,
 	)
 	return i, err
 }
@@ -463,7 +476,7 @@ func (q *Queries) IsUserManagetShift(ctx context.Context, arg IsUserManagetShift
 }
 
 const listShifts = `-- name: ListShifts :many
-SELECT shift_id, company_id, name, description, start_time, end_time, break_duration_minutes, grace_period_minutes, early_departure_minutes, work_days, is_flexible, overtime_after_minutes, is_active, created_at, updated_at
+SELECT shift_id, company_id, name, description, start_time, end_time, break_duration_minutes, grace_period_minutes, early_departure_minutes, work_days, is_flexible, overtime_after_minutes, is_active, created_at, updated_at, core_start_time, core_end_time, required_work_minutes
 FROM work_shifts
 WHERE company_id = $1
 ORDER BY name
@@ -501,6 +514,9 @@ func (q *Queries) ListShifts(ctx context.Context, arg ListShiftsParams) ([]WorkS
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CoreStartTime,
+			&i.CoreEndTime,
+			&i.RequiredWorkMinutes,
 		); err != nil {
 			return nil, err
 		}
@@ -520,6 +536,10 @@ SET start_time = $2,
     grace_period_minutes = $5,
     early_departure_minutes = $6,
     work_days = $7,
+    is_flexible = $8,
+    core_start_time = $9,
+    core_end_time = $10,
+    required_work_minutes = $11,
     updated_at = now()
 WHERE shift_id = $1
 `
@@ -532,6 +552,10 @@ type UpdateTimeShiftParams struct {
 	GracePeriodMinutes    pgtype.Int4
 	EarlyDepartureMinutes pgtype.Int4
 	WorkDays              []int32
+	IsFlexible            pgtype.Bool
+	CoreStartTime         pgtype.Time
+	CoreEndTime           pgtype.Time
+	RequiredWorkMinutes   pgtype.Int4
 }
 
 func (q *Queries) UpdateTimeShift(ctx context.Context, arg UpdateTimeShiftParams) error {
@@ -543,6 +567,10 @@ func (q *Queries) UpdateTimeShift(ctx context.Context, arg UpdateTimeShiftParams
 		arg.GracePeriodMinutes,
 		arg.EarlyDepartureMinutes,
 		arg.WorkDays,
+		arg.IsFlexible,
+		arg.CoreStartTime,
+		arg.CoreEndTime,
+		arg.RequiredWorkMinutes,
 	)
 	return err
 }
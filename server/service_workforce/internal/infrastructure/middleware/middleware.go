@@ -20,3 +20,10 @@ func GetValidateMiddleware() *ValidateMiddleware {
 func GetAuthAccessTokenJwtMiddleware() *AuthAccessTokenJwtMiddleware {
 	return &AuthAccessTokenJwtMiddleware{}
 }
+
+/**
+ * Get subscription read-only middleware instance
+ */
+func GetSubscriptionReadOnlyMiddleware() *SubscriptionReadOnlyMiddleware {
+	return &SubscriptionReadOnlyMiddleware{}
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	applicationService "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/application/service"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/model"
+	utilsContext "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/shared/utils/context"
+	utilsUuid "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/shared/utils/uuid"
+)
+
+// Define the SubscriptionReadOnlyMiddleware struct
+type SubscriptionReadOnlyMiddleware struct{}
+
+/**
+ * Apply method to block write requests when company subscription has expired.
+ * DELETE requests are always allowed so the company can remove data to get back under its quotas.
+ * Must run after the access token middleware saved the session to context.
+ */
+func (m *SubscriptionReadOnlyMiddleware) Apply() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodDelete {
+			c.Next()
+			return
+		}
+		_, _, userRole, companyId, ok := utilsContext.GetSessionFromContext(c)
+		if !ok {
+			c.JSON(401, gin.H{"error": "Unauthorized"})
+			c.Abort()
+			return
+		}
+		// ADMIN hệ thống không bị giới hạn bởi gói dịch vụ
+		if userRole == domainModel.RoleAdmin || companyId == "" {
+			c.Next()
+			return
+		}
+		companyUuid, err := utilsUuid.ParseUUID(companyId)
+		if err != nil {
+			c.JSON(401, gin.H{"error": "Unauthorized"})
+			c.Abort()
+			return
+		}
+		if errApp := applicationService.GetSubscriptionService().CheckCompanyWritable(c, companyUuid); errApp != nil {
+			if errApp.ErrorSystem != nil {
+				c.JSON(500, gin.H{"error": "Internal Server Error"})
+				c.Abort()
+				return
+			}
+			c.JSON(403, gin.H{"error": "Forbidden - " + errApp.ErrorClient})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
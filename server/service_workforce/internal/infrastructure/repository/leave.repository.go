@@ -0,0 +1,271 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/model"
+	domainRepo "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/repository"
+	database "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/infrastructure/gen"
+)
+
+/**
+ * Leave repository implementation
+ */
+type LeaveRepository struct {
+	db   *database.Queries
+	pool *pgxpool.Pool
+}
+
+// CreateCompanyHoliday implements repository.ILeaveRepository.
+// Ngày nghỉ đã tồn tại thì cập nhật tên và mô tả.
+func (l *LeaveRepository) CreateCompanyHoliday(ctx context.Context, input *model.CreateCompanyHolidayInput) (uuid.UUID, error) {
+	if input == nil {
+		return uuid.Nil, errors.New("input cannot be nil")
+	}
+	createdBy := pgtype.UUID{}
+	if input.CreatedBy != uuid.Nil {
+		createdBy = pgtype.UUID{Valid: true, Bytes: input.CreatedBy}
+	}
+	id, err := l.db.CreateCompanyHoliday(ctx, database.CreateCompanyHolidayParams{
+		CompanyID:   pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+		HolidayDate: toPgDate(input.HolidayDate),
+		Name:        input.Name,
+		Description: toPgText(input.Description),
+		CreatedBy:   createdBy,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return id.Bytes, nil
+}
+
+// DeleteCompanyHoliday implements repository.ILeaveRepository.
+func (l *LeaveRepository) DeleteCompanyHoliday(ctx context.Context, input *model.DeleteCompanyHolidayInput) (bool, error) {
+	if input == nil {
+		return false, errors.New("input cannot be nil")
+	}
+	rows, err := l.db.DeleteCompanyHoliday(ctx, database.DeleteCompanyHolidayParams{
+		HolidayID: pgtype.UUID{Valid: true, Bytes: input.HolidayID},
+		CompanyID: pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// ListCompanyHolidays implements repository.ILeaveRepository.
+func (l *LeaveRepository) ListCompanyHolidays(ctx context.Context, input *model.ListCompanyHolidaysInput) ([]*model.CompanyHoliday, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
+	}
+	rows, err := l.db.ListCompanyHolidays(ctx, database.ListCompanyHolidaysParams{
+		CompanyID:     pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+		HolidayDate:   toPgDate(input.From),
+		HolidayDate_2: toPgDate(input.To),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return []*model.CompanyHoliday{}, nil
+		}
+		return nil, err
+	}
+	result := make([]*model.CompanyHoliday, 0, len(rows))
+	for _, r := range rows {
+		result = append(result, &model.CompanyHoliday{
+			HolidayID:   r.HolidayID.Bytes,
+			CompanyID:   r.CompanyID.Bytes,
+			HolidayDate: fromPgDate(r.HolidayDate),
+			Name:        r.Name,
+			Description: fromPgText(r.Description),
+			CreatedBy:   r.CreatedBy.Bytes,
+			CreatedAt:   fromPgTimestamptz(r.CreatedAt),
+		})
+	}
+	return result, nil
+}
+
+// CreateLeaveRequest implements repository.ILeaveRepository.
+func (l *LeaveRepository) CreateLeaveRequest(ctx context.Context, input *model.CreateLeaveRequestInput) (uuid.UUID, error) {
+	if input == nil {
+		return uuid.Nil, errors.New("input cannot be nil")
+	}
+	id, err := l.db.CreateLeaveRequest(ctx, database.CreateLeaveRequestParams{
+		CompanyID:  pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+		EmployeeID: pgtype.UUID{Valid: true, Bytes: input.EmployeeID},
+		LeaveType:  int16(input.LeaveType),
+		StartDate:  toPgDate(input.StartDate),
+		EndDate:    toPgDate(input.EndDate),
+		Reason:     toPgText(input.Reason),
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return id.Bytes, nil
+}
+
+// CountOverlappingLeaveRequests implements repository.ILeaveRepository.
+// Đếm các đơn đang chờ duyệt hoặc đã duyệt giao với khoảng [StartDate, EndDate].
+func (l *LeaveRepository) CountOverlappingLeaveRequests(ctx context.Context, input *model.CountOverlappingLeaveRequestsInput) (int64, error) {
+	if input == nil {
+		return 0, errors.New("input cannot be nil")
+	}
+	return l.db.CountOverlappingLeaveRequests(ctx, database.CountOverlappingLeaveRequestsParams{
+		EmployeeID: pgtype.UUID{Valid: true, Bytes: input.EmployeeID},
+		StartDate:  toPgDate(input.EndDate),
+		EndDate:    toPgDate(input.StartDate),
+	})
+}
+
+// GetLeaveRequest implements repository.ILeaveRepository.
+func (l *LeaveRepository) GetLeaveRequest(ctx context.Context, input *model.GetLeaveRequestInput) (*model.LeaveRequest, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
+	}
+	r, err := l.db.GetLeaveRequestByID(ctx, database.GetLeaveRequestByIDParams{
+		RequestID: pgtype.UUID{Valid: true, Bytes: input.RequestID},
+		CompanyID: pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toLeaveRequestModel(r), nil
+}
+
+// ListLeaveRequestsByEmployee implements repository.ILeaveRepository.
+func (l *LeaveRepository) ListLeaveRequestsByEmployee(ctx context.Context, input *model.ListLeaveRequestsByEmployeeInput) ([]*model.LeaveRequest, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
+	}
+	rows, err := l.db.ListLeaveRequestsByEmployee(ctx, database.ListLeaveRequestsByEmployeeParams{
+		EmployeeID: pgtype.UUID{Valid: true, Bytes: input.EmployeeID},
+		Limit:      input.Limit,
+		Offset:     input.Offset,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return []*model.LeaveRequest{}, nil
+		}
+		return nil, err
+	}
+	result := make([]*model.LeaveRequest, 0, len(rows))
+	for _, r := range rows {
+		result = append(result, toLeaveRequestModel(r))
+	}
+	return result, nil
+}
+
+// ListPendingLeaveRequests implements repository.ILeaveRepository.
+func (l *LeaveRepository) ListPendingLeaveRequests(ctx context.Context, input *model.ListPendingLeaveRequestsInput) ([]*model.LeaveRequest, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
+	}
+	rows, err := l.db.ListPendingLeaveRequestsByCompany(ctx, database.ListPendingLeaveRequestsByCompanyParams{
+		CompanyID: pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+		Limit:     input.Limit,
+		Offset:    input.Offset,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return []*model.LeaveRequest{}, nil
+		}
+		return nil, err
+	}
+	result := make([]*model.LeaveRequest, 0, len(rows))
+	for _, r := range rows {
+		result = append(result, toLeaveRequestModel(r))
+	}
+	return result, nil
+}
+
+// ApproveLeaveRequest implements repository.ILeaveRepository.
+// Trả về false nếu đơn không còn ở trạng thái chờ duyệt.
+func (l *LeaveRepository) ApproveLeaveRequest(ctx context.Context, input *model.ApproveLeaveRequestInput) (bool, error) {
+	if input == nil {
+		return false, errors.New("input cannot be nil")
+	}
+	rows, err := l.db.ApproveLeaveRequest(ctx, database.ApproveLeaveRequestParams{
+		RequestID:  pgtype.UUID{Valid: true, Bytes: input.RequestID},
+		CompanyID:  pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+		ApprovedBy: pgtype.UUID{Valid: true, Bytes: input.ApprovedBy},
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// RejectLeaveRequest implements repository.ILeaveRepository.
+func (l *LeaveRepository) RejectLeaveRequest(ctx context.Context, input *model.RejectLeaveRequestInput) (bool, error) {
+	if input == nil {
+		return false, errors.New("input cannot be nil")
+	}
+	rows, err := l.db.RejectLeaveRequest(ctx, database.RejectLeaveRequestParams{
+		RequestID:       pgtype.UUID{Valid: true, Bytes: input.RequestID},
+		CompanyID:       pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+		ApprovedBy:      pgtype.UUID{Valid: true, Bytes: input.ApprovedBy},
+		RejectionReason: toPgText(input.RejectionReason),
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// CancelLeaveRequest implements repository.ILeaveRepository.
+func (l *LeaveRepository) CancelLeaveRequest(ctx context.Context, input *model.CancelLeaveRequestInput) (bool, error) {
+	if input == nil {
+		return false, errors.New("input cannot be nil")
+	}
+	rows, err := l.db.CancelLeaveRequest(ctx, database.CancelLeaveRequestParams{
+		RequestID:  pgtype.UUID{Valid: true, Bytes: input.RequestID},
+		EmployeeID: pgtype.UUID{Valid: true, Bytes: input.EmployeeID},
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// NewLeaveRepository create new instance and implement ILeaveRepository
+func NewLeaveRepository(
+	postgresConnect *pgxpool.Pool,
+) domainRepo.ILeaveRepository {
+	return &LeaveRepository{
+		db:   database.New(postgresConnect),
+		pool: postgresConnect,
+	}
+}
+
+func toLeaveRequestModel(r database.LeaveRequest) *model.LeaveRequest {
+	out := &model.LeaveRequest{
+		RequestID:       r.RequestID.Bytes,
+		CompanyID:       r.CompanyID.Bytes,
+		EmployeeID:      r.EmployeeID.Bytes,
+		LeaveType:       int(r.LeaveType),
+		StartDate:       fromPgDate(r.StartDate),
+		EndDate:         fromPgDate(r.EndDate),
+		Reason:          fromPgText(r.Reason),
+		Status:          int(r.Status),
+		RejectionReason: fromPgText(r.RejectionReason),
+		CreatedAt:       fromPgTimestamptz(r.CreatedAt),
+		UpdatedAt:       fromPgTimestamptz(r.UpdatedAt),
+	}
+	if r.ApprovedBy.Valid {
+		approvedBy := uuid.UUID(r.ApprovedBy.Bytes)
+		out.ApprovedBy = &approvedBy
+	}
+	if r.ApprovedAt.Valid {
+		approvedAt := r.ApprovedAt.Time
+		out.ApprovedAt = &approvedAt
+	}
+	return out
+}
@@ -123,6 +123,17 @@ func toPgTime(t time.Time) pgtype.Time {
 	return pgtype.Time{Microseconds: microseconds, Valid: true}
 }
 
+func toPgTimePtr(t *time.Time) pgtype.Time {
+	if t == nil {
+		return pgtype.Time{}
+	}
+	return toPgTime(*t)
+}
+
+func toPgBool(b bool) pgtype.Bool {
+	return pgtype.Bool{Bool: b, Valid: true}
+}
+
 func toPgInt4(i int32) pgtype.Int4 {
 	return pgtype.Int4{Int32: i, Valid: true}
 }
@@ -150,6 +161,14 @@ func fromPgTime(t pgtype.Time) time.Time {
 		int(hours), int(minutes), int(seconds), int(nanoseconds), now.Location())
 }
 
+func fromPgTimePtr(t pgtype.Time) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := fromPgTime(t)
+	return &v
+}
+
 func fromPgInt4(i pgtype.Int4) int32 {
 	if i.Valid {
 		return i.Int32
@@ -187,6 +206,10 @@ func (s *ShiftRepository) CreateShift(ctx context.Context, input *model.CreateSh
 		GracePeriodMinutes:    toPgInt4(input.GracePeriodMinutes),
 		EarlyDepartureMinutes: toPgInt4(input.EarlyDepartureMinutes),
 		WorkDays:              input.WorkDays,
+		IsFlexible:            toPgBool(input.IsFlexible),
+		CoreStartTime:         toPgTimePtr(input.CoreStartTime),
+		CoreEndTime:           toPgTimePtr(input.CoreEndTime),
+		RequiredWorkMinutes:   toPgInt4(input.RequiredWorkMinutes),
 	})
 	if err != nil {
 		return uuid.UUID{}, err
@@ -226,6 +249,9 @@ func (s *ShiftRepository) ListShifts(ctx context.Context, input *model.ListShift
 			EarlyDepartureMinutes: fromPgInt4(r.EarlyDepartureMinutes),
 			WorkDays:              r.WorkDays,
 			IsFlexible:            fromPgBool(r.IsFlexible),
+			CoreStartTime:         fromPgTimePtr(r.CoreStartTime),
+			CoreEndTime:           fromPgTimePtr(r.CoreEndTime),
+			RequiredWorkMinutes:   fromPgInt4(r.RequiredWorkMinutes),
 			OvertimeAfterMinutes:  fromPgInt4(r.OvertimeAfterMinutes),
 			IsActive:              fromPgBool(r.IsActive),
 			CreatedAt:             fromPgTimestamptz(r.CreatedAt),
@@ -256,6 +282,9 @@ func (s *ShiftRepository) GetShiftByID(ctx context.Context, shiftID uuid.UUID) (
 		EarlyDepartureMinutes: fromPgInt4(r.EarlyDepartureMinutes),
 		WorkDays:              r.WorkDays,
 		IsFlexible:            fromPgBool(r.IsFlexible),
+		CoreStartTime:         fromPgTimePtr(r.CoreStartTime),
+		CoreEndTime:           fromPgTimePtr(r.CoreEndTime),
+		RequiredWorkMinutes:   fromPgInt4(r.RequiredWorkMinutes),
 		OvertimeAfterMinutes:  fromPgInt4(r.OvertimeAfterMinutes),
 		IsActive:              fromPgBool(r.IsActive),
 		CreatedAt:             fromPgTimestamptz(r.CreatedAt),
@@ -277,6 +306,10 @@ func (s *ShiftRepository) UpdateTimeShift(ctx context.Context, input *model.Upda
 		GracePeriodMinutes:    toPgInt4(input.GracePeriodMinutes),
 		EarlyDepartureMinutes: toPgInt4(input.EarlyDepartureMinutes),
 		WorkDays:              input.WorkDays,
+		IsFlexible:            toPgBool(input.IsFlexible),
+		CoreStartTime:         toPgTimePtr(input.CoreStartTime),
+		CoreEndTime:           toPgTimePtr(input.CoreEndTime),
+		RequiredWorkMinutes:   toPgInt4(input.RequiredWorkMinutes),
 	})
 }
 
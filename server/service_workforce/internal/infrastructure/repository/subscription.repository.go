@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/model"
+	domainRepo "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/repository"
+	database "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/infrastructure/gen"
+)
+
+/**
+ * Company subscription repository implementation
+ */
+type SubscriptionRepository struct {
+	db *database.Queries
+}
+
+// GetCompanySubscription implements repository.ISubscriptionRepository.
+func (s *SubscriptionRepository) GetCompanySubscription(ctx context.Context, companyID uuid.UUID) (*model.GetCompanySubscriptionOutput, error) {
+	r, err := s.db.GetCompanySubscription(ctx, pgtype.UUID{Valid: true, Bytes: companyID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	out := &model.GetCompanySubscriptionOutput{
+		CompanyID: r.CompanyID.Bytes,
+		Status:    int(r.Status.Int16),
+		Plan:      int(r.SubscriptionPlan.Int16),
+	}
+	if r.SubscriptionStartDate.Valid {
+		startDate := fromPgDate(r.SubscriptionStartDate)
+		out.StartDate = &startDate
+	}
+	if r.SubscriptionEndDate.Valid {
+		endDate := fromPgDate(r.SubscriptionEndDate)
+		out.EndDate = &endDate
+	}
+	if r.MaxEmployees.Valid {
+		maxEmployees := int(r.MaxEmployees.Int32)
+		out.MaxEmployees = &maxEmployees
+	}
+	if r.MaxDevices.Valid {
+		maxDevices := int(r.MaxDevices.Int32)
+		out.MaxDevices = &maxDevices
+	}
+	return out, nil
+}
+
+// CountEmployeesInCompany implements repository.ISubscriptionRepository.
+func (s *SubscriptionRepository) CountEmployeesInCompany(ctx context.Context, input *model.CountEmployeesInCompanyInput) (int64, error) {
+	if input == nil {
+		return 0, errors.New("input cannot be nil")
+	}
+	excludeIDs := make([]pgtype.UUID, 0, len(input.ExcludeEmployeeIDs))
+	for _, id := range input.ExcludeEmployeeIDs {
+		excludeIDs = append(excludeIDs, pgtype.UUID{Valid: true, Bytes: id})
+	}
+	return s.db.CountEmployeesInCompanyExclude(ctx, database.CountEmployeesInCompanyExcludeParams{
+		CompanyID:          pgtype.UUID{Valid: true, Bytes: input.CompanyID},
+		ExcludeEmployeeIds: excludeIDs,
+	})
+}
+
+// NewSubscriptionRepository create new instance and implement ISubscriptionRepository
+func NewSubscriptionRepository(
+	postgresConnect *pgxpool.Pool,
+) domainRepo.ISubscriptionRepository {
+	return &SubscriptionRepository{
+		db: database.New(postgresConnect),
+	}
+}
@@ -0,0 +1,103 @@
+-- Table: company_holidays
+-- holiday_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+-- company_id UUID NOT NULL REFERENCES companies(company_id) ON DELETE CASCADE,
+-- holiday_date DATE NOT NULL,
+-- name VARCHAR(255) NOT NULL,
+-- description TEXT,
+-- created_by UUID REFERENCES users(user_id),
+-- UNIQUE (company_id, holiday_date)
+
+-- Table: leave_requests
+-- request_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+-- company_id UUID NOT NULL, employee_id UUID NOT NULL,
+-- leave_type INT2 (0=annual, 1=sick, 2=unpaid, 3=other),
+-- start_date DATE NOT NULL, end_date DATE NOT NULL, reason TEXT,
+-- status INT2 (0=pending, 1=approved, 2=rejected, 3=cancelled),
+-- approved_by UUID, approved_at TIMESTAMPTZ, rejection_reason TEXT
+
+-- name: CreateCompanyHoliday :one
+INSERT INTO company_holidays (
+    company_id, holiday_date, name, description, created_by
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (company_id, holiday_date) DO UPDATE
+SET name = EXCLUDED.name,
+    description = EXCLUDED.description,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING holiday_id;
+
+-- name: DeleteCompanyHoliday :execrows
+DELETE FROM company_holidays
+WHERE holiday_id = $1 AND company_id = $2;
+
+-- name: ListCompanyHolidays :many
+SELECT holiday_id, company_id, holiday_date, name, description, created_by, created_at, updated_at
+FROM company_holidays
+WHERE company_id = $1
+    AND holiday_date >= $2
+    AND holiday_date <= $3
+ORDER BY holiday_date ASC;
+
+-- name: CreateLeaveRequest :one
+INSERT INTO leave_requests (
+    company_id, employee_id, leave_type, start_date, end_date, reason
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+RETURNING request_id;
+
+-- name: CountOverlappingLeaveRequests :one
+-- Overlap: existing.start_date <= new end_date AND existing.end_date >= new start_date
+SELECT COUNT(1)
+FROM leave_requests
+WHERE employee_id = $1
+    AND status IN (0, 1)
+    AND start_date <= $2
+    AND end_date >= $3;
+
+-- name: GetLeaveRequestByID :one
+SELECT request_id, company_id, employee_id, leave_type, start_date, end_date, reason, status, approved_by, approved_at, rejection_reason, meta_data, created_at, updated_at
+FROM leave_requests
+WHERE request_id = $1 AND company_id = $2
+LIMIT 1;
+
+-- name: ListLeaveRequestsByEmployee :many
+SELECT request_id, company_id, employee_id, leave_type, start_date, end_date, reason, status, approved_by, approved_at, rejection_reason, meta_data, created_at, updated_at
+FROM leave_requests
+WHERE employee_id = $1
+ORDER BY start_date DESC
+LIMIT $2 OFFSET $3;
+
+-- name: ListPendingLeaveRequestsByCompany :many
+SELECT request_id, company_id, employee_id, leave_type, start_date, end_date, reason, status, approved_by, approved_at, rejection_reason, meta_data, created_at, updated_at
+FROM leave_requests
+WHERE company_id = $1 AND status = 0
+ORDER BY created_at ASC
+LIMIT $2 OFFSET $3;
+
+-- name: ApproveLeaveRequest :execrows
+UPDATE leave_requests
+SET status = 1,
+    approved_by = $3,
+    approved_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE request_id = $1 AND company_id = $2 AND status = 0;
+
+-- name: RejectLeaveRequest :execrows
+UPDATE leave_requests
+SET status = 2,
+    approved_by = $3,
+    approved_at = CURRENT_TIMESTAMP,
+    rejection_reason = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE request_id = $1 AND company_id = $2 AND status = 0;
+
+-- name: CancelLeaveRequest :execrows
+-- Employee can cancel a pending request, or an approved one that has not started yet
+UPDATE leave_requests
+SET status = 3,
+    updated_at = CURRENT_TIMESTAMP
+WHERE request_id = $1
+    AND employee_id = $2
+    AND (status = 0 OR (status = 1 AND start_date > CURRENT_DATE));
@@ -13,7 +13,10 @@
 -- overtime_after_minutes INTEGER DEFAULT 480, -- 8 hours = 480 minutes
 -- is_active BOOLEAN DEFAULT TRUE,
 -- created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
--- updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+-- updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+-- core_start_time TIME, -- Flexible shift core hours
+-- core_end_time TIME,
+-- required_work_minutes INTEGER DEFAULT 0 -- Flexible shift required duration
 -- )
 
 -- name: GetListEmployeeInShift :many
@@ -108,11 +111,13 @@ LIMIT 1;
 INSERT INTO work_shifts (
   company_id, name, description, start_time, end_time,
   break_duration_minutes, grace_period_minutes, early_departure_minutes,
-  work_days, is_active
+  work_days, is_flexible, core_start_time, core_end_time,
+  required_work_minutes, is_active
 ) VALUES (
     $1, $2, $3, $4, $5,
     $6, $7, $8,
-    $9, TRUE
+    $9, $10, $11, $12,
+    $13, TRUE
 )
 RETURNING shift_id;
 
@@ -136,6 +141,10 @@ SET start_time = $2,
     grace_period_minutes = $5,
     early_departure_minutes = $6,
     work_days = $7,
+    is_flexible = $8,
+    core_start_time = $9,
+    core_end_time = $10,
+    required_work_minutes = $11,
     updated_at = now()
 WHERE shift_id = $1;
 
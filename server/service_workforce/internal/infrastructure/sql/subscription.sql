@@ -0,0 +1,19 @@
+-- name: GetCompanySubscription :one
+SELECT
+    company_id,
+    status,
+    subscription_plan,
+    subscription_start_date,
+    subscription_end_date,
+    max_employees,
+    max_devices
+FROM companies
+WHERE company_id = $1
+LIMIT 1;
+
+-- name: CountEmployeesInCompanyExclude :one
+SELECT COUNT(*)
+FROM employees
+WHERE company_id = sqlc.arg('company_id')
+    AND status <> 1
+    AND NOT (employee_id = ANY(sqlc.arg('exclude_employee_ids')::uuid[]));
@@ -0,0 +1,39 @@
+package dto
+
+// =======================================
+// Leave and holiday DTO
+// =======================================
+
+// CreateHolidayReq request
+type CreateHolidayReq struct {
+	CompanyId   string `json:"company_id,omitempty"` // Chỉ admin hệ thống được truyền công ty khác
+	HolidayDate int64  `json:"holiday_date" validate:"required"`
+	Name        string `json:"name" validate:"required,max=255"`
+	Description string `json:"description,omitempty"`
+}
+
+// CreateLeaveRequestReq request
+type CreateLeaveRequestReq struct {
+	LeaveType int    `json:"leave_type" validate:"gte=0,lte=3"`
+	StartDate int64  `json:"start_date" validate:"required"`
+	EndDate   int64  `json:"end_date" validate:"required"`
+	Reason    string `json:"reason,omitempty" validate:"max=1000"`
+}
+
+// ApproveLeaveRequestReq request
+type ApproveLeaveRequestReq struct {
+	CompanyId string `json:"company_id,omitempty"`
+	RequestId string `json:"request_id" validate:"required"`
+}
+
+// RejectLeaveRequestReq request
+type RejectLeaveRequestReq struct {
+	CompanyId string `json:"company_id,omitempty"`
+	RequestId string `json:"request_id" validate:"required"`
+	Reason    string `json:"reason" validate:"required,max=1000"`
+}
+
+// CancelLeaveRequestReq request
+type CancelLeaveRequestReq struct {
+	RequestId string `json:"request_id" validate:"required"`
+}
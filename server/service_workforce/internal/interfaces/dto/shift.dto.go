@@ -23,6 +23,10 @@ type EditShiftReq struct {
 	GracePeriodMinutes    int    `json:"grace_period_minutes" validate:"gte=0"`
 	EarlyDepartureMinutes int    `json:"early_departure_minutes" validate:"gte=0"`
 	WorkDays              []int  `json:"work_days" validate:"required"`
+	IsFlexible            bool   `json:"is_flexible"`
+	CoreStartTime         int64  `json:"core_start_time,omitempty"`
+	CoreEndTime           int64  `json:"core_end_time,omitempty"`
+	RequiredWorkMinutes   int    `json:"required_work_minutes" validate:"gte=0"`
 }
 
 // Get detail shift
@@ -40,6 +44,10 @@ type CreateShiftReq struct {
 	GracePeriodMinutes    int    `json:"grace_period_minutes" validate:"gte=0"`
 	EarlyDepartureMinutes int    `json:"early_departure_minutes" validate:"gte=0"`
 	WorkDays              []int  `json:"work_days" validate:"required"`
+	IsFlexible            bool   `json:"is_flexible"`
+	CoreStartTime         int64  `json:"core_start_time,omitempty"`
+	CoreEndTime           int64  `json:"core_end_time,omitempty"`
+	RequiredWorkMinutes   int    `json:"required_work_minutes" validate:"gte=0"`
 }
 
 // Get shift info base (for user)
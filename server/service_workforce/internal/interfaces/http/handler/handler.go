@@ -38,6 +38,17 @@ type iHandler interface {
 	AddShiftEmployeeList(*gin.Context)
 	GetInfoEmployeeDonotInShift(*gin.Context)
 	GetInfoEmployeeInShift(*gin.Context)
+	// For holiday calendar
+	CreateHoliday(*gin.Context)
+	DeleteHoliday(*gin.Context)
+	GetListHoliday(*gin.Context)
+	// For leave request
+	CreateLeaveRequest(*gin.Context)
+	GetListMyLeaveRequest(*gin.Context)
+	CancelLeaveRequest(*gin.Context)
+	GetListPendingLeaveRequest(*gin.Context)
+	ApproveLeaveRequest(*gin.Context)
+	RejectLeaveRequest(*gin.Context)
 }
 
 /**
@@ -653,6 +664,10 @@ func (h *Handler) CreateShift(c *gin.Context) {
 			GracePeriodMinutes:    req.GracePeriodMinutes,
 			EarlyDepartureMinutes: req.EarlyDepartureMinutes,
 			WorkDays:              req.WorkDays,
+			IsFlexible:            req.IsFlexible,
+			CoreStartTime:         unixToTimePtr(req.CoreStartTime),
+			CoreEndTime:           unixToTimePtr(req.CoreEndTime),
+			RequiredWorkMinutes:   req.RequiredWorkMinutes,
 		},
 	)
 	if errReq != nil {
@@ -966,6 +981,10 @@ func (h *Handler) EditShift(c *gin.Context) {
 			GracePeriodMinutes:    req.GracePeriodMinutes,
 			EarlyDepartureMinutes: req.EarlyDepartureMinutes,
 			WorkDays:              req.WorkDays,
+			IsFlexible:            req.IsFlexible,
+			CoreStartTime:         unixToTimePtr(req.CoreStartTime),
+			CoreEndTime:           unixToTimePtr(req.CoreEndTime),
+			RequiredWorkMinutes:   req.RequiredWorkMinutes,
 		},
 	)
 	if errReq != nil {
@@ -1282,6 +1301,15 @@ func (h *Handler) GetShiftUserWithEffectiveDate(c *gin.Context) {
 	response.SuccessResponse(c, 200, reps)
 }
 
+// unixToTimePtr chuyển unix timestamp sang *time.Time, trả về nil nếu không có giá trị
+func unixToTimePtr(ts int64) *time.Time {
+	if ts <= 0 {
+		return nil
+	}
+	t := time.Unix(ts, 0)
+	return &t
+}
+
 /**
  * New handler and impl interface
  */
@@ -0,0 +1,659 @@
+package handler
+
+import (
+	"strconv"
+	"time"
+
+	gin "github.com/gin-gonic/gin"
+	validator "github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/application/model"
+	applicationService "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/application/service"
+	constants "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/constants"
+	dto "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/interfaces/dto"
+	response "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/interfaces/response"
+	contextShared "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/shared/utils/context"
+	uuidShared "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/shared/utils/uuid"
+)
+
+// CreateHoliday implements iHandler.
+// @Summary      Create company holiday
+// @Description  Add a day to the company holiday calendar, attendance on this day is recorded as holiday
+// @Tags         Holiday
+// @Accept       json
+// @Produce      json
+// @Param		 authorization header string true "Bearer <token>"
+// @Param        dto body dto.CreateHolidayReq true "Create Holiday Request"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/holiday [post]
+func (h *Handler) CreateHoliday(g *gin.Context) {
+	var req dto.CreateHolidayReq
+	if err := g.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponse(g, 400, "Data input error")
+		return
+	}
+	// Validate req
+	validateMiddleware, ok := g.Get(constants.MIDDLEWARE_VALIDATE_SERVICE_NAME)
+	if !ok {
+		response.ErrorResponse(g, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	validate, ok := validateMiddleware.(*validator.Validate)
+	if !ok {
+		response.ErrorResponse(g, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	if err := validate.Struct(&req); err != nil {
+		response.ErrorResponse(g, 400, "Validation error")
+		return
+	}
+	// Get data auth from context
+	userId, sessionId, userRole, companyId, ok := contextShared.GetSessionFromContext(g)
+	if !ok {
+		response.ErrorResponse(g, response.ErrorCodeAuthSessionInvalid, "Invalid auth session")
+		return
+	}
+	var companyUuid uuid.UUID
+	if companyId != "" {
+		companyUuid, _ = uuidShared.ParseUUID(companyId)
+	}
+	userUuid, _ := uuidShared.ParseUUID(userId)
+	sessionUuid, _ := uuidShared.ParseUUID(sessionId)
+	var targetCompanyUuid uuid.UUID
+	if req.CompanyId != "" {
+		parsed, err := uuidShared.ParseUUID(req.CompanyId)
+		if err != nil {
+			response.ErrorResponse(g, 400, "Invalid company ID")
+			return
+		}
+		targetCompanyUuid = parsed
+	}
+	// Call service create holiday
+	resp, errResp := applicationService.GetLeaveService().CreateHoliday(
+		g,
+		&applicationModel.CreateHolidayInput{
+			// User info
+			UserId:      userUuid,
+			SessionId:   sessionUuid,
+			Role:        userRole,
+			ClientIp:    g.ClientIP(),
+			ClientAgent: g.Request.UserAgent(),
+			CompanyId:   companyUuid,
+			//
+			TargetCompanyId: targetCompanyUuid,
+			HolidayDate:     time.Unix(req.HolidayDate, 0),
+			Name:            req.Name,
+			Description:     req.Description,
+		},
+	)
+	if errResp != nil {
+		if errResp.ErrorSystem != nil {
+			response.ErrorResponse(g, response.ErrorCodeSystemTemporary, "Internal server error")
+			return
+		}
+		response.ErrorResponse(g, 400, errResp.ErrorClient)
+		return
+	}
+	response.SuccessResponse(g, 200, resp)
+}
+
+// DeleteHoliday implements iHandler.
+// @Summary      Delete company holiday
+// @Description  Remove a day from the company holiday calendar
+// @Tags         Holiday
+// @Accept       json
+// @Produce      json
+// @Param		 authorization header string true "Bearer <token>"
+// @Param        id  path string  true  "Holiday ID"
+// @Param        company_id query string false "Company ID (system admin only)"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/holiday/{id} [delete]
+func (h *Handler) DeleteHoliday(g *gin.Context) {
+	holidayUuid, err := uuidShared.ParseUUID(g.Param("id"))
+	if err != nil {
+		response.ErrorResponse(g, 400, "Invalid holiday ID")
+		return
+	}
+	var targetCompanyUuid uuid.UUID
+	if companyIdQuery := g.Query("company_id"); companyIdQuery != "" {
+		targetCompanyUuid, err = uuidShared.ParseUUID(companyIdQuery)
+		if err != nil {
+			response.ErrorResponse(g, 400, "Invalid company ID")
+			return
+		}
+	}
+	// Get data auth from context
+	userId, sessionId, userRole, companyId, ok := contextShared.GetSessionFromContext(g)
+	if !ok {
+		response.ErrorResponse(g, response.ErrorCodeAuthSessionInvalid, "Invalid auth session")
+		return
+	}
+	var companyUuid uuid.UUID
+	if companyId != "" {
+		companyUuid, _ = uuidShared.ParseUUID(companyId)
+	}
+	userUuid, _ := uuidShared.ParseUUID(userId)
+	sessionUuid, _ := uuidShared.ParseUUID(sessionId)
+	// Call service delete holiday
+	errResp := applicationService.GetLeaveService().DeleteHoliday(
+		g,
+		&applicationModel.DeleteHolidayInput{
+			// User info
+			UserId:      userUuid,
+			SessionId:   sessionUuid,
+			Role:        userRole,
+			ClientIp:    g.ClientIP(),
+			ClientAgent: g.Request.UserAgent(),
+			CompanyId:   companyUuid,
+			//
+			TargetCompanyId: targetCompanyUuid,
+			HolidayId:       holidayUuid,
+		},
+	)
+	if errResp != nil {
+		if errResp.ErrorSystem != nil {
+			response.ErrorResponse(g, response.ErrorCodeSystemTemporary, "Internal server error")
+			return
+		}
+		response.ErrorResponse(g, 400, errResp.ErrorClient)
+		return
+	}
+	response.SuccessResponse(g, 200, nil)
+}
+
+// GetListHoliday implements iHandler.
+// @Summary      Get company holiday calendar
+// @Description  Get company holidays in a date range
+// @Tags         Holiday
+// @Accept       json
+// @Produce      json
+// @Param		 authorization header string true "Bearer <token>"
+// @Param        from query int true "From date (unix seconds)"
+// @Param        to query int true "To date (unix seconds)"
+// @Param        company_id query string false "Company ID (system admin only)"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/holiday [get]
+func (h *Handler) GetListHoliday(g *gin.Context) {
+	from, err := strconv.ParseInt(g.Query("from"), 10, 64)
+	if err != nil {
+		response.ErrorResponse(g, 400, "Invalid from date")
+		return
+	}
+	to, err := strconv.ParseInt(g.Query("to"), 10, 64)
+	if err != nil || to < from {
+		response.ErrorResponse(g, 400, "Invalid to date")
+		return
+	}
+	var targetCompanyUuid uuid.UUID
+	if companyIdQuery := g.Query("company_id"); companyIdQuery != "" {
+		targetCompanyUuid, err = uuidShared.ParseUUID(companyIdQuery)
+		if err != nil {
+			response.ErrorResponse(g, 400, "Invalid company ID")
+			return
+		}
+	}
+	// Get data auth from context
+	userId, sessionId, userRole, companyId, ok := contextShared.GetSessionFromContext(g)
+	if !ok {
+		response.ErrorResponse(g, response.ErrorCodeAuthSessionInvalid, "Invalid auth session")
+		return
+	}
+	var companyUuid uuid.UUID
+	if companyId != "" {
+		companyUuid, _ = uuidShared.ParseUUID(companyId)
+	}
+	userUuid, _ := uuidShared.ParseUUID(userId)
+	sessionUuid, _ := uuidShared.ParseUUID(sessionId)
+	// Call service get list holiday
+	resp, errResp := applicationService.GetLeaveService().GetListHoliday(
+		g,
+		&applicationModel.GetListHolidayInput{
+			// User info
+			UserId:      userUuid,
+			SessionId:   sessionUuid,
+			Role:        userRole,
+			ClientIp:    g.ClientIP(),
+			ClientAgent: g.Request.UserAgent(),
+			CompanyId:   companyUuid,
+			//
+			TargetCompanyId: targetCompanyUuid,
+			From:            time.Unix(from, 0),
+			To:              time.Unix(to, 0),
+		},
+	)
+	if errResp != nil {
+		if errResp.ErrorSystem != nil {
+			response.ErrorResponse(g, response.ErrorCodeSystemTemporary, "Internal server error")
+			return
+		}
+		response.ErrorResponse(g, 400, errResp.ErrorClient)
+		return
+	}
+	response.SuccessResponse(g, 200, resp)
+}
+
+// CreateLeaveRequest implements iHandler.
+// @Summary      Create leave request
+// @Description  Employee submits a leave request, pending manager approval
+// @Tags         Leave
+// @Accept       json
+// @Produce      json
+// @Param		 authorization header string true "Bearer <token>"
+// @Param        dto body dto.CreateLeaveRequestReq true "Create Leave Request"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/leave [post]
+func (h *Handler) CreateLeaveRequest(g *gin.Context) {
+	var req dto.CreateLeaveRequestReq
+	if err := g.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponse(g, 400, "Data input error")
+		return
+	}
+	// Validate req
+	validateMiddleware, ok := g.Get(constants.MIDDLEWARE_VALIDATE_SERVICE_NAME)
+	if !ok {
+		response.ErrorResponse(g, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	validate, ok := validateMiddleware.(*validator.Validate)
+	if !ok {
+		response.ErrorResponse(g, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	if err := validate.Struct(&req); err != nil {
+		response.ErrorResponse(g, 400, "Validation error")
+		return
+	}
+	// Get data auth from context
+	userId, sessionId, userRole, companyId, ok := contextShared.GetSessionFromContext(g)
+	if !ok {
+		response.ErrorResponse(g, response.ErrorCodeAuthSessionInvalid, "Invalid auth session")
+		return
+	}
+	var companyUuid uuid.UUID
+	if companyId != "" {
+		companyUuid, _ = uuidShared.ParseUUID(companyId)
+	}
+	userUuid, _ := uuidShared.ParseUUID(userId)
+	sessionUuid, _ := uuidShared.ParseUUID(sessionId)
+	// Call service create leave request
+	resp, errResp := applicationService.GetLeaveService().CreateLeaveRequest(
+		g,
+		&applicationModel.CreateLeaveRequestInput{
+			// User info
+			UserId:      userUuid,
+			SessionId:   sessionUuid,
+			Role:        userRole,
+			ClientIp:    g.ClientIP(),
+			ClientAgent: g.Request.UserAgent(),
+			CompanyId:   companyUuid,
+			//
+			LeaveType: req.LeaveType,
+			StartDate: time.Unix(req.StartDate, 0),
+			EndDate:   time.Unix(req.EndDate, 0),
+			Reason:    req.Reason,
+		},
+	)
+	if errResp != nil {
+		if errResp.ErrorSystem != nil {
+			response.ErrorResponse(g, response.ErrorCodeSystemTemporary, "Internal server error")
+			return
+		}
+		response.ErrorResponse(g, 400, errResp.ErrorClient)
+		return
+	}
+	response.SuccessResponse(g, 200, resp)
+}
+
+// GetListMyLeaveRequest implements iHandler.
+// @Summary      Get my leave requests
+// @Description  Employee fetches their own leave requests
+// @Tags         Leave
+// @Accept       json
+// @Produce      json
+// @Param		 authorization header string true "Bearer <token>"
+// @Param        page query int false "Page number"
+// @Param        size query int false "Page size"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/leave [get]
+func (h *Handler) GetListMyLeaveRequest(g *gin.Context) {
+	// Paging params
+	page, err := strconv.Atoi(g.DefaultQuery("page", constants.DEFAULT_PAGE_STRING))
+	if err != nil || page <= 0 {
+		response.ErrorResponse(g, 400, "Invalid page number")
+		return
+	}
+	size, err := strconv.Atoi(g.DefaultQuery("size", strconv.Itoa(constants.DEFAULT_PAGE_SIZE)))
+	if err != nil || size <= 0 {
+		response.ErrorResponse(g, 400, "Invalid page size")
+		return
+	}
+	// Get data auth from context
+	userId, sessionId, userRole, companyId, ok := contextShared.GetSessionFromContext(g)
+	if !ok {
+		response.ErrorResponse(g, response.ErrorCodeAuthSessionInvalid, "Invalid auth session")
+		return
+	}
+	var companyUuid uuid.UUID
+	if companyId != "" {
+		companyUuid, _ = uuidShared.ParseUUID(companyId)
+	}
+	userUuid, _ := uuidShared.ParseUUID(userId)
+	sessionUuid, _ := uuidShared.ParseUUID(sessionId)
+	// Call service get list leave request of current employee
+	resp, errResp := applicationService.GetLeaveService().GetListMyLeaveRequest(
+		g,
+		&applicationModel.GetListMyLeaveRequestInput{
+			// User info
+			UserId:      userUuid,
+			SessionId:   sessionUuid,
+			Role:        userRole,
+			ClientIp:    g.ClientIP(),
+			ClientAgent: g.Request.UserAgent(),
+			CompanyId:   companyUuid,
+			//
+			Page: page,
+			Size: size,
+		},
+	)
+	if errResp != nil {
+		if errResp.ErrorSystem != nil {
+			response.ErrorResponse(g, response.ErrorCodeSystemTemporary, "Internal server error")
+			return
+		}
+		response.ErrorResponse(g, 400, errResp.ErrorClient)
+		return
+	}
+	response.SuccessResponse(g, 200, resp)
+}
+
+// CancelLeaveRequest implements iHandler.
+// @Summary      Cancel leave request
+// @Description  Employee cancels a pending leave request, or an approved one that has not started yet
+// @Tags         Leave
+// @Accept       json
+// @Produce      json
+// @Param		 authorization header string true "Bearer <token>"
+// @Param        dto body dto.CancelLeaveRequestReq true "Cancel Leave Request"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/leave/cancel [post]
+func (h *Handler) CancelLeaveRequest(g *gin.Context) {
+	var req dto.CancelLeaveRequestReq
+	if err := g.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponse(g, 400, "Data input error")
+		return
+	}
+	requestUuid, err := uuidShared.ParseUUID(req.RequestId)
+	if err != nil {
+		response.ErrorResponse(g, 400, "Invalid request ID")
+		return
+	}
+	// Get data auth from context
+	userId, sessionId, userRole, companyId, ok := contextShared.GetSessionFromContext(g)
+	if !ok {
+		response.ErrorResponse(g, response.ErrorCodeAuthSessionInvalid, "Invalid auth session")
+		return
+	}
+	var companyUuid uuid.UUID
+	if companyId != "" {
+		companyUuid, _ = uuidShared.ParseUUID(companyId)
+	}
+	userUuid, _ := uuidShared.ParseUUID(userId)
+	sessionUuid, _ := uuidShared.ParseUUID(sessionId)
+	// Call service cancel leave request
+	errResp := applicationService.GetLeaveService().CancelLeaveRequest(
+		g,
+		&applicationModel.CancelLeaveRequestInput{
+			// User info
+			UserId:      userUuid,
+			SessionId:   sessionUuid,
+			Role:        userRole,
+			ClientIp:    g.ClientIP(),
+			ClientAgent: g.Request.UserAgent(),
+			CompanyId:   companyUuid,
+			//
+			RequestId: requestUuid,
+		},
+	)
+	if errResp != nil {
+		if errResp.ErrorSystem != nil {
+			response.ErrorResponse(g, response.ErrorCodeSystemTemporary, "Internal server error")
+			return
+		}
+		response.ErrorResponse(g, 400, errResp.ErrorClient)
+		return
+	}
+	response.SuccessResponse(g, 200, nil)
+}
+
+// GetListPendingLeaveRequest implements iHandler.
+// @Summary      Get pending leave requests
+// @Description  Manager fetches pending leave requests of the company
+// @Tags         Leave
+// @Accept       json
+// @Produce      json
+// @Param		 authorization header string true "Bearer <token>"
+// @Param        page query int false "Page number"
+// @Param        size query int false "Page size"
+// @Param        company_id query string false "Company ID (system admin only)"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/leave/pending [get]
+func (h *Handler) GetListPendingLeaveRequest(g *gin.Context) {
+	// Paging params
+	page, err := strconv.Atoi(g.DefaultQuery("page", constants.DEFAULT_PAGE_STRING))
+	if err != nil || page <= 0 {
+		response.ErrorResponse(g, 400, "Invalid page number")
+		return
+	}
+	size, err := strconv.Atoi(g.DefaultQuery("size", strconv.Itoa(constants.DEFAULT_PAGE_SIZE)))
+	if err != nil || size <= 0 {
+		response.ErrorResponse(g, 400, "Invalid page size")
+		return
+	}
+	var targetCompanyUuid uuid.UUID
+	if companyIdQuery := g.Query("company_id"); companyIdQuery != "" {
+		targetCompanyUuid, err = uuidShared.ParseUUID(companyIdQuery)
+		if err != nil {
+			response.ErrorResponse(g, 400, "Invalid company ID")
+			return
+		}
+	}
+	// Get data auth from context
+	userId, sessionId, userRole, companyId, ok := contextShared.GetSessionFromContext(g)
+	if !ok {
+		response.ErrorResponse(g, response.ErrorCodeAuthSessionInvalid, "Invalid auth session")
+		return
+	}
+	var companyUuid uuid.UUID
+	if companyId != "" {
+		companyUuid, _ = uuidShared.ParseUUID(companyId)
+	}
+	userUuid, _ := uuidShared.ParseUUID(userId)
+	sessionUuid, _ := uuidShared.ParseUUID(sessionId)
+	// Call service get list pending leave request
+	resp, errResp := applicationService.GetLeaveService().GetListPendingLeaveRequest(
+		g,
+		&applicationModel.GetListPendingLeaveRequestInput{
+			// User info
+			UserId:      userUuid,
+			SessionId:   sessionUuid,
+			Role:        userRole,
+			ClientIp:    g.ClientIP(),
+			ClientAgent: g.Request.UserAgent(),
+			CompanyId:   companyUuid,
+			//
+			TargetCompanyId: targetCompanyUuid,
+			Page:            page,
+			Size:            size,
+		},
+	)
+	if errResp != nil {
+		if errResp.ErrorSystem != nil {
+			response.ErrorResponse(g, response.ErrorCodeSystemTemporary, "Internal server error")
+			return
+		}
+		response.ErrorResponse(g, 400, errResp.ErrorClient)
+		return
+	}
+	response.SuccessResponse(g, 200, resp)
+}
+
+// ApproveLeaveRequest implements iHandler.
+// @Summary      Approve leave request
+// @Description  Manager approves a pending leave request
+// @Tags         Leave
+// @Accept       json
+// @Produce      json
+// @Param		 authorization header string true "Bearer <token>"
+// @Param        dto body dto.ApproveLeaveRequestReq true "Approve Leave Request"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/leave/approve [post]
+func (h *Handler) ApproveLeaveRequest(g *gin.Context) {
+	var req dto.ApproveLeaveRequestReq
+	if err := g.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponse(g, 400, "Data input error")
+		return
+	}
+	requestUuid, err := uuidShared.ParseUUID(req.RequestId)
+	if err != nil {
+		response.ErrorResponse(g, 400, "Invalid request ID")
+		return
+	}
+	var targetCompanyUuid uuid.UUID
+	if req.CompanyId != "" {
+		targetCompanyUuid, err = uuidShared.ParseUUID(req.CompanyId)
+		if err != nil {
+			response.ErrorResponse(g, 400, "Invalid company ID")
+			return
+		}
+	}
+	// Get data auth from context
+	userId, sessionId, userRole, companyId, ok := contextShared.GetSessionFromContext(g)
+	if !ok {
+		response.ErrorResponse(g, response.ErrorCodeAuthSessionInvalid, "Invalid auth session")
+		return
+	}
+	var companyUuid uuid.UUID
+	if companyId != "" {
+		companyUuid, _ = uuidShared.ParseUUID(companyId)
+	}
+	userUuid, _ := uuidShared.ParseUUID(userId)
+	sessionUuid, _ := uuidShared.ParseUUID(sessionId)
+	// Call service approve leave request
+	errResp := applicationService.GetLeaveService().ApproveLeaveRequest(
+		g,
+		&applicationModel.ApproveLeaveRequestInput{
+			// User info
+			UserId:      userUuid,
+			SessionId:   sessionUuid,
+			Role:        userRole,
+			ClientIp:    g.ClientIP(),
+			ClientAgent: g.Request.UserAgent(),
+			CompanyId:   companyUuid,
+			//
+			TargetCompanyId: targetCompanyUuid,
+			RequestId:       requestUuid,
+		},
+	)
+	if errResp != nil {
+		if errResp.ErrorSystem != nil {
+			response.ErrorResponse(g, response.ErrorCodeSystemTemporary, "Internal server error")
+			return
+		}
+		response.ErrorResponse(g, 400, errResp.ErrorClient)
+		return
+	}
+	response.SuccessResponse(g, 200, nil)
+}
+
+// RejectLeaveRequest implements iHandler.
+// @Summary      Reject leave request
+// @Description  Manager rejects a pending leave request with a reason
+// @Tags         Leave
+// @Accept       json
+// @Produce      json
+// @Param		 authorization header string true "Bearer <token>"
+// @Param        dto body dto.RejectLeaveRequestReq true "Reject Leave Request"
+// @Success      200  {object}  dto.ResponseData
+// @Failure      400  {object}  dto.ErrResponseData
+// @Router       /v1/leave/reject [post]
+func (h *Handler) RejectLeaveRequest(g *gin.Context) {
+	var req dto.RejectLeaveRequestReq
+	if err := g.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponse(g, 400, "Data input error")
+		return
+	}
+	// Validate req
+	validateMiddleware, ok := g.Get(constants.MIDDLEWARE_VALIDATE_SERVICE_NAME)
+	if !ok {
+		response.ErrorResponse(g, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	validate, ok := validateMiddleware.(*validator.Validate)
+	if !ok {
+		response.ErrorResponse(g, response.ErrorCodeSystemTemporary, "Internal server error")
+		return
+	}
+	if err := validate.Struct(&req); err != nil {
+		response.ErrorResponse(g, 400, "Validation error")
+		return
+	}
+	requestUuid, err := uuidShared.ParseUUID(req.RequestId)
+	if err != nil {
+		response.ErrorResponse(g, 400, "Invalid request ID")
+		return
+	}
+	var targetCompanyUuid uuid.UUID
+	if req.CompanyId != "" {
+		targetCompanyUuid, err = uuidShared.ParseUUID(req.CompanyId)
+		if err != nil {
+			response.ErrorResponse(g, 400, "Invalid company ID")
+			return
+		}
+	}
+	// Get data auth from context
+	userId, sessionId, userRole, companyId, ok := contextShared.GetSessionFromContext(g)
+	if !ok {
+		response.ErrorResponse(g, response.ErrorCodeAuthSessionInvalid, "Invalid auth session")
+		return
+	}
+	var companyUuid uuid.UUID
+	if companyId != "" {
+		companyUuid, _ = uuidShared.ParseUUID(companyId)
+	}
+	userUuid, _ := uuidShared.ParseUUID(userId)
+	sessionUuid, _ := uuidShared.ParseUUID(sessionId)
+	// Call service reject leave request
+	errResp := applicationService.GetLeaveService().RejectLeaveRequest(
+		g,
+		&applicationModel.RejectLeaveRequestInput{
+			// User info
+			UserId:      userUuid,
+			SessionId:   sessionUuid,
+			Role:        userRole,
+			ClientIp:    g.ClientIP(),
+			ClientAgent: g.Request.UserAgent(),
+			CompanyId:   companyUuid,
+			//
+			TargetCompanyId: targetCompanyUuid,
+			RequestId:       requestUuid,
+			Reason:          req.Reason,
+		},
+	)
+	if errResp != nil {
+		if errResp.ErrorSystem != nil {
+			response.ErrorResponse(g, response.ErrorCodeSystemTemporary, "Internal server error")
+			return
+		}
+		response.ErrorResponse(g, 400, errResp.ErrorClient)
+		return
+	}
+	response.SuccessResponse(g, 200, nil)
+}
@@ -16,15 +16,17 @@ type HttpRouterManager struct {
  * Initialize routes
  */
 func (r *HttpRouterManager) InitRoutes(group *gin.RouterGroup) {
+	// Chặn thao tác ghi khi gói dịch vụ của công ty đã hết hạn
+	readOnly := infraMiddleware.GetSubscriptionReadOnlyMiddleware().Apply()
 	shiftRouterV1 := group.Group("/v1/shift")
 	shiftRouterV1.Use(infraMiddleware.GetAuthAdminAccessTokenJwtMiddleware().Apply())
 	{
-		shiftRouterV1.GET("", handler.NewHandler().GetListShift) // Lay danh sach ca lam viec
-		shiftRouterV1.POST("", handler.NewHandler().CreateShift)              // Tao ca lam viec
-		shiftRouterV1.GET("/:id", handler.NewHandler().GetDetailShift)        // Xem chi tiet thong tin ca lam viec
-		shiftRouterV1.POST("/edit", handler.NewHandler().EditShift)           // Chinh sua ca lam viec
-		shiftRouterV1.DELETE("/:id", handler.NewHandler().DeleteShift)        // Xoa ca lam viec
-		shiftRouterV1.POST("/status", handler.NewHandler().ChangeStatusShift) // Thay doi trang thai ca lam viec
+		shiftRouterV1.GET("", handler.NewHandler().GetListShift)                        // Lay danh sach ca lam viec
+		shiftRouterV1.POST("", readOnly, handler.NewHandler().CreateShift)              // Tao ca lam viec
+		shiftRouterV1.GET("/:id", handler.NewHandler().GetDetailShift)                  // Xem chi tiet thong tin ca lam viec
+		shiftRouterV1.POST("/edit", readOnly, handler.NewHandler().EditShift)           // Chinh sua ca lam viec
+		shiftRouterV1.DELETE("/:id", readOnly, handler.NewHandler().DeleteShift)        // Xoa ca lam viec
+		shiftRouterV1.POST("/status", readOnly, handler.NewHandler().ChangeStatusShift) // Thay doi trang thai ca lam viec
 	}
 	shiftRouterV1Employee := group.Group("/v1/shift")
 	shiftRouterV1Employee.Use(infraMiddleware.GetAuthAccessTokenJwtMiddleware().Apply())
@@ -34,14 +36,39 @@ func (r *HttpRouterManager) InitRoutes(group *gin.RouterGroup) {
 	shiftEmployeeRouterV1 := group.Group("/v1/employee/shift")
 	shiftEmployeeRouterV1.Use(infraMiddleware.GetAuthAdminAccessTokenJwtMiddleware().Apply())
 	{
-		shiftEmployeeRouterV1.POST("", handler.NewHandler().GetShiftUserWithEffectiveDate)                 // Get shift for user with effective date
-		shiftEmployeeRouterV1.POST("/edit/effective", handler.NewHandler().EditShiftUserWithEffectiveDate) // Edit shift for user with effective date
-		shiftEmployeeRouterV1.POST("/enable", handler.NewHandler().EnableShiftUser)                        // Enable shift for user
-		shiftEmployeeRouterV1.POST("/disable", handler.NewHandler().DisableShiftUser)                      // Disable shift for user
-		shiftEmployeeRouterV1.POST("/delete", handler.NewHandler().DeleteShiftUser)                        // Delete shift for user
-		shiftEmployeeRouterV1.POST("/add", handler.NewHandler().AddShiftEmployee)                          // Add shift employee
-		shiftEmployeeRouterV1.POST("/add/list", handler.NewHandler().AddShiftEmployeeList)                 // Add shift employee list
-		shiftEmployeeRouterV1.POST("/not_in", handler.NewHandler().GetInfoEmployeeDonotInShift)            // Get info employee donot in shift
-		shiftEmployeeRouterV1.POST("/in", handler.NewHandler().GetInfoEmployeeInShift)                     // Get info employee in shift
+		shiftEmployeeRouterV1.POST("", handler.NewHandler().GetShiftUserWithEffectiveDate)                           // Get shift for user with effective date
+		shiftEmployeeRouterV1.POST("/edit/effective", readOnly, handler.NewHandler().EditShiftUserWithEffectiveDate) // Edit shift for user with effective date
+		shiftEmployeeRouterV1.POST("/enable", readOnly, handler.NewHandler().EnableShiftUser)                        // Enable shift for user
+		shiftEmployeeRouterV1.POST("/disable", handler.NewHandler().DisableShiftUser)                                // Disable shift for user, cho phép khi read-only như DELETE
+		shiftEmployeeRouterV1.POST("/delete", handler.NewHandler().DeleteShiftUser)                                  // Delete shift for user, cho phép khi read-only như DELETE
+		shiftEmployeeRouterV1.POST("/add", readOnly, handler.NewHandler().AddShiftEmployee)                          // Add shift employee
+		shiftEmployeeRouterV1.POST("/add/list", readOnly, handler.NewHandler().AddShiftEmployeeList)                 // Add shift employee list
+		shiftEmployeeRouterV1.POST("/not_in", handler.NewHandler().GetInfoEmployeeDonotInShift)                      // Get info employee donot in shift
+		shiftEmployeeRouterV1.POST("/in", handler.NewHandler().GetInfoEmployeeInShift)                               // Get info employee in shift
+	}
+	holidayRouterV1 := group.Group("/v1/holiday")
+	holidayRouterV1.Use(infraMiddleware.GetAuthAdminAccessTokenJwtMiddleware().Apply())
+	{
+		holidayRouterV1.POST("", readOnly, handler.NewHandler().CreateHoliday)       // Them ngay nghi le cong ty
+		holidayRouterV1.DELETE("/:id", readOnly, handler.NewHandler().DeleteHoliday) // Xoa ngay nghi le cong ty
+	}
+	holidayRouterV1Employee := group.Group("/v1/holiday")
+	holidayRouterV1Employee.Use(infraMiddleware.GetAuthAccessTokenJwtMiddleware().Apply())
+	{
+		holidayRouterV1Employee.GET("", handler.NewHandler().GetListHoliday) // Lay lich nghi le cong ty
+	}
+	leaveRouterV1 := group.Group("/v1/leave")
+	leaveRouterV1.Use(infraMiddleware.GetAuthAdminAccessTokenJwtMiddleware().Apply())
+	{
+		leaveRouterV1.GET("/pending", handler.NewHandler().GetListPendingLeaveRequest)     // Lay danh sach don nghi phep cho duyet
+		leaveRouterV1.POST("/approve", readOnly, handler.NewHandler().ApproveLeaveRequest) // Duyet don nghi phep
+		leaveRouterV1.POST("/reject", readOnly, handler.NewHandler().RejectLeaveRequest)   // Tu choi don nghi phep
+	}
+	leaveRouterV1Employee := group.Group("/v1/leave")
+	leaveRouterV1Employee.Use(infraMiddleware.GetAuthAccessTokenJwtMiddleware().Apply())
+	{
+		leaveRouterV1Employee.POST("", readOnly, handler.NewHandler().CreateLeaveRequest)        // Tao don nghi phep
+		leaveRouterV1Employee.GET("", handler.NewHandler().GetListMyLeaveRequest)                // Lay danh sach don nghi phep cua toi
+		leaveRouterV1Employee.POST("/cancel", readOnly, handler.NewHandler().CancelLeaveRequest) // Huy don nghi phep
 	}
 }
@@ -164,6 +164,21 @@ func GetKeyCacheListFriendsOfUser(userIdHash string, page int) string {
 	return fmt.Sprintf("user:friends:list:of:user:%s:%d", userIdHash, page)
 }
 
+// Key gói dịch vụ và giới hạn của công ty
+func GetKeyCompanySubscription(companyIdHash string) string {
+	return fmt.Sprintf("company:subscription:%s", companyIdHash)
+}
+
+// Key lock kiểm tra giới hạn nhân viên của công ty
+func GetKeyCompanyEmployeeQuotaLock(companyIdHash string) string {
+	return fmt.Sprintf("company:employee:quota:lock:%s", companyIdHash)
+}
+
 // =================================
 // 			Define value cache
 // =================================
+
+// Key lock approve/reject leave request
+func GetKeyLeaveApprovalLock(requestId string) string {
+	return fmt.Sprintf("leave:approval:lock:%s", requestId)
+}
@@ -1,6 +1,8 @@
 package start
 
 import (
+	"context"
+
 	applicationService "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/application/service"
 	applicationServiceImpl "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/application/service/impl"
 )
@@ -12,10 +14,23 @@ func initApplication() error {
 	if err := applicationService.SetShiftService(shiftServiceImpl); err != nil {
 		return err
 	}
+	// Init ISubscriptionService, must be initialized before IShiftEmployeeService
+	subscriptionServiceImpl := applicationServiceImpl.NewSubscriptionService()
+	if err := applicationService.SetSubscriptionService(subscriptionServiceImpl); err != nil {
+		return err
+	}
+	if err := subscriptionServiceImpl.ListenSubscriptionChanged(context.Background()); err != nil {
+		return err
+	}
 	// Init IShiftEmployeeService
 	shiftEmployeeServiceImpl := applicationServiceImpl.NewShiftEmployeeService()
 	if err := applicationService.SetShiftEmployeeService(shiftEmployeeServiceImpl); err != nil {
 		return err
 	}
+	// Init ILeaveService
+	leaveServiceImpl := applicationServiceImpl.NewLeaveService()
+	if err := applicationService.SetLeaveService(leaveServiceImpl); err != nil {
+		return err
+	}
 	return nil
 }
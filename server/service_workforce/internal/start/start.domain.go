@@ -1,8 +1,10 @@
 package start
 
 import (
+	domainAttendance "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/attendance"
 	domainRepository "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/repository"
 	domainToken "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/domain/token"
+	infraAttendance "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/infrastructure/attendance"
 	infraConn "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/infrastructure/conn"
 	infraRepository "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/infrastructure/repository"
 	infraToken "github.com/youknow2509/cio_verify_face/server/service_workforce/internal/infrastructure/token"
@@ -37,12 +39,32 @@ func initDomain() error {
 	); err != nil {
 		return err
 	}
+	// initialize ILeaveRepository
+	if err := domainRepository.SetLeaveRepository(
+		infraRepository.NewLeaveRepository(postgres),
+	); err != nil {
+		return err
+	}
+	// initialize ISubscriptionRepository
+	if err := domainRepository.SetSubscriptionRepository(
+		infraRepository.NewSubscriptionRepository(postgres),
+	); err != nil {
+		return err
+	}
 	// initialize token service
 	if err := domainToken.SetTokenService(
 		infraToken.NewTokenService(grpcClient),
 	); err != nil {
 		return err
 	}
+	// initialize attendance service
+	if attendanceGrpcClient != nil {
+		if err := domainAttendance.SetAttendanceService(
+			infraAttendance.NewAttendanceService(attendanceGrpcClient),
+		); err != nil {
+			return err
+		}
+	}
 	// ============================================
 
 	// v.v
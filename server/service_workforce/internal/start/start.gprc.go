@@ -13,6 +13,8 @@ import (
 
 var (
 	grpcClient pb.AuthServiceClient
+	// nil nếu service_attendance bị tắt, bản tổng hợp không được tính lại khi ngày nghỉ thay đổi
+	attendanceGrpcClient pb.AttendanceServiceClient
 )
 
 // init client grpc
@@ -47,6 +49,35 @@ func initClientGrpc() error {
 		return fmt.Errorf("failed to connect to gRPC server: %w", err)
 	}
 	grpcClient = pb.NewAuthServiceClient(conn)
+	return initAttendanceClientGrpc()
+}
+
+func initAttendanceClientGrpc() error {
+	config := global.SettingServer.AttendanceService
+	if !config.Enabled {
+		global.Logger.Warn("attendance gRPC client is disabled, daily summaries will not be recomputed on leave/holiday changes")
+		return nil
+	}
+	var opts []grpc.DialOption
+	if config.Tls.Enabled {
+		creds, err := credentials.NewClientTLSFromFile(config.Tls.CertFile, "")
+		if err != nil {
+			return fmt.Errorf("failed to load TLS credentials: %w", err)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+		Time:                time.Duration(config.KeepaliveTimeMs) * time.Millisecond,
+		Timeout:             time.Duration(config.KeepaliveTimeoutMs) * time.Millisecond,
+		PermitWithoutStream: config.KeepalivePermitWithoutCalls,
+	}))
+	conn, err := grpc.Dial(config.GrpcAddr, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to attendance gRPC server: %w", err)
+	}
+	attendanceGrpcClient = pb.NewAttendanceServiceClient(conn)
 	return nil
 }
 
@@ -8,6 +8,6 @@ mkdir -p proto/pb
 # Generate Go code from proto files
 protoc --go_out=. --go_opt=paths=source_relative \
     --go-grpc_out=. --go-grpc_opt=paths=source_relative \
-    proto/auth.proto
+    proto/auth.proto proto/attendance.proto
 
 echo "gRPC code generation completed!"
\ No newline at end of file
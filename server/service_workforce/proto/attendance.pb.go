@@ -0,0 +1,3187 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.0
+// source: attendance.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BatchItemStatus int32
+
+const (
+	BatchItemStatus_BATCH_ITEM_STATUS_UNSPECIFIED BatchItemStatus = 0
+	BatchItemStatus_BATCH_ITEM_STATUS_ACCEPTED    BatchItemStatus = 1 // Recorded
+	BatchItemStatus_BATCH_ITEM_STATUS_REJECTED    BatchItemStatus = 2 // Invalid item, do not retry
+	BatchItemStatus_BATCH_ITEM_STATUS_DUPLICATE   BatchItemStatus = 3 // Already recorded with the same idempotency key
+	BatchItemStatus_BATCH_ITEM_STATUS_FAILED      BatchItemStatus = 4 // Temporary failure, safe to retry
+)
+
+// Enum value maps for BatchItemStatus.
+var (
+	BatchItemStatus_name = map[int32]string{
+		0: "BATCH_ITEM_STATUS_UNSPECIFIED",
+		1: "BATCH_ITEM_STATUS_ACCEPTED",
+		2: "BATCH_ITEM_STATUS_REJECTED",
+		3: "BATCH_ITEM_STATUS_DUPLICATE",
+		4: "BATCH_ITEM_STATUS_FAILED",
+	}
+	BatchItemStatus_value = map[string]int32{
+		"BATCH_ITEM_STATUS_UNSPECIFIED": 0,
+		"BATCH_ITEM_STATUS_ACCEPTED":    1,
+		"BATCH_ITEM_STATUS_REJECTED":    2,
+		"BATCH_ITEM_STATUS_DUPLICATE":   3,
+		"BATCH_ITEM_STATUS_FAILED":      4,
+	}
+)
+
+func (x BatchItemStatus) Enum() *BatchItemStatus {
+	p := new(BatchItemStatus)
+	*p = x
+	return p
+}
+
+func (x BatchItemStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchItemStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_attendance_proto_enumTypes[0].Descriptor()
+}
+
+func (BatchItemStatus) Type() protoreflect.EnumType {
+	return &file_attendance_proto_enumTypes[0]
+}
+
+func (x BatchItemStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchItemStatus.Descriptor instead.
+func (BatchItemStatus) EnumDescriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{0}
+}
+
+// For recomputing daily summaries of a company in background
+type RecomputeDailySummariesInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Month         string                 `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"`                                // Format: YYYY-MM, instead of from_date/to_date
+	FromDate      string                 `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`          // Format: YYYY-MM-DD
+	ToDate        string                 `protobuf:"bytes,4,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`                // Format: YYYY-MM-DD
+	EmployeeIds   []string               `protobuf:"bytes,5,rep,name=employee_ids,json=employeeIds,proto3" json:"employee_ids,omitempty"` // Empty: all employees with active shift
+	DryRun        bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`               // Only report differences, do not write
+	Session       *SessionInfo           `protobuf:"bytes,7,opt,name=session,proto3" json:"session,omitempty"`
+	Immediate     bool                   `protobuf:"varint,8,opt,name=immediate,proto3" json:"immediate,omitempty"` // Recompute within the request without the company-wide job lock, only for listed employees or a single date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeDailySummariesInput) Reset() {
+	*x = RecomputeDailySummariesInput{}
+	mi := &file_attendance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeDailySummariesInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeDailySummariesInput) ProtoMessage() {}
+
+func (x *RecomputeDailySummariesInput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeDailySummariesInput.ProtoReflect.Descriptor instead.
+func (*RecomputeDailySummariesInput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecomputeDailySummariesInput) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *RecomputeDailySummariesInput) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *RecomputeDailySummariesInput) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *RecomputeDailySummariesInput) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *RecomputeDailySummariesInput) GetEmployeeIds() []string {
+	if x != nil {
+		return x.EmployeeIds
+	}
+	return nil
+}
+
+func (x *RecomputeDailySummariesInput) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RecomputeDailySummariesInput) GetSession() *SessionInfo {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *RecomputeDailySummariesInput) GetImmediate() bool {
+	if x != nil {
+		return x.Immediate
+	}
+	return false
+}
+
+type GetRecomputeJobInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Session       *SessionInfo           `protobuf:"bytes,3,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecomputeJobInput) Reset() {
+	*x = GetRecomputeJobInput{}
+	mi := &file_attendance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecomputeJobInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecomputeJobInput) ProtoMessage() {}
+
+func (x *GetRecomputeJobInput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecomputeJobInput.ProtoReflect.Descriptor instead.
+func (*GetRecomputeJobInput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRecomputeJobInput) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *GetRecomputeJobInput) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *GetRecomputeJobInput) GetSession() *SessionInfo {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type RecomputeJobOutput struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	JobId          string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	CompanyId      string                 `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // pending, running, completed, failed
+	DryRun         bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	FromDate       string                 `protobuf:"bytes,5,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate         string                 `protobuf:"bytes,6,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	EmployeeIds    []string               `protobuf:"bytes,7,rep,name=employee_ids,json=employeeIds,proto3" json:"employee_ids,omitempty"`
+	Total          int32                  `protobuf:"varint,8,opt,name=total,proto3" json:"total,omitempty"`
+	Processed      int32                  `protobuf:"varint,9,opt,name=processed,proto3" json:"processed,omitempty"`
+	Changed        int32                  `protobuf:"varint,10,opt,name=changed,proto3" json:"changed,omitempty"`
+	Failed         int32                  `protobuf:"varint,11,opt,name=failed,proto3" json:"failed,omitempty"`
+	Diffs          []*RecomputeDiff       `protobuf:"bytes,12,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	DiffsTruncated bool                   `protobuf:"varint,13,opt,name=diffs_truncated,json=diffsTruncated,proto3" json:"diffs_truncated,omitempty"`
+	Error          string                 `protobuf:"bytes,14,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,15,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FinishedAt     int64                  `protobuf:"varint,17,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RecomputeJobOutput) Reset() {
+	*x = RecomputeJobOutput{}
+	mi := &file_attendance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeJobOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeJobOutput) ProtoMessage() {}
+
+func (x *RecomputeJobOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeJobOutput.ProtoReflect.Descriptor instead.
+func (*RecomputeJobOutput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RecomputeJobOutput) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *RecomputeJobOutput) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *RecomputeJobOutput) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RecomputeJobOutput) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RecomputeJobOutput) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *RecomputeJobOutput) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *RecomputeJobOutput) GetEmployeeIds() []string {
+	if x != nil {
+		return x.EmployeeIds
+	}
+	return nil
+}
+
+func (x *RecomputeJobOutput) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RecomputeJobOutput) GetProcessed() int32 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *RecomputeJobOutput) GetChanged() int32 {
+	if x != nil {
+		return x.Changed
+	}
+	return 0
+}
+
+func (x *RecomputeJobOutput) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *RecomputeJobOutput) GetDiffs() []*RecomputeDiff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+func (x *RecomputeJobOutput) GetDiffsTruncated() bool {
+	if x != nil {
+		return x.DiffsTruncated
+	}
+	return false
+}
+
+func (x *RecomputeJobOutput) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RecomputeJobOutput) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *RecomputeJobOutput) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *RecomputeJobOutput) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+type RecomputeDiff struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	EmployeeId    string                  `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	WorkDate      string                  `protobuf:"bytes,2,opt,name=work_date,json=workDate,proto3" json:"work_date,omitempty"`
+	Missing       bool                    `protobuf:"varint,3,opt,name=missing,proto3" json:"missing,omitempty"` // No summary before recompute
+	Changes       []*RecomputeFieldChange `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeDiff) Reset() {
+	*x = RecomputeDiff{}
+	mi := &file_attendance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeDiff) ProtoMessage() {}
+
+func (x *RecomputeDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeDiff.ProtoReflect.Descriptor instead.
+func (*RecomputeDiff) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RecomputeDiff) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *RecomputeDiff) GetWorkDate() string {
+	if x != nil {
+		return x.WorkDate
+	}
+	return ""
+}
+
+func (x *RecomputeDiff) GetMissing() bool {
+	if x != nil {
+		return x.Missing
+	}
+	return false
+}
+
+func (x *RecomputeDiff) GetChanges() []*RecomputeFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type RecomputeFieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeFieldChange) Reset() {
+	*x = RecomputeFieldChange{}
+	mi := &file_attendance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeFieldChange) ProtoMessage() {}
+
+func (x *RecomputeFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeFieldChange.ProtoReflect.Descriptor instead.
+func (*RecomputeFieldChange) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RecomputeFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *RecomputeFieldChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *RecomputeFieldChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// For creating attendance correction request
+type CreateCorrectionRequestInput struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId        string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	EmployeeId       string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	WorkDate         string                 `protobuf:"bytes,3,opt,name=work_date,json=workDate,proto3" json:"work_date,omitempty"`                            // Format: YYYY-MM-DD
+	ProposedCheckIn  int64                  `protobuf:"varint,4,opt,name=proposed_check_in,json=proposedCheckIn,proto3" json:"proposed_check_in,omitempty"`    // 0 if not proposed
+	ProposedCheckOut int64                  `protobuf:"varint,5,opt,name=proposed_check_out,json=proposedCheckOut,proto3" json:"proposed_check_out,omitempty"` // 0 if not proposed
+	Reason           string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Session          *SessionInfo           `protobuf:"bytes,7,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateCorrectionRequestInput) Reset() {
+	*x = CreateCorrectionRequestInput{}
+	mi := &file_attendance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCorrectionRequestInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCorrectionRequestInput) ProtoMessage() {}
+
+func (x *CreateCorrectionRequestInput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCorrectionRequestInput.ProtoReflect.Descriptor instead.
+func (*CreateCorrectionRequestInput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateCorrectionRequestInput) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *CreateCorrectionRequestInput) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *CreateCorrectionRequestInput) GetWorkDate() string {
+	if x != nil {
+		return x.WorkDate
+	}
+	return ""
+}
+
+func (x *CreateCorrectionRequestInput) GetProposedCheckIn() int64 {
+	if x != nil {
+		return x.ProposedCheckIn
+	}
+	return 0
+}
+
+func (x *CreateCorrectionRequestInput) GetProposedCheckOut() int64 {
+	if x != nil {
+		return x.ProposedCheckOut
+	}
+	return 0
+}
+
+func (x *CreateCorrectionRequestInput) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateCorrectionRequestInput) GetSession() *SessionInfo {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type CreateCorrectionRequestOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCorrectionRequestOutput) Reset() {
+	*x = CreateCorrectionRequestOutput{}
+	mi := &file_attendance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCorrectionRequestOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCorrectionRequestOutput) ProtoMessage() {}
+
+func (x *CreateCorrectionRequestOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCorrectionRequestOutput.ProtoReflect.Descriptor instead.
+func (*CreateCorrectionRequestOutput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateCorrectionRequestOutput) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// For approving or rejecting attendance correction request
+type ReviewCorrectionRequestInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ReviewNote    string                 `protobuf:"bytes,3,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`
+	Session       *SessionInfo           `protobuf:"bytes,4,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewCorrectionRequestInput) Reset() {
+	*x = ReviewCorrectionRequestInput{}
+	mi := &file_attendance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewCorrectionRequestInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewCorrectionRequestInput) ProtoMessage() {}
+
+func (x *ReviewCorrectionRequestInput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewCorrectionRequestInput.ProtoReflect.Descriptor instead.
+func (*ReviewCorrectionRequestInput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReviewCorrectionRequestInput) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *ReviewCorrectionRequestInput) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ReviewCorrectionRequestInput) GetReviewNote() string {
+	if x != nil {
+		return x.ReviewNote
+	}
+	return ""
+}
+
+func (x *ReviewCorrectionRequestInput) GetSession() *SessionInfo {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type ApproveCorrectionRequestOutput struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RequestId         string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	CorrectedCheckIn  int64                  `protobuf:"varint,2,opt,name=corrected_check_in,json=correctedCheckIn,proto3" json:"corrected_check_in,omitempty"`
+	CorrectedCheckOut int64                  `protobuf:"varint,3,opt,name=corrected_check_out,json=correctedCheckOut,proto3" json:"corrected_check_out,omitempty"`
+	CorrectedStatus   int32                  `protobuf:"varint,4,opt,name=corrected_status,json=correctedStatus,proto3" json:"corrected_status,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ApproveCorrectionRequestOutput) Reset() {
+	*x = ApproveCorrectionRequestOutput{}
+	mi := &file_attendance_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveCorrectionRequestOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveCorrectionRequestOutput) ProtoMessage() {}
+
+func (x *ApproveCorrectionRequestOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveCorrectionRequestOutput.ProtoReflect.Descriptor instead.
+func (*ApproveCorrectionRequestOutput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ApproveCorrectionRequestOutput) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ApproveCorrectionRequestOutput) GetCorrectedCheckIn() int64 {
+	if x != nil {
+		return x.CorrectedCheckIn
+	}
+	return 0
+}
+
+func (x *ApproveCorrectionRequestOutput) GetCorrectedCheckOut() int64 {
+	if x != nil {
+		return x.CorrectedCheckOut
+	}
+	return 0
+}
+
+func (x *ApproveCorrectionRequestOutput) GetCorrectedStatus() int32 {
+	if x != nil {
+		return x.CorrectedStatus
+	}
+	return 0
+}
+
+// For listing pending attendance correction requests of a company
+type ListPendingCorrectionRequestsInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Session       *SessionInfo           `protobuf:"bytes,4,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingCorrectionRequestsInput) Reset() {
+	*x = ListPendingCorrectionRequestsInput{}
+	mi := &file_attendance_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingCorrectionRequestsInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingCorrectionRequestsInput) ProtoMessage() {}
+
+func (x *ListPendingCorrectionRequestsInput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingCorrectionRequestsInput.ProtoReflect.Descriptor instead.
+func (*ListPendingCorrectionRequestsInput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListPendingCorrectionRequestsInput) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *ListPendingCorrectionRequestsInput) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPendingCorrectionRequestsInput) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListPendingCorrectionRequestsInput) GetSession() *SessionInfo {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type ListCorrectionRequestsOutput struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Records       []*CorrectionRequestInfo `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Limit         int32                    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCorrectionRequestsOutput) Reset() {
+	*x = ListCorrectionRequestsOutput{}
+	mi := &file_attendance_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCorrectionRequestsOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCorrectionRequestsOutput) ProtoMessage() {}
+
+func (x *ListCorrectionRequestsOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCorrectionRequestsOutput.ProtoReflect.Descriptor instead.
+func (*ListCorrectionRequestsOutput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListCorrectionRequestsOutput) GetRecords() []*CorrectionRequestInfo {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *ListCorrectionRequestsOutput) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCorrectionRequestsOutput) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type CorrectionRequestInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RequestId        string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	CompanyId        string                 `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	EmployeeId       string                 `protobuf:"bytes,3,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	ShiftId          string                 `protobuf:"bytes,4,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	WorkDate         string                 `protobuf:"bytes,5,opt,name=work_date,json=workDate,proto3" json:"work_date,omitempty"`
+	ProposedCheckIn  int64                  `protobuf:"varint,6,opt,name=proposed_check_in,json=proposedCheckIn,proto3" json:"proposed_check_in,omitempty"`
+	ProposedCheckOut int64                  `protobuf:"varint,7,opt,name=proposed_check_out,json=proposedCheckOut,proto3" json:"proposed_check_out,omitempty"`
+	Reason           string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	OriginalCheckIn  int64                  `protobuf:"varint,9,opt,name=original_check_in,json=originalCheckIn,proto3" json:"original_check_in,omitempty"`
+	OriginalCheckOut int64                  `protobuf:"varint,10,opt,name=original_check_out,json=originalCheckOut,proto3" json:"original_check_out,omitempty"`
+	OriginalStatus   int32                  `protobuf:"varint,11,opt,name=original_status,json=originalStatus,proto3" json:"original_status,omitempty"` // -1 if unknown
+	Status           int32                  `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`
+	ReviewedBy       string                 `protobuf:"bytes,13,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt       int64                  `protobuf:"varint,14,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	ReviewNote       string                 `protobuf:"bytes,15,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`
+	CreatedAt        int64                  `protobuf:"varint,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CorrectionRequestInfo) Reset() {
+	*x = CorrectionRequestInfo{}
+	mi := &file_attendance_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorrectionRequestInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrectionRequestInfo) ProtoMessage() {}
+
+func (x *CorrectionRequestInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrectionRequestInfo.ProtoReflect.Descriptor instead.
+func (*CorrectionRequestInfo) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CorrectionRequestInfo) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CorrectionRequestInfo) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *CorrectionRequestInfo) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *CorrectionRequestInfo) GetShiftId() string {
+	if x != nil {
+		return x.ShiftId
+	}
+	return ""
+}
+
+func (x *CorrectionRequestInfo) GetWorkDate() string {
+	if x != nil {
+		return x.WorkDate
+	}
+	return ""
+}
+
+func (x *CorrectionRequestInfo) GetProposedCheckIn() int64 {
+	if x != nil {
+		return x.ProposedCheckIn
+	}
+	return 0
+}
+
+func (x *CorrectionRequestInfo) GetProposedCheckOut() int64 {
+	if x != nil {
+		return x.ProposedCheckOut
+	}
+	return 0
+}
+
+func (x *CorrectionRequestInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CorrectionRequestInfo) GetOriginalCheckIn() int64 {
+	if x != nil {
+		return x.OriginalCheckIn
+	}
+	return 0
+}
+
+func (x *CorrectionRequestInfo) GetOriginalCheckOut() int64 {
+	if x != nil {
+		return x.OriginalCheckOut
+	}
+	return 0
+}
+
+func (x *CorrectionRequestInfo) GetOriginalStatus() int32 {
+	if x != nil {
+		return x.OriginalStatus
+	}
+	return 0
+}
+
+func (x *CorrectionRequestInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *CorrectionRequestInfo) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *CorrectionRequestInfo) GetReviewedAt() int64 {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return 0
+}
+
+func (x *CorrectionRequestInfo) GetReviewNote() string {
+	if x != nil {
+		return x.ReviewNote
+	}
+	return ""
+}
+
+func (x *CorrectionRequestInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// For bidirectional batch adding attendance records
+type AddBatchAttendanceItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seq           int64                  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"` // Client sequence number, echoed back in the result
+	Attendance    *AddAttendanceInput    `protobuf:"bytes,2,opt,name=attendance,proto3" json:"attendance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBatchAttendanceItem) Reset() {
+	*x = AddBatchAttendanceItem{}
+	mi := &file_attendance_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBatchAttendanceItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBatchAttendanceItem) ProtoMessage() {}
+
+func (x *AddBatchAttendanceItem) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBatchAttendanceItem.ProtoReflect.Descriptor instead.
+func (*AddBatchAttendanceItem) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddBatchAttendanceItem) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *AddBatchAttendanceItem) GetAttendance() *AddAttendanceInput {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+type ServiceAddBatchAttendanceItem struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Seq           int64                           `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"` // Client sequence number, echoed back in the result
+	Attendance    *ServiceAddBatchAttendanceInput `protobuf:"bytes,2,opt,name=attendance,proto3" json:"attendance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceAddBatchAttendanceItem) Reset() {
+	*x = ServiceAddBatchAttendanceItem{}
+	mi := &file_attendance_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceAddBatchAttendanceItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceAddBatchAttendanceItem) ProtoMessage() {}
+
+func (x *ServiceAddBatchAttendanceItem) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceAddBatchAttendanceItem.ProtoReflect.Descriptor instead.
+func (*ServiceAddBatchAttendanceItem) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ServiceAddBatchAttendanceItem) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ServiceAddBatchAttendanceItem) GetAttendance() *ServiceAddBatchAttendanceInput {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+type AddBatchAttendanceResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seq           int64                  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Status        BatchItemStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=attendance.BatchItemStatus" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	RecordType    int32                  `protobuf:"varint,4,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"` // 0: check in, 1: check out, -1: no shift matched
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBatchAttendanceResult) Reset() {
+	*x = AddBatchAttendanceResult{}
+	mi := &file_attendance_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBatchAttendanceResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBatchAttendanceResult) ProtoMessage() {}
+
+func (x *AddBatchAttendanceResult) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBatchAttendanceResult.ProtoReflect.Descriptor instead.
+func (*AddBatchAttendanceResult) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AddBatchAttendanceResult) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *AddBatchAttendanceResult) GetStatus() BatchItemStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BatchItemStatus_BATCH_ITEM_STATUS_UNSPECIFIED
+}
+
+func (x *AddBatchAttendanceResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AddBatchAttendanceResult) GetRecordType() int32 {
+	if x != nil {
+		return x.RecordType
+	}
+	return 0
+}
+
+// For deleting attendance records
+type DeleteAttendanceRecordsInput struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId      string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	SummaryMonth   string                 `protobuf:"bytes,2,opt,name=summary_month,json=summaryMonth,proto3" json:"summary_month,omitempty"`
+	Session        *SessionInfo           `protobuf:"bytes,3,opt,name=session,proto3" json:"session,omitempty"`
+	ServiceSession *ServiceSessionInfo    `protobuf:"bytes,4,opt,name=service_session,json=serviceSession,proto3" json:"service_session,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteAttendanceRecordsInput) Reset() {
+	*x = DeleteAttendanceRecordsInput{}
+	mi := &file_attendance_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttendanceRecordsInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttendanceRecordsInput) ProtoMessage() {}
+
+func (x *DeleteAttendanceRecordsInput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttendanceRecordsInput.ProtoReflect.Descriptor instead.
+func (*DeleteAttendanceRecordsInput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteAttendanceRecordsInput) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *DeleteAttendanceRecordsInput) GetSummaryMonth() string {
+	if x != nil {
+		return x.SummaryMonth
+	}
+	return ""
+}
+
+func (x *DeleteAttendanceRecordsInput) GetSession() *SessionInfo {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *DeleteAttendanceRecordsInput) GetServiceSession() *ServiceSessionInfo {
+	if x != nil {
+		return x.ServiceSession
+	}
+	return nil
+}
+
+// For service adding batch attendance records
+type ServiceAddBatchAttendanceInput struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId           string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	EmployeeId          string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	DeviceId            string                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	RecordTime          int64                  `protobuf:"varint,4,opt,name=record_time,json=recordTime,proto3" json:"record_time,omitempty"`
+	VerificationMethod  string                 `protobuf:"bytes,5,opt,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
+	VerificationScore   float64                `protobuf:"fixed64,6,opt,name=verification_score,json=verificationScore,proto3" json:"verification_score,omitempty"`
+	FaceImageUrl        string                 `protobuf:"bytes,7,opt,name=face_image_url,json=faceImageUrl,proto3" json:"face_image_url,omitempty"`
+	LocationCoordinates string                 `protobuf:"bytes,8,opt,name=location_coordinates,json=locationCoordinates,proto3" json:"location_coordinates,omitempty"`
+	Session             *ServiceSessionInfo    `protobuf:"bytes,9,opt,name=session,proto3" json:"session,omitempty"`
+	IdempotencyKey      string                 `protobuf:"bytes,10,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // Optional client-supplied event id
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ServiceAddBatchAttendanceInput) Reset() {
+	*x = ServiceAddBatchAttendanceInput{}
+	mi := &file_attendance_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceAddBatchAttendanceInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceAddBatchAttendanceInput) ProtoMessage() {}
+
+func (x *ServiceAddBatchAttendanceInput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceAddBatchAttendanceInput.ProtoReflect.Descriptor instead.
+func (*ServiceAddBatchAttendanceInput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ServiceAddBatchAttendanceInput) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *ServiceAddBatchAttendanceInput) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ServiceAddBatchAttendanceInput) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ServiceAddBatchAttendanceInput) GetRecordTime() int64 {
+	if x != nil {
+		return x.RecordTime
+	}
+	return 0
+}
+
+func (x *ServiceAddBatchAttendanceInput) GetVerificationMethod() string {
+	if x != nil {
+		return x.VerificationMethod
+	}
+	return ""
+}
+
+func (x *ServiceAddBatchAttendanceInput) GetVerificationScore() float64 {
+	if x != nil {
+		return x.VerificationScore
+	}
+	return 0
+}
+
+func (x *ServiceAddBatchAttendanceInput) GetFaceImageUrl() string {
+	if x != nil {
+		return x.FaceImageUrl
+	}
+	return ""
+}
+
+func (x *ServiceAddBatchAttendanceInput) GetLocationCoordinates() string {
+	if x != nil {
+		return x.LocationCoordinates
+	}
+	return ""
+}
+
+func (x *ServiceAddBatchAttendanceInput) GetSession() *ServiceSessionInfo {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *ServiceAddBatchAttendanceInput) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ServiceSessionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName   string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	ServiceId     string                 `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	ClientAgent   string                 `protobuf:"bytes,4,opt,name=client_agent,json=clientAgent,proto3" json:"client_agent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceSessionInfo) Reset() {
+	*x = ServiceSessionInfo{}
+	mi := &file_attendance_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceSessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceSessionInfo) ProtoMessage() {}
+
+func (x *ServiceSessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceSessionInfo.ProtoReflect.Descriptor instead.
+func (*ServiceSessionInfo) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ServiceSessionInfo) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ServiceSessionInfo) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ServiceSessionInfo) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *ServiceSessionInfo) GetClientAgent() string {
+	if x != nil {
+		return x.ClientAgent
+	}
+	return ""
+}
+
+// For getting daily attendance summary of an employee within a company
+type GetDailyAttendanceSummaryEmployeeInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	SummaryMonth  string                 `protobuf:"bytes,3,opt,name=summary_month,json=summaryMonth,proto3" json:"summary_month,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageStage     string                 `protobuf:"bytes,5,opt,name=page_stage,json=pageStage,proto3" json:"page_stage,omitempty"`
+	Session       *SessionInfo           `protobuf:"bytes,6,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyAttendanceSummaryEmployeeInput) Reset() {
+	*x = GetDailyAttendanceSummaryEmployeeInput{}
+	mi := &file_attendance_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyAttendanceSummaryEmployeeInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyAttendanceSummaryEmployeeInput) ProtoMessage() {}
+
+func (x *GetDailyAttendanceSummaryEmployeeInput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyAttendanceSummaryEmployeeInput.ProtoReflect.Descriptor instead.
+func (*GetDailyAttendanceSummaryEmployeeInput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetDailyAttendanceSummaryEmployeeInput) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *GetDailyAttendanceSummaryEmployeeInput) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *GetDailyAttendanceSummaryEmployeeInput) GetSummaryMonth() string {
+	if x != nil {
+		return x.SummaryMonth
+	}
+	return ""
+}
+
+func (x *GetDailyAttendanceSummaryEmployeeInput) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetDailyAttendanceSummaryEmployeeInput) GetPageStage() string {
+	if x != nil {
+		return x.PageStage
+	}
+	return ""
+}
+
+func (x *GetDailyAttendanceSummaryEmployeeInput) GetSession() *SessionInfo {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+// For getting daily attendance summary
+type GetDailyAttendanceSummaryInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	SummaryMonth  string                 `protobuf:"bytes,3,opt,name=summary_month,json=summaryMonth,proto3" json:"summary_month,omitempty"`
+	WorkDate      int64                  `protobuf:"varint,4,opt,name=work_date,json=workDate,proto3" json:"work_date,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageStage     string                 `protobuf:"bytes,6,opt,name=page_stage,json=pageStage,proto3" json:"page_stage,omitempty"`
+	Session       *SessionInfo           `protobuf:"bytes,7,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyAttendanceSummaryInput) Reset() {
+	*x = GetDailyAttendanceSummaryInput{}
+	mi := &file_attendance_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyAttendanceSummaryInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyAttendanceSummaryInput) ProtoMessage() {}
+
+func (x *GetDailyAttendanceSummaryInput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyAttendanceSummaryInput.ProtoReflect.Descriptor instead.
+func (*GetDailyAttendanceSummaryInput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetDailyAttendanceSummaryInput) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *GetDailyAttendanceSummaryInput) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *GetDailyAttendanceSummaryInput) GetSummaryMonth() string {
+	if x != nil {
+		return x.SummaryMonth
+	}
+	return ""
+}
+
+func (x *GetDailyAttendanceSummaryInput) GetWorkDate() int64 {
+	if x != nil {
+		return x.WorkDate
+	}
+	return 0
+}
+
+func (x *GetDailyAttendanceSummaryInput) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetDailyAttendanceSummaryInput) GetPageStage() string {
+	if x != nil {
+		return x.PageStage
+	}
+	return ""
+}
+
+func (x *GetDailyAttendanceSummaryInput) GetSession() *SessionInfo {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type GetDailyAttendanceSummaryOutput struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	PageStageNext []byte                        `protobuf:"bytes,1,opt,name=page_stage_next,json=pageStageNext,proto3" json:"page_stage_next,omitempty"`
+	PageSize      int32                         `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Records       []*DailyAttendanceSummaryInfo `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyAttendanceSummaryOutput) Reset() {
+	*x = GetDailyAttendanceSummaryOutput{}
+	mi := &file_attendance_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyAttendanceSummaryOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyAttendanceSummaryOutput) ProtoMessage() {}
+
+func (x *GetDailyAttendanceSummaryOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyAttendanceSummaryOutput.ProtoReflect.Descriptor instead.
+func (*GetDailyAttendanceSummaryOutput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetDailyAttendanceSummaryOutput) GetPageStageNext() []byte {
+	if x != nil {
+		return x.PageStageNext
+	}
+	return nil
+}
+
+func (x *GetDailyAttendanceSummaryOutput) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetDailyAttendanceSummaryOutput) GetRecords() []*DailyAttendanceSummaryInfo {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type DailyAttendanceSummaryInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId         string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	SummaryMonth      string                 `protobuf:"bytes,2,opt,name=summary_month,json=summaryMonth,proto3" json:"summary_month,omitempty"`
+	WorkDate          int64                  `protobuf:"varint,3,opt,name=work_date,json=workDate,proto3" json:"work_date,omitempty"`
+	EmployeeId        string                 `protobuf:"bytes,4,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	ShiftId           string                 `protobuf:"bytes,5,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	ActualCheckIn     int64                  `protobuf:"varint,6,opt,name=actual_check_in,json=actualCheckIn,proto3" json:"actual_check_in,omitempty"`
+	ActualCheckOut    int64                  `protobuf:"varint,7,opt,name=actual_check_out,json=actualCheckOut,proto3" json:"actual_check_out,omitempty"`
+	AttendanceStatus  string                 `protobuf:"bytes,8,opt,name=attendance_status,json=attendanceStatus,proto3" json:"attendance_status,omitempty"`
+	LateMinutes       int32                  `protobuf:"varint,9,opt,name=late_minutes,json=lateMinutes,proto3" json:"late_minutes,omitempty"`
+	EarlyLeaveMinutes int32                  `protobuf:"varint,10,opt,name=early_leave_minutes,json=earlyLeaveMinutes,proto3" json:"early_leave_minutes,omitempty"`
+	TotalWorkMinutes  int32                  `protobuf:"varint,11,opt,name=total_work_minutes,json=totalWorkMinutes,proto3" json:"total_work_minutes,omitempty"`
+	Notes             string                 `protobuf:"bytes,12,opt,name=notes,proto3" json:"notes,omitempty"`
+	UpdatedAt         int64                  `protobuf:"varint,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ScheduledMinutes  int32                  `protobuf:"varint,14,opt,name=scheduled_minutes,json=scheduledMinutes,proto3" json:"scheduled_minutes,omitempty"`
+	BreakMinutes      int32                  `protobuf:"varint,15,opt,name=break_minutes,json=breakMinutes,proto3" json:"break_minutes,omitempty"`
+	OvertimeMinutes   int32                  `protobuf:"varint,16,opt,name=overtime_minutes,json=overtimeMinutes,proto3" json:"overtime_minutes,omitempty"`
+	NetWorkMinutes    int32                  `protobuf:"varint,17,opt,name=net_work_minutes,json=netWorkMinutes,proto3" json:"net_work_minutes,omitempty"`
+	WorkIntervals     []*WorkInterval        `protobuf:"bytes,18,rep,name=work_intervals,json=workIntervals,proto3" json:"work_intervals,omitempty"`
+	UnpairedPunches   int32                  `protobuf:"varint,19,opt,name=unpaired_punches,json=unpairedPunches,proto3" json:"unpaired_punches,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DailyAttendanceSummaryInfo) Reset() {
+	*x = DailyAttendanceSummaryInfo{}
+	mi := &file_attendance_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyAttendanceSummaryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyAttendanceSummaryInfo) ProtoMessage() {}
+
+func (x *DailyAttendanceSummaryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyAttendanceSummaryInfo.ProtoReflect.Descriptor instead.
+func (*DailyAttendanceSummaryInfo) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DailyAttendanceSummaryInfo) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *DailyAttendanceSummaryInfo) GetSummaryMonth() string {
+	if x != nil {
+		return x.SummaryMonth
+	}
+	return ""
+}
+
+func (x *DailyAttendanceSummaryInfo) GetWorkDate() int64 {
+	if x != nil {
+		return x.WorkDate
+	}
+	return 0
+}
+
+func (x *DailyAttendanceSummaryInfo) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *DailyAttendanceSummaryInfo) GetShiftId() string {
+	if x != nil {
+		return x.ShiftId
+	}
+	return ""
+}
+
+func (x *DailyAttendanceSummaryInfo) GetActualCheckIn() int64 {
+	if x != nil {
+		return x.ActualCheckIn
+	}
+	return 0
+}
+
+func (x *DailyAttendanceSummaryInfo) GetActualCheckOut() int64 {
+	if x != nil {
+		return x.ActualCheckOut
+	}
+	return 0
+}
+
+func (x *DailyAttendanceSummaryInfo) GetAttendanceStatus() string {
+	if x != nil {
+		return x.AttendanceStatus
+	}
+	return ""
+}
+
+func (x *DailyAttendanceSummaryInfo) GetLateMinutes() int32 {
+	if x != nil {
+		return x.LateMinutes
+	}
+	return 0
+}
+
+func (x *DailyAttendanceSummaryInfo) GetEarlyLeaveMinutes() int32 {
+	if x != nil {
+		return x.EarlyLeaveMinutes
+	}
+	return 0
+}
+
+func (x *DailyAttendanceSummaryInfo) GetTotalWorkMinutes() int32 {
+	if x != nil {
+		return x.TotalWorkMinutes
+	}
+	return 0
+}
+
+func (x *DailyAttendanceSummaryInfo) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *DailyAttendanceSummaryInfo) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *DailyAttendanceSummaryInfo) GetScheduledMinutes() int32 {
+	if x != nil {
+		return x.ScheduledMinutes
+	}
+	return 0
+}
+
+func (x *DailyAttendanceSummaryInfo) GetBreakMinutes() int32 {
+	if x != nil {
+		return x.BreakMinutes
+	}
+	return 0
+}
+
+func (x *DailyAttendanceSummaryInfo) GetOvertimeMinutes() int32 {
+	if x != nil {
+		return x.OvertimeMinutes
+	}
+	return 0
+}
+
+func (x *DailyAttendanceSummaryInfo) GetNetWorkMinutes() int32 {
+	if x != nil {
+		return x.NetWorkMinutes
+	}
+	return 0
+}
+
+func (x *DailyAttendanceSummaryInfo) GetWorkIntervals() []*WorkInterval {
+	if x != nil {
+		return x.WorkIntervals
+	}
+	return nil
+}
+
+func (x *DailyAttendanceSummaryInfo) GetUnpairedPunches() int32 {
+	if x != nil {
+		return x.UnpairedPunches
+	}
+	return 0
+}
+
+// A check-in/check-out pair; one side is 0 for an unpaired punch
+type WorkInterval struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CheckIn       int64                  `protobuf:"varint,1,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	CheckOut      int64                  `protobuf:"varint,2,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
+	Minutes       int32                  `protobuf:"varint,3,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkInterval) Reset() {
+	*x = WorkInterval{}
+	mi := &file_attendance_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkInterval) ProtoMessage() {}
+
+func (x *WorkInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkInterval.ProtoReflect.Descriptor instead.
+func (*WorkInterval) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WorkInterval) GetCheckIn() int64 {
+	if x != nil {
+		return x.CheckIn
+	}
+	return 0
+}
+
+func (x *WorkInterval) GetCheckOut() int64 {
+	if x != nil {
+		return x.CheckOut
+	}
+	return 0
+}
+
+func (x *WorkInterval) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+// For getting attendance records of an employee within a company
+type GetAttendanceRecordsEmployeeInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	YearMonth     string                 `protobuf:"bytes,3,opt,name=year_month,json=yearMonth,proto3" json:"year_month,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageStage     string                 `protobuf:"bytes,5,opt,name=page_stage,json=pageStage,proto3" json:"page_stage,omitempty"`
+	Session       *SessionInfo           `protobuf:"bytes,6,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceRecordsEmployeeInput) Reset() {
+	*x = GetAttendanceRecordsEmployeeInput{}
+	mi := &file_attendance_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceRecordsEmployeeInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceRecordsEmployeeInput) ProtoMessage() {}
+
+func (x *GetAttendanceRecordsEmployeeInput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceRecordsEmployeeInput.ProtoReflect.Descriptor instead.
+func (*GetAttendanceRecordsEmployeeInput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetAttendanceRecordsEmployeeInput) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRecordsEmployeeInput) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRecordsEmployeeInput) GetYearMonth() string {
+	if x != nil {
+		return x.YearMonth
+	}
+	return ""
+}
+
+func (x *GetAttendanceRecordsEmployeeInput) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAttendanceRecordsEmployeeInput) GetPageStage() string {
+	if x != nil {
+		return x.PageStage
+	}
+	return ""
+}
+
+func (x *GetAttendanceRecordsEmployeeInput) GetSession() *SessionInfo {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type GetAttendanceRecordsEmployeeOutput struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	PageStageNext []byte                  `protobuf:"bytes,1,opt,name=page_stage_next,json=pageStageNext,proto3" json:"page_stage_next,omitempty"`
+	PageSize      int32                   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Records       []*AttendanceRecordInfo `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceRecordsEmployeeOutput) Reset() {
+	*x = GetAttendanceRecordsEmployeeOutput{}
+	mi := &file_attendance_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceRecordsEmployeeOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceRecordsEmployeeOutput) ProtoMessage() {}
+
+func (x *GetAttendanceRecordsEmployeeOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceRecordsEmployeeOutput.ProtoReflect.Descriptor instead.
+func (*GetAttendanceRecordsEmployeeOutput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetAttendanceRecordsEmployeeOutput) GetPageStageNext() []byte {
+	if x != nil {
+		return x.PageStageNext
+	}
+	return nil
+}
+
+func (x *GetAttendanceRecordsEmployeeOutput) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAttendanceRecordsEmployeeOutput) GetRecords() []*AttendanceRecordInfo {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// For getting attendance records
+type GetAttendanceRecordsInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	YearMonth     string                 `protobuf:"bytes,3,opt,name=year_month,json=yearMonth,proto3" json:"year_month,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageStage     string                 `protobuf:"bytes,5,opt,name=page_stage,json=pageStage,proto3" json:"page_stage,omitempty"`
+	Session       *SessionInfo           `protobuf:"bytes,6,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceRecordsInput) Reset() {
+	*x = GetAttendanceRecordsInput{}
+	mi := &file_attendance_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceRecordsInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceRecordsInput) ProtoMessage() {}
+
+func (x *GetAttendanceRecordsInput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceRecordsInput.ProtoReflect.Descriptor instead.
+func (*GetAttendanceRecordsInput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetAttendanceRecordsInput) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRecordsInput) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRecordsInput) GetYearMonth() string {
+	if x != nil {
+		return x.YearMonth
+	}
+	return ""
+}
+
+func (x *GetAttendanceRecordsInput) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAttendanceRecordsInput) GetPageStage() string {
+	if x != nil {
+		return x.PageStage
+	}
+	return ""
+}
+
+func (x *GetAttendanceRecordsInput) GetSession() *SessionInfo {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type GetAttendanceRecordsOutput struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	PageStageNext []byte                  `protobuf:"bytes,1,opt,name=page_stage_next,json=pageStageNext,proto3" json:"page_stage_next,omitempty"`
+	PageSize      int32                   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Records       []*AttendanceRecordInfo `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceRecordsOutput) Reset() {
+	*x = GetAttendanceRecordsOutput{}
+	mi := &file_attendance_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceRecordsOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceRecordsOutput) ProtoMessage() {}
+
+func (x *GetAttendanceRecordsOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceRecordsOutput.ProtoReflect.Descriptor instead.
+func (*GetAttendanceRecordsOutput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetAttendanceRecordsOutput) GetPageStageNext() []byte {
+	if x != nil {
+		return x.PageStageNext
+	}
+	return nil
+}
+
+func (x *GetAttendanceRecordsOutput) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAttendanceRecordsOutput) GetRecords() []*AttendanceRecordInfo {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type AttendanceRecordInfo struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId           string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	YearMonth           string                 `protobuf:"bytes,2,opt,name=year_month,json=yearMonth,proto3" json:"year_month,omitempty"`
+	RecordTime          int64                  `protobuf:"varint,3,opt,name=record_time,json=recordTime,proto3" json:"record_time,omitempty"`
+	EmployeeId          string                 `protobuf:"bytes,4,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	DeviceId            string                 `protobuf:"bytes,5,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	RecordType          int32                  `protobuf:"varint,6,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	VerificationMethod  string                 `protobuf:"bytes,7,opt,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
+	VerificationScore   float64                `protobuf:"fixed64,8,opt,name=verification_score,json=verificationScore,proto3" json:"verification_score,omitempty"`
+	FaceImageUrl        string                 `protobuf:"bytes,9,opt,name=face_image_url,json=faceImageUrl,proto3" json:"face_image_url,omitempty"`
+	LocationCoordinates string                 `protobuf:"bytes,10,opt,name=location_coordinates,json=locationCoordinates,proto3" json:"location_coordinates,omitempty"`
+	Metadata            map[string]string      `protobuf:"bytes,11,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SyncStatus          string                 `protobuf:"bytes,12,opt,name=sync_status,json=syncStatus,proto3" json:"sync_status,omitempty"`
+	CreatedAt           int64                  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AttendanceRecordInfo) Reset() {
+	*x = AttendanceRecordInfo{}
+	mi := &file_attendance_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceRecordInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceRecordInfo) ProtoMessage() {}
+
+func (x *AttendanceRecordInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceRecordInfo.ProtoReflect.Descriptor instead.
+func (*AttendanceRecordInfo) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AttendanceRecordInfo) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *AttendanceRecordInfo) GetYearMonth() string {
+	if x != nil {
+		return x.YearMonth
+	}
+	return ""
+}
+
+func (x *AttendanceRecordInfo) GetRecordTime() int64 {
+	if x != nil {
+		return x.RecordTime
+	}
+	return 0
+}
+
+func (x *AttendanceRecordInfo) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *AttendanceRecordInfo) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *AttendanceRecordInfo) GetRecordType() int32 {
+	if x != nil {
+		return x.RecordType
+	}
+	return 0
+}
+
+func (x *AttendanceRecordInfo) GetVerificationMethod() string {
+	if x != nil {
+		return x.VerificationMethod
+	}
+	return ""
+}
+
+func (x *AttendanceRecordInfo) GetVerificationScore() float64 {
+	if x != nil {
+		return x.VerificationScore
+	}
+	return 0
+}
+
+func (x *AttendanceRecordInfo) GetFaceImageUrl() string {
+	if x != nil {
+		return x.FaceImageUrl
+	}
+	return ""
+}
+
+func (x *AttendanceRecordInfo) GetLocationCoordinates() string {
+	if x != nil {
+		return x.LocationCoordinates
+	}
+	return ""
+}
+
+func (x *AttendanceRecordInfo) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AttendanceRecordInfo) GetSyncStatus() string {
+	if x != nil {
+		return x.SyncStatus
+	}
+	return ""
+}
+
+func (x *AttendanceRecordInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// For adding attendance record
+type AddAttendanceInput struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId           string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	EmployeeId          string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	DeviceId            string                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	RecordTime          int64                  `protobuf:"varint,4,opt,name=record_time,json=recordTime,proto3" json:"record_time,omitempty"`
+	VerificationMethod  string                 `protobuf:"bytes,5,opt,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
+	VerificationScore   float64                `protobuf:"fixed64,6,opt,name=verification_score,json=verificationScore,proto3" json:"verification_score,omitempty"`
+	FaceImageUrl        string                 `protobuf:"bytes,7,opt,name=face_image_url,json=faceImageUrl,proto3" json:"face_image_url,omitempty"`
+	LocationCoordinates string                 `protobuf:"bytes,8,opt,name=location_coordinates,json=locationCoordinates,proto3" json:"location_coordinates,omitempty"`
+	Session             *SessionInfo           `protobuf:"bytes,9,opt,name=session,proto3" json:"session,omitempty"`
+	IdempotencyKey      string                 `protobuf:"bytes,10,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // Optional client-supplied event id, replays return the original outcome
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AddAttendanceInput) Reset() {
+	*x = AddAttendanceInput{}
+	mi := &file_attendance_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAttendanceInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAttendanceInput) ProtoMessage() {}
+
+func (x *AddAttendanceInput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAttendanceInput.ProtoReflect.Descriptor instead.
+func (*AddAttendanceInput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AddAttendanceInput) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *AddAttendanceInput) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *AddAttendanceInput) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *AddAttendanceInput) GetRecordTime() int64 {
+	if x != nil {
+		return x.RecordTime
+	}
+	return 0
+}
+
+func (x *AddAttendanceInput) GetVerificationMethod() string {
+	if x != nil {
+		return x.VerificationMethod
+	}
+	return ""
+}
+
+func (x *AddAttendanceInput) GetVerificationScore() float64 {
+	if x != nil {
+		return x.VerificationScore
+	}
+	return 0
+}
+
+func (x *AddAttendanceInput) GetFaceImageUrl() string {
+	if x != nil {
+		return x.FaceImageUrl
+	}
+	return ""
+}
+
+func (x *AddAttendanceInput) GetLocationCoordinates() string {
+	if x != nil {
+		return x.LocationCoordinates
+	}
+	return ""
+}
+
+func (x *AddAttendanceInput) GetSession() *SessionInfo {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *AddAttendanceInput) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type AddAttendanceOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	StatusCode    int32                  `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	RecordType    int32                  `protobuf:"varint,3,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"` // 0: check in, 1: check out, -1: no shift matched
+	Replayed      bool                   `protobuf:"varint,4,opt,name=replayed,proto3" json:"replayed,omitempty"`                       // true if the idempotency key was already recorded
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAttendanceOutput) Reset() {
+	*x = AddAttendanceOutput{}
+	mi := &file_attendance_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAttendanceOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAttendanceOutput) ProtoMessage() {}
+
+func (x *AddAttendanceOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAttendanceOutput.ProtoReflect.Descriptor instead.
+func (*AddAttendanceOutput) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddAttendanceOutput) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddAttendanceOutput) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *AddAttendanceOutput) GetRecordType() int32 {
+	if x != nil {
+		return x.RecordType
+	}
+	return 0
+}
+
+func (x *AddAttendanceOutput) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+// For session info
+type SessionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          int32                  `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"`
+	SessionId     string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	CompanyId     string                 `protobuf:"bytes,4,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,5,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	ClientAgent   string                 `protobuf:"bytes,6,opt,name=client_agent,json=clientAgent,proto3" json:"client_agent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
+	mi := &file_attendance_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionInfo) ProtoMessage() {}
+
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SessionInfo) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetClientAgent() string {
+	if x != nil {
+		return x.ClientAgent
+	}
+	return ""
+}
+
+var File_attendance_proto protoreflect.FileDescriptor
+
+const file_attendance_proto_rawDesc = "" +
+	"\n" +
+	"\x10attendance.proto\x12\n" +
+	"attendance\x1a\x1bgoogle/protobuf/empty.proto\"\x96\x02\n" +
+	"\x1cRecomputeDailySummariesInput\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\tR\x05month\x12\x1b\n" +
+	"\tfrom_date\x18\x03 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x04 \x01(\tR\x06toDate\x12!\n" +
+	"\femployee_ids\x18\x05 \x03(\tR\vemployeeIds\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x121\n" +
+	"\asession\x18\a \x01(\v2\x17.attendance.SessionInfoR\asession\x12\x1c\n" +
+	"\timmediate\x18\b \x01(\bR\timmediate\"\x7f\n" +
+	"\x14GetRecomputeJobInput\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\x121\n" +
+	"\asession\x18\x03 \x01(\v2\x17.attendance.SessionInfoR\asession\"\x89\x04\n" +
+	"\x12RecomputeJobOutput\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x02 \x01(\tR\tcompanyId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12\x1b\n" +
+	"\tfrom_date\x18\x05 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x06 \x01(\tR\x06toDate\x12!\n" +
+	"\femployee_ids\x18\a \x03(\tR\vemployeeIds\x12\x14\n" +
+	"\x05total\x18\b \x01(\x05R\x05total\x12\x1c\n" +
+	"\tprocessed\x18\t \x01(\x05R\tprocessed\x12\x18\n" +
+	"\achanged\x18\n" +
+	" \x01(\x05R\achanged\x12\x16\n" +
+	"\x06failed\x18\v \x01(\x05R\x06failed\x12/\n" +
+	"\x05diffs\x18\f \x03(\v2\x19.attendance.RecomputeDiffR\x05diffs\x12'\n" +
+	"\x0fdiffs_truncated\x18\r \x01(\bR\x0ediffsTruncated\x12\x14\n" +
+	"\x05error\x18\x0e \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x0f \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\x03R\tcreatedAt\x12\x1f\n" +
+	"\vfinished_at\x18\x11 \x01(\x03R\n" +
+	"finishedAt\"\xa3\x01\n" +
+	"\rRecomputeDiff\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x12\x1b\n" +
+	"\twork_date\x18\x02 \x01(\tR\bworkDate\x12\x18\n" +
+	"\amissing\x18\x03 \x01(\bR\amissing\x12:\n" +
+	"\achanges\x18\x04 \x03(\v2 .attendance.RecomputeFieldChangeR\achanges\"Z\n" +
+	"\x14RecomputeFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"\xa0\x02\n" +
+	"\x1cCreateCorrectionRequestInput\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\x12\x1b\n" +
+	"\twork_date\x18\x03 \x01(\tR\bworkDate\x12*\n" +
+	"\x11proposed_check_in\x18\x04 \x01(\x03R\x0fproposedCheckIn\x12,\n" +
+	"\x12proposed_check_out\x18\x05 \x01(\x03R\x10proposedCheckOut\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x121\n" +
+	"\asession\x18\a \x01(\v2\x17.attendance.SessionInfoR\asession\">\n" +
+	"\x1dCreateCorrectionRequestOutput\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"\xb0\x01\n" +
+	"\x1cReviewCorrectionRequestInput\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x1f\n" +
+	"\vreview_note\x18\x03 \x01(\tR\n" +
+	"reviewNote\x121\n" +
+	"\asession\x18\x04 \x01(\v2\x17.attendance.SessionInfoR\asession\"\xc8\x01\n" +
+	"\x1eApproveCorrectionRequestOutput\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12,\n" +
+	"\x12corrected_check_in\x18\x02 \x01(\x03R\x10correctedCheckIn\x12.\n" +
+	"\x13corrected_check_out\x18\x03 \x01(\x03R\x11correctedCheckOut\x12)\n" +
+	"\x10corrected_status\x18\x04 \x01(\x05R\x0fcorrectedStatus\"\xa4\x01\n" +
+	"\"ListPendingCorrectionRequestsInput\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x121\n" +
+	"\asession\x18\x04 \x01(\v2\x17.attendance.SessionInfoR\asession\"\x89\x01\n" +
+	"\x1cListCorrectionRequestsOutput\x12;\n" +
+	"\arecords\x18\x01 \x03(\v2!.attendance.CorrectionRequestInfoR\arecords\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"\xbd\x04\n" +
+	"\x15CorrectionRequestInfo\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x02 \x01(\tR\tcompanyId\x12\x1f\n" +
+	"\vemployee_id\x18\x03 \x01(\tR\n" +
+	"employeeId\x12\x19\n" +
+	"\bshift_id\x18\x04 \x01(\tR\ashiftId\x12\x1b\n" +
+	"\twork_date\x18\x05 \x01(\tR\bworkDate\x12*\n" +
+	"\x11proposed_check_in\x18\x06 \x01(\x03R\x0fproposedCheckIn\x12,\n" +
+	"\x12proposed_check_out\x18\a \x01(\x03R\x10proposedCheckOut\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12*\n" +
+	"\x11original_check_in\x18\t \x01(\x03R\x0foriginalCheckIn\x12,\n" +
+	"\x12original_check_out\x18\n" +
+	" \x01(\x03R\x10originalCheckOut\x12'\n" +
+	"\x0foriginal_status\x18\v \x01(\x05R\x0eoriginalStatus\x12\x16\n" +
+	"\x06status\x18\f \x01(\x05R\x06status\x12\x1f\n" +
+	"\vreviewed_by\x18\r \x01(\tR\n" +
+	"reviewedBy\x12\x1f\n" +
+	"\vreviewed_at\x18\x0e \x01(\x03R\n" +
+	"reviewedAt\x12\x1f\n" +
+	"\vreview_note\x18\x0f \x01(\tR\n" +
+	"reviewNote\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\x03R\tcreatedAt\"j\n" +
+	"\x16AddBatchAttendanceItem\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x03R\x03seq\x12>\n" +
+	"\n" +
+	"attendance\x18\x02 \x01(\v2\x1e.attendance.AddAttendanceInputR\n" +
+	"attendance\"}\n" +
+	"\x1dServiceAddBatchAttendanceItem\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x03R\x03seq\x12J\n" +
+	"\n" +
+	"attendance\x18\x02 \x01(\v2*.attendance.ServiceAddBatchAttendanceInputR\n" +
+	"attendance\"\x9a\x01\n" +
+	"\x18AddBatchAttendanceResult\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x03R\x03seq\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.attendance.BatchItemStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1f\n" +
+	"\vrecord_type\x18\x04 \x01(\x05R\n" +
+	"recordType\"\xde\x01\n" +
+	"\x1cDeleteAttendanceRecordsInput\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12#\n" +
+	"\rsummary_month\x18\x02 \x01(\tR\fsummaryMonth\x121\n" +
+	"\asession\x18\x03 \x01(\v2\x17.attendance.SessionInfoR\asession\x12G\n" +
+	"\x0fservice_session\x18\x04 \x01(\v2\x1e.attendance.ServiceSessionInfoR\x0eserviceSession\"\xba\x03\n" +
+	"\x1eServiceAddBatchAttendanceInput\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\x12\x1b\n" +
+	"\tdevice_id\x18\x03 \x01(\tR\bdeviceId\x12\x1f\n" +
+	"\vrecord_time\x18\x04 \x01(\x03R\n" +
+	"recordTime\x12/\n" +
+	"\x13verification_method\x18\x05 \x01(\tR\x12verificationMethod\x12-\n" +
+	"\x12verification_score\x18\x06 \x01(\x01R\x11verificationScore\x12$\n" +
+	"\x0eface_image_url\x18\a \x01(\tR\ffaceImageUrl\x121\n" +
+	"\x14location_coordinates\x18\b \x01(\tR\x13locationCoordinates\x128\n" +
+	"\asession\x18\t \x01(\v2\x1e.attendance.ServiceSessionInfoR\asession\x12'\n" +
+	"\x0fidempotency_key\x18\n" +
+	" \x01(\tR\x0eidempotencyKey\"\x96\x01\n" +
+	"\x12ServiceSessionInfo\x12!\n" +
+	"\fservice_name\x18\x01 \x01(\tR\vserviceName\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\tR\tserviceId\x12\x1b\n" +
+	"\tclient_ip\x18\x03 \x01(\tR\bclientIp\x12!\n" +
+	"\fclient_agent\x18\x04 \x01(\tR\vclientAgent\"\xfc\x01\n" +
+	"&GetDailyAttendanceSummaryEmployeeInput\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\x12#\n" +
+	"\rsummary_month\x18\x03 \x01(\tR\fsummaryMonth\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_stage\x18\x05 \x01(\tR\tpageStage\x121\n" +
+	"\asession\x18\x06 \x01(\v2\x17.attendance.SessionInfoR\asession\"\x91\x02\n" +
+	"\x1eGetDailyAttendanceSummaryInput\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\x12#\n" +
+	"\rsummary_month\x18\x03 \x01(\tR\fsummaryMonth\x12\x1b\n" +
+	"\twork_date\x18\x04 \x01(\x03R\bworkDate\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_stage\x18\x06 \x01(\tR\tpageStage\x121\n" +
+	"\asession\x18\a \x01(\v2\x17.attendance.SessionInfoR\asession\"\xa8\x01\n" +
+	"\x1fGetDailyAttendanceSummaryOutput\x12&\n" +
+	"\x0fpage_stage_next\x18\x01 \x01(\fR\rpageStageNext\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12@\n" +
+	"\arecords\x18\x03 \x03(\v2&.attendance.DailyAttendanceSummaryInfoR\arecords\"\x81\x06\n" +
+	"\x1aDailyAttendanceSummaryInfo\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12#\n" +
+	"\rsummary_month\x18\x02 \x01(\tR\fsummaryMonth\x12\x1b\n" +
+	"\twork_date\x18\x03 \x01(\x03R\bworkDate\x12\x1f\n" +
+	"\vemployee_id\x18\x04 \x01(\tR\n" +
+	"employeeId\x12\x19\n" +
+	"\bshift_id\x18\x05 \x01(\tR\ashiftId\x12&\n" +
+	"\x0factual_check_in\x18\x06 \x01(\x03R\ractualCheckIn\x12(\n" +
+	"\x10actual_check_out\x18\a \x01(\x03R\x0eactualCheckOut\x12+\n" +
+	"\x11attendance_status\x18\b \x01(\tR\x10attendanceStatus\x12!\n" +
+	"\flate_minutes\x18\t \x01(\x05R\vlateMinutes\x12.\n" +
+	"\x13early_leave_minutes\x18\n" +
+	" \x01(\x05R\x11earlyLeaveMinutes\x12,\n" +
+	"\x12total_work_minutes\x18\v \x01(\x05R\x10totalWorkMinutes\x12\x14\n" +
+	"\x05notes\x18\f \x01(\tR\x05notes\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\x03R\tupdatedAt\x12+\n" +
+	"\x11scheduled_minutes\x18\x0e \x01(\x05R\x10scheduledMinutes\x12#\n" +
+	"\rbreak_minutes\x18\x0f \x01(\x05R\fbreakMinutes\x12)\n" +
+	"\x10overtime_minutes\x18\x10 \x01(\x05R\x0fovertimeMinutes\x12(\n" +
+	"\x10net_work_minutes\x18\x11 \x01(\x05R\x0enetWorkMinutes\x12?\n" +
+	"\x0ework_intervals\x18\x12 \x03(\v2\x18.attendance.WorkIntervalR\rworkIntervals\x12)\n" +
+	"\x10unpaired_punches\x18\x13 \x01(\x05R\x0funpairedPunches\"`\n" +
+	"\fWorkInterval\x12\x19\n" +
+	"\bcheck_in\x18\x01 \x01(\x03R\acheckIn\x12\x1b\n" +
+	"\tcheck_out\x18\x02 \x01(\x03R\bcheckOut\x12\x18\n" +
+	"\aminutes\x18\x03 \x01(\x05R\aminutes\"\xf1\x01\n" +
+	"!GetAttendanceRecordsEmployeeInput\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\x12\x1d\n" +
+	"\n" +
+	"year_month\x18\x03 \x01(\tR\tyearMonth\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_stage\x18\x05 \x01(\tR\tpageStage\x121\n" +
+	"\asession\x18\x06 \x01(\v2\x17.attendance.SessionInfoR\asession\"\xa5\x01\n" +
+	"\"GetAttendanceRecordsEmployeeOutput\x12&\n" +
+	"\x0fpage_stage_next\x18\x01 \x01(\fR\rpageStageNext\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12:\n" +
+	"\arecords\x18\x03 \x03(\v2 .attendance.AttendanceRecordInfoR\arecords\"\xe9\x01\n" +
+	"\x19GetAttendanceRecordsInput\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\x12\x1d\n" +
+	"\n" +
+	"year_month\x18\x03 \x01(\tR\tyearMonth\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_stage\x18\x05 \x01(\tR\tpageStage\x121\n" +
+	"\asession\x18\x06 \x01(\v2\x17.attendance.SessionInfoR\asession\"\x9d\x01\n" +
+	"\x1aGetAttendanceRecordsOutput\x12&\n" +
+	"\x0fpage_stage_next\x18\x01 \x01(\fR\rpageStageNext\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12:\n" +
+	"\arecords\x18\x03 \x03(\v2 .attendance.AttendanceRecordInfoR\arecords\"\xd6\x04\n" +
+	"\x14AttendanceRecordInfo\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x1d\n" +
+	"\n" +
+	"year_month\x18\x02 \x01(\tR\tyearMonth\x12\x1f\n" +
+	"\vrecord_time\x18\x03 \x01(\x03R\n" +
+	"recordTime\x12\x1f\n" +
+	"\vemployee_id\x18\x04 \x01(\tR\n" +
+	"employeeId\x12\x1b\n" +
+	"\tdevice_id\x18\x05 \x01(\tR\bdeviceId\x12\x1f\n" +
+	"\vrecord_type\x18\x06 \x01(\x05R\n" +
+	"recordType\x12/\n" +
+	"\x13verification_method\x18\a \x01(\tR\x12verificationMethod\x12-\n" +
+	"\x12verification_score\x18\b \x01(\x01R\x11verificationScore\x12$\n" +
+	"\x0eface_image_url\x18\t \x01(\tR\ffaceImageUrl\x121\n" +
+	"\x14location_coordinates\x18\n" +
+	" \x01(\tR\x13locationCoordinates\x12J\n" +
+	"\bmetadata\x18\v \x03(\v2..attendance.AttendanceRecordInfo.MetadataEntryR\bmetadata\x12\x1f\n" +
+	"\vsync_status\x18\f \x01(\tR\n" +
+	"syncStatus\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\x03R\tcreatedAt\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa7\x03\n" +
+	"\x12AddAttendanceInput\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\x12\x1b\n" +
+	"\tdevice_id\x18\x03 \x01(\tR\bdeviceId\x12\x1f\n" +
+	"\vrecord_time\x18\x04 \x01(\x03R\n" +
+	"recordTime\x12/\n" +
+	"\x13verification_method\x18\x05 \x01(\tR\x12verificationMethod\x12-\n" +
+	"\x12verification_score\x18\x06 \x01(\x01R\x11verificationScore\x12$\n" +
+	"\x0eface_image_url\x18\a \x01(\tR\ffaceImageUrl\x121\n" +
+	"\x14location_coordinates\x18\b \x01(\tR\x13locationCoordinates\x121\n" +
+	"\asession\x18\t \x01(\v2\x17.attendance.SessionInfoR\asession\x12'\n" +
+	"\x0fidempotency_key\x18\n" +
+	" \x01(\tR\x0eidempotencyKey\"\x8d\x01\n" +
+	"\x13AddAttendanceOutput\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x1f\n" +
+	"\vstatus_code\x18\x02 \x01(\x05R\n" +
+	"statusCode\x12\x1f\n" +
+	"\vrecord_type\x18\x03 \x01(\x05R\n" +
+	"recordType\x12\x1a\n" +
+	"\breplayed\x18\x04 \x01(\bR\breplayed\"\xb8\x01\n" +
+	"\vSessionInfo\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\x05R\x04role\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x04 \x01(\tR\tcompanyId\x12\x1b\n" +
+	"\tclient_ip\x18\x05 \x01(\tR\bclientIp\x12!\n" +
+	"\fclient_agent\x18\x06 \x01(\tR\vclientAgent*\xb3\x01\n" +
+	"\x0fBatchItemStatus\x12!\n" +
+	"\x1dBATCH_ITEM_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aBATCH_ITEM_STATUS_ACCEPTED\x10\x01\x12\x1e\n" +
+	"\x1aBATCH_ITEM_STATUS_REJECTED\x10\x02\x12\x1f\n" +
+	"\x1bBATCH_ITEM_STATUS_DUPLICATE\x10\x03\x12\x1c\n" +
+	"\x18BATCH_ITEM_STATUS_FAILED\x10\x042\xe3\x0e\n" +
+	"\x11AttendanceService\x12=\n" +
+	"\vHealthCheck\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12P\n" +
+	"\rAddAttendance\x12\x1e.attendance.AddAttendanceInput\x1a\x1f.attendance.AddAttendanceOutput\x12\\\n" +
+	"\x12AddBatchAttendance\x12\x1e.attendance.AddAttendanceInput\x1a\x1f.attendance.AddAttendanceOutput\"\x03\x88\x02\x01(\x01\x12o\n" +
+	"\x19ServiceAddBatchAttendance\x12*.attendance.ServiceAddBatchAttendanceInput\x1a\x1f.attendance.AddAttendanceOutput\"\x03\x88\x02\x01(\x01\x12h\n" +
+	"\x18AddBatchAttendanceStream\x12\".attendance.AddBatchAttendanceItem\x1a$.attendance.AddBatchAttendanceResult(\x010\x01\x12v\n" +
+	"\x1fServiceAddBatchAttendanceStream\x12).attendance.ServiceAddBatchAttendanceItem\x1a$.attendance.AddBatchAttendanceResult(\x010\x01\x12e\n" +
+	"\x14GetAttendanceRecords\x12%.attendance.GetAttendanceRecordsInput\x1a&.attendance.GetAttendanceRecordsOutput\x12u\n" +
+	"\x1cGetAttendanceRecordsEmployee\x12-.attendance.GetAttendanceRecordsEmployeeInput\x1a&.attendance.GetAttendanceRecordsOutput\x12t\n" +
+	"\x19GetDailyAttendanceSummary\x12*.attendance.GetDailyAttendanceSummaryInput\x1a+.attendance.GetDailyAttendanceSummaryOutput\x12\x84\x01\n" +
+	"!GetDailyAttendanceSummaryEmployee\x122.attendance.GetDailyAttendanceSummaryEmployeeInput\x1a+.attendance.GetDailyAttendanceSummaryOutput\x12[\n" +
+	"\x17DeleteAttendanceRecords\x12(.attendance.DeleteAttendanceRecordsInput\x1a\x16.google.protobuf.Empty\x12`\n" +
+	"\x1cDeleteDailyAttendanceSummary\x12(.attendance.DeleteAttendanceRecordsInput\x1a\x16.google.protobuf.Empty\x12n\n" +
+	"\x17CreateCorrectionRequest\x12(.attendance.CreateCorrectionRequestInput\x1a).attendance.CreateCorrectionRequestOutput\x12p\n" +
+	"\x18ApproveCorrectionRequest\x12(.attendance.ReviewCorrectionRequestInput\x1a*.attendance.ApproveCorrectionRequestOutput\x12[\n" +
+	"\x17RejectCorrectionRequest\x12(.attendance.ReviewCorrectionRequestInput\x1a\x16.google.protobuf.Empty\x12y\n" +
+	"\x1dListPendingCorrectionRequests\x12..attendance.ListPendingCorrectionRequestsInput\x1a(.attendance.ListCorrectionRequestsOutput\x12c\n" +
+	"\x17RecomputeDailySummaries\x12(.attendance.RecomputeDailySummariesInput\x1a\x1e.attendance.RecomputeJobOutput\x12S\n" +
+	"\x0fGetRecomputeJob\x12 .attendance.GetRecomputeJobInput\x1a\x1e.attendance.RecomputeJobOutputBKZIgithub.com/youknow2509/cio_verify_face/server/service_attendance/proto/pbb\x06proto3"
+
+var (
+	file_attendance_proto_rawDescOnce sync.Once
+	file_attendance_proto_rawDescData []byte
+)
+
+func file_attendance_proto_rawDescGZIP() []byte {
+	file_attendance_proto_rawDescOnce.Do(func() {
+		file_attendance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_attendance_proto_rawDesc), len(file_attendance_proto_rawDesc)))
+	})
+	return file_attendance_proto_rawDescData
+}
+
+var file_attendance_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_attendance_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_attendance_proto_goTypes = []any{
+	(BatchItemStatus)(0),                           // 0: attendance.BatchItemStatus
+	(*RecomputeDailySummariesInput)(nil),           // 1: attendance.RecomputeDailySummariesInput
+	(*GetRecomputeJobInput)(nil),                   // 2: attendance.GetRecomputeJobInput
+	(*RecomputeJobOutput)(nil),                     // 3: attendance.RecomputeJobOutput
+	(*RecomputeDiff)(nil),                          // 4: attendance.RecomputeDiff
+	(*RecomputeFieldChange)(nil),                   // 5: attendance.RecomputeFieldChange
+	(*CreateCorrectionRequestInput)(nil),           // 6: attendance.CreateCorrectionRequestInput
+	(*CreateCorrectionRequestOutput)(nil),          // 7: attendance.CreateCorrectionRequestOutput
+	(*ReviewCorrectionRequestInput)(nil),           // 8: attendance.ReviewCorrectionRequestInput
+	(*ApproveCorrectionRequestOutput)(nil),         // 9: attendance.ApproveCorrectionRequestOutput
+	(*ListPendingCorrectionRequestsInput)(nil),     // 10: attendance.ListPendingCorrectionRequestsInput
+	(*ListCorrectionRequestsOutput)(nil),           // 11: attendance.ListCorrectionRequestsOutput
+	(*CorrectionRequestInfo)(nil),                  // 12: attendance.CorrectionRequestInfo
+	(*AddBatchAttendanceItem)(nil),                 // 13: attendance.AddBatchAttendanceItem
+	(*ServiceAddBatchAttendanceItem)(nil),          // 14: attendance.ServiceAddBatchAttendanceItem
+	(*AddBatchAttendanceResult)(nil),               // 15: attendance.AddBatchAttendanceResult
+	(*DeleteAttendanceRecordsInput)(nil),           // 16: attendance.DeleteAttendanceRecordsInput
+	(*ServiceAddBatchAttendanceInput)(nil),         // 17: attendance.ServiceAddBatchAttendanceInput
+	(*ServiceSessionInfo)(nil),                     // 18: attendance.ServiceSessionInfo
+	(*GetDailyAttendanceSummaryEmployeeInput)(nil), // 19: attendance.GetDailyAttendanceSummaryEmployeeInput
+	(*GetDailyAttendanceSummaryInput)(nil),         // 20: attendance.GetDailyAttendanceSummaryInput
+	(*GetDailyAttendanceSummaryOutput)(nil),        // 21: attendance.GetDailyAttendanceSummaryOutput
+	(*DailyAttendanceSummaryInfo)(nil),             // 22: attendance.DailyAttendanceSummaryInfo
+	(*WorkInterval)(nil),                           // 23: attendance.WorkInterval
+	(*GetAttendanceRecordsEmployeeInput)(nil),      // 24: attendance.GetAttendanceRecordsEmployeeInput
+	(*GetAttendanceRecordsEmployeeOutput)(nil),     // 25: attendance.GetAttendanceRecordsEmployeeOutput
+	(*GetAttendanceRecordsInput)(nil),              // 26: attendance.GetAttendanceRecordsInput
+	(*GetAttendanceRecordsOutput)(nil),             // 27: attendance.GetAttendanceRecordsOutput
+	(*AttendanceRecordInfo)(nil),                   // 28: attendance.AttendanceRecordInfo
+	(*AddAttendanceInput)(nil),                     // 29: attendance.AddAttendanceInput
+	(*AddAttendanceOutput)(nil),                    // 30: attendance.AddAttendanceOutput
+	(*SessionInfo)(nil),                            // 31: attendance.SessionInfo
+	nil,                                            // 32: attendance.AttendanceRecordInfo.MetadataEntry
+	(*emptypb.Empty)(nil),                          // 33: google.protobuf.Empty
+}
+var file_attendance_proto_depIdxs = []int32{
+	31, // 0: attendance.RecomputeDailySummariesInput.session:type_name -> attendance.SessionInfo
+	31, // 1: attendance.GetRecomputeJobInput.session:type_name -> attendance.SessionInfo
+	4,  // 2: attendance.RecomputeJobOutput.diffs:type_name -> attendance.RecomputeDiff
+	5,  // 3: attendance.RecomputeDiff.changes:type_name -> attendance.RecomputeFieldChange
+	31, // 4: attendance.CreateCorrectionRequestInput.session:type_name -> attendance.SessionInfo
+	31, // 5: attendance.ReviewCorrectionRequestInput.session:type_name -> attendance.SessionInfo
+	31, // 6: attendance.ListPendingCorrectionRequestsInput.session:type_name -> attendance.SessionInfo
+	12, // 7: attendance.ListCorrectionRequestsOutput.records:type_name -> attendance.CorrectionRequestInfo
+	29, // 8: attendance.AddBatchAttendanceItem.attendance:type_name -> attendance.AddAttendanceInput
+	17, // 9: attendance.ServiceAddBatchAttendanceItem.attendance:type_name -> attendance.ServiceAddBatchAttendanceInput
+	0,  // 10: attendance.AddBatchAttendanceResult.status:type_name -> attendance.BatchItemStatus
+	31, // 11: attendance.DeleteAttendanceRecordsInput.session:type_name -> attendance.SessionInfo
+	18, // 12: attendance.DeleteAttendanceRecordsInput.service_session:type_name -> attendance.ServiceSessionInfo
+	18, // 13: attendance.ServiceAddBatchAttendanceInput.session:type_name -> attendance.ServiceSessionInfo
+	31, // 14: attendance.GetDailyAttendanceSummaryEmployeeInput.session:type_name -> attendance.SessionInfo
+	31, // 15: attendance.GetDailyAttendanceSummaryInput.session:type_name -> attendance.SessionInfo
+	22, // 16: attendance.GetDailyAttendanceSummaryOutput.records:type_name -> attendance.DailyAttendanceSummaryInfo
+	23, // 17: attendance.DailyAttendanceSummaryInfo.work_intervals:type_name -> attendance.WorkInterval
+	31, // 18: attendance.GetAttendanceRecordsEmployeeInput.session:type_name -> attendance.SessionInfo
+	28, // 19: attendance.GetAttendanceRecordsEmployeeOutput.records:type_name -> attendance.AttendanceRecordInfo
+	31, // 20: attendance.GetAttendanceRecordsInput.session:type_name -> attendance.SessionInfo
+	28, // 21: attendance.GetAttendanceRecordsOutput.records:type_name -> attendance.AttendanceRecordInfo
+	32, // 22: attendance.AttendanceRecordInfo.metadata:type_name -> attendance.AttendanceRecordInfo.MetadataEntry
+	31, // 23: attendance.AddAttendanceInput.session:type_name -> attendance.SessionInfo
+	33, // 24: attendance.AttendanceService.HealthCheck:input_type -> google.protobuf.Empty
+	29, // 25: attendance.AttendanceService.AddAttendance:input_type -> attendance.AddAttendanceInput
+	29, // 26: attendance.AttendanceService.AddBatchAttendance:input_type -> attendance.AddAttendanceInput
+	17, // 27: attendance.AttendanceService.ServiceAddBatchAttendance:input_type -> attendance.ServiceAddBatchAttendanceInput
+	13, // 28: attendance.AttendanceService.AddBatchAttendanceStream:input_type -> attendance.AddBatchAttendanceItem
+	14, // 29: attendance.AttendanceService.ServiceAddBatchAttendanceStream:input_type -> attendance.ServiceAddBatchAttendanceItem
+	26, // 30: attendance.AttendanceService.GetAttendanceRecords:input_type -> attendance.GetAttendanceRecordsInput
+	24, // 31: attendance.AttendanceService.GetAttendanceRecordsEmployee:input_type -> attendance.GetAttendanceRecordsEmployeeInput
+	20, // 32: attendance.AttendanceService.GetDailyAttendanceSummary:input_type -> attendance.GetDailyAttendanceSummaryInput
+	19, // 33: attendance.AttendanceService.GetDailyAttendanceSummaryEmployee:input_type -> attendance.GetDailyAttendanceSummaryEmployeeInput
+	16, // 34: attendance.AttendanceService.DeleteAttendanceRecords:input_type -> attendance.DeleteAttendanceRecordsInput
+	16, // 35: attendance.AttendanceService.DeleteDailyAttendanceSummary:input_type -> attendance.DeleteAttendanceRecordsInput
+	6,  // 36: attendance.AttendanceService.CreateCorrectionRequest:input_type -> attendance.CreateCorrectionRequestInput
+	8,  // 37: attendance.AttendanceService.ApproveCorrectionRequest:input_type -> attendance.ReviewCorrectionRequestInput
+	8,  // 38: attendance.AttendanceService.RejectCorrectionRequest:input_type -> attendance.ReviewCorrectionRequestInput
+	10, // 39: attendance.AttendanceService.ListPendingCorrectionRequests:input_type -> attendance.ListPendingCorrectionRequestsInput
+	1,  // 40: attendance.AttendanceService.RecomputeDailySummaries:input_type -> attendance.RecomputeDailySummariesInput
+	2,  // 41: attendance.AttendanceService.GetRecomputeJob:input_type -> attendance.GetRecomputeJobInput
+	33, // 42: attendance.AttendanceService.HealthCheck:output_type -> google.protobuf.Empty
+	30, // 43: attendance.AttendanceService.AddAttendance:output_type -> attendance.AddAttendanceOutput
+	30, // 44: attendance.AttendanceService.AddBatchAttendance:output_type -> attendance.AddAttendanceOutput
+	30, // 45: attendance.AttendanceService.ServiceAddBatchAttendance:output_type -> attendance.AddAttendanceOutput
+	15, // 46: attendance.AttendanceService.AddBatchAttendanceStream:output_type -> attendance.AddBatchAttendanceResult
+	15, // 47: attendance.AttendanceService.ServiceAddBatchAttendanceStream:output_type -> attendance.AddBatchAttendanceResult
+	27, // 48: attendance.AttendanceService.GetAttendanceRecords:output_type -> attendance.GetAttendanceRecordsOutput
+	27, // 49: attendance.AttendanceService.GetAttendanceRecordsEmployee:output_type -> attendance.GetAttendanceRecordsOutput
+	21, // 50: attendance.AttendanceService.GetDailyAttendanceSummary:output_type -> attendance.GetDailyAttendanceSummaryOutput
+	21, // 51: attendance.AttendanceService.GetDailyAttendanceSummaryEmployee:output_type -> attendance.GetDailyAttendanceSummaryOutput
+	33, // 52: attendance.AttendanceService.DeleteAttendanceRecords:output_type -> google.protobuf.Empty
+	33, // 53: attendance.AttendanceService.DeleteDailyAttendanceSummary:output_type -> google.protobuf.Empty
+	7,  // 54: attendance.AttendanceService.CreateCorrectionRequest:output_type -> attendance.CreateCorrectionRequestOutput
+	9,  // 55: attendance.AttendanceService.ApproveCorrectionRequest:output_type -> attendance.ApproveCorrectionRequestOutput
+	33, // 56: attendance.AttendanceService.RejectCorrectionRequest:output_type -> google.protobuf.Empty
+	11, // 57: attendance.AttendanceService.ListPendingCorrectionRequests:output_type -> attendance.ListCorrectionRequestsOutput
+	3,  // 58: attendance.AttendanceService.RecomputeDailySummaries:output_type -> attendance.RecomputeJobOutput
+	3,  // 59: attendance.AttendanceService.GetRecomputeJob:output_type -> attendance.RecomputeJobOutput
+	42, // [42:60] is the sub-list for method output_type
+	24, // [24:42] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
+}
+
+func init() { file_attendance_proto_init() }
+func file_attendance_proto_init() {
+	if File_attendance_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_attendance_proto_rawDesc), len(file_attendance_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   32,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_attendance_proto_goTypes,
+		DependencyIndexes: file_attendance_proto_depIdxs,
+		EnumInfos:         file_attendance_proto_enumTypes,
+		MessageInfos:      file_attendance_proto_msgTypes,
+	}.Build()
+	File_attendance_proto = out.File
+	file_attendance_proto_goTypes = nil
+	file_attendance_proto_depIdxs = nil
+}
@@ -0,0 +1,771 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.0
+// source: attendance.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AttendanceService_HealthCheck_FullMethodName                       = "/attendance.AttendanceService/HealthCheck"
+	AttendanceService_AddAttendance_FullMethodName                     = "/attendance.AttendanceService/AddAttendance"
+	AttendanceService_AddBatchAttendance_FullMethodName                = "/attendance.AttendanceService/AddBatchAttendance"
+	AttendanceService_ServiceAddBatchAttendance_FullMethodName         = "/attendance.AttendanceService/ServiceAddBatchAttendance"
+	AttendanceService_AddBatchAttendanceStream_FullMethodName          = "/attendance.AttendanceService/AddBatchAttendanceStream"
+	AttendanceService_ServiceAddBatchAttendanceStream_FullMethodName   = "/attendance.AttendanceService/ServiceAddBatchAttendanceStream"
+	AttendanceService_GetAttendanceRecords_FullMethodName              = "/attendance.AttendanceService/GetAttendanceRecords"
+	AttendanceService_GetAttendanceRecordsEmployee_FullMethodName      = "/attendance.AttendanceService/GetAttendanceRecordsEmployee"
+	AttendanceService_GetDailyAttendanceSummary_FullMethodName         = "/attendance.AttendanceService/GetDailyAttendanceSummary"
+	AttendanceService_GetDailyAttendanceSummaryEmployee_FullMethodName = "/attendance.AttendanceService/GetDailyAttendanceSummaryEmployee"
+	AttendanceService_DeleteAttendanceRecords_FullMethodName           = "/attendance.AttendanceService/DeleteAttendanceRecords"
+	AttendanceService_DeleteDailyAttendanceSummary_FullMethodName      = "/attendance.AttendanceService/DeleteDailyAttendanceSummary"
+	AttendanceService_CreateCorrectionRequest_FullMethodName           = "/attendance.AttendanceService/CreateCorrectionRequest"
+	AttendanceService_ApproveCorrectionRequest_FullMethodName          = "/attendance.AttendanceService/ApproveCorrectionRequest"
+	AttendanceService_RejectCorrectionRequest_FullMethodName           = "/attendance.AttendanceService/RejectCorrectionRequest"
+	AttendanceService_ListPendingCorrectionRequests_FullMethodName     = "/attendance.AttendanceService/ListPendingCorrectionRequests"
+	AttendanceService_RecomputeDailySummaries_FullMethodName           = "/attendance.AttendanceService/RecomputeDailySummaries"
+	AttendanceService_GetRecomputeJob_FullMethodName                   = "/attendance.AttendanceService/GetRecomputeJob"
+)
+
+// AttendanceServiceClient is the client API for AttendanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Attendance service for inter-service communication
+type AttendanceServiceClient interface {
+	// System methods
+	HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Methods Add attendance record
+	AddAttendance(ctx context.Context, in *AddAttendanceInput, opts ...grpc.CallOption) (*AddAttendanceOutput, error)
+	// Deprecated: Do not use.
+	// Legacy client-streaming batch, new callers use AddBatchAttendanceStream / ServiceAddBatchAttendanceStream for per-item results.
+	// Items are processed like the bidirectional RPCs; the single response summarizes failed items as "seq:reason", seq starting at 1
+	AddBatchAttendance(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddAttendanceInput, AddAttendanceOutput], error)
+	// Deprecated: Do not use.
+	ServiceAddBatchAttendance(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ServiceAddBatchAttendanceInput, AddAttendanceOutput], error)
+	// Bidirectional batch: one result per item, correlated by seq; the stream survives item failures
+	AddBatchAttendanceStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AddBatchAttendanceItem, AddBatchAttendanceResult], error)
+	ServiceAddBatchAttendanceStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ServiceAddBatchAttendanceItem, AddBatchAttendanceResult], error)
+	// Methods Get attendance records
+	GetAttendanceRecords(ctx context.Context, in *GetAttendanceRecordsInput, opts ...grpc.CallOption) (*GetAttendanceRecordsOutput, error)
+	GetAttendanceRecordsEmployee(ctx context.Context, in *GetAttendanceRecordsEmployeeInput, opts ...grpc.CallOption) (*GetAttendanceRecordsOutput, error)
+	GetDailyAttendanceSummary(ctx context.Context, in *GetDailyAttendanceSummaryInput, opts ...grpc.CallOption) (*GetDailyAttendanceSummaryOutput, error)
+	GetDailyAttendanceSummaryEmployee(ctx context.Context, in *GetDailyAttendanceSummaryEmployeeInput, opts ...grpc.CallOption) (*GetDailyAttendanceSummaryOutput, error)
+	// Methods Delete attendance records
+	DeleteAttendanceRecords(ctx context.Context, in *DeleteAttendanceRecordsInput, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteDailyAttendanceSummary(ctx context.Context, in *DeleteAttendanceRecordsInput, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Methods Attendance correction requests
+	CreateCorrectionRequest(ctx context.Context, in *CreateCorrectionRequestInput, opts ...grpc.CallOption) (*CreateCorrectionRequestOutput, error)
+	ApproveCorrectionRequest(ctx context.Context, in *ReviewCorrectionRequestInput, opts ...grpc.CallOption) (*ApproveCorrectionRequestOutput, error)
+	RejectCorrectionRequest(ctx context.Context, in *ReviewCorrectionRequestInput, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListPendingCorrectionRequests(ctx context.Context, in *ListPendingCorrectionRequestsInput, opts ...grpc.CallOption) (*ListCorrectionRequestsOutput, error)
+	// Methods Daily summary recompute
+	RecomputeDailySummaries(ctx context.Context, in *RecomputeDailySummariesInput, opts ...grpc.CallOption) (*RecomputeJobOutput, error)
+	GetRecomputeJob(ctx context.Context, in *GetRecomputeJobInput, opts ...grpc.CallOption) (*RecomputeJobOutput, error)
+}
+
+type attendanceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttendanceServiceClient(cc grpc.ClientConnInterface) AttendanceServiceClient {
+	return &attendanceServiceClient{cc}
+}
+
+func (c *attendanceServiceClient) HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AttendanceService_HealthCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) AddAttendance(ctx context.Context, in *AddAttendanceInput, opts ...grpc.CallOption) (*AddAttendanceOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddAttendanceOutput)
+	err := c.cc.Invoke(ctx, AttendanceService_AddAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *attendanceServiceClient) AddBatchAttendance(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddAttendanceInput, AddAttendanceOutput], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AttendanceService_ServiceDesc.Streams[0], AttendanceService_AddBatchAttendance_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AddAttendanceInput, AddAttendanceOutput]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttendanceService_AddBatchAttendanceClient = grpc.ClientStreamingClient[AddAttendanceInput, AddAttendanceOutput]
+
+// Deprecated: Do not use.
+func (c *attendanceServiceClient) ServiceAddBatchAttendance(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ServiceAddBatchAttendanceInput, AddAttendanceOutput], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AttendanceService_ServiceDesc.Streams[1], AttendanceService_ServiceAddBatchAttendance_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ServiceAddBatchAttendanceInput, AddAttendanceOutput]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttendanceService_ServiceAddBatchAttendanceClient = grpc.ClientStreamingClient[ServiceAddBatchAttendanceInput, AddAttendanceOutput]
+
+func (c *attendanceServiceClient) AddBatchAttendanceStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AddBatchAttendanceItem, AddBatchAttendanceResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AttendanceService_ServiceDesc.Streams[2], AttendanceService_AddBatchAttendanceStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AddBatchAttendanceItem, AddBatchAttendanceResult]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttendanceService_AddBatchAttendanceStreamClient = grpc.BidiStreamingClient[AddBatchAttendanceItem, AddBatchAttendanceResult]
+
+func (c *attendanceServiceClient) ServiceAddBatchAttendanceStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ServiceAddBatchAttendanceItem, AddBatchAttendanceResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AttendanceService_ServiceDesc.Streams[3], AttendanceService_ServiceAddBatchAttendanceStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ServiceAddBatchAttendanceItem, AddBatchAttendanceResult]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttendanceService_ServiceAddBatchAttendanceStreamClient = grpc.BidiStreamingClient[ServiceAddBatchAttendanceItem, AddBatchAttendanceResult]
+
+func (c *attendanceServiceClient) GetAttendanceRecords(ctx context.Context, in *GetAttendanceRecordsInput, opts ...grpc.CallOption) (*GetAttendanceRecordsOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttendanceRecordsOutput)
+	err := c.cc.Invoke(ctx, AttendanceService_GetAttendanceRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) GetAttendanceRecordsEmployee(ctx context.Context, in *GetAttendanceRecordsEmployeeInput, opts ...grpc.CallOption) (*GetAttendanceRecordsOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttendanceRecordsOutput)
+	err := c.cc.Invoke(ctx, AttendanceService_GetAttendanceRecordsEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) GetDailyAttendanceSummary(ctx context.Context, in *GetDailyAttendanceSummaryInput, opts ...grpc.CallOption) (*GetDailyAttendanceSummaryOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDailyAttendanceSummaryOutput)
+	err := c.cc.Invoke(ctx, AttendanceService_GetDailyAttendanceSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) GetDailyAttendanceSummaryEmployee(ctx context.Context, in *GetDailyAttendanceSummaryEmployeeInput, opts ...grpc.CallOption) (*GetDailyAttendanceSummaryOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDailyAttendanceSummaryOutput)
+	err := c.cc.Invoke(ctx, AttendanceService_GetDailyAttendanceSummaryEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) DeleteAttendanceRecords(ctx context.Context, in *DeleteAttendanceRecordsInput, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AttendanceService_DeleteAttendanceRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) DeleteDailyAttendanceSummary(ctx context.Context, in *DeleteAttendanceRecordsInput, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AttendanceService_DeleteDailyAttendanceSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) CreateCorrectionRequest(ctx context.Context, in *CreateCorrectionRequestInput, opts ...grpc.CallOption) (*CreateCorrectionRequestOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCorrectionRequestOutput)
+	err := c.cc.Invoke(ctx, AttendanceService_CreateCorrectionRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) ApproveCorrectionRequest(ctx context.Context, in *ReviewCorrectionRequestInput, opts ...grpc.CallOption) (*ApproveCorrectionRequestOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveCorrectionRequestOutput)
+	err := c.cc.Invoke(ctx, AttendanceService_ApproveCorrectionRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) RejectCorrectionRequest(ctx context.Context, in *ReviewCorrectionRequestInput, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AttendanceService_RejectCorrectionRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) ListPendingCorrectionRequests(ctx context.Context, in *ListPendingCorrectionRequestsInput, opts ...grpc.CallOption) (*ListCorrectionRequestsOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCorrectionRequestsOutput)
+	err := c.cc.Invoke(ctx, AttendanceService_ListPendingCorrectionRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) RecomputeDailySummaries(ctx context.Context, in *RecomputeDailySummariesInput, opts ...grpc.CallOption) (*RecomputeJobOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecomputeJobOutput)
+	err := c.cc.Invoke(ctx, AttendanceService_RecomputeDailySummaries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) GetRecomputeJob(ctx context.Context, in *GetRecomputeJobInput, opts ...grpc.CallOption) (*RecomputeJobOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecomputeJobOutput)
+	err := c.cc.Invoke(ctx, AttendanceService_GetRecomputeJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttendanceServiceServer is the server API for AttendanceService service.
+// All implementations must embed UnimplementedAttendanceServiceServer
+// for forward compatibility.
+//
+// Attendance service for inter-service communication
+type AttendanceServiceServer interface {
+	// System methods
+	HealthCheck(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Methods Add attendance record
+	AddAttendance(context.Context, *AddAttendanceInput) (*AddAttendanceOutput, error)
+	// Deprecated: Do not use.
+	// Legacy client-streaming batch, new callers use AddBatchAttendanceStream / ServiceAddBatchAttendanceStream for per-item results.
+	// Items are processed like the bidirectional RPCs; the single response summarizes failed items as "seq:reason", seq starting at 1
+	AddBatchAttendance(grpc.ClientStreamingServer[AddAttendanceInput, AddAttendanceOutput]) error
+	// Deprecated: Do not use.
+	ServiceAddBatchAttendance(grpc.ClientStreamingServer[ServiceAddBatchAttendanceInput, AddAttendanceOutput]) error
+	// Bidirectional batch: one result per item, correlated by seq; the stream survives item failures
+	AddBatchAttendanceStream(grpc.BidiStreamingServer[AddBatchAttendanceItem, AddBatchAttendanceResult]) error
+	ServiceAddBatchAttendanceStream(grpc.BidiStreamingServer[ServiceAddBatchAttendanceItem, AddBatchAttendanceResult]) error
+	// Methods Get attendance records
+	GetAttendanceRecords(context.Context, *GetAttendanceRecordsInput) (*GetAttendanceRecordsOutput, error)
+	GetAttendanceRecordsEmployee(context.Context, *GetAttendanceRecordsEmployeeInput) (*GetAttendanceRecordsOutput, error)
+	GetDailyAttendanceSummary(context.Context, *GetDailyAttendanceSummaryInput) (*GetDailyAttendanceSummaryOutput, error)
+	GetDailyAttendanceSummaryEmployee(context.Context, *GetDailyAttendanceSummaryEmployeeInput) (*GetDailyAttendanceSummaryOutput, error)
+	// Methods Delete attendance records
+	DeleteAttendanceRecords(context.Context, *DeleteAttendanceRecordsInput) (*emptypb.Empty, error)
+	DeleteDailyAttendanceSummary(context.Context, *DeleteAttendanceRecordsInput) (*emptypb.Empty, error)
+	// Methods Attendance correction requests
+	CreateCorrectionRequest(context.Context, *CreateCorrectionRequestInput) (*CreateCorrectionRequestOutput, error)
+	ApproveCorrectionRequest(context.Context, *ReviewCorrectionRequestInput) (*ApproveCorrectionRequestOutput, error)
+	RejectCorrectionRequest(context.Context, *ReviewCorrectionRequestInput) (*emptypb.Empty, error)
+	ListPendingCorrectionRequests(context.Context, *ListPendingCorrectionRequestsInput) (*ListCorrectionRequestsOutput, error)
+	// Methods Daily summary recompute
+	RecomputeDailySummaries(context.Context, *RecomputeDailySummariesInput) (*RecomputeJobOutput, error)
+	GetRecomputeJob(context.Context, *GetRecomputeJobInput) (*RecomputeJobOutput, error)
+	mustEmbedUnimplementedAttendanceServiceServer()
+}
+
+// UnimplementedAttendanceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttendanceServiceServer struct{}
+
+func (UnimplementedAttendanceServiceServer) HealthCheck(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+}
+func (UnimplementedAttendanceServiceServer) AddAttendance(context.Context, *AddAttendanceInput) (*AddAttendanceOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) AddBatchAttendance(grpc.ClientStreamingServer[AddAttendanceInput, AddAttendanceOutput]) error {
+	return status.Errorf(codes.Unimplemented, "method AddBatchAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) ServiceAddBatchAttendance(grpc.ClientStreamingServer[ServiceAddBatchAttendanceInput, AddAttendanceOutput]) error {
+	return status.Errorf(codes.Unimplemented, "method ServiceAddBatchAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) AddBatchAttendanceStream(grpc.BidiStreamingServer[AddBatchAttendanceItem, AddBatchAttendanceResult]) error {
+	return status.Errorf(codes.Unimplemented, "method AddBatchAttendanceStream not implemented")
+}
+func (UnimplementedAttendanceServiceServer) ServiceAddBatchAttendanceStream(grpc.BidiStreamingServer[ServiceAddBatchAttendanceItem, AddBatchAttendanceResult]) error {
+	return status.Errorf(codes.Unimplemented, "method ServiceAddBatchAttendanceStream not implemented")
+}
+func (UnimplementedAttendanceServiceServer) GetAttendanceRecords(context.Context, *GetAttendanceRecordsInput) (*GetAttendanceRecordsOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttendanceRecords not implemented")
+}
+func (UnimplementedAttendanceServiceServer) GetAttendanceRecordsEmployee(context.Context, *GetAttendanceRecordsEmployeeInput) (*GetAttendanceRecordsOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttendanceRecordsEmployee not implemented")
+}
+func (UnimplementedAttendanceServiceServer) GetDailyAttendanceSummary(context.Context, *GetDailyAttendanceSummaryInput) (*GetDailyAttendanceSummaryOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyAttendanceSummary not implemented")
+}
+func (UnimplementedAttendanceServiceServer) GetDailyAttendanceSummaryEmployee(context.Context, *GetDailyAttendanceSummaryEmployeeInput) (*GetDailyAttendanceSummaryOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyAttendanceSummaryEmployee not implemented")
+}
+func (UnimplementedAttendanceServiceServer) DeleteAttendanceRecords(context.Context, *DeleteAttendanceRecordsInput) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttendanceRecords not implemented")
+}
+func (UnimplementedAttendanceServiceServer) DeleteDailyAttendanceSummary(context.Context, *DeleteAttendanceRecordsInput) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDailyAttendanceSummary not implemented")
+}
+func (UnimplementedAttendanceServiceServer) CreateCorrectionRequest(context.Context, *CreateCorrectionRequestInput) (*CreateCorrectionRequestOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCorrectionRequest not implemented")
+}
+func (UnimplementedAttendanceServiceServer) ApproveCorrectionRequest(context.Context, *ReviewCorrectionRequestInput) (*ApproveCorrectionRequestOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveCorrectionRequest not implemented")
+}
+func (UnimplementedAttendanceServiceServer) RejectCorrectionRequest(context.Context, *ReviewCorrectionRequestInput) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectCorrectionRequest not implemented")
+}
+func (UnimplementedAttendanceServiceServer) ListPendingCorrectionRequests(context.Context, *ListPendingCorrectionRequestsInput) (*ListCorrectionRequestsOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingCorrectionRequests not implemented")
+}
+func (UnimplementedAttendanceServiceServer) RecomputeDailySummaries(context.Context, *RecomputeDailySummariesInput) (*RecomputeJobOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecomputeDailySummaries not implemented")
+}
+func (UnimplementedAttendanceServiceServer) GetRecomputeJob(context.Context, *GetRecomputeJobInput) (*RecomputeJobOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecomputeJob not implemented")
+}
+func (UnimplementedAttendanceServiceServer) mustEmbedUnimplementedAttendanceServiceServer() {}
+func (UnimplementedAttendanceServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAttendanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttendanceServiceServer will
+// result in compilation errors.
+type UnsafeAttendanceServiceServer interface {
+	mustEmbedUnimplementedAttendanceServiceServer()
+}
+
+func RegisterAttendanceServiceServer(s grpc.ServiceRegistrar, srv AttendanceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttendanceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttendanceService_ServiceDesc, srv)
+}
+
+func _AttendanceService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_HealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).HealthCheck(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_AddAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAttendanceInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).AddAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_AddAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).AddAttendance(ctx, req.(*AddAttendanceInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_AddBatchAttendance_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AttendanceServiceServer).AddBatchAttendance(&grpc.GenericServerStream[AddAttendanceInput, AddAttendanceOutput]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttendanceService_AddBatchAttendanceServer = grpc.ClientStreamingServer[AddAttendanceInput, AddAttendanceOutput]
+
+func _AttendanceService_ServiceAddBatchAttendance_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AttendanceServiceServer).ServiceAddBatchAttendance(&grpc.GenericServerStream[ServiceAddBatchAttendanceInput, AddAttendanceOutput]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttendanceService_ServiceAddBatchAttendanceServer = grpc.ClientStreamingServer[ServiceAddBatchAttendanceInput, AddAttendanceOutput]
+
+func _AttendanceService_AddBatchAttendanceStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AttendanceServiceServer).AddBatchAttendanceStream(&grpc.GenericServerStream[AddBatchAttendanceItem, AddBatchAttendanceResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttendanceService_AddBatchAttendanceStreamServer = grpc.BidiStreamingServer[AddBatchAttendanceItem, AddBatchAttendanceResult]
+
+func _AttendanceService_ServiceAddBatchAttendanceStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AttendanceServiceServer).ServiceAddBatchAttendanceStream(&grpc.GenericServerStream[ServiceAddBatchAttendanceItem, AddBatchAttendanceResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttendanceService_ServiceAddBatchAttendanceStreamServer = grpc.BidiStreamingServer[ServiceAddBatchAttendanceItem, AddBatchAttendanceResult]
+
+func _AttendanceService_GetAttendanceRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttendanceRecordsInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).GetAttendanceRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_GetAttendanceRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).GetAttendanceRecords(ctx, req.(*GetAttendanceRecordsInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_GetAttendanceRecordsEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttendanceRecordsEmployeeInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).GetAttendanceRecordsEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_GetAttendanceRecordsEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).GetAttendanceRecordsEmployee(ctx, req.(*GetAttendanceRecordsEmployeeInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_GetDailyAttendanceSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyAttendanceSummaryInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).GetDailyAttendanceSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_GetDailyAttendanceSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).GetDailyAttendanceSummary(ctx, req.(*GetDailyAttendanceSummaryInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_GetDailyAttendanceSummaryEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyAttendanceSummaryEmployeeInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).GetDailyAttendanceSummaryEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_GetDailyAttendanceSummaryEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).GetDailyAttendanceSummaryEmployee(ctx, req.(*GetDailyAttendanceSummaryEmployeeInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_DeleteAttendanceRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttendanceRecordsInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).DeleteAttendanceRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_DeleteAttendanceRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).DeleteAttendanceRecords(ctx, req.(*DeleteAttendanceRecordsInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_DeleteDailyAttendanceSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttendanceRecordsInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).DeleteDailyAttendanceSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_DeleteDailyAttendanceSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).DeleteDailyAttendanceSummary(ctx, req.(*DeleteAttendanceRecordsInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_CreateCorrectionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCorrectionRequestInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).CreateCorrectionRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_CreateCorrectionRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).CreateCorrectionRequest(ctx, req.(*CreateCorrectionRequestInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_ApproveCorrectionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewCorrectionRequestInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).ApproveCorrectionRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_ApproveCorrectionRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).ApproveCorrectionRequest(ctx, req.(*ReviewCorrectionRequestInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_RejectCorrectionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewCorrectionRequestInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).RejectCorrectionRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_RejectCorrectionRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).RejectCorrectionRequest(ctx, req.(*ReviewCorrectionRequestInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_ListPendingCorrectionRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingCorrectionRequestsInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).ListPendingCorrectionRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_ListPendingCorrectionRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).ListPendingCorrectionRequests(ctx, req.(*ListPendingCorrectionRequestsInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_RecomputeDailySummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputeDailySummariesInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).RecomputeDailySummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_RecomputeDailySummaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).RecomputeDailySummaries(ctx, req.(*RecomputeDailySummariesInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_GetRecomputeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecomputeJobInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).GetRecomputeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_GetRecomputeJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).GetRecomputeJob(ctx, req.(*GetRecomputeJobInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttendanceService_ServiceDesc is the grpc.ServiceDesc for AttendanceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttendanceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "attendance.AttendanceService",
+	HandlerType: (*AttendanceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HealthCheck",
+			Handler:    _AttendanceService_HealthCheck_Handler,
+		},
+		{
+			MethodName: "AddAttendance",
+			Handler:    _AttendanceService_AddAttendance_Handler,
+		},
+		{
+			MethodName: "GetAttendanceRecords",
+			Handler:    _AttendanceService_GetAttendanceRecords_Handler,
+		},
+		{
+			MethodName: "GetAttendanceRecordsEmployee",
+			Handler:    _AttendanceService_GetAttendanceRecordsEmployee_Handler,
+		},
+		{
+			MethodName: "GetDailyAttendanceSummary",
+			Handler:    _AttendanceService_GetDailyAttendanceSummary_Handler,
+		},
+		{
+			MethodName: "GetDailyAttendanceSummaryEmployee",
+			Handler:    _AttendanceService_GetDailyAttendanceSummaryEmployee_Handler,
+		},
+		{
+			MethodName: "DeleteAttendanceRecords",
+			Handler:    _AttendanceService_DeleteAttendanceRecords_Handler,
+		},
+		{
+			MethodName: "DeleteDailyAttendanceSummary",
+			Handler:    _AttendanceService_DeleteDailyAttendanceSummary_Handler,
+		},
+		{
+			MethodName: "CreateCorrectionRequest",
+			Handler:    _AttendanceService_CreateCorrectionRequest_Handler,
+		},
+		{
+			MethodName: "ApproveCorrectionRequest",
+			Handler:    _AttendanceService_ApproveCorrectionRequest_Handler,
+		},
+		{
+			MethodName: "RejectCorrectionRequest",
+			Handler:    _AttendanceService_RejectCorrectionRequest_Handler,
+		},
+		{
+			MethodName: "ListPendingCorrectionRequests",
+			Handler:    _AttendanceService_ListPendingCorrectionRequests_Handler,
+		},
+		{
+			MethodName: "RecomputeDailySummaries",
+			Handler:    _AttendanceService_RecomputeDailySummaries_Handler,
+		},
+		{
+			MethodName: "GetRecomputeJob",
+			Handler:    _AttendanceService_GetRecomputeJob_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AddBatchAttendance",
+			Handler:       _AttendanceService_AddBatchAttendance_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ServiceAddBatchAttendance",
+			Handler:       _AttendanceService_ServiceAddBatchAttendance_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "AddBatchAttendanceStream",
+			Handler:       _AttendanceService_AddBatchAttendanceStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ServiceAddBatchAttendanceStream",
+			Handler:       _AttendanceService_ServiceAddBatchAttendanceStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "attendance.proto",
+}
@@ -3,6 +3,6 @@
 echo "Generating gRPC code..."
 protoc --go_out=. --go_opt=paths=source_relative \
                 --go-grpc_out=. --go-grpc_opt=paths=source_relative \
-                proto/ws.proto
+                proto/ws.proto proto/ws_frame.proto proto/auth.proto
 
 echo "gRPC code generation completed."
\ No newline at end of file
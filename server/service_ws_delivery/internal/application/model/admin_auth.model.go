@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ================================================
+//
+//	Admin auth model
+//
+// ================================================
+type AuthenticateAdminInput struct {
+	Token        string `json:"token"`
+	CompanyIdReq string `json:"company_id_req"` // Công ty theo dõi, chỉ dùng cho role admin hệ thống
+}
+
+type AdminAuthOutput struct {
+	UserId    uuid.UUID `json:"user_id"`
+	CompanyId uuid.UUID `json:"company_id"`
+	Role      int       `json:"role"`
+	TokenId   string    `json:"token_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type CheckAdminTokenInput struct {
+	TokenId   string    `json:"token_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
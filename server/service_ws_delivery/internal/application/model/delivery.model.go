@@ -0,0 +1,34 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/model"
+)
+
+// ================================================
+//
+//	Delivery model
+//
+// ================================================
+type SendToDeviceInput struct {
+	DeviceId uuid.UUID               `json:"device_id"`
+	Type     domainModel.WSEventType `json:"type"`
+	Payload  interface{}             `json:"payload"`
+	DedupeId string                  `json:"dedupe_id,omitempty"` // Khác rỗng: message cùng DedupeId dùng lại sequence đã cấp
+}
+
+type BroadcastToCompanyInput struct {
+	CompanyId uuid.UUID `json:"company_id"`
+	Message   []byte    `json:"message"`
+}
+
+type ReplayOutboxInput struct {
+	DeviceId     uuid.UUID `json:"device_id"`
+	ConnectionId uuid.UUID `json:"connection_id"`
+	AfterSeq     int64     `json:"after_seq"` // Sequence cuối cùng device đã xử lý
+}
+
+type AckDeviceInput struct {
+	DeviceId uuid.UUID `json:"device_id"`
+	Seq      int64     `json:"seq"`
+}
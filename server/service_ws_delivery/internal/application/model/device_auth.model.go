@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ================================================
+//
+//	Device auth model
+//
+// ================================================
+type AuthenticateDeviceInput struct {
+	Token string `json:"token"`
+}
+
+type DeviceAuthOutput struct {
+	DeviceId  uuid.UUID `json:"device_id"`
+	CompanyId uuid.UUID `json:"company_id"`
+	TokenId   string    `json:"token_id"`
+	TokenHash string    `json:"token_hash"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type CheckDeviceTokenInput struct {
+	DeviceId  uuid.UUID `json:"device_id"`
+	TokenId   string    `json:"token_id"`
+	TokenHash string    `json:"token_hash"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
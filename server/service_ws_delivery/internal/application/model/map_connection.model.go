@@ -14,5 +14,6 @@ type RegisterConnection struct {
 }
 
 type UnregisterConnection struct {
-	DeviceId  string `json:"device_id"`
+	DeviceId     string `json:"device_id"`
+	ConnectionId string `json:"connection_id"`
 }
@@ -0,0 +1,33 @@
+package model
+
+import "github.com/google/uuid"
+
+// ================================================
+//
+//	Monitor model - sự kiện gửi cho phiên admin
+//
+// ================================================
+type PublishDeviceStatusInput struct {
+	DeviceId  uuid.UUID `json:"device_id"`
+	CompanyId uuid.UUID `json:"company_id"`
+	Status    int       `json:"status"`
+	IpAddress string    `json:"ip_address"`
+	Timestamp int64     `json:"timestamp"`
+}
+
+type PublishAdminAlertInput struct {
+	CompanyId uuid.UUID `json:"company_id"`
+	DeviceId  uuid.UUID `json:"device_id"`
+	AlertType int       `json:"alert_type"`
+	Message   string    `json:"message"`
+	Timestamp int64     `json:"timestamp"`
+}
+
+type RecordVerifyResultInput struct {
+	DeviceId  uuid.UUID `json:"device_id"`
+	CompanyId uuid.UUID `json:"company_id"`
+	Result    bool      `json:"result"`
+	Spoofing  bool      `json:"spoofing"`
+	Message   string    `json:"message"`
+	Timestamp int64     `json:"timestamp"`
+}
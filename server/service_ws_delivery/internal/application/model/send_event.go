@@ -6,7 +6,9 @@ import "github.com/google/uuid"
 // Model from send event
 // ==========================================
 type SendDataVerifyFace struct {
+	RequestId string    `json:"request_id"`
 	DeviceId  uuid.UUID `json:"device_id"`
+	CompanyId uuid.UUID `json:"company_id"`
 	DataUrl   string    `json:"data_url"`
 	Metadata  string    `json:"metadata"`
 	Timestamp int64     `json:"timestamp"`
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/model"
+	domainErrors "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/errors"
+)
+
+// =======================================================
+// Admin auth service interface - phiên web admin dùng user token
+// =======================================================
+type IAdminAuthService interface {
+	// AuthenticateAdmin xác thực user token khi handshake và xác định công ty được theo dõi
+	AuthenticateAdmin(ctx context.Context, input *model.AuthenticateAdminInput) (*model.AdminAuthOutput, *domainErrors.TokenValidationError)
+	// CheckAdminTokenActive kiểm tra token của phiên đang mở chưa hết hạn hoặc bị khoá
+	CheckAdminTokenActive(ctx context.Context, input *model.CheckAdminTokenInput) *domainErrors.TokenValidationError
+}
+
+// Save instance interface
+var (
+	_vIAdminAuthService IAdminAuthService
+)
+
+// ================================================
+//
+//	Getter and setter for instance
+//
+// ================================================
+func GetAdminAuthService() IAdminAuthService {
+	return _vIAdminAuthService
+}
+
+func SetAdminAuthService(service IAdminAuthService) error {
+	if service == nil {
+		return errors.New("service is nil")
+	}
+	if _vIAdminAuthService != nil {
+		return errors.New("service already set")
+	}
+	_vIAdminAuthService = service
+	return nil
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/model"
+)
+
+// Device không có kết nối trên replica nào, message vẫn được giữ trong outbox
+var ErrDeviceNotConnected = errors.New("device is not connected")
+
+// =======================================================
+// Delivery service interface - route message to device connection
+// =======================================================
+type IDeliveryService interface {
+	// SendToDevice gán sequence, lưu outbox rồi gửi message tới device,
+	// chuyển tiếp sang replica đang giữ kết nối nếu cần
+	SendToDevice(ctx context.Context, input *model.SendToDeviceInput) error
+	// ReplayOutbox gửi lại message chưa được ack cho kết nối mới của device
+	ReplayOutbox(ctx context.Context, input *model.ReplayOutboxInput) error
+	// AckDevice xoá khỏi outbox các message device đã xử lý
+	AckDevice(ctx context.Context, input *model.AckDeviceInput) error
+	// BroadcastToCompany gửi message tới mọi kết nối của công ty trên tất cả replica
+	BroadcastToCompany(ctx context.Context, input *model.BroadcastToCompanyInput) error
+	// ListenRemoteDelivery nhận message replica khác chuyển tiếp tới, chạy tới khi ctx kết thúc
+	ListenRemoteDelivery(ctx context.Context) error
+	// KeepServiceAlive đánh dấu replica còn sống để replica khác chuyển tiếp message, chạy tới khi ctx kết thúc
+	KeepServiceAlive(ctx context.Context) error
+}
+
+// Save instance interface
+var (
+	_vIDeliveryService IDeliveryService
+)
+
+// ================================================
+//
+//	Getter and setter for instance
+//
+// ================================================
+func GetDeliveryService() IDeliveryService {
+	return _vIDeliveryService
+}
+
+func SetDeliveryService(service IDeliveryService) error {
+	if service == nil {
+		return errors.New("service is nil")
+	}
+	if _vIDeliveryService != nil {
+		return errors.New("service already set")
+	}
+	_vIDeliveryService = service
+	return nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/model"
+	domainErrors "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/errors"
+)
+
+// =======================================================
+// Device auth service interface
+// =======================================================
+type IDeviceAuthService interface {
+	// AuthenticateDevice xác thực device token khi handshake
+	AuthenticateDevice(ctx context.Context, input *model.AuthenticateDeviceInput) (*model.DeviceAuthOutput, *domainErrors.TokenValidationError)
+	// CheckDeviceTokenActive kiểm tra token của kết nối đang mở chưa hết hạn, chưa bị refresh hoặc thu hồi
+	CheckDeviceTokenActive(ctx context.Context, input *model.CheckDeviceTokenInput) *domainErrors.TokenValidationError
+}
+
+// Save instance interface
+var (
+	_vIDeviceAuthService IDeviceAuthService
+)
+
+// ================================================
+//
+//	Getter and setter for instance
+//
+// ================================================
+func GetDeviceAuthService() IDeviceAuthService {
+	return _vIDeviceAuthService
+}
+
+func SetDeviceAuthService(service IDeviceAuthService) error {
+	if service == nil {
+		return errors.New("service is nil")
+	}
+	if _vIDeviceAuthService != nil {
+		return errors.New("service already set")
+	}
+	_vIDeviceAuthService = service
+	return nil
+}
@@ -0,0 +1,92 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/model"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/service"
+	domainCache "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/cache"
+	domainErrors "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/errors"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/model"
+	domainToken "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/token"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/global"
+	utilsCache "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/shared/utils/cache"
+	utilsCrypto "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/shared/utils/crypto"
+)
+
+// ================================================
+// Service admin auth implementation
+// ================================================
+type AdminAuthService struct {
+}
+
+// AuthenticateAdmin implements service.IAdminAuthService.
+func (a *AdminAuthService) AuthenticateAdmin(ctx context.Context, input *model.AuthenticateAdminInput) (*model.AdminAuthOutput, *domainErrors.TokenValidationError) {
+	tokenObj, errToken := domainToken.GetTokenService().ParseUserToken(ctx, input.Token)
+	if errToken != nil {
+		return nil, errToken
+	}
+	// Chỉ admin và manager được theo dõi device
+	if tokenObj.Role > domainModel.RoleManager {
+		return nil, domainErrors.GetTokenValidationError(domainErrors.TokenPermissionDeniedCode)
+	}
+	userId, err := uuid.Parse(tokenObj.UserId)
+	if err != nil {
+		return nil, domainErrors.GetTokenValidationError(domainErrors.TokenMalformedErrorCode)
+	}
+	// Manager chỉ theo dõi công ty của mình, admin hệ thống chọn công ty qua request
+	companyIdStr := tokenObj.CompanyId
+	if tokenObj.Role == domainModel.RoleAdmin && input.CompanyIdReq != "" {
+		companyIdStr = input.CompanyIdReq
+	}
+	companyId, err := uuid.Parse(companyIdStr)
+	if err != nil {
+		return nil, domainErrors.GetTokenValidationError(domainErrors.TokenPermissionDeniedCode)
+	}
+	output := &model.AdminAuthOutput{
+		UserId:    userId,
+		CompanyId: companyId,
+		Role:      tokenObj.Role,
+		TokenId:   tokenObj.TokenId,
+		ExpiresAt: tokenObj.ExpiresAt,
+	}
+	if errToken := a.CheckAdminTokenActive(ctx, &model.CheckAdminTokenInput{
+		TokenId:   output.TokenId,
+		ExpiresAt: output.ExpiresAt,
+	}); errToken != nil {
+		return nil, errToken
+	}
+	return output, nil
+}
+
+// CheckAdminTokenActive implements service.IAdminAuthService.
+func (a *AdminAuthService) CheckAdminTokenActive(ctx context.Context, input *model.CheckAdminTokenInput) *domainErrors.TokenValidationError {
+	if !input.ExpiresAt.IsZero() && time.Now().After(input.ExpiresAt) {
+		return domainErrors.GetTokenValidationError(domainErrors.TokenExpiredErrorCode)
+	}
+	distributedCache, err := domainCache.GetDistributedCache()
+	if err != nil {
+		global.Logger.Error("AdminAuthService.CheckAdminTokenActive", "error", err)
+		return domainErrors.GetTokenValidationError(domainErrors.TokenServiceUnavailableCode)
+	}
+	// Giống middleware access token: không có key hoặc "0" là token đã bị khoá
+	status, err := distributedCache.Get(
+		ctx,
+		utilsCache.GetKeyUserAccessTokenIsActive(utilsCrypto.GetHash(input.TokenId)),
+	)
+	if err != nil {
+		global.Logger.Error("AdminAuthService.CheckAdminTokenActive", "error", err)
+		return domainErrors.GetTokenValidationError(domainErrors.TokenServiceUnavailableCode)
+	}
+	if status == "" || status == "0" {
+		return domainErrors.GetTokenValidationError(domainErrors.TokenRevokedErrorCode)
+	}
+	return nil
+}
+
+// New service and implement
+func NewAdminAuthService() service.IAdminAuthService {
+	return &AdminAuthService{}
+}
@@ -0,0 +1,355 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/model"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/service"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/constants"
+	domainCache "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/cache"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/model"
+	domainRepository "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/repository"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/global"
+	wsCore "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/interfaces/ws/core"
+	utilsCache "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/utils/cache"
+)
+
+// Chu kỳ làm mới khoá alive của replica, nhỏ hơn TTL_SERVICE_WS_ALIVE
+const serviceAliveRefreshInterval = 10 * time.Second
+
+// ================================================
+// Service delivery implementation
+// ================================================
+type DeliveryService struct {
+}
+
+// SendToDevice implements service.IDeliveryService.
+func (d *DeliveryService) SendToDevice(ctx context.Context, input *model.SendToDeviceInput) error {
+	message, err := pushOutbox(ctx, input)
+	if err != nil {
+		global.Logger.Error("DeliveryService.SendToDevice", "error", err)
+		return err
+	}
+	// Device kết nối tại replica này
+	if clients := wsCore.GetHub().GetClientsByDevice(input.DeviceId); len(clients) > 0 {
+		return sendToClients(clients, message)
+	}
+	// Tìm replica đang giữ kết nối trong registry
+	connection, err := domainRepository.GetManagerConnectionRepository().GetConnection(
+		ctx,
+		utilsCache.GetDeviceConnectionWsKey(input.DeviceId.String()),
+	)
+	if err != nil {
+		global.Logger.Error("DeliveryService.SendToDevice", "error", err)
+		return err
+	}
+	if connection == nil || connection.ServiceId == global.ServerSetting.Id {
+		return service.ErrDeviceNotConnected
+	}
+	distributedCache, err := domainCache.GetDistributedCache()
+	if err != nil {
+		return err
+	}
+	// Replica giữ kết nối đã dừng mà chưa kịp xoá registry
+	alive, err := distributedCache.Exists(ctx, utilsCache.GetServiceWsAliveKey(connection.ServiceId))
+	if err != nil {
+		global.Logger.Error("DeliveryService.SendToDevice", "error", err)
+		return err
+	}
+	if !alive {
+		removeStaleConnection(ctx, input.DeviceId.String(), connection)
+		return service.ErrDeviceNotConnected
+	}
+	if err := distributedCache.Publish(
+		ctx,
+		utilsCache.GetServiceDeliveryChannel(connection.ServiceId),
+		domainModel.RemoteDeliveryMessage{
+			DeviceId:     input.DeviceId.String(),
+			ConnectionId: connection.ConnectionId,
+			Data:         message,
+		},
+	); err != nil {
+		global.Logger.Error("DeliveryService.SendToDevice", "error", err)
+		return err
+	}
+	return nil
+}
+
+// ReplayOutbox implements service.IDeliveryService.
+func (d *DeliveryService) ReplayOutbox(ctx context.Context, input *model.ReplayOutboxInput) error {
+	client, ok := wsCore.GetHub().GetClient(input.ConnectionId)
+	if !ok {
+		return service.ErrDeviceNotConnected
+	}
+	outboxRepo := domainRepository.GetDeviceOutboxRepository()
+	outboxKey := utilsCache.GetDeviceOutboxKey(input.DeviceId.String())
+	// Device báo đã xử lý tới AfterSeq nhưng ack có thể bị mất khi rớt mạng
+	if input.AfterSeq > 0 {
+		if err := outboxRepo.Ack(ctx, outboxKey, input.AfterSeq); err != nil {
+			global.Logger.Warn("DeliveryService.ReplayOutbox", "device_id", input.DeviceId, "error", err)
+		}
+	}
+	messages, err := outboxRepo.ListPending(ctx, outboxKey, input.AfterSeq)
+	if err != nil {
+		global.Logger.Error("DeliveryService.ReplayOutbox", "error", err)
+		return err
+	}
+	for _, message := range messages {
+		if err := client.Send(message); err != nil {
+			// Phần còn lại vẫn trong outbox, gửi lại ở lần kết nối sau
+			return err
+		}
+	}
+	return nil
+}
+
+// AckDevice implements service.IDeliveryService.
+func (d *DeliveryService) AckDevice(ctx context.Context, input *model.AckDeviceInput) error {
+	if err := domainRepository.GetDeviceOutboxRepository().Ack(
+		ctx,
+		utilsCache.GetDeviceOutboxKey(input.DeviceId.String()),
+		input.Seq,
+	); err != nil {
+		global.Logger.Error("DeliveryService.AckDevice", "error", err)
+		return err
+	}
+	return nil
+}
+
+// BroadcastToCompany implements service.IDeliveryService.
+func (d *DeliveryService) BroadcastToCompany(ctx context.Context, input *model.BroadcastToCompanyInput) error {
+	// Gửi cho kết nối tại replica này, replica khác nhận qua channel broadcast
+	if clients := wsCore.GetHub().GetClientsByCompany(input.CompanyId); len(clients) > 0 {
+		if err := sendToClients(clients, input.Message); err != nil {
+			global.Logger.Warn("DeliveryService.BroadcastToCompany", "company_id", input.CompanyId, "error", err)
+		}
+	}
+	distributedCache, err := domainCache.GetDistributedCache()
+	if err != nil {
+		return err
+	}
+	if err := distributedCache.Publish(
+		ctx,
+		utilsCache.GetBroadcastDeliveryChannel(),
+		domainModel.RemoteBroadcastMessage{
+			CompanyId: input.CompanyId.String(),
+			ServiceId: global.ServerSetting.Id,
+			Data:      input.Message,
+		},
+	); err != nil {
+		global.Logger.Error("DeliveryService.BroadcastToCompany", "error", err)
+		return err
+	}
+	return nil
+}
+
+// ListenRemoteDelivery implements service.IDeliveryService.
+func (d *DeliveryService) ListenRemoteDelivery(ctx context.Context) error {
+	distributedCache, err := domainCache.GetDistributedCache()
+	if err != nil {
+		return err
+	}
+	messages, err := distributedCache.Subscribe(ctx, utilsCache.GetServiceDeliveryChannel(global.ServerSetting.Id))
+	if err != nil {
+		return err
+	}
+	broadcasts, err := distributedCache.Subscribe(ctx, utilsCache.GetBroadcastDeliveryChannel())
+	if err != nil {
+		return err
+	}
+	for messages != nil || broadcasts != nil {
+		select {
+		case msg, ok := <-messages:
+			if !ok {
+				messages = nil
+				continue
+			}
+			var remote domainModel.RemoteDeliveryMessage
+			if err := decodePubSubMessage(msg, &remote); err != nil {
+				global.Logger.Warn("DeliveryService.ListenRemoteDelivery invalid message", "error", err)
+				continue
+			}
+			if err := deliverLocal(&remote); err != nil {
+				global.Logger.Warn("DeliveryService.ListenRemoteDelivery", "device_id", remote.DeviceId, "error", err)
+			}
+		case msg, ok := <-broadcasts:
+			if !ok {
+				broadcasts = nil
+				continue
+			}
+			var remote domainModel.RemoteBroadcastMessage
+			if err := decodePubSubMessage(msg, &remote); err != nil {
+				global.Logger.Warn("DeliveryService.ListenRemoteDelivery invalid broadcast", "error", err)
+				continue
+			}
+			if remote.ServiceId == global.ServerSetting.Id {
+				continue
+			}
+			if err := broadcastLocal(&remote); err != nil {
+				global.Logger.Warn("DeliveryService.ListenRemoteDelivery", "company_id", remote.CompanyId, "error", err)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return ctx.Err()
+}
+
+// KeepServiceAlive implements service.IDeliveryService.
+func (d *DeliveryService) KeepServiceAlive(ctx context.Context) error {
+	distributedCache, err := domainCache.GetDistributedCache()
+	if err != nil {
+		return err
+	}
+	key := utilsCache.GetServiceWsAliveKey(global.ServerSetting.Id)
+	ticker := time.NewTicker(serviceAliveRefreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := distributedCache.SetTTL(ctx, key, time.Now().Unix(), constants.TTL_SERVICE_WS_ALIVE); err != nil {
+			global.Logger.Warn("DeliveryService.KeepServiceAlive", "error", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			// Dừng nhận chuyển tiếp ngay, không chờ khoá hết hạn
+			_ = distributedCache.Delete(context.Background(), key)
+			return ctx.Err()
+		}
+	}
+}
+
+// pushOutbox gán sequence và lưu message vào outbox trước khi gửi,
+// message chỉ bị xoá khi device ack hoặc outbox hết hạn.
+// Message có DedupeId được gửi lại giữ nguyên sequence và nội dung nên không bị thêm trùng vào outbox.
+func pushOutbox(ctx context.Context, input *model.SendToDeviceInput) ([]byte, error) {
+	outboxRepo := domainRepository.GetDeviceOutboxRepository()
+	deviceId := input.DeviceId.String()
+	ttl := int64(global.ServerWsSetting.OutboxTtl)
+	if ttl <= 0 {
+		ttl = constants.TTL_DEVICE_OUTBOX
+	}
+	var (
+		seq int64
+		err error
+	)
+	if input.DedupeId != "" {
+		seq, err = outboxRepo.ReserveSeq(
+			ctx,
+			utilsCache.GetDeviceSeqKey(deviceId),
+			utilsCache.GetDeviceDeliveryDedupeKey(deviceId, input.DedupeId),
+			ttl,
+		)
+	} else {
+		seq, err = outboxRepo.NextSeq(ctx, utilsCache.GetDeviceSeqKey(deviceId))
+	}
+	if err != nil {
+		return nil, err
+	}
+	message, err := json.Marshal(domainModel.WsDataSend{
+		Seq:     seq,
+		Type:    input.Type,
+		Payload: input.Payload,
+	})
+	if err != nil {
+		return nil, err
+	}
+	maxSize := global.ServerWsSetting.OutboxMaxSize
+	if maxSize <= 0 {
+		maxSize = constants.WS_DEFAULT_OUTBOX_MAX_SIZE
+	}
+	if err := outboxRepo.Push(ctx, &domainModel.OutboxPushInput{
+		OutboxKey: utilsCache.GetDeviceOutboxKey(deviceId),
+		Seq:       seq,
+		Data:      message,
+		MaxSize:   maxSize,
+		Ttl:       ttl,
+	}); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// deliverLocal gửi message chuyển tiếp tới kết nối tại replica này,
+// ưu tiên connection trong registry, sau đó tới kết nối bất kỳ của device
+func deliverLocal(remote *domainModel.RemoteDeliveryMessage) error {
+	hub := wsCore.GetHub()
+	if connId, err := uuid.Parse(remote.ConnectionId); err == nil {
+		if client, ok := hub.GetClient(connId); ok {
+			return client.Send(remote.Data)
+		}
+	}
+	deviceId, err := uuid.Parse(remote.DeviceId)
+	if err != nil {
+		return err
+	}
+	clients := hub.GetClientsByDevice(deviceId)
+	if len(clients) == 0 {
+		return service.ErrDeviceNotConnected
+	}
+	return sendToClients(clients, remote.Data)
+}
+
+// broadcastLocal gửi message broadcast tới kết nối của công ty tại replica này
+func broadcastLocal(remote *domainModel.RemoteBroadcastMessage) error {
+	companyId, err := uuid.Parse(remote.CompanyId)
+	if err != nil {
+		return err
+	}
+	clients := wsCore.GetHub().GetClientsByCompany(companyId)
+	if len(clients) == 0 {
+		return nil
+	}
+	return sendToClients(clients, remote.Data)
+}
+
+// removeStaleConnection xoá kết nối của replica đã dừng khỏi registry
+func removeStaleConnection(ctx context.Context, deviceId string, connection *domainModel.DeviceConnection) {
+	if _, err := domainRepository.GetManagerConnectionRepository().RemoveConnection(
+		ctx,
+		&domainModel.RemoveConnectionInput{
+			DeviceId:              deviceId,
+			ConnectionId:          connection.ConnectionId,
+			DeviceConnectionsKey:  utilsCache.GetDeviceConnectionWsKey(deviceId),
+			ServiceConnectionsKey: utilsCache.GetServiceWsConnectionKey(connection.ServiceId),
+		},
+	); err != nil {
+		global.Logger.Warn("DeliveryService.removeStaleConnection", "device_id", deviceId, "error", err)
+	}
+}
+
+// sendToClients trả lỗi khi không gửi được tới kết nối nào
+func sendToClients(clients []*wsCore.Client, message []byte) error {
+	var errs []error
+	for _, client := range clients {
+		if err := client.Send(message); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == len(clients) {
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+// decodePubSubMessage redis cache đã decode payload JSON thành map, cần encode lại trước khi unmarshal
+func decodePubSubMessage(msg interface{}, out interface{}) error {
+	raw, ok := msg.(string)
+	if !ok {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("marshal pubsub message: %w", err)
+		}
+		raw = string(data)
+	}
+	return json.Unmarshal([]byte(raw), out)
+}
+
+// New service and implement
+func NewDeliveryService() service.IDeliveryService {
+	return &DeliveryService{}
+}
@@ -0,0 +1,97 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/model"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/service"
+	domainCache "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/cache"
+	domainErrors "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/errors"
+	domainToken "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/token"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/global"
+	utilsCache "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/shared/utils/cache"
+	utilsCrypto "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/shared/utils/crypto"
+)
+
+// ================================================
+// Service device auth implementation
+// ================================================
+type DeviceAuthService struct {
+}
+
+// AuthenticateDevice implements service.IDeviceAuthService.
+func (d *DeviceAuthService) AuthenticateDevice(ctx context.Context, input *model.AuthenticateDeviceInput) (*model.DeviceAuthOutput, *domainErrors.TokenValidationError) {
+	tokenObj, errToken := domainToken.GetTokenService().ParseDeviceToken(ctx, input.Token)
+	if errToken != nil {
+		return nil, errToken
+	}
+	deviceId, err := uuid.Parse(tokenObj.DeviceId)
+	if err != nil {
+		return nil, domainErrors.GetTokenValidationError(domainErrors.TokenMalformedErrorCode)
+	}
+	companyId, err := uuid.Parse(tokenObj.CompanyId)
+	if err != nil {
+		return nil, domainErrors.GetTokenValidationError(domainErrors.TokenMalformedErrorCode)
+	}
+	output := &model.DeviceAuthOutput{
+		DeviceId:  deviceId,
+		CompanyId: companyId,
+		TokenId:   tokenObj.TokenId,
+		TokenHash: utilsCrypto.GetHash(input.Token),
+		ExpiresAt: tokenObj.ExpiresAt,
+	}
+	// Auth service cache kết quả parse token, cần kiểm tra trạng thái thu hồi tại đây
+	if errToken := d.CheckDeviceTokenActive(ctx, &model.CheckDeviceTokenInput{
+		DeviceId:  output.DeviceId,
+		TokenId:   output.TokenId,
+		TokenHash: output.TokenHash,
+		ExpiresAt: output.ExpiresAt,
+	}); errToken != nil {
+		return nil, errToken
+	}
+	return output, nil
+}
+
+// CheckDeviceTokenActive implements service.IDeviceAuthService.
+func (d *DeviceAuthService) CheckDeviceTokenActive(ctx context.Context, input *model.CheckDeviceTokenInput) *domainErrors.TokenValidationError {
+	if !input.ExpiresAt.IsZero() && time.Now().After(input.ExpiresAt) {
+		return domainErrors.GetTokenValidationError(domainErrors.TokenExpiredErrorCode)
+	}
+	distributedCache, err := domainCache.GetDistributedCache()
+	if err != nil {
+		global.Logger.Error("DeviceAuthService.CheckDeviceTokenActive", "error", err)
+		return domainErrors.GetTokenValidationError(domainErrors.TokenServiceUnavailableCode)
+	}
+	// Token bị thu hồi
+	status, err := distributedCache.Get(
+		ctx,
+		utilsCache.GetKeyStatusTokenDevice(utilsCrypto.GetHash(input.TokenId)),
+	)
+	if err != nil {
+		global.Logger.Error("DeviceAuthService.CheckDeviceTokenActive", "error", err)
+		return domainErrors.GetTokenValidationError(domainErrors.TokenServiceUnavailableCode)
+	}
+	if status == "0" {
+		return domainErrors.GetTokenValidationError(domainErrors.TokenRevokedErrorCode)
+	}
+	// Token đã được refresh, chỉ token mới nhất của device được kết nối
+	activeTokenHash, err := distributedCache.Get(
+		ctx,
+		utilsCache.GetKeyDeviceActiveToken(utilsCrypto.GetHash(input.DeviceId.String())),
+	)
+	if err != nil {
+		global.Logger.Error("DeviceAuthService.CheckDeviceTokenActive", "error", err)
+		return domainErrors.GetTokenValidationError(domainErrors.TokenServiceUnavailableCode)
+	}
+	if activeTokenHash != "" && activeTokenHash != input.TokenHash {
+		return domainErrors.GetTokenValidationError(domainErrors.TokenRevokedErrorCode)
+	}
+	return nil
+}
+
+// New service and implement
+func NewDeviceAuthService() service.IDeviceAuthService {
+	return &DeviceAuthService{}
+}
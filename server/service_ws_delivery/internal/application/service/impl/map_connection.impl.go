@@ -40,19 +40,21 @@ func (m *MapConnectionService) RegisterConnection(ctx context.Context, input *mo
 }
 
 // UnregisterConnection implements service.IMapConnectionService.
-func (m *MapConnectionService) UnregisterConnection(ctx context.Context, input *model.UnregisterConnection) error {
+func (m *MapConnectionService) UnregisterConnection(ctx context.Context, input *model.UnregisterConnection) (bool, error) {
 	domainMapConnectionRepo := domainRepository.GetManagerConnectionRepository()
-	if _, err := domainMapConnectionRepo.RemoveConnection(
+	removed, err := domainMapConnectionRepo.RemoveConnection(
 		ctx,
 		&domainModel.RemoveConnectionInput{
 			DeviceId:              input.DeviceId,
+			ConnectionId:          input.ConnectionId,
 			DeviceConnectionsKey:  utilsCache.GetDeviceConnectionWsKey(input.DeviceId),
 			ServiceConnectionsKey: utilsCache.GetServiceWsConnectionKey(global.ServerSetting.Id),
-		}); err != nil {
+		})
+	if err != nil {
 		global.Logger.Error("MapConnectionService.UnregisterConnection", "error", err)
-		return err
+		return false, err
 	}
-	return nil
+	return removed, nil
 }
 
 // New service and implement
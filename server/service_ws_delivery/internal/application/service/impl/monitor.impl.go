@@ -0,0 +1,226 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/model"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/service"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/constants"
+	domainCache "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/cache"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/model"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/global"
+	wsCore "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/interfaces/ws/core"
+	utilsCache "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/utils/cache"
+)
+
+// ================================================
+// Service monitor implementation
+// ================================================
+type MonitorService struct {
+}
+
+// PublishDeviceStatus implements service.IMonitorService.
+func (m *MonitorService) PublishDeviceStatus(ctx context.Context, input *model.PublishDeviceStatusInput) error {
+	return publishMonitorEvent(ctx, input.CompanyId, domainModel.WsDataSend{
+		Type: domainModel.WSEventDeviceStatus,
+		Payload: domainModel.ActionDeviceStatusSend{
+			DeviceId:  input.DeviceId,
+			CompanyId: input.CompanyId,
+			Status:    input.Status,
+			IpAddress: input.IpAddress,
+			Timestamp: input.Timestamp,
+		},
+	})
+}
+
+// PublishAdminAlert implements service.IMonitorService.
+func (m *MonitorService) PublishAdminAlert(ctx context.Context, input *model.PublishAdminAlertInput) error {
+	return publishMonitorEvent(ctx, input.CompanyId, domainModel.WsDataSend{
+		Type: domainModel.WSEventAdminAlert,
+		Payload: domainModel.ActionAdminAlertSend{
+			AlertType: input.AlertType,
+			CompanyId: input.CompanyId,
+			DeviceId:  input.DeviceId,
+			Message:   input.Message,
+			Timestamp: input.Timestamp,
+		},
+	})
+}
+
+// RecordVerifyResult implements service.IMonitorService.
+func (m *MonitorService) RecordVerifyResult(ctx context.Context, input *model.RecordVerifyResultInput) error {
+	distributedCache, err := domainCache.GetDistributedCache()
+	if err != nil {
+		return err
+	}
+	failedKey := utilsCache.GetDeviceFailedVerifyKey(input.DeviceId.String())
+	if input.Result {
+		// Xác thực thành công, bắt đầu đếm lại
+		return distributedCache.Delete(ctx, failedKey)
+	}
+	if input.Spoofing {
+		if err := m.PublishAdminAlert(ctx, &model.PublishAdminAlertInput{
+			CompanyId: input.CompanyId,
+			DeviceId:  input.DeviceId,
+			AlertType: domainModel.AdminAlertSpoofing,
+			Message:   input.Message,
+			Timestamp: input.Timestamp,
+		}); err != nil {
+			return err
+		}
+	}
+	count, err := incrementWindow(ctx, distributedCache, failedKey, constants.TTL_DEVICE_FAILED_VERIFY)
+	if err != nil {
+		global.Logger.Error("MonitorService.RecordVerifyResult", "error", err)
+		return err
+	}
+	// Cảnh báo mỗi khi đủ ngưỡng, không gửi lại với từng lần thất bại tiếp theo
+	if count%constants.WS_ALERT_FAILED_VERIFY_THRESHOLD != 0 {
+		return nil
+	}
+	return m.PublishAdminAlert(ctx, &model.PublishAdminAlertInput{
+		CompanyId: input.CompanyId,
+		DeviceId:  input.DeviceId,
+		AlertType: domainModel.AdminAlertRepeatedFailedVerify,
+		Message:   fmt.Sprintf("Device failed %d verifications in a row", count),
+		Timestamp: input.Timestamp,
+	})
+}
+
+// ListenMonitorEvents implements service.IMonitorService.
+func (m *MonitorService) ListenMonitorEvents(ctx context.Context) error {
+	distributedCache, err := domainCache.GetDistributedCache()
+	if err != nil {
+		return err
+	}
+	messages, err := distributedCache.Subscribe(ctx, utilsCache.GetMonitorDeliveryChannel())
+	if err != nil {
+		return err
+	}
+	for msg := range messages {
+		var remote domainModel.RemoteMonitorMessage
+		if err := decodePubSubMessage(msg, &remote); err != nil {
+			global.Logger.Warn("MonitorService.ListenMonitorEvents invalid message", "error", err)
+			continue
+		}
+		companyId, err := uuid.Parse(remote.CompanyId)
+		if err != nil {
+			global.Logger.Warn("MonitorService.ListenMonitorEvents invalid company id", "company_id", remote.CompanyId)
+			continue
+		}
+		clients := wsCore.GetHub().GetAdminClientsByCompany(companyId)
+		if len(clients) == 0 {
+			continue
+		}
+		if err := sendToClients(clients, remote.Data); err != nil {
+			global.Logger.Warn("MonitorService.ListenMonitorEvents", "company_id", remote.CompanyId, "error", err)
+		}
+	}
+	return ctx.Err()
+}
+
+// ListenDeviceStatusEvents implements service.IMonitorService.
+func (m *MonitorService) ListenDeviceStatusEvents(ctx context.Context) error {
+	distributedCache, err := domainCache.GetDistributedCache()
+	if err != nil {
+		return err
+	}
+	messages, err := distributedCache.Subscribe(ctx, constants.RedisChannelDeviceStatusChanged)
+	if err != nil {
+		return err
+	}
+	// Mọi replica đều nhận sự kiện nên chỉ gửi cho phiên admin tại chỗ, không publish lại
+	for msg := range messages {
+		var event domainModel.DeviceStatusChangedEvent
+		if err := decodePubSubMessage(msg, &event); err != nil {
+			global.Logger.Warn("MonitorService.ListenDeviceStatusEvents invalid message", "error", err)
+			continue
+		}
+		deviceId, err := uuid.Parse(event.DeviceId)
+		if err != nil {
+			global.Logger.Warn("MonitorService.ListenDeviceStatusEvents invalid device id", "device_id", event.DeviceId)
+			continue
+		}
+		companyId, err := uuid.Parse(event.CompanyId)
+		if err != nil {
+			global.Logger.Warn("MonitorService.ListenDeviceStatusEvents invalid company id", "company_id", event.CompanyId)
+			continue
+		}
+		clients := wsCore.GetHub().GetAdminClientsByCompany(companyId)
+		if len(clients) == 0 {
+			continue
+		}
+		data, err := json.Marshal(domainModel.WsDataSend{
+			Type: domainModel.WSEventDeviceStatus,
+			Payload: domainModel.ActionDeviceStatusSend{
+				DeviceId:  deviceId,
+				CompanyId: companyId,
+				Status:    event.Status,
+				Reason:    event.Reason,
+				Timestamp: event.Timestamp,
+			},
+		})
+		if err != nil {
+			global.Logger.Error("MonitorService.ListenDeviceStatusEvents", "error", err)
+			continue
+		}
+		if err := sendToClients(clients, data); err != nil {
+			global.Logger.Warn("MonitorService.ListenDeviceStatusEvents", "company_id", event.CompanyId, "error", err)
+		}
+	}
+	return ctx.Err()
+}
+
+// publishMonitorEvent gửi qua pub/sub cả cho replica hiện tại để phiên admin tại chỗ nhận cùng một luồng
+func publishMonitorEvent(ctx context.Context, companyId uuid.UUID, event domainModel.WsDataSend) error {
+	if companyId == uuid.Nil {
+		return errors.New("company id is required")
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	distributedCache, err := domainCache.GetDistributedCache()
+	if err != nil {
+		return err
+	}
+	if err := distributedCache.Publish(
+		ctx,
+		utilsCache.GetMonitorDeliveryChannel(),
+		domainModel.RemoteMonitorMessage{
+			CompanyId: companyId.String(),
+			Data:      data,
+		},
+	); err != nil {
+		global.Logger.Error("MonitorService.publishMonitorEvent", "error", err)
+		return err
+	}
+	return nil
+}
+
+// incrementWindow tăng bộ đếm, TTL đặt ở lần đầu nên bộ đếm tự reset sau cửa sổ
+func incrementWindow(ctx context.Context, distributedCache domainCache.IDistributedCache, key string, ttl int) (int, error) {
+	scriptBytes, err := os.ReadFile(constants.LUA_SCRIPT_INCREMENT_WINDOW_PATH)
+	if err != nil {
+		return 0, errors.New("failed to read Lua script file: " + err.Error())
+	}
+	res, err := distributedCache.LuaScript(ctx, string(scriptBytes), []string{key}, ttl)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := res.(int64)
+	if !ok {
+		return 0, errors.New("unexpected result type from Redis Eval")
+	}
+	return int(count), nil
+}
+
+// New service and implement
+func NewMonitorService() service.IMonitorService {
+	return &MonitorService{}
+}
@@ -25,8 +25,10 @@ func (s *SendEventService) SendDataVerifyFace(ctx context.Context, input *model.
 	err := serviceMq.SendDataVerify(
 		ctx,
 		domainModel.KafkaAttendanceVerifyReceived{
+			RequestId: input.RequestId,
 			ServiceId: global.ServerSetting.Id,
 			DeviceId: input.DeviceId.String(),
+			CompanyId: input.CompanyId.String(),
 			DataUrl: input.DataUrl,
 			Metadata: input.Metadata,
 			Timestamp: input.Timestamp,
@@ -15,8 +15,8 @@ import (
 type IMapConnectionService interface {
 	// Register connection
 	RegisterConnection(ctx context.Context, input *model.RegisterConnection) error
-	// Unregister connection
-	UnregisterConnection(ctx context.Context, input *model.UnregisterConnection) error
+	// Unregister connection, trả về true khi kết nối hiện tại của device bị xoá khỏi registry
+	UnregisterConnection(ctx context.Context, input *model.UnregisterConnection) (bool, error)
 }
 
 // Save instance interface
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/model"
+)
+
+// =======================================================
+// Monitor service interface - trạng thái device và cảnh báo cho phiên admin
+// =======================================================
+type IMonitorService interface {
+	// PublishDeviceStatus gửi trạng thái online/offline của device tới phiên admin của công ty
+	PublishDeviceStatus(ctx context.Context, input *model.PublishDeviceStatusInput) error
+	// PublishAdminAlert gửi cảnh báo tới phiên admin của công ty
+	PublishAdminAlert(ctx context.Context, input *model.PublishAdminAlertInput) error
+	// RecordVerifyResult tạo cảnh báo khi phát hiện giả mạo hoặc device xác thực thất bại liên tiếp
+	RecordVerifyResult(ctx context.Context, input *model.RecordVerifyResultInput) error
+	// ListenMonitorEvents nhận sự kiện từ mọi replica và gửi cho phiên admin tại chỗ, chạy tới khi ctx kết thúc
+	ListenMonitorEvents(ctx context.Context) error
+	// ListenDeviceStatusEvents nhận sự kiện trạng thái device từ service device và gửi cho phiên admin tại chỗ, chạy tới khi ctx kết thúc
+	ListenDeviceStatusEvents(ctx context.Context) error
+}
+
+// Save instance interface
+var (
+	_vIMonitorService IMonitorService
+)
+
+// ================================================
+//
+//	Getter and setter for instance
+//
+// ================================================
+func GetMonitorService() IMonitorService {
+	return _vIMonitorService
+}
+
+func SetMonitorService(service IMonitorService) error {
+	if service == nil {
+		return errors.New("service is nil")
+	}
+	if _vIMonitorService != nil {
+		return errors.New("service already set")
+	}
+	_vIMonitorService = service
+	return nil
+}
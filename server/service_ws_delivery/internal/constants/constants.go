@@ -6,6 +6,7 @@ package constants
 const (
 	LUA_SCRIPT_CREATE_CONNECTION_PATH = "./internal/infrastructure/scripts/lua/create_connection.lua"
 	LUA_SCRIPT_REMOVE_CONNECTION_PATH  = "./internal/infrastructure/scripts/lua/remove_connection.lua"
+	LUA_SCRIPT_INCREMENT_WINDOW_PATH   = "./internal/infrastructure/scripts/lua/increment_window.lua"
 	
 	TIME_OUT_SEND_MSG_TO_CHAN = 1.2
 
@@ -14,6 +14,8 @@ const (
 const (
 	KAFKA_TOPIC_NOTIFICATION             = "notification_requests"
 	KAFKA_TOPIC_ATTENDANCE_VERIFY        = "attendance_verify_requests"
+	KAFKA_TOPIC_ATTENDANCE_VERIFY_RESULT = "attendance_verify_results"
+	KAFKA_TOPIC_ADMIN_ALERT              = "admin_alert_events"
 )
 
 // type event notification
@@ -14,3 +14,11 @@ const (
 const (
 	RedisPrefixRateLimiter = "ratelimiter:"
 )
+
+// Pub/sub channel
+const (
+	// Channel nhận sự kiện token device bị refresh hoặc thu hồi, payload là model.DeviceTokenRevokedEvent
+	RedisChannelDeviceTokenRevoked = "device:token:revoked"
+	// Channel nhận sự kiện trạng thái device thay đổi từ service device (heartbeat, sweeper, admin), payload là model.DeviceStatusChangedEvent
+	RedisChannelDeviceStatusChanged = "device:status:changed"
+)
@@ -18,6 +18,9 @@ const (
 	TTL_LIST_FRIEND_REQUEST_TO_USER = 60 * 3            // 3 minutes
 	TTL_LIST_FRIENDS_OF_USER        = 60 * 10           // 10 minutes
 	TTL_TOKEN_DEVICE                = 60 * 60 * 24 * 30 // 30 days
+	TTL_SERVICE_WS_ALIVE            = 30                // 30 seconds, replica refresh mỗi 10 giây
+	TTL_DEVICE_FAILED_VERIFY        = 60 * 5            // 5 minutes, cửa sổ đếm xác thực thất bại
+	TTL_DEVICE_OUTBOX               = 60 * 10           // 10 minutes, mặc định khi config không đặt
 
 	// Local cache TTLs (shorter for faster invalidation)
 	TTL_LOCAL_USER_INFO_VIEW  = 60 * 2 // 2 minutes
@@ -7,6 +7,9 @@ package constants
 // WS endpoint
 const WS_ENDPOINT = "/ws"
 
+// WS endpoint cho phiên web admin
+const WS_ADMIN_ENDPOINT = "/admin"
+
 // WS route event
 const (
 	ROUTES_HANDLE_EVENT_TO_KAFKA = iota
@@ -20,3 +23,37 @@ const (
 	// v.v
 )
 
+
+// WS handshake authentication
+const (
+	// Query param chứa device token, dùng khi client không set được header
+	WS_TOKEN_QUERY_PARAM = "token"
+	// Prefix của subprotocol chứa device token: Sec-WebSocket-Protocol: bearer.<token>
+	WS_TOKEN_SUBPROTOCOL_PREFIX = "bearer."
+	// Close code gửi cho client khi token hết hạn hoặc bị thu hồi
+	WS_CLOSE_CODE_TOKEN_REVOKED = 4001
+)
+
+// WS admin session
+const (
+	// Query param chọn công ty theo dõi, chỉ dùng cho role admin hệ thống
+	WS_COMPANY_QUERY_PARAM = "company_id"
+	// Số lần xác thực thất bại liên tiếp của device trước khi gửi cảnh báo
+	WS_ALERT_FAILED_VERIFY_THRESHOLD = 5
+)
+
+// WS reliable delivery
+const (
+	// Query param chứa sequence cuối cùng device đã xử lý, server gửi lại message sau sequence này
+	WS_LAST_SEQ_QUERY_PARAM = "last_seq"
+	// Số message chưa ack giữ lại mặc định khi config không đặt
+	WS_DEFAULT_OUTBOX_MAX_SIZE = 100
+)
+
+// WS frame format, chọn qua subprotocol khi handshake
+const (
+	WS_SUBPROTOCOL_JSON     = "json"
+	WS_SUBPROTOCOL_PROTOBUF = "protobuf"
+	// Type của protobuf frame chứa thông báo dạng text, không theo event
+	WS_FRAME_TYPE_TEXT = -1
+)
@@ -7,6 +7,7 @@ package config
 // ==========================================================
 type (
 	Setting struct {
+		AuthService       AuthServiceSetting   `mapstructure:"service_auth"`
 		GrpcServer        GrpcSetting          `mapstructure:"grpc"`
 		Server            ServerSetting        `mapstructure:"server"`
 		WsServer          WsSetting            `mapstructure:"ws"`
@@ -55,6 +56,19 @@ type ServerSetting struct {
 	OutOfServiceThreshold float64 `mapstructure:"out_of_service_threshold"`
 }
 
+// AuthServiceSetting
+type AuthServiceSetting struct {
+	GrpcAddr                    string `mapstructure:"grpc_addr"`
+	TimeoutMs                   int    `mapstructure:"timeout_ms"`
+	KeepaliveTimeMs             int    `mapstructure:"keepalive_time_ms"`
+	KeepaliveTimeoutMs          int    `mapstructure:"keepalive_timeout_ms"`
+	KeepalivePermitWithoutCalls bool   `mapstructure:"keepalive_permit_without_calls"`
+	Tls                         struct {
+		Enabled  bool   `mapstructure:"enabled"`
+		CertFile string `mapstructure:"cert_file"`
+	} `mapstructure:"tls"`
+}
+
 // grpc server
 type GrpcSetting struct {
 	Network string `mapstructure:"network"`
@@ -82,6 +96,15 @@ type WsSetting struct {
 	HandshakeTimeout    int   `mapstructure:"handshake_timeout"`
 	EnableCompression   bool  `mapstructure:"enable_compression"`
 	MaxConnsPerUser     int   `mapstructure:"max_conn_per_user"`
+	// Danh sách origin được phép handshake, "*" cho phép tất cả.
+	// Request không có header Origin (thiết bị native) luôn được chấp nhận.
+	AllowedOrigins []string `mapstructure:"allowed_origins"`
+	// Chu kỳ kiểm tra lại token của các kết nối đang mở (giây)
+	TokenRevalidateInterval int `mapstructure:"token_revalidate_interval"`
+	// Số message chưa ack tối đa giữ lại cho mỗi device
+	OutboxMaxSize int `mapstructure:"outbox_max_size"`
+	// Thời gian giữ message chưa ack (giây)
+	OutboxTtl int `mapstructure:"outbox_ttl"`
 }
 
 // cassandra
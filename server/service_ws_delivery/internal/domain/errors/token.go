@@ -17,6 +17,9 @@ const (
 	TokenSignatureInvalidErrCode = 1003
 	TokenExpiredErrorCode        = 1004
 	TokenErrorNotFoundCode       = 1005
+	TokenRevokedErrorCode        = 1006
+	TokenServiceUnavailableCode  = 1007
+	TokenPermissionDeniedCode    = 1008
 )
 
 var (
@@ -26,6 +29,9 @@ var (
 		TokenSignatureInvalidErrCode: "Token signature is invalid",
 		TokenExpiredErrorCode:        "Token has expired",
 		TokenErrorNotFoundCode:       "Token error not found",
+		TokenRevokedErrorCode:        "Token has been revoked",
+		TokenServiceUnavailableCode:  "Token service is unavailable",
+		TokenPermissionDeniedCode:    "Token does not have permission",
 	}
 )
 
@@ -19,9 +19,34 @@ type (
 type (
 	// For action attendance result - server send to clients
 	ActionAttendanceResultSend struct {
+		RequestId  string    `json:"request_id"`
+		DeviceId   uuid.UUID `json:"device_id"`
+		UserId     uuid.UUID `json:"user_id"`
+		UserName   string    `json:"user_name,omitempty"`
+		Result     bool      `json:"result"`      // true - success | false - fail
+		RecordType int       `json:"record_type"` // 0: check in, 1: check out
+		RecordTime int64     `json:"record_time"`
+		Score      float64   `json:"score"`
+		Message    string    `json:"message,omitempty"`
+		Timestamp  int64     `json:"timestamp"`
+	}
+
+	// For action device status - server send to admin sessions
+	ActionDeviceStatusSend struct {
 		DeviceId  uuid.UUID `json:"device_id"`
-		UserId    uuid.UUID `json:"user_id"`
-		Result    bool      `json:"result"` // true - success | false - fail
+		CompanyId uuid.UUID `json:"company_id"`
+		Status    int       `json:"status"` // 0: offline | 1: online
+		IpAddress string    `json:"ip_address,omitempty"`
+		Reason    string    `json:"reason,omitempty"` // Có giá trị khi trạng thái do service device thay đổi
+		Timestamp int64     `json:"timestamp"`
+	}
+
+	// For action admin alert - server send to admin sessions
+	ActionAdminAlertSend struct {
+		AlertType int       `json:"alert_type"`
+		CompanyId uuid.UUID `json:"company_id"`
+		DeviceId  uuid.UUID `json:"device_id,omitempty"`
+		Message   string    `json:"message,omitempty"`
 		Timestamp int64     `json:"timestamp"`
 	}
 )
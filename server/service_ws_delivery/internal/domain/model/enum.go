@@ -12,4 +12,26 @@ const (
 	WSEventSendAttendance
 	WSEventDeviceStatus
 	WSEventAdminAlert
+	WSEventAck          // Client ack message đã nhận theo sequence
+	WSEventDeviceConfig // service_device đẩy cấu hình mới, device ack version đã áp dụng qua service_device
+)
+
+// User role, trùng với service auth
+const (
+	RoleAdmin   = 0
+	RoleManager = 1
+	RoleUser    = 2
+)
+
+// Device status gửi cho phiên admin
+const (
+	DeviceStatusOffline = 0
+	DeviceStatusOnline  = 1
+)
+
+// Admin alert types
+const (
+	AdminAlertSpoofing             = 1 // Phát hiện giả mạo khuôn mặt (liveness check fail)
+	AdminAlertRepeatedFailedVerify = 2 // Device xác thực thất bại liên tiếp
+	// v.v - alert từ service khác gửi qua kafka giữ nguyên type
 )
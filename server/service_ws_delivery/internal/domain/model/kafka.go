@@ -1,9 +1,37 @@
 package model
 
 type KafkaAttendanceVerifyReceived struct {
+	RequestId string `json:"request_id"`
 	ServiceId string `json:"service_id"`
 	DeviceId  string `json:"device_id"`
+	CompanyId string `json:"company_id"`
 	DataUrl   string `json:"data_url"`
 	Metadata  string `json:"metadata"`
 	Timestamp int64  `json:"timestamp"`
-}
\ No newline at end of file
+}
+
+// Kết quả xác thực khuôn mặt trả về cho device đã gửi request
+type KafkaAttendanceVerifyResult struct {
+	RequestId    string  `json:"request_id"`
+	ServiceId    string  `json:"service_id"`
+	DeviceId     string  `json:"device_id"`
+	CompanyId    string  `json:"company_id,omitempty"`
+	Result       bool    `json:"result"`
+	Spoofing     bool    `json:"spoofing,omitempty"` // liveness check fail
+	EmployeeId   string  `json:"employee_id,omitempty"`
+	EmployeeName string  `json:"employee_name,omitempty"`
+	RecordType   int     `json:"record_type"` // 0: check in, 1: check out
+	RecordTime   int64   `json:"record_time"`
+	Score        float64 `json:"score"`
+	Message      string  `json:"message,omitempty"`
+	Timestamp    int64   `json:"timestamp"`
+}
+
+// Cảnh báo cho phiên admin của công ty, gửi từ service khác
+type KafkaAdminAlertEvent struct {
+	CompanyId string `json:"company_id"`
+	DeviceId  string `json:"device_id,omitempty"`
+	AlertType int    `json:"alert_type"`
+	Message   string `json:"message,omitempty"`
+	Timestamp int64  `json:"timestamp"`
+}
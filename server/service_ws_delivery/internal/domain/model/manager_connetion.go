@@ -33,7 +33,18 @@ type RemoveConnectionInput struct {
 	// 2: service_conns_key
 	// ARGV:
 	// 1: device_id
+	// 2: connection_id
 	DeviceId              string `json:"device_id"`
+	ConnectionId          string `json:"connection_id"`
 	DeviceConnectionsKey  string `json:"device_connections_key"`
 	ServiceConnectionsKey string `json:"service_connections_key"`
 }
+
+// Thông tin kết nối hiện tại của device trong registry
+type DeviceConnection struct {
+	ConnectionId string `json:"connection_id"`
+	ServiceId    string `json:"service_id"`
+	IpAddress    string `json:"ip_address"`
+	ConnectedAt  string `json:"connected_at"`
+	UserAgent    string `json:"user_agent"`
+}
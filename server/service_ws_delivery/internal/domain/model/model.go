@@ -10,7 +10,37 @@ type (
 	}
 
 	WsDataSend struct {
+		Seq     int64       `json:"seq,omitempty"` // Chỉ có với message cần ack
 		Type    WSEventType `json:"type"`
 		Payload interface{} `json:"payload"`
 	}
+
+	// Message chuyển tiếp qua redis pub/sub tới replica đang giữ kết nối của device
+	RemoteDeliveryMessage struct {
+		DeviceId     string `json:"device_id"`
+		ConnectionId string `json:"connection_id"`
+		Data         []byte `json:"data"`
+	}
+
+	// Message broadcast qua redis pub/sub tới mọi replica, mỗi replica gửi cho kết nối của công ty tại chỗ
+	RemoteBroadcastMessage struct {
+		CompanyId string `json:"company_id"`
+		ServiceId string `json:"service_id"` // replica gửi, đã tự gửi cho kết nối tại chỗ
+		Data      []byte `json:"data"`
+	}
+
+	// Message gửi qua redis pub/sub tới phiên admin của công ty trên mọi replica
+	RemoteMonitorMessage struct {
+		CompanyId string `json:"company_id"`
+		Data      []byte `json:"data"`
+	}
+
+	// Sự kiện trạng thái device thay đổi do service device gửi
+	DeviceStatusChangedEvent struct {
+		DeviceId  string `json:"device_id"`
+		CompanyId string `json:"company_id"`
+		Status    int    `json:"status"` // 0: OFFLINE, 1: ONLINE, 2: MAINTENANCE, 3: ERROR
+		Reason    string `json:"reason"`
+		Timestamp int64  `json:"timestamp"`
+	}
 )
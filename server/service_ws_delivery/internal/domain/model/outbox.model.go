@@ -0,0 +1,14 @@
+package model
+
+// ===============================================================
+//
+//	Define model for device outbox
+//
+// ===============================================================
+type OutboxPushInput struct {
+	OutboxKey string `json:"outbox_key"`
+	Seq       int64  `json:"seq"`
+	Data      []byte `json:"data"`
+	MaxSize   int    `json:"max_size"` // Giữ lại số message mới nhất
+	Ttl       int64  `json:"ttl"`      // Seconds, làm mới mỗi lần push
+}
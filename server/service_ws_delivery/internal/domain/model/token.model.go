@@ -61,6 +61,7 @@ type (
 	TokenUserJwtOutput struct {
 		UserId    string    `json:"user_id"`
 		Role      int       `json:"role"`
+		CompanyId string    `json:"company_id"`
 		TokenId   string    `json:"jti,omitempty"`
 		Issuer    string    `json:"iss,omitempty"`
 		Subject   string    `json:"sub,omitempty"`
@@ -105,3 +106,15 @@ type (
 		IssuedAt  time.Time `json:"iat,omitempty"`
 	}
 )
+
+// ========================================
+//
+//	Token event model
+//
+// ========================================
+type (
+	// DeviceTokenRevokedEvent sự kiện token của device được refresh hoặc bị thu hồi
+	DeviceTokenRevokedEvent struct {
+		DeviceId string `json:"device_id"`
+	}
+)
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	model "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/model"
+)
+
+// ======================================================================================================
+//
+//	Device outbox Repository Interface - lưu message chưa được device ack để gửi lại khi kết nối lại
+//
+// ======================================================================================================
+type IDeviceOutboxRepository interface {
+	// NextSeq cấp sequence tăng dần theo device, không hết hạn để không cấp lại sequence cũ
+	NextSeq(ctx context.Context, seqKey string) (int64, error)
+	// ReserveSeq cấp sequence một lần cho mỗi dedupeKey, message gửi lại (kafka redelivery) dùng lại sequence đã cấp
+	ReserveSeq(ctx context.Context, seqKey string, dedupeKey string, ttl int64) (int64, error)
+	Push(ctx context.Context, input *model.OutboxPushInput) error
+	// Ack xoá các message có sequence <= seq
+	Ack(ctx context.Context, outboxKey string, seq int64) error
+	// ListPending trả về message có sequence > afterSeq theo thứ tự sequence
+	ListPending(ctx context.Context, outboxKey string, afterSeq int64) ([][]byte, error)
+}
+
+// variable to hold the repository implementation
+var (
+	_vIDeviceOutboxRepository IDeviceOutboxRepository
+)
+
+// ======================================================================================================
+//
+//	Getter and setter for the repository implementation
+//
+// ======================================================================================================
+func GetDeviceOutboxRepository() IDeviceOutboxRepository {
+	return _vIDeviceOutboxRepository
+}
+
+func SetDeviceOutboxRepository(deviceOutboxRepository IDeviceOutboxRepository) error {
+	if deviceOutboxRepository == nil {
+		return errors.New("device outbox repository cannot be nil")
+	}
+	if _vIDeviceOutboxRepository != nil {
+		return errors.New("device outbox repository is already set")
+	}
+	_vIDeviceOutboxRepository = deviceOutboxRepository
+	return nil
+}
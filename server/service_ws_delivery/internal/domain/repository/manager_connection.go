@@ -15,6 +15,8 @@ import (
 type IManagerConnectionRepository interface {
 	CreateConnection(ctx context.Context, input *model.CreateConnectionInput) (bool, error)
 	RemoveConnection(ctx context.Context, input *model.RemoveConnectionInput) (bool, error)
+	// GetConnection trả về nil nếu device không có kết nối
+	GetConnection(ctx context.Context, deviceConnectionsKey string) (*model.DeviceConnection, error)
 }
 
 // variable to hold the repository implementation
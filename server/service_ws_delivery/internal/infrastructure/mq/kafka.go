@@ -5,13 +5,13 @@ import (
 	"crypto/tls"
 	"time"
 
-	clients "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/infrastructure/conn"
-	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/config"
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
 	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/constants"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/config"
 	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/mq"
+	clients "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/infrastructure/conn"
 	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/shared/utils"
-	"github.com/segmentio/kafka-go"
-	"github.com/segmentio/kafka-go/sasl"
 )
 
 type (
@@ -34,14 +34,15 @@ type (
 
 // ReadListenTopicManual implements mq.IKafkaRead.
 func (k *KafkaReaderService) ReadListenTopicManual(ctx context.Context, topic string, callback func(message interface{}) error) error {
-	reader := k.getConsumer()
+	reader := k.getConsumer(topic)
 	defer reader.Close()
 	for {
-		m, err := reader.ReadMessage(ctx)
+		// FetchMessage không tự commit, offset chỉ được commit sau khi callback xử lý thành công
+		m, err := reader.FetchMessage(ctx)
 		if err != nil {
 			return err
 		}
-		if err := callback(&m); err != nil {
+		if err := callback(m.Value); err != nil {
 			return err
 		}
 		// Commit the message after processing
@@ -53,37 +54,37 @@ func (k *KafkaReaderService) ReadListenTopicManual(ctx context.Context, topic st
 
 // ReadMessageAtOffset implements mq.IKafkaRead.
 func (k *KafkaReaderService) ReadMessageAtOffset(ctx context.Context, topic string, partition int32, offset int64) (interface{}, error) {
-	reader := k.getConsumer()
+	reader := k.getConsumer(topic)
 	defer reader.Close()
 	reader.SetOffset(offset)
 	m, err := reader.ReadMessage(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &m, nil
+	return m.Value, nil
 }
 
 // ReadMessageAutoCommit implements mq.IKafkaRead.
 func (k *KafkaReaderService) ReadMessageAutoCommit(ctx context.Context, topic string) (interface{}, error) {
-	reader := k.getConsumerAutoCommit()
+	reader := k.getConsumerAutoCommit(topic)
 	m, err := reader.ReadMessage(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &m, nil
+	return m.Value, nil
 }
 
 // ReadMessageBatchAtOffsetManual implements mq.IKafkaRead.
 func (k *KafkaReaderService) ReadMessageBatchAtOffsetManual(ctx context.Context, topic string, partition int32, offset int64, limit int32, callback func(message interface{}) error) error {
-	reader := k.getConsumer()
+	reader := k.getConsumer(topic)
 	defer reader.Close()
 	reader.SetOffset(offset)
 	for i := int32(0); i < limit; i++ {
-		m, err := reader.ReadMessage(ctx)
+		m, err := reader.FetchMessage(ctx)
 		if err != nil {
 			return err
 		}
-		if err := callback(&m); err != nil {
+		if err := callback(m.Value); err != nil {
 			return err
 		}
 		// Commit the message after processing
@@ -96,7 +97,7 @@ func (k *KafkaReaderService) ReadMessageBatchAtOffsetManual(ctx context.Context,
 
 // ReadMessageBatchFromTimestampManual implements mq.IKafkaRead.
 func (k *KafkaReaderService) ReadMessageBatchFromTimestampManual(ctx context.Context, topic string, partition int32, timestamp int64, limit int32, callback func(message interface{}) error) error {
-	reader := k.getConsumer()
+	reader := k.getConsumer(topic)
 	defer reader.Close()
 	seekTime := time.UnixMilli(timestamp)
 	reader.SetOffsetAt(ctx, seekTime)
@@ -105,7 +106,7 @@ func (k *KafkaReaderService) ReadMessageBatchFromTimestampManual(ctx context.Con
 		if err != nil {
 			return err
 		}
-		if err := callback(&m); err != nil {
+		if err := callback(m.Value); err != nil {
 			return err
 		}
 	}
@@ -119,7 +120,7 @@ func (k *KafkaReaderService) ReadMessageBatchManual(ctx context.Context, topic s
 
 // ReadMessageFromTimestamp implements mq.IKafkaRead.
 func (k *KafkaReaderService) ReadMessageFromTimestamp(ctx context.Context, topic string, partition int32, timestamp int64) (interface{}, error) {
-	reader := k.getConsumer()
+	reader := k.getConsumer(topic)
 	defer reader.Close()
 	seekTime := time.UnixMilli(timestamp)
 	reader.SetOffsetAt(ctx, seekTime)
@@ -127,19 +128,19 @@ func (k *KafkaReaderService) ReadMessageFromTimestamp(ctx context.Context, topic
 	if err != nil {
 		return nil, err
 	}
-	return &m, nil
+	return m.Value, nil
 }
 
 // ReadMessageManual implements mq.IKafkaRead.
 func (k *KafkaReaderService) ReadMessageManual(ctx context.Context, topic string, callback func(message interface{}) error) error {
-	reader := k.getConsumer()
+	reader := k.getConsumer(topic)
 	defer reader.Close()
 	for {
 		m, err := reader.ReadMessage(ctx)
 		if err != nil {
 			return err
 		}
-		if err := callback(&m); err != nil {
+		if err := callback(m.Value); err != nil {
 			return err
 		}
 	}
@@ -147,7 +148,7 @@ func (k *KafkaReaderService) ReadMessageManual(ctx context.Context, topic string
 
 // commitMessage implements mq.IKafkaRead.
 func (k *KafkaReaderService) CommitMessage(ctx context.Context, topic string, partition int32, offset int64) error {
-	cl := k.getConsumer()
+	cl := k.getConsumer(topic)
 	defer cl.Close()
 	message := &kafka.Message{
 		Topic:     topic,
@@ -295,11 +296,12 @@ func (k *KafkaWriterService) getProducerAllAckRequired() *kafka.Writer {
 	return writer
 }
 
-func (k *KafkaReaderService) getConsumer() *kafka.Reader {
+func (k *KafkaReaderService) getConsumer(topic string) *kafka.Reader {
 	return kafka.NewReader(
 		kafka.ReaderConfig{
 			Brokers: k.kafkaSetting.Brokers,
 			GroupID: k.kafkaSetting.Consumer.GroupID,
+			Topic:   topic,
 			Dialer: &kafka.Dialer{
 				TLS:           k.kafkaTls,
 				SASLMechanism: k.kafkaSasl,
@@ -320,11 +322,12 @@ func (k *KafkaReaderService) getConsumer() *kafka.Reader {
 		})
 }
 
-func (k *KafkaReaderService) getConsumerAutoCommit() *kafka.Reader {
+func (k *KafkaReaderService) getConsumerAutoCommit(topic string) *kafka.Reader {
 	return kafka.NewReader(
 		kafka.ReaderConfig{
 			Brokers: k.kafkaSetting.Brokers,
 			GroupID: k.kafkaSetting.Consumer.GroupID,
+			Topic:   topic,
 			Dialer: &kafka.Dialer{
 				TLS:           k.kafkaTls,
 				SASLMechanism: k.kafkaSasl,
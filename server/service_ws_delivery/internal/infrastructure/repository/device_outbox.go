@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/model"
+	domainRepository "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/repository"
+)
+
+// ======================================================================================================
+// Redis device outbox repository implementation - sorted set, score là sequence
+// ======================================================================================================
+type RedisDeviceOutboxRepository struct {
+	client *redis.Client
+}
+
+// NextSeq implements repository.IDeviceOutboxRepository.
+func (r *RedisDeviceOutboxRepository) NextSeq(ctx context.Context, seqKey string) (int64, error) {
+	seq, err := r.client.Incr(ctx, seqKey).Result()
+	if err != nil {
+		return 0, errors.New("failed to increment device sequence: " + err.Error())
+	}
+	return seq, nil
+}
+
+// ReserveSeq implements repository.IDeviceOutboxRepository.
+func (r *RedisDeviceOutboxRepository) ReserveSeq(ctx context.Context, seqKey string, dedupeKey string, ttl int64) (int64, error) {
+	seq, err := r.client.Get(ctx, dedupeKey).Int64()
+	if err == nil {
+		return seq, nil
+	}
+	if !errors.Is(err, redis.Nil) {
+		return 0, errors.New("failed to get reserved device sequence: " + err.Error())
+	}
+	if seq, err = r.NextSeq(ctx, seqKey); err != nil {
+		return 0, err
+	}
+	ok, err := r.client.SetNX(ctx, dedupeKey, seq, time.Duration(ttl)*time.Second).Result()
+	if err != nil {
+		return 0, errors.New("failed to reserve device sequence: " + err.Error())
+	}
+	if ok {
+		return seq, nil
+	}
+	// Consumer khác vừa cấp sequence cho cùng message, dùng sequence đã được giữ
+	seq, err = r.client.Get(ctx, dedupeKey).Int64()
+	if err != nil {
+		return 0, errors.New("failed to get reserved device sequence: " + err.Error())
+	}
+	return seq, nil
+}
+
+// Push implements repository.IDeviceOutboxRepository.
+func (r *RedisDeviceOutboxRepository) Push(ctx context.Context, input *model.OutboxPushInput) error {
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, input.OutboxKey, redis.Z{
+			Score:  float64(input.Seq),
+			Member: input.Data,
+		})
+		// Bỏ message cũ nhất khi vượt quá kích thước
+		if input.MaxSize > 0 {
+			pipe.ZRemRangeByRank(ctx, input.OutboxKey, 0, int64(-input.MaxSize-1))
+		}
+		if input.Ttl > 0 {
+			pipe.Expire(ctx, input.OutboxKey, time.Duration(input.Ttl)*time.Second)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.New("failed to push device outbox: " + err.Error())
+	}
+	return nil
+}
+
+// Ack implements repository.IDeviceOutboxRepository.
+func (r *RedisDeviceOutboxRepository) Ack(ctx context.Context, outboxKey string, seq int64) error {
+	if err := r.client.ZRemRangeByScore(ctx, outboxKey, "-inf", strconv.FormatInt(seq, 10)).Err(); err != nil {
+		return errors.New("failed to ack device outbox: " + err.Error())
+	}
+	return nil
+}
+
+// ListPending implements repository.IDeviceOutboxRepository.
+func (r *RedisDeviceOutboxRepository) ListPending(ctx context.Context, outboxKey string, afterSeq int64) ([][]byte, error) {
+	res, err := r.client.ZRangeByScore(ctx, outboxKey, &redis.ZRangeBy{
+		Min: "(" + strconv.FormatInt(afterSeq, 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return nil, errors.New("failed to list device outbox: " + err.Error())
+	}
+	messages := make([][]byte, 0, len(res))
+	for _, item := range res {
+		messages = append(messages, []byte(item))
+	}
+	return messages, nil
+}
+
+/**
+ * NewRedisDeviceOutboxRepository creates a new instance of RedisDeviceOutboxRepository
+ * implementation Domain DeviceOutboxRepository
+ */
+func NewRedisDeviceOutboxRepository(client *redis.Client) domainRepository.IDeviceOutboxRepository {
+	return &RedisDeviceOutboxRepository{
+		client: client,
+	}
+}
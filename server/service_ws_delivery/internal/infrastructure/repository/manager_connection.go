@@ -80,8 +80,10 @@ func (r *RedisManagerConnectionRepository) RemoveConnection(ctx context.Context,
 	}
 	// ARGV:
 	// 1: device_id
+	// 2: connection_id
 	args := []interface{}{
 		input.DeviceId,
+		input.ConnectionId,
 	}
 	// Send script to Redis
 	res, err := r.client.Eval(ctx, script, keys, args...).Result()
@@ -95,6 +97,24 @@ func (r *RedisManagerConnectionRepository) RemoveConnection(ctx context.Context,
 	return intRes == 1, nil
 }
 
+// GetConnection implements repository.IManagerConnectionRepository.
+func (r *RedisManagerConnectionRepository) GetConnection(ctx context.Context, deviceConnectionsKey string) (*model.DeviceConnection, error) {
+	res, err := r.client.HGetAll(ctx, deviceConnectionsKey).Result()
+	if err != nil {
+		return nil, errors.New("failed to get device connection: " + err.Error())
+	}
+	if len(res) == 0 || res["connection_id"] == "" {
+		return nil, nil
+	}
+	return &model.DeviceConnection{
+		ConnectionId: res["connection_id"],
+		ServiceId:    res["service_id"],
+		IpAddress:    res["ip_address"],
+		ConnectedAt:  res["connected_at"],
+		UserAgent:    res["user_agent"],
+	}, nil
+}
+
 /**
  * NewRedisManagerConnectionRepository creates a new instance of RedisManagerConnectionRepository
  * implementation Domain ManagerConnectionRepository
@@ -0,0 +1,20 @@
+--[[
+  Tăng bộ đếm trong cửa sổ thời gian một cách nguyên tử.
+  TTL chỉ được đặt ở lần tăng đầu tiên để cửa sổ không bị kéo dài.
+
+  KEYS:
+    1: counter_key
+
+  ARGV:
+    1: ttl (seconds)
+--]]
+
+local counter_key = KEYS[1]
+local ttl = tonumber(ARGV[1])
+
+local count = redis.call("INCR", counter_key)
+if count == 1 then
+    redis.call("EXPIRE", counter_key, ttl)
+end
+
+return count
@@ -1,5 +1,7 @@
 --[[
   Thực hiện ngắt kết nối một cách nguyên tử.
+  Chỉ xóa khi registry vẫn trỏ tới connection đang ngắt,
+  tránh xóa kết nối mới khi device đã kết nối lại (có thể ở replica khác).
 
   KEYS:
     1: device_conns_key
@@ -7,13 +9,20 @@
 
   ARGV:
     1: device_id
+    2: connection_id
 --]]
 
 local device_conns_key = KEYS[1]
 local service_conns_key = KEYS[2]
 local device_id = ARGV[1]
+local connection_id = ARGV[2]
+
+local current_connection_id = redis.call("HGET", device_conns_key, "connection_id")
+if current_connection_id and current_connection_id ~= connection_id then
+    return 0
+end
 
 redis.call("DEL", device_conns_key)
 redis.call("SREM", service_conns_key, device_id)
 
-return 1
\ No newline at end of file
+return 1
@@ -0,0 +1,96 @@
+package token
+
+import (
+	"context"
+	"time"
+
+	domainErrors "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/errors"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/model"
+	domainToken "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/token"
+	pb "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// =======================================================
+// Define token infrastructure implementation - Use grpc call to auth service
+// =======================================================
+type TokenService struct {
+	grpc    pb.AuthServiceClient
+	timeout time.Duration
+}
+
+// ParseUserToken implements token.ITokenService.
+func (t *TokenService) ParseUserToken(ctx context.Context, token string) (*domainModel.TokenUserJwtOutput, *domainErrors.TokenValidationError) {
+	ctx, cancel := t.withTimeout(ctx)
+	defer cancel()
+	resp, err := t.grpc.ParseUserToken(ctx, &pb.ParseUserTokenRequest{
+		Token: token,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &domainModel.TokenUserJwtOutput{
+		UserId:    resp.UserId,
+		Role:      int(resp.Roles),
+		CompanyId: resp.CompanyId,
+		TokenId:   resp.TokenId,
+		ExpiresAt: time.Unix(resp.ExpriresAt, 0),
+	}, nil
+}
+
+// ParseDeviceToken implements token.ITokenService.
+func (t *TokenService) ParseDeviceToken(ctx context.Context, token string) (*domainModel.TokenDeviceJwtOutput, *domainErrors.TokenValidationError) {
+	ctx, cancel := t.withTimeout(ctx)
+	defer cancel()
+	resp, err := t.grpc.ParseDeviceToken(ctx, &pb.ParseDeviceTokenRequest{
+		Token: token,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &domainModel.TokenDeviceJwtOutput{
+		DeviceId:  resp.DeviceId,
+		CompanyId: resp.CompanyId,
+		TokenId:   resp.TokenId,
+		ExpiresAt: time.Unix(resp.ExpiresAt, 0),
+	}, nil
+}
+
+func (t *TokenService) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if t.timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, t.timeout)
+}
+
+/**
+ * New token implementation
+ */
+func NewTokenService(
+	grpcClient pb.AuthServiceClient,
+	timeout time.Duration,
+) domainToken.ITokenService {
+	return &TokenService{
+		grpc:    grpcClient,
+		timeout: timeout,
+	}
+}
+
+// =======================================================
+//
+//	Helper functions
+//
+// =======================================================
+// handleError tách lỗi token không hợp lệ với lỗi khi không gọi được auth service
+func handleError(err error) *domainErrors.TokenValidationError {
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		return domainErrors.GetTokenValidationError(domainErrors.TokenValidationErrorCode)
+	case codes.InvalidArgument:
+		return domainErrors.GetTokenValidationError(domainErrors.TokenMalformedErrorCode)
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled, codes.FailedPrecondition, codes.Internal:
+		return domainErrors.GetTokenValidationError(domainErrors.TokenServiceUnavailableCode)
+	}
+	return domainErrors.NewTokenServiceValidationError(err)
+}
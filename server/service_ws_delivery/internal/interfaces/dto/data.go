@@ -7,7 +7,14 @@ package dto
 // ==========================================
 type (
 	SendDataVerifyFace struct {
+		// Client tự đặt để đối chiếu kết quả, server sinh nếu để trống
+		RequestId string `json:"request_id" validate:"omitempty,max=64"`
 		DataUrl   string `json:"data_url" validate:"required,url"`
 		Metadata  string `json:"metadata" validate:"omitempty"`
 	}
+
+	// Ack tích luỹ, mọi message có sequence <= seq đã được xử lý
+	AckData struct {
+		Seq int64 `json:"seq" validate:"required,min=1"`
+	}
 )
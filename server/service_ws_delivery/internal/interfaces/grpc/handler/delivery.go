@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/model"
+	applicationService "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/service"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/model"
+	pb "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/**
+ * Impl grpc gen dispatcher server - deliver to device/company on any replica
+ */
+type DeliveryHandler struct{}
+
+// SendToDevice implements gen.DispatcherServer.
+func (h *DeliveryHandler) SendToDevice(ctx context.Context, req *pb.SendToDeviceRequest) (*pb.SendToDeviceResponse, error) {
+	deviceId, err := uuid.Parse(req.DeviceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid device_id")
+	}
+	payload, err := parsePayload(req.Payload)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := applicationService.GetDeliveryService().SendToDevice(
+		ctx,
+		&applicationModel.SendToDeviceInput{
+			DeviceId: deviceId,
+			Type:     domainModel.WSEventType(req.Type),
+			Payload:  payload,
+		},
+	); err != nil {
+		if errors.Is(err, applicationService.ErrDeviceNotConnected) {
+			// Message nằm trong outbox, device nhận khi kết nối lại
+			return &pb.SendToDeviceResponse{
+				Delivered: false,
+				Message:   "Device is not connected, message queued",
+			}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.SendToDeviceResponse{
+		Delivered: true,
+		Message:   "Message sent successfully",
+	}, nil
+}
+
+// BroadcastToCompany implements gen.DispatcherServer.
+func (h *DeliveryHandler) BroadcastToCompany(ctx context.Context, req *pb.BroadcastToCompanyRequest) (*pb.BroadcastToCompanyResponse, error) {
+	companyId, err := uuid.Parse(req.CompanyId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid company_id")
+	}
+	message, err := buildWsMessage(req.Type, req.Payload)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := applicationService.GetDeliveryService().BroadcastToCompany(
+		ctx,
+		&applicationModel.BroadcastToCompanyInput{
+			CompanyId: companyId,
+			Message:   message,
+		},
+	); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.BroadcastToCompanyResponse{
+		Success: true,
+		Message: "Message broadcast successfully",
+	}, nil
+}
+
+// buildWsMessage đóng gói payload JSON theo định dạng message gửi cho client
+func buildWsMessage(eventType int32, payload []byte) ([]byte, error) {
+	rawPayload, err := parsePayload(payload)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(domainModel.WsDataSend{
+		Type:    domainModel.WSEventType(eventType),
+		Payload: rawPayload,
+	})
+}
+
+// parsePayload kiểm tra payload là JSON hợp lệ
+func parsePayload(payload []byte) (json.RawMessage, error) {
+	if len(payload) == 0 {
+		payload = []byte("null")
+	}
+	if !json.Valid(payload) {
+		return nil, errors.New("payload must be valid JSON")
+	}
+	return json.RawMessage(payload), nil
+}
+
+/**
+ * New DeliveryHandler
+ */
+func NewDeliveryHandler() *DeliveryHandler {
+	return &DeliveryHandler{}
+}
@@ -36,3 +36,14 @@ func InitGrpcRoutes(server *grpc.Server) {
 func (gr *grpcRoutes) SendMessage(ctx context.Context, req *pb.MessageRequest) (*pb.SendMessageResponse, error) {
 	return handler.NewSendMsgClient().SendMessage(ctx, req)
 }
+
+/**
+ * Routes deliver gRPC requests to device or company connections on any replica
+ */
+func (gr *grpcRoutes) SendToDevice(ctx context.Context, req *pb.SendToDeviceRequest) (*pb.SendToDeviceResponse, error) {
+	return handler.NewDeliveryHandler().SendToDevice(ctx, req)
+}
+
+func (gr *grpcRoutes) BroadcastToCompany(ctx context.Context, req *pb.BroadcastToCompanyRequest) (*pb.BroadcastToCompanyResponse, error) {
+	return handler.NewDeliveryHandler().BroadcastToCompany(ctx, req)
+}
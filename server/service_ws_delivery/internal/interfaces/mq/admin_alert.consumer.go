@@ -0,0 +1,80 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/model"
+	applicationService "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/service"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/constants"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/model"
+	domainMq "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/mq"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/global"
+)
+
+// =============================================
+//
+//	Consumer cảnh báo cho phiên admin
+//
+// =============================================
+type AdminAlertConsumer struct {
+	reader domainMq.IKafkaRead
+}
+
+// Run lắng nghe topic cảnh báo từ service khác và gửi tới phiên admin của công ty trên mọi replica
+func (a *AdminAlertConsumer) Run(ctx context.Context) {
+	for {
+		err := a.reader.ReadListenTopicManual(ctx, constants.KAFKA_TOPIC_ADMIN_ALERT, func(message interface{}) error {
+			return a.handleMessage(ctx, message)
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		global.Logger.Error(fmt.Sprintf("Admin alert consumer stopped, restarting: %v", err))
+		time.Sleep(verifyResultConsumerRestartDelay)
+	}
+}
+
+// handleMessage bỏ qua message sai định dạng, trả lỗi khi không publish được để message được đọc lại
+func (a *AdminAlertConsumer) handleMessage(ctx context.Context, message interface{}) error {
+	value, ok := message.([]byte)
+	if !ok {
+		global.Logger.Warn(fmt.Sprintf("Unexpected admin alert message type %T", message))
+		return nil
+	}
+	var event domainModel.KafkaAdminAlertEvent
+	if err := json.Unmarshal(value, &event); err != nil {
+		global.Logger.Warn(fmt.Sprintf("Invalid admin alert message: %v", err))
+		return nil
+	}
+	companyId, err := uuid.Parse(event.CompanyId)
+	if err != nil {
+		global.Logger.Warn(fmt.Sprintf("Invalid company id in admin alert: %s", event.CompanyId))
+		return nil
+	}
+	// Cảnh báo không gắn với device thì device_id để trống
+	deviceId, _ := uuid.Parse(event.DeviceId)
+	if event.Timestamp == 0 {
+		event.Timestamp = time.Now().Unix()
+	}
+	return applicationService.GetMonitorService().PublishAdminAlert(
+		ctx,
+		&applicationModel.PublishAdminAlertInput{
+			CompanyId: companyId,
+			DeviceId:  deviceId,
+			AlertType: event.AlertType,
+			Message:   event.Message,
+			Timestamp: event.Timestamp,
+		},
+	)
+}
+
+// NewAdminAlertConsumer
+func NewAdminAlertConsumer(reader domainMq.IKafkaRead) *AdminAlertConsumer {
+	return &AdminAlertConsumer{
+		reader: reader,
+	}
+}
@@ -0,0 +1,135 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/model"
+	applicationService "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/service"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/constants"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/model"
+	domainMq "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/mq"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/global"
+	wsCore "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/interfaces/ws/core"
+)
+
+// Thời gian chờ trước khi lắng nghe lại topic khi reader bị lỗi
+const verifyResultConsumerRestartDelay = 5 * time.Second
+
+// =============================================
+//
+//	Consumer kết quả xác thực khuôn mặt
+//
+// =============================================
+type VerifyResultConsumer struct {
+	reader domainMq.IKafkaRead
+}
+
+// Run lắng nghe topic kết quả xác thực và gửi kết quả về device đã gửi request.
+// Replica nhận message có thể không giữ kết nối của device, delivery service sẽ chuyển tiếp qua registry.
+// Offset chỉ được commit sau khi xử lý xong, message lỗi chưa commit sẽ được đọc lại khi consumer khởi động lại.
+func (v *VerifyResultConsumer) Run(ctx context.Context) {
+	for {
+		err := v.reader.ReadListenTopicManual(ctx, constants.KAFKA_TOPIC_ATTENDANCE_VERIFY_RESULT, func(message interface{}) error {
+			return v.handleMessage(ctx, message)
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		global.Logger.Error(fmt.Sprintf("Verify result consumer stopped, restarting: %v", err))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(verifyResultConsumerRestartDelay):
+		}
+	}
+}
+
+// handleMessage trả lỗi khi không gửi được do lỗi hệ thống, consumer dừng trước khi commit offset
+// và đọc lại message từ offset đã commit sau khi khởi động lại
+func (v *VerifyResultConsumer) handleMessage(ctx context.Context, message interface{}) error {
+	value, ok := message.([]byte)
+	if !ok {
+		global.Logger.Warn(fmt.Sprintf("Unexpected verify result message type %T", message))
+		return nil
+	}
+	var result domainModel.KafkaAttendanceVerifyResult
+	if err := json.Unmarshal(value, &result); err != nil {
+		global.Logger.Warn(fmt.Sprintf("Invalid verify result message: %v", err))
+		return nil
+	}
+	deviceId, err := uuid.Parse(result.DeviceId)
+	if err != nil {
+		global.Logger.Warn(fmt.Sprintf("Invalid device id in verify result: %s", result.DeviceId))
+		return nil
+	}
+	// Cảnh báo cho phiên admin không ảnh hưởng tới việc trả kết quả cho device
+	v.recordVerifyResult(ctx, deviceId, &result)
+	// Không nhận diện được nhân viên thì employee_id để trống
+	userId, _ := uuid.Parse(result.EmployeeId)
+	err = applicationService.GetDeliveryService().SendToDevice(
+		ctx,
+		&applicationModel.SendToDeviceInput{
+			DeviceId: deviceId,
+			Type:     domainModel.WSEventSendAttendance,
+			Payload: domainModel.ActionAttendanceResultSend{
+				RequestId:  result.RequestId,
+				DeviceId:   deviceId,
+				UserId:     userId,
+				UserName:   result.EmployeeName,
+				Result:     result.Result,
+				RecordType: result.RecordType,
+				RecordTime: result.RecordTime,
+				Score:      result.Score,
+				Message:    result.Message,
+				Timestamp:  result.Timestamp,
+			},
+			// Message được kafka gửi lại dùng lại sequence đã cấp theo request_id
+			DedupeId: result.RequestId,
+		},
+	)
+	if errors.Is(err, applicationService.ErrDeviceNotConnected) {
+		// Kết quả nằm trong outbox, gửi lại khi device kết nối lại
+		global.Logger.Warn(fmt.Sprintf("Verify result %s queued: device %s is not connected", result.RequestId, deviceId))
+		return nil
+	}
+	return err
+}
+
+// recordVerifyResult gửi kết quả cho monitor service để phát hiện giả mạo, xác thực thất bại liên tiếp
+func (v *VerifyResultConsumer) recordVerifyResult(ctx context.Context, deviceId uuid.UUID, result *domainModel.KafkaAttendanceVerifyResult) {
+	companyId, err := uuid.Parse(result.CompanyId)
+	if err != nil {
+		// Kết quả cũ chưa có company_id, lấy từ kết nối của device tại replica này
+		clients := wsCore.GetHub().GetClientsByDevice(deviceId)
+		if len(clients) == 0 {
+			global.Logger.Warn(fmt.Sprintf("Skip monitor verify result %s: unknown company of device %s", result.RequestId, deviceId))
+			return
+		}
+		companyId = clients[0].CompanyId
+	}
+	if err := applicationService.GetMonitorService().RecordVerifyResult(
+		ctx,
+		&applicationModel.RecordVerifyResultInput{
+			DeviceId:  deviceId,
+			CompanyId: companyId,
+			Result:    result.Result,
+			Spoofing:  result.Spoofing,
+			Message:   result.Message,
+			Timestamp: result.Timestamp,
+		},
+	); err != nil {
+		global.Logger.Warn(fmt.Sprintf("Failed to record verify result %s: %v", result.RequestId, err))
+	}
+}
+
+// NewVerifyResultConsumer
+func NewVerifyResultConsumer(reader domainMq.IKafkaRead) *VerifyResultConsumer {
+	return &VerifyResultConsumer{
+		reader: reader,
+	}
+}
@@ -0,0 +1,70 @@
+package codec
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/gorilla/websocket"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/constants"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/interfaces/ws/model"
+	pb "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================
+//
+//	Frame codec - JSON (mặc định) hoặc protobuf theo subprotocol
+//
+// =============================================
+
+// Message server gửi luôn ở dạng JSON {"seq", "type", "payload"}
+type jsonFrame struct {
+	Seq     int64           `json:"seq"`
+	Type    *int32          `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// DecodeFrame tách type và payload từ frame client gửi
+func DecodeFrame(format model.FrameFormat, data []byte) (int, []byte, error) {
+	if format == model.FrameFormatProtobuf {
+		var frame pb.WsFrame
+		if err := proto.Unmarshal(data, &frame); err != nil {
+			return 0, nil, err
+		}
+		if len(frame.Payload) == 0 {
+			return 0, nil, errors.New("missing payload")
+		}
+		return int(frame.Type), frame.Payload, nil
+	}
+	var frame jsonFrame
+	if err := json.Unmarshal(data, &frame); err != nil {
+		return 0, nil, err
+	}
+	if frame.Type == nil || frame.Payload == nil {
+		return 0, nil, errors.New("missing type or payload")
+	}
+	return int(*frame.Type), frame.Payload, nil
+}
+
+// EncodeFrame chuyển message JSON của server sang frame theo định dạng của client.
+// Trả về websocket message type cùng dữ liệu gửi đi: JSON gửi dạng text frame, protobuf gửi dạng binary frame.
+func EncodeFrame(format model.FrameFormat, message []byte) (int, []byte, error) {
+	if format != model.FrameFormatProtobuf {
+		return websocket.TextMessage, message, nil
+	}
+	frame := &pb.WsFrame{
+		Type:    constants.WS_FRAME_TYPE_TEXT,
+		Payload: message,
+	}
+	var event jsonFrame
+	if err := json.Unmarshal(message, &event); err == nil && event.Type != nil {
+		frame.Type = *event.Type
+		frame.Seq = event.Seq
+		frame.Payload = event.Payload
+	}
+	data, err := proto.Marshal(frame)
+	if err != nil {
+		return 0, nil, err
+	}
+	return websocket.BinaryMessage, data, nil
+}
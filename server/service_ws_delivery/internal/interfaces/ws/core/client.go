@@ -10,10 +10,10 @@ import (
 	"github.com/gorilla/websocket"
 	libsDomainModel "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/model"
 	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/global"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/interfaces/ws/codec"
 	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/interfaces/ws/model"
 )
 
-// TODO: Handle protobuf message
 // =============================================
 //
 //	Client WS Structure
@@ -21,15 +21,27 @@ import (
 // =============================================
 type Client struct {
 	// Info Client
+	Kind            model.ClientKind
+	UserId          uuid.UUID // Chỉ có với phiên admin
 	DeviceId        uuid.UUID
+	CompanyId       uuid.UUID
 	ConnId          uuid.UUID
 	ClientIpAddress string
 	ClientUserAgent string
+	// Sequence cuối cùng device đã xử lý khi kết nối lại, gửi lại outbox từ sau sequence này
+	ResumeSeq int64
+	// Định dạng frame chọn qua subprotocol khi handshake
+	Format model.FrameFormat
+	// Token used at handshake
+	auth ClientAuth
 	// Connection
 	conn *websocket.Conn
 	// Message queue for outgoing messages, protected by mutex.
+	// Message cần ack đã nằm trong outbox, mất queue khi ngắt kết nối sẽ được gửi lại khi kết nối lại.
 	sendQueue      [][]byte
 	sendQueueMutex sync.Mutex
+	// Báo cho WritePump có message mới
+	sendNotify chan struct{}
 	// Config Client
 	maxMessageSize   int64
 	maxSendQueueSize int
@@ -41,6 +53,17 @@ type Client struct {
 	cancel context.CancelFunc
 }
 
+// ClientAuth thông tin token đã xác thực khi handshake
+type ClientAuth struct {
+	Kind      model.ClientKind
+	UserId    uuid.UUID
+	DeviceId  uuid.UUID
+	CompanyId uuid.UUID
+	TokenId   string
+	TokenHash string
+	ExpiresAt time.Time
+}
+
 // Send handle add message to the queue safely
 func (c *Client) Send(message []byte) error {
 	c.sendQueueMutex.Lock()
@@ -51,6 +74,11 @@ func (c *Client) Send(message []byte) error {
 		return fmt.Errorf("Client %s send queue is full, disconnecting client", c.ConnId)
 	}
 	c.sendQueue = append(c.sendQueue, message)
+	select {
+	case c.sendNotify <- struct{}{}:
+	default:
+		// WritePump đã có thông báo chưa xử lý, sẽ lấy cả batch
+	}
 	return nil
 }
 
@@ -94,8 +122,12 @@ func (c *Client) ReadPump() {
 		select {
 		case GetHub().HandlerReceive <- model.ClientWriterData{
 			ClientInfo: model.ClientInfo{
+				Kind:         c.Kind,
+				ResumeSeq:    c.ResumeSeq,
+				Format:       c.Format,
 				ConnectionId: c.ConnId,
 				DeviceId:     c.DeviceId,
+				CompanyId:    c.CompanyId,
 				IpAddress:    c.ClientIpAddress,
 				UserAgent:    c.ClientUserAgent,
 			},
@@ -107,6 +139,18 @@ func (c *Client) ReadPump() {
 	}
 }
 
+// Disconnect gửi close frame với lý do cho client rồi đóng kết nối.
+// ReadPump sẽ trả lỗi khi kết nối bị đóng và unregister client khỏi hub.
+func (c *Client) Disconnect(code int, reason string) {
+	_ = c.conn.WriteControl(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(code, reason),
+		time.Now().Add(c.writeWait),
+	)
+	c.cancel()
+	_ = c.conn.Close()
+}
+
 // writePump send batch messages to the websocket.
 func (c *Client) WritePump() {
 	ticker := time.NewTicker(c.pingPeriod)
@@ -124,13 +168,11 @@ func (c *Client) WritePump() {
 			}
 		case <-c.ctx.Done():
 			return
-		default:
+		case <-c.sendNotify:
 			// Get batch messages from sendQueue
 			c.sendQueueMutex.Lock()
 			if len(c.sendQueue) == 0 {
 				c.sendQueueMutex.Unlock()
-				// Wait a bit before checking again to avoid busy-loop
-				time.Sleep(50 * time.Millisecond)
 				continue
 			}
 			// Copy the queue to a local variable and clear the original queue
@@ -140,9 +182,15 @@ func (c *Client) WritePump() {
 			c.sendQueueMutex.Unlock()
 			// Send all messages that have been retrieved
 			for _, message := range messages {
+				messageType, data, err := codec.EncodeFrame(c.Format, message)
+				if err != nil {
+					global.Logger.Warn(fmt.Sprintf("Error encoding message to client %s: %v", c.ConnId, err))
+					continue
+				}
 				_ = c.conn.SetWriteDeadline(time.Now().Add(c.writeWait))
-				if err := c.conn.WriteMessage(websocket.BinaryMessage, message); err != nil {
+				if err := c.conn.WriteMessage(messageType, data); err != nil {
 					global.Logger.Warn(fmt.Sprintf("Error sending message to client %s: %v", c.ConnId, err))
+					c.cancel()
 					return
 				}
 			}
@@ -153,7 +201,7 @@ func (c *Client) WritePump() {
 // NewClientWS
 func NewClientWS(
 	ctx context.Context,
-	deviceId uuid.UUID,
+	auth ClientAuth,
 	clientIpAddress string,
 	clientUserAgent string,
 	conn *websocket.Conn,
@@ -165,13 +213,18 @@ func NewClientWS(
 ) *Client {
 	clientCtx, cancel := context.WithCancel(ctx)
 	return &Client{
-		DeviceId:         deviceId,
+		Kind:             auth.Kind,
+		UserId:           auth.UserId,
+		DeviceId:         auth.DeviceId,
+		CompanyId:        auth.CompanyId,
+		auth:             auth,
 		ConnId:           uuid.New(),
 		ClientIpAddress:  clientIpAddress,
 		ClientUserAgent:  clientUserAgent,
 		conn:             conn,
 		sendQueue:        make([][]byte, 0, maxSendQueueSize),
 		sendQueueMutex:   sync.Mutex{},
+		sendNotify:       make(chan struct{}, 1),
 		maxSendQueueSize: maxSendQueueSize,
 		maxMessageSize:   maxMessageSize,
 		readWait:         readWait,
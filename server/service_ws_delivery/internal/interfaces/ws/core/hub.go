@@ -102,7 +102,10 @@ func (h *Hub) Run(ctx context.Context) {
 			h.clients[client.ConnId] = client
 			h.clientsMutex.Unlock()
 			h.RegisterChan <- model.ClientInfo{
+				Kind:         client.Kind,
+				ResumeSeq:    client.ResumeSeq,
 				DeviceId:     client.DeviceId,
+				CompanyId:    client.CompanyId,
 				ConnectionId: client.ConnId,
 				UserAgent:    client.ClientUserAgent,
 				IpAddress:    client.ClientIpAddress,
@@ -114,7 +117,9 @@ func (h *Hub) Run(ctx context.Context) {
 			if _, ok := h.clients[client.ConnId]; ok {
 				delete(h.clients, client.ConnId)
 				h.UnregisterChan <- model.ClientInfo{
+					Kind:         client.Kind,
 					DeviceId:     client.DeviceId,
+					CompanyId:    client.CompanyId,
 					ConnectionId: client.ConnId,
 					UserAgent:    client.ClientUserAgent,
 					IpAddress:    client.ClientIpAddress,
@@ -171,6 +176,52 @@ func (h *Hub) GetClient(connId uuid.UUID) (*Client, bool) {
 	return client, ok
 }
 
+// Get clients of device
+func (h *Hub) GetClientsByDevice(deviceId uuid.UUID) []*Client {
+	h.clientsMutex.RLock()
+	defer h.clientsMutex.RUnlock()
+	clients := make([]*Client, 0)
+	for _, client := range h.clients {
+		if client.Kind == model.ClientKindDevice && client.DeviceId == deviceId {
+			clients = append(clients, client)
+		}
+	}
+	return clients
+}
+
+// Get device clients of company
+func (h *Hub) GetClientsByCompany(companyId uuid.UUID) []*Client {
+	return h.getClientsByCompany(companyId, model.ClientKindDevice)
+}
+
+// Get admin sessions of company
+func (h *Hub) GetAdminClientsByCompany(companyId uuid.UUID) []*Client {
+	return h.getClientsByCompany(companyId, model.ClientKindAdmin)
+}
+
+func (h *Hub) getClientsByCompany(companyId uuid.UUID, kind model.ClientKind) []*Client {
+	h.clientsMutex.RLock()
+	defer h.clientsMutex.RUnlock()
+	clients := make([]*Client, 0)
+	for _, client := range h.clients {
+		if client.Kind == kind && client.CompanyId == companyId {
+			clients = append(clients, client)
+		}
+	}
+	return clients
+}
+
+// Snapshot clients
+func (h *Hub) ListClients() []*Client {
+	h.clientsMutex.RLock()
+	defer h.clientsMutex.RUnlock()
+	clients := make([]*Client, 0, len(h.clients))
+	for _, client := range h.clients {
+		clients = append(clients, client)
+	}
+	return clients
+}
+
 // Num client
 func (h *Hub) NumClients() int {
 	h.clientsMutex.RLock()
@@ -0,0 +1,131 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/model"
+	applicationService "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/service"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/constants"
+	domainCache "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/cache"
+	domainErrors "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/errors"
+	domainModel "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/model"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/global"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/interfaces/ws/model"
+)
+
+// Chu kỳ kiểm tra lại token mặc định (giây)
+const defaultTokenRevalidateInterval = 60
+
+// =============================================
+//
+//	Token watcher - drop connection khi token bị refresh hoặc thu hồi
+//
+// =============================================
+
+// RunTokenWatcher ngắt kết nối của device khi token hết hạn, bị refresh hoặc thu hồi.
+// Sự kiện pub/sub giúp ngắt ngay trên mọi replica, kiểm tra định kỳ để không bỏ sót khi mất sự kiện.
+func (h *Hub) RunTokenWatcher(ctx context.Context) {
+	interval := global.ServerWsSetting.TokenRevalidateInterval
+	if interval <= 0 {
+		interval = defaultTokenRevalidateInterval
+	}
+	ticker := time.NewTicker(time.Duration(interval) * time.Second)
+	defer ticker.Stop()
+
+	events := h.subscribeTokenRevoked(ctx)
+	for {
+		select {
+		case msg, ok := <-events:
+			if !ok {
+				// Mất kết nối pub/sub, vẫn còn kiểm tra định kỳ
+				global.Logger.Warn("Device token revoked subscription closed")
+				events = nil
+				continue
+			}
+			deviceId, err := parseDeviceTokenRevokedEvent(msg)
+			if err != nil {
+				global.Logger.Warn(fmt.Sprintf("Invalid device token revoked event: %v", err))
+				continue
+			}
+			for _, client := range h.GetClientsByDevice(deviceId) {
+				h.revalidateClient(ctx, client)
+			}
+		case <-ticker.C:
+			for _, client := range h.ListClients() {
+				h.revalidateClient(ctx, client)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// subscribeTokenRevoked trả về nil nếu không subscribe được, khi đó chỉ còn kiểm tra định kỳ
+func (h *Hub) subscribeTokenRevoked(ctx context.Context) <-chan interface{} {
+	distributedCache, err := domainCache.GetDistributedCache()
+	if err != nil {
+		global.Logger.Error(fmt.Sprintf("Failed to get distributed cache: %v", err))
+		return nil
+	}
+	events, err := distributedCache.Subscribe(ctx, constants.RedisChannelDeviceTokenRevoked)
+	if err != nil {
+		global.Logger.Error(fmt.Sprintf("Failed to subscribe device token revoked channel: %v", err))
+		return nil
+	}
+	return events
+}
+
+// revalidateClient ngắt kết nối nếu token không còn hiệu lực.
+// Không ngắt khi không kiểm tra được (redis lỗi) để tránh ngắt hàng loạt thiết bị.
+func (h *Hub) revalidateClient(ctx context.Context, client *Client) {
+	var errToken *domainErrors.TokenValidationError
+	if client.Kind == model.ClientKindAdmin {
+		errToken = applicationService.GetAdminAuthService().CheckAdminTokenActive(
+			ctx,
+			&applicationModel.CheckAdminTokenInput{
+				TokenId:   client.auth.TokenId,
+				ExpiresAt: client.auth.ExpiresAt,
+			},
+		)
+	} else {
+		errToken = applicationService.GetDeviceAuthService().CheckDeviceTokenActive(
+			ctx,
+			&applicationModel.CheckDeviceTokenInput{
+				DeviceId:  client.auth.DeviceId,
+				TokenId:   client.auth.TokenId,
+				TokenHash: client.auth.TokenHash,
+				ExpiresAt: client.auth.ExpiresAt,
+			},
+		)
+	}
+	if errToken == nil {
+		return
+	}
+	if errToken.Code == domainErrors.TokenServiceUnavailableCode {
+		global.Logger.Warn(fmt.Sprintf("Skip revalidate token for client %s: %s", client.ConnId, errToken.Message))
+		return
+	}
+	global.Logger.Warn(fmt.Sprintf("Client %s disconnected: %s", client.ConnId, errToken.Message))
+	client.Disconnect(constants.WS_CLOSE_CODE_TOKEN_REVOKED, errToken.Message)
+}
+
+func parseDeviceTokenRevokedEvent(msg interface{}) (uuid.UUID, error) {
+	raw, ok := msg.(string)
+	if !ok {
+		// Redis cache đã decode payload JSON thành map
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		raw = string(data)
+	}
+	var event domainModel.DeviceTokenRevokedEvent
+	if err := json.Unmarshal([]byte(raw), &event); err != nil {
+		return uuid.Nil, err
+	}
+	return uuid.Parse(event.DeviceId)
+}
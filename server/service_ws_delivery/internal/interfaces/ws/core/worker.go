@@ -2,11 +2,10 @@ package core
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/global"
-	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/interfaces/dto"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/interfaces/ws/codec"
 	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/interfaces/ws/handler"
 )
 
@@ -37,23 +36,18 @@ func (h *Worker) Run() {
 				global.Logger.Warn(fmt.Sprintf("Failed to unregister connection: %v", err))
 			}
 		case data := <-GetHub().HandlerReceive:
-			var dataObj dto.DataServerReceive
-			dataObj.Type = -1
-			if err := json.Unmarshal(data.Data, &dataObj); err != nil {
-				global.Logger.Warn(fmt.Sprintf("Failed to unmarshal data: %v", err))
-				continue
-			}
-			// Validate data
-			if dataObj.Type == -1 || dataObj.Payload == nil {
-				global.Logger.Warn("Invalid data received: missing type or payload")
+			// Decode frame theo định dạng client chọn khi handshake
+			eventType, payload, err := codec.DecodeFrame(data.ClientInfo.Format, data.Data)
+			if err != nil {
+				global.Logger.Warn(fmt.Sprintf("Invalid data received: %v", err))
 				continue
 			}
 			// Handler data
 			if err := handler.GetWorkerHandler().HandleDataReceive(
 				h.ctx,
 				data.ClientInfo,
-				dataObj.Type,
-				dataObj.Payload,
+				eventType,
+				payload,
 			); err != nil {
 				global.Logger.Warn(fmt.Sprintf("Failed to handle data receive: %v", err))
 				continue
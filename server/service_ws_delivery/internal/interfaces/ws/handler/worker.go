@@ -36,6 +36,10 @@ func GetWorkerHandler() *WorkerHandler {
  * Register new client
  */
 func (wh *WorkerHandler) RegisterClient(ctx context.Context, input model.ClientInfo) error {
+	// Phiên admin không nhận message theo device, không cần registry
+	if input.Kind == model.ClientKindAdmin {
+		return nil
+	}
 	service := applicationService.GetMapConnectionService()
 	if err := service.RegisterConnection(
 		ctx,
@@ -49,6 +53,18 @@ func (wh *WorkerHandler) RegisterClient(ctx context.Context, input model.ClientI
 	); err != nil {
 		return fmt.Errorf("failed to register connection: %v", err)
 	}
+	wh.publishDeviceStatus(ctx, input, domainModel.DeviceStatusOnline)
+	// Gửi lại message chưa được ack từ kết nối trước
+	if err := applicationService.GetDeliveryService().ReplayOutbox(
+		ctx,
+		&applicationModel.ReplayOutboxInput{
+			DeviceId:     input.DeviceId,
+			ConnectionId: input.ConnectionId,
+			AfterSeq:     input.ResumeSeq,
+		},
+	); err != nil {
+		return fmt.Errorf("failed to replay outbox: %v", err)
+	}
 	return nil
 }
 
@@ -56,22 +72,54 @@ func (wh *WorkerHandler) RegisterClient(ctx context.Context, input model.ClientI
  * Unregister client
  */
 func (wh *WorkerHandler) UnregisterClient(ctx context.Context, input model.ClientInfo) error {
+	if input.Kind == model.ClientKindAdmin {
+		return nil
+	}
 	service := applicationService.GetMapConnectionService()
-	if err := service.UnregisterConnection(
+	removed, err := service.UnregisterConnection(
 		ctx,
 		&applicationModel.UnregisterConnection{
-			DeviceId: input.DeviceId.String(),
+			DeviceId:     input.DeviceId.String(),
+			ConnectionId: input.ConnectionId.String(),
 		},
-	); err != nil {
+	)
+	if err != nil {
 		return fmt.Errorf("failed to unregister connection: %v", err)
 	}
+	// Device đã kết nối lại bằng kết nối khác thì vẫn online
+	if removed {
+		wh.publishDeviceStatus(ctx, input, domainModel.DeviceStatusOffline)
+	}
 	return nil
 }
 
+/**
+ * Publish device status to admin sessions
+ */
+func (wh *WorkerHandler) publishDeviceStatus(ctx context.Context, input model.ClientInfo, status int) {
+	if err := applicationService.GetMonitorService().PublishDeviceStatus(
+		ctx,
+		&applicationModel.PublishDeviceStatusInput{
+			DeviceId:  input.DeviceId,
+			CompanyId: input.CompanyId,
+			Status:    status,
+			IpAddress: input.IpAddress,
+			Timestamp: time.Now().Unix(),
+		},
+	); err != nil {
+		global.Logger.Warn(fmt.Sprintf("Failed to publish device %s status: %v", input.DeviceId, err))
+	}
+}
+
 /**
  * Handle data receive
  */
 func (wh *WorkerHandler) HandleDataReceive(ctx context.Context, clientInfo model.ClientInfo, eventType int, data []byte) error {
+	// Phiên admin chỉ nhận sự kiện, không gửi event lên server
+	if clientInfo.Kind == model.ClientKindAdmin {
+		errorBytes, _ := json.Marshal(fmt.Sprintf("Event type %d is not supported for admin session", eventType))
+		return wh.SendDataToClient(ctx, clientInfo.ConnectionId, errorBytes)
+	}
 	switch eventType {
 	case int(domainModel.WSEventReceivedAttendance):
 		// Unmarshal data
@@ -99,11 +147,17 @@ func (wh *WorkerHandler) HandleDataReceive(ctx context.Context, clientInfo model
 			)
             return errors.New(err_str)
         }
+		// Request id để trả kết quả xác thực về đúng request của device
+		if attendanceData.RequestId == "" {
+			attendanceData.RequestId = uuid.NewString()
+		}
 		// Send data to verify face service
 		if err := applicationService.GetSendEventService().SendDataVerifyFace(
 			ctx,
 			&applicationModel.SendDataVerifyFace{
+				RequestId: attendanceData.RequestId,
 				DeviceId:  clientInfo.DeviceId,
+				CompanyId: clientInfo.CompanyId,
 				DataUrl:   attendanceData.DataUrl,
 				Metadata:  attendanceData.Metadata,
 				Timestamp: time.Now().Unix(),
@@ -113,6 +167,21 @@ func (wh *WorkerHandler) HandleDataReceive(ctx context.Context, clientInfo model
 			return errors.New("failed to send data verify face")
 		}
 		return nil
+	case int(domainModel.WSEventAck):
+		var ackData dto.AckData
+		if err := json.Unmarshal(data, &ackData); err != nil {
+			return fmt.Errorf("failed to unmarshal ack data: %v", err)
+		}
+		if err := global.Validate.Struct(ackData); err != nil {
+			return fmt.Errorf("invalid ack data: %v", err)
+		}
+		return applicationService.GetDeliveryService().AckDevice(
+			ctx,
+			&applicationModel.AckDeviceInput{
+				DeviceId: clientInfo.DeviceId,
+				Seq:      ackData.Seq,
+			},
+		)
 	default:
 		errorBytes, _ := json.Marshal(fmt.Sprintf("Unknown event type: %d", eventType))
 		err := wh.SendDataToClient(ctx, clientInfo.ConnectionId, errorBytes)
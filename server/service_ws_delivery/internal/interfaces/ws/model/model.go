@@ -6,14 +6,38 @@ import (
 	"github.com/google/uuid"
 )
 
+/**
+ * Client kind
+ */
+type ClientKind int
+
+const (
+	ClientKindDevice ClientKind = iota // Device kết nối bằng device token
+	ClientKindAdmin                    // Phiên web admin kết nối bằng user token
+)
+
+/**
+ * Frame format
+ */
+type FrameFormat int
+
+const (
+	FrameFormatJSON     FrameFormat = iota // Mặc định, dùng cho browser
+	FrameFormatProtobuf                    // Envelope gen.WsFrame, dùng cho thiết bị nhúng
+)
+
 /**
  * Client info
  */
 type ClientInfo struct {
-	DeviceId     uuid.UUID `json:"device_id"`
-	ConnectionId uuid.UUID `json:"connection_id"`
-	IpAddress    string    `json:"ip_address"`
-	UserAgent    string    `json:"user_agent"`
+	Kind         ClientKind  `json:"kind"`
+	DeviceId     uuid.UUID   `json:"device_id"`
+	CompanyId    uuid.UUID   `json:"company_id"`
+	ConnectionId uuid.UUID   `json:"connection_id"`
+	IpAddress    string      `json:"ip_address"`
+	UserAgent    string      `json:"user_agent"`
+	ResumeSeq    int64       `json:"resume_seq"`
+	Format       FrameFormat `json:"format"`
 }
 
 /**
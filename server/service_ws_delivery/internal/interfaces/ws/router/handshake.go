@@ -0,0 +1,69 @@
+package router
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/constants"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/global"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/interfaces/ws/model"
+	utilsContext "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/shared/utils/context"
+)
+
+// extractToken lấy token (device token hoặc user token) theo thứ tự: header Authorization, query param, subprotocol.
+// Trả về thêm subprotocol chứa token khi token được gửi qua subprotocol.
+func extractToken(c *gin.Context) (string, string) {
+	if token, ok := utilsContext.ExtractBearerToken(c); ok && token != "" {
+		return token, ""
+	}
+	if token := c.Query(constants.WS_TOKEN_QUERY_PARAM); token != "" {
+		return token, ""
+	}
+	for _, protocol := range websocket.Subprotocols(c.Request) {
+		if strings.HasPrefix(protocol, constants.WS_TOKEN_SUBPROTOCOL_PREFIX) {
+			return strings.TrimPrefix(protocol, constants.WS_TOKEN_SUBPROTOCOL_PREFIX), protocol
+		}
+	}
+	return "", ""
+}
+
+// negotiateSubprotocol chọn định dạng frame theo subprotocol client gửi, mặc định JSON.
+// Trả về subprotocol phản hồi lại cho client, rỗng khi client không gửi subprotocol nào.
+func negotiateSubprotocol(c *gin.Context, tokenProtocol string) (string, model.FrameFormat) {
+	var other string
+	for _, protocol := range websocket.Subprotocols(c.Request) {
+		switch {
+		case protocol == constants.WS_SUBPROTOCOL_PROTOBUF:
+			return protocol, model.FrameFormatProtobuf
+		case protocol == constants.WS_SUBPROTOCOL_JSON:
+			return protocol, model.FrameFormatJSON
+		case strings.HasPrefix(protocol, constants.WS_TOKEN_SUBPROTOCOL_PREFIX):
+			continue
+		case other == "":
+			other = protocol
+		}
+	}
+	// Browser yêu cầu server chọn một subprotocol client đã gửi,
+	// ưu tiên subprotocol khác để không phản hồi token trong header
+	if other != "" {
+		return other, model.FrameFormatJSON
+	}
+	return tokenProtocol, model.FrameFormatJSON
+}
+
+// checkOrigin kiểm tra origin theo allow-list trong config
+func checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		// Thiết bị native không gửi header Origin
+		return true
+	}
+	for _, allowed := range global.ServerWsSetting.AllowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
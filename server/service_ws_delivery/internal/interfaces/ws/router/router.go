@@ -2,13 +2,18 @@ package router
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
+	applicationModel "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/model"
+	applicationService "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/service"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/constants"
+	domainErrors "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/errors"
 	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/global"
 	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/interfaces/ws/core"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/interfaces/ws/model"
 )
 
 /**
@@ -26,42 +31,138 @@ func GetBaseRouter() *BaseRouter {
 func (b *BaseRouter) Initialize(g *gin.Engine) {
 	group := g.Group("/ws")
 	group.GET("", func(c *gin.Context) {
-		// create upgrade connection
-		upgradeObj := websocket.Upgrader{
-			HandshakeTimeout: time.Second * time.Duration(global.ServerWsSetting.HandshakeTimeout),
-			ReadBufferSize:   global.ServerWsSetting.ReadBufferSize,
-			WriteBufferSize:  global.ServerWsSetting.WriteBufferSize,
-			CheckOrigin: func(r *http.Request) bool {
-				return true
+		// Check origin before authenticate
+		if !checkOrigin(c.Request) {
+			c.JSON(403, gin.H{"error": "Forbidden - Origin not allowed"})
+			c.Abort()
+			return
+		}
+		// Authenticate device token
+		tokenStr, tokenProtocol := extractToken(c)
+		if tokenStr == "" {
+			c.JSON(401, gin.H{"error": "Unauthorized"})
+			c.Abort()
+			return
+		}
+		deviceAuth, errToken := applicationService.GetDeviceAuthService().AuthenticateDevice(
+			c,
+			&applicationModel.AuthenticateDeviceInput{
+				Token: tokenStr,
 			},
-			EnableCompression: global.ServerWsSetting.EnableCompression,
+		)
+		if errToken != nil {
+			abortWithTokenError(c, errToken)
+			return
+		}
+		// Device kết nối lại gửi sequence cuối cùng đã xử lý để nhận lại message bị lỡ
+		var resumeSeq int64
+		if lastSeq := c.Query(constants.WS_LAST_SEQ_QUERY_PARAM); lastSeq != "" {
+			seq, err := strconv.ParseInt(lastSeq, 10, 64)
+			if err != nil || seq < 0 {
+				c.JSON(400, gin.H{"error": "Bad Request - invalid " + constants.WS_LAST_SEQ_QUERY_PARAM})
+				c.Abort()
+				return
+			}
+			resumeSeq = seq
+		}
+		upgradeClient(c, tokenProtocol, resumeSeq, core.ClientAuth{
+			Kind:      model.ClientKindDevice,
+			DeviceId:  deviceAuth.DeviceId,
+			CompanyId: deviceAuth.CompanyId,
+			TokenId:   deviceAuth.TokenId,
+			TokenHash: deviceAuth.TokenHash,
+			ExpiresAt: deviceAuth.ExpiresAt,
+		})
+	})
+	// Web admin session - live device status and alerts of company
+	group.GET(constants.WS_ADMIN_ENDPOINT, func(c *gin.Context) {
+		if !checkOrigin(c.Request) {
+			c.JSON(403, gin.H{"error": "Forbidden - Origin not allowed"})
+			c.Abort()
+			return
 		}
-		// create connection handshake
-		conn, err := upgradeObj.Upgrade(c.Writer, c.Request, nil)
-		if err != nil {
-			global.Logger.Warn("Failed to upgrade connection", "error", err)
-			c.Error(err)
+		// Authenticate user token
+		tokenStr, tokenProtocol := extractToken(c)
+		if tokenStr == "" {
+			c.JSON(401, gin.H{"error": "Unauthorized"})
+			c.Abort()
 			return
 		}
-		// Get data user after auth
-		deviceId, _ := uuid.NewUUID() // TODO: fix in prod
-		// Create client info
-		clientInfo := core.NewClientWS(
+		adminAuth, errToken := applicationService.GetAdminAuthService().AuthenticateAdmin(
 			c,
-			deviceId,
-			c.ClientIP(),
-			c.Request.UserAgent(),
-			conn,
-			global.ServerWsSetting.MaxMessageSize,
-			time.Second*time.Duration(global.ServerWsSetting.ReadWait),
-			time.Second*time.Duration(global.ServerWsSetting.WriteWait),
-			time.Second*time.Duration(global.ServerWsSetting.PingPeriod),
-			global.ServerWsSetting.MaxSendQueueSize,
+			&applicationModel.AuthenticateAdminInput{
+				Token:        tokenStr,
+				CompanyIdReq: c.Query(constants.WS_COMPANY_QUERY_PARAM),
+			},
 		)
-		// register with hub
-		core.GetHub().RegisterClient(clientInfo)
-		// read, write
-		go clientInfo.ReadPump()
-		go clientInfo.WritePump()
+		if errToken != nil {
+			abortWithTokenError(c, errToken)
+			return
+		}
+		upgradeClient(c, tokenProtocol, 0, core.ClientAuth{
+			Kind:      model.ClientKindAdmin,
+			UserId:    adminAuth.UserId,
+			CompanyId: adminAuth.CompanyId,
+			TokenId:   adminAuth.TokenId,
+			ExpiresAt: adminAuth.ExpiresAt,
+		})
 	})
 }
+
+// abortWithTokenError trả mã lỗi theo loại lỗi xác thực token
+func abortWithTokenError(c *gin.Context, errToken *domainErrors.TokenValidationError) {
+	switch errToken.Code {
+	case domainErrors.TokenServiceUnavailableCode:
+		global.Logger.Error("Failed to authenticate token", "error", errToken.Message)
+		c.JSON(503, gin.H{"error": "Service Unavailable"})
+	case domainErrors.TokenPermissionDeniedCode:
+		c.JSON(403, gin.H{"error": "Forbidden - " + errToken.Message})
+	default:
+		c.JSON(401, gin.H{"error": "Unauthorized - " + errToken.Message})
+	}
+	c.Abort()
+}
+
+// upgradeClient upgrade kết nối đã xác thực và đăng ký client với hub
+func upgradeClient(c *gin.Context, tokenProtocol string, resumeSeq int64, auth core.ClientAuth) {
+	subprotocol, format := negotiateSubprotocol(c, tokenProtocol)
+	// create upgrade connection
+	upgradeObj := websocket.Upgrader{
+		HandshakeTimeout:  time.Second * time.Duration(global.ServerWsSetting.HandshakeTimeout),
+		ReadBufferSize:    global.ServerWsSetting.ReadBufferSize,
+		WriteBufferSize:   global.ServerWsSetting.WriteBufferSize,
+		CheckOrigin:       checkOrigin,
+		EnableCompression: global.ServerWsSetting.EnableCompression,
+	}
+	var responseHeader http.Header
+	if subprotocol != "" {
+		responseHeader = http.Header{"Sec-Websocket-Protocol": []string{subprotocol}}
+	}
+	// create connection handshake
+	conn, err := upgradeObj.Upgrade(c.Writer, c.Request, responseHeader)
+	if err != nil {
+		global.Logger.Warn("Failed to upgrade connection", "error", err)
+		c.Error(err)
+		return
+	}
+	// Create client info
+	clientInfo := core.NewClientWS(
+		c,
+		auth,
+		c.ClientIP(),
+		c.Request.UserAgent(),
+		conn,
+		global.ServerWsSetting.MaxMessageSize,
+		time.Second*time.Duration(global.ServerWsSetting.ReadWait),
+		time.Second*time.Duration(global.ServerWsSetting.WriteWait),
+		time.Second*time.Duration(global.ServerWsSetting.PingPeriod),
+		global.ServerWsSetting.MaxSendQueueSize,
+	)
+	clientInfo.ResumeSeq = resumeSeq
+	clientInfo.Format = format
+	// register with hub
+	core.GetHub().RegisterClient(clientInfo)
+	// read, write
+	go clientInfo.ReadPump()
+	go clientInfo.WritePump()
+}
@@ -92,6 +92,16 @@ func GetKeyCacheListFriendsOfUser(userIdHash string, page int) string {
 	return fmt.Sprintf("user:friends:list:of:user:%s:%d", userIdHash, page)
 }
 
+// Key status token device, value "0" khi token đã bị thu hồi
+func GetKeyStatusTokenDevice(tokenIdHash string) string {
+	return fmt.Sprintf("device:token:status:%s", tokenIdHash)
+}
+
+// Key hash token hiện tại của device, được cập nhật mỗi lần refresh token
+func GetKeyDeviceActiveToken(deviceIdHash string) string {
+	return fmt.Sprintf("device:token:active:%s", deviceIdHash)
+}
+
 // =================================
 // 			Define value cache
 // =================================
@@ -24,6 +24,22 @@ func initApplication() error {
 	if err := applicationService.SetHealthCheckService(_healthCheckService); err != nil {
 		return err
 	}
+	_deviceAuthService := applicationServiceImpl.NewDeviceAuthService()
+	if err := applicationService.SetDeviceAuthService(_deviceAuthService); err != nil {
+		return err
+	}
+	_deliveryService := applicationServiceImpl.NewDeliveryService()
+	if err := applicationService.SetDeliveryService(_deliveryService); err != nil {
+		return err
+	}
+	_adminAuthService := applicationServiceImpl.NewAdminAuthService()
+	if err := applicationService.SetAdminAuthService(_adminAuthService); err != nil {
+		return err
+	}
+	_monitorService := applicationServiceImpl.NewMonitorService()
+	if err := applicationService.SetMonitorService(_monitorService); err != nil {
+		return err
+	}
 	// v.v
 	return nil
 }
@@ -17,6 +17,10 @@ func initConnectionToInfrastructure(setting *libsConfig.Setting) error {
 	if err := initKafkaWriter(&setting.Kafka); err != nil {
 		return err
 	}
+	// initialize kafka reader
+	if err := initKafkaReader(&setting.Kafka); err != nil {
+		return err
+	}
 	// v.v
 
 	return nil
@@ -27,6 +31,11 @@ func initKafkaWriter(setting *libsConfig.KafkaSetting) error {
 	return nil
 }
 
+func initKafkaReader(setting *libsConfig.KafkaSetting) error {
+	domainMq.InitKafkaReadService(infraMq.NewKafkaReaderService(setting))
+	return nil
+}
+
 func initRedisDistributedCache(setting *libsConfig.RedisSetting) error {
 	distributedCacheImpl, err := infraCache.NewRedisDistributedCache(setting)
 	if err != nil {
@@ -1,16 +1,21 @@
 package start
 
 import (
+	"time"
+
 	libsClients "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/infrastructure/conn"
 	domainHealth "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/health"
 	domainMq "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/mq"
+	libsConfig "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/config"
 	domainRepository "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/repository"
+	domainToken "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/token"
 	infraHealth "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/infrastructure/health"
 	infraMq "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/infrastructure/mq"
 	infraRepository "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/infrastructure/repository"
+	infraToken "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/infrastructure/token"
 )
 
-func initDomain() error {
+func initDomain(setting *libsConfig.Setting) error {
 	// init manager connetion session
 	redisClient, err := libsClients.GetRedisClient()
 	if err != nil {
@@ -22,6 +27,13 @@ func initDomain() error {
 	if err := domainRepository.SetManagerConnectionRepository(implRedisManagerConnectionRepository); err != nil {
 		return err
 	}
+	// init device outbox
+	implRedisDeviceOutboxRepository := infraRepository.NewRedisDeviceOutboxRepository(
+		redisClient,
+	)
+	if err := domainRepository.SetDeviceOutboxRepository(implRedisDeviceOutboxRepository); err != nil {
+		return err
+	}
 	// init send event client to kafka
 	implSendEventClient := infraMq.NewSendEventToKafka()
 	if err := domainMq.SetSendEventToKafka(implSendEventClient); err != nil {
@@ -32,6 +44,14 @@ func initDomain() error {
 	if err := domainHealth.SetHealthCheck(implHealthCheck); err != nil {
 		return err
 	}
+	// init token service, parse token through auth service
+	implTokenService := infraToken.NewTokenService(
+		authGrpcClient,
+		time.Duration(setting.AuthService.TimeoutMs)*time.Millisecond,
+	)
+	if err := domainToken.SetTokenService(implTokenService); err != nil {
+		return err
+	}
 	// v.v
 	return nil
 }
@@ -35,8 +35,12 @@ func StartService() error {
 	if err := initConnectionToInfrastructure(setting); err != nil {
 		return err
 	}
+	// Initialize gRPC clients
+	if err := initClientGrpc(setting); err != nil {
+		return err
+	}
 	// Initialize domain
-	if err := initDomain(); err != nil {
+	if err := initDomain(setting); err != nil {
 		return err
 	}
 	// Initialize application
@@ -51,6 +55,10 @@ func StartService() error {
 	if err := initWebSocketServer(); err != nil {
 		return err
 	}
+	// Initialize MQ consumers
+	if err := initMqConsumer(); err != nil {
+		return err
+	}
 	// Initialize gRPC server
 	if err := initGrpcServer(); err != nil {
 		return err
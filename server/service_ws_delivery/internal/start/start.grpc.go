@@ -4,13 +4,58 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"time"
 
+	libsConfig "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/config"
 	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/global"
 	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/interfaces/grpc/routes"
+	pb "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/proto"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
 )
 
+var (
+	authGrpcClient pb.AuthServiceClient
+)
+
+// ===============================
+//
+//	Grpc client
+//
+// ===============================
+func initClientGrpc(setting *libsConfig.Setting) error {
+	if err := initAuthClientGrpc(&setting.AuthService); err != nil {
+		return err
+	}
+	return nil
+}
+
+func initAuthClientGrpc(config *libsConfig.AuthServiceSetting) error {
+	var opts []grpc.DialOption
+	if config.Tls.Enabled {
+		creds, err := credentials.NewClientTLSFromFile(config.Tls.CertFile, "")
+		if err != nil {
+			return fmt.Errorf("failed to load TLS credentials: %w", err)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+		Time:                time.Duration(config.KeepaliveTimeMs) * time.Millisecond,
+		Timeout:             time.Duration(config.KeepaliveTimeoutMs) * time.Millisecond,
+		PermitWithoutStream: config.KeepalivePermitWithoutCalls,
+	}))
+	conn, err := grpc.Dial(config.GrpcAddr, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to auth gRPC server: %w", err)
+	}
+	authGrpcClient = pb.NewAuthServiceClient(conn)
+	return nil
+}
+
 // ===============================
 //
 //	Grpc server
@@ -0,0 +1,22 @@
+package start
+
+import (
+	domainMq "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/domain/mq"
+	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/global"
+	interfacesMq "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/interfaces/mq"
+)
+
+/**
+ * MQ consumers startup
+ */
+func initMqConsumer() error {
+	reader, err := domainMq.GetKafkaReadService()
+	if err != nil {
+		return err
+	}
+	// Verify face result consumer
+	go interfacesMq.NewVerifyResultConsumer(reader).Run(global.WsContext)
+	// Admin alert consumer
+	go interfacesMq.NewAdminAlertConsumer(reader).Run(global.WsContext)
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 
+	applicationService "github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/application/service"
 	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/global"
 	"github.com/youknow2509/cio_verify_face/server/service_ws_delivery/internal/interfaces/ws/core"
 )
@@ -27,5 +28,31 @@ func initWebSocketServer() error {
 	core.SetHub(hub)
 	// run hub
 	go hub.Run(global.WsContext)
+	// drop connections when device token is refreshed or revoked
+	go hub.RunTokenWatcher(global.WsContext)
+	// mark this replica alive so others can route messages to it
+	go func() {
+		if err := applicationService.GetDeliveryService().KeepServiceAlive(global.WsContext); err != nil {
+			global.Logger.Error("Service alive heartbeat stopped", "error", err)
+		}
+	}()
+	// push device status and alerts to admin sessions
+	go func() {
+		if err := applicationService.GetMonitorService().ListenMonitorEvents(global.WsContext); err != nil {
+			global.Logger.Error("Monitor event listener stopped", "error", err)
+		}
+	}()
+	// push device status changed by service device (heartbeat sweeper, admin update)
+	go func() {
+		if err := applicationService.GetMonitorService().ListenDeviceStatusEvents(global.WsContext); err != nil {
+			global.Logger.Error("Device status event listener stopped", "error", err)
+		}
+	}()
+	// receive messages routed from other replicas
+	go func() {
+		if err := applicationService.GetDeliveryService().ListenRemoteDelivery(global.WsContext); err != nil {
+			global.Logger.Error("Remote delivery listener stopped", "error", err)
+		}
+	}()
 	return nil
 }
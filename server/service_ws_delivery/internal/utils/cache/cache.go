@@ -19,3 +19,43 @@ func GetDeviceConnectionWsKey(deviceId string) string {
 func GetServiceWsConnectionKey(serviceId string) string {
 	return fmt.Sprintf("ws:service:%s", serviceId)
 }
+
+// Get service ws alive key, hết hạn khi replica dừng
+func GetServiceWsAliveKey(serviceId string) string {
+	return fmt.Sprintf("ws:service:alive:%s", serviceId)
+}
+
+// Get channel pub/sub broadcast tới mọi service ws
+func GetBroadcastDeliveryChannel() string {
+	return "ws:delivery:broadcast"
+}
+
+// Get channel pub/sub gửi sự kiện cho phiên admin trên mọi service ws
+func GetMonitorDeliveryChannel() string {
+	return "ws:monitor:company"
+}
+
+// Get key đếm số lần xác thực thất bại liên tiếp của device
+func GetDeviceFailedVerifyKey(deviceId string) string {
+	return fmt.Sprintf("ws:device:verify:failed:%s", deviceId)
+}
+
+// Get key sequence message gửi tới device
+func GetDeviceSeqKey(deviceId string) string {
+	return fmt.Sprintf("ws:device:seq:%s", deviceId)
+}
+
+// Get key giữ sequence đã cấp cho message theo request_id, tránh cấp sequence mới khi message được gửi lại
+func GetDeviceDeliveryDedupeKey(deviceId string, requestId string) string {
+	return fmt.Sprintf("ws:device:dedupe:%s:%s", deviceId, requestId)
+}
+
+// Get key outbox message chưa được device ack
+func GetDeviceOutboxKey(deviceId string) string {
+	return fmt.Sprintf("ws:device:outbox:%s", deviceId)
+}
+
+// Get channel pub/sub nhận message chuyển tiếp tới service ws
+func GetServiceDeliveryChannel(serviceId string) string {
+	return fmt.Sprintf("ws:delivery:%s", serviceId)
+}
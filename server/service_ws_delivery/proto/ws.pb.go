@@ -125,6 +125,231 @@ func (x *SendMessageResponse) GetMessage() string {
 	return ""
 }
 
+// payload là JSON, được gửi cho client dưới dạng {"type": type, "payload": payload}
+type SendToDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendToDeviceRequest) Reset() {
+	*x = SendToDeviceRequest{}
+	mi := &file_proto_ws_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendToDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendToDeviceRequest) ProtoMessage() {}
+
+func (x *SendToDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendToDeviceRequest.ProtoReflect.Descriptor instead.
+func (*SendToDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ws_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SendToDeviceRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *SendToDeviceRequest) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *SendToDeviceRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type SendToDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivered     bool                   `protobuf:"varint,1,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendToDeviceResponse) Reset() {
+	*x = SendToDeviceResponse{}
+	mi := &file_proto_ws_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendToDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendToDeviceResponse) ProtoMessage() {}
+
+func (x *SendToDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendToDeviceResponse.ProtoReflect.Descriptor instead.
+func (*SendToDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ws_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SendToDeviceResponse) GetDelivered() bool {
+	if x != nil {
+		return x.Delivered
+	}
+	return false
+}
+
+func (x *SendToDeviceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BroadcastToCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastToCompanyRequest) Reset() {
+	*x = BroadcastToCompanyRequest{}
+	mi := &file_proto_ws_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastToCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastToCompanyRequest) ProtoMessage() {}
+
+func (x *BroadcastToCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastToCompanyRequest.ProtoReflect.Descriptor instead.
+func (*BroadcastToCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ws_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BroadcastToCompanyRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *BroadcastToCompanyRequest) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *BroadcastToCompanyRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type BroadcastToCompanyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastToCompanyResponse) Reset() {
+	*x = BroadcastToCompanyResponse{}
+	mi := &file_proto_ws_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastToCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastToCompanyResponse) ProtoMessage() {}
+
+func (x *BroadcastToCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastToCompanyResponse.ProtoReflect.Descriptor instead.
+func (*BroadcastToCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ws_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BroadcastToCompanyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BroadcastToCompanyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_proto_ws_proto protoreflect.FileDescriptor
 
 const file_proto_ws_proto_rawDesc = "" +
@@ -135,10 +360,27 @@ const file_proto_ws_proto_rawDesc = "" +
 	"\apayload\x18\x02 \x01(\fR\apayload\"I\n" +
 	"\x13SendMessageResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2H\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"`\n" +
+	"\x13SendToDeviceRequest\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\x05R\x04type\x12\x18\n" +
+	"\apayload\x18\x03 \x01(\fR\apayload\"N\n" +
+	"\x14SendToDeviceResponse\x12\x1c\n" +
+	"\tdelivered\x18\x01 \x01(\bR\tdelivered\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"h\n" +
+	"\x19BroadcastToCompanyRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\x05R\x04type\x12\x18\n" +
+	"\apayload\x18\x03 \x01(\fR\apayload\"P\n" +
+	"\x1aBroadcastToCompanyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\xe0\x01\n" +
 	"\n" +
 	"Dispatcher\x12:\n" +
-	"\vSendMessage\x12\x12.pb.MessageRequest\x1a\x17.pb.SendMessageResponseB\aZ\x05./genb\x06proto3"
+	"\vSendMessage\x12\x12.pb.MessageRequest\x1a\x17.pb.SendMessageResponse\x12A\n" +
+	"\fSendToDevice\x12\x17.pb.SendToDeviceRequest\x1a\x18.pb.SendToDeviceResponse\x12S\n" +
+	"\x12BroadcastToCompany\x12\x1d.pb.BroadcastToCompanyRequest\x1a\x1e.pb.BroadcastToCompanyResponseB\aZ\x05./genb\x06proto3"
 
 var (
 	file_proto_ws_proto_rawDescOnce sync.Once
@@ -152,16 +394,24 @@ func file_proto_ws_proto_rawDescGZIP() []byte {
 	return file_proto_ws_proto_rawDescData
 }
 
-var file_proto_ws_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_ws_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_proto_ws_proto_goTypes = []any{
-	(*MessageRequest)(nil),      // 0: pb.MessageRequest
-	(*SendMessageResponse)(nil), // 1: pb.SendMessageResponse
+	(*MessageRequest)(nil),             // 0: pb.MessageRequest
+	(*SendMessageResponse)(nil),        // 1: pb.SendMessageResponse
+	(*SendToDeviceRequest)(nil),        // 2: pb.SendToDeviceRequest
+	(*SendToDeviceResponse)(nil),       // 3: pb.SendToDeviceResponse
+	(*BroadcastToCompanyRequest)(nil),  // 4: pb.BroadcastToCompanyRequest
+	(*BroadcastToCompanyResponse)(nil), // 5: pb.BroadcastToCompanyResponse
 }
 var file_proto_ws_proto_depIdxs = []int32{
 	0, // 0: pb.Dispatcher.SendMessage:input_type -> pb.MessageRequest
-	1, // 1: pb.Dispatcher.SendMessage:output_type -> pb.SendMessageResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
+	2, // 1: pb.Dispatcher.SendToDevice:input_type -> pb.SendToDeviceRequest
+	4, // 2: pb.Dispatcher.BroadcastToCompany:input_type -> pb.BroadcastToCompanyRequest
+	1, // 3: pb.Dispatcher.SendMessage:output_type -> pb.SendMessageResponse
+	3, // 4: pb.Dispatcher.SendToDevice:output_type -> pb.SendToDeviceResponse
+	5, // 5: pb.Dispatcher.BroadcastToCompany:output_type -> pb.BroadcastToCompanyResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -178,7 +428,7 @@ func file_proto_ws_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_ws_proto_rawDesc), len(file_proto_ws_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,144 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.29.2
+// source: proto/ws_frame.proto
+
+package gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Envelope cho subprotocol "protobuf", tương ứng với JSON {"seq", "type", "payload"}.
+// payload giữ nguyên JSON của từng event.
+type WsFrame struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type = -1: payload là thông báo dạng text, không theo event
+	Type int32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Chỉ có với message server gửi cần ack
+	Seq           int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Payload       []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WsFrame) Reset() {
+	*x = WsFrame{}
+	mi := &file_proto_ws_frame_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WsFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WsFrame) ProtoMessage() {}
+
+func (x *WsFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ws_frame_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WsFrame.ProtoReflect.Descriptor instead.
+func (*WsFrame) Descriptor() ([]byte, []int) {
+	return file_proto_ws_frame_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WsFrame) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *WsFrame) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *WsFrame) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+var File_proto_ws_frame_proto protoreflect.FileDescriptor
+
+const file_proto_ws_frame_proto_rawDesc = "" +
+	"\n" +
+	"\x14proto/ws_frame.proto\x12\x02pb\"I\n" +
+	"\aWsFrame\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x03R\x03seq\x12\x18\n" +
+	"\apayload\x18\x03 \x01(\fR\apayloadB\aZ\x05./genb\x06proto3"
+
+var (
+	file_proto_ws_frame_proto_rawDescOnce sync.Once
+	file_proto_ws_frame_proto_rawDescData []byte
+)
+
+func file_proto_ws_frame_proto_rawDescGZIP() []byte {
+	file_proto_ws_frame_proto_rawDescOnce.Do(func() {
+		file_proto_ws_frame_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_ws_frame_proto_rawDesc), len(file_proto_ws_frame_proto_rawDesc)))
+	})
+	return file_proto_ws_frame_proto_rawDescData
+}
+
+var file_proto_ws_frame_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_ws_frame_proto_goTypes = []any{
+	(*WsFrame)(nil), // 0: pb.WsFrame
+}
+var file_proto_ws_frame_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_ws_frame_proto_init() }
+func file_proto_ws_frame_proto_init() {
+	if File_proto_ws_frame_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_ws_frame_proto_rawDesc), len(file_proto_ws_frame_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_ws_frame_proto_goTypes,
+		DependencyIndexes: file_proto_ws_frame_proto_depIdxs,
+		MessageInfos:      file_proto_ws_frame_proto_msgTypes,
+	}.Build()
+	File_proto_ws_frame_proto = out.File
+	file_proto_ws_frame_proto_goTypes = nil
+	file_proto_ws_frame_proto_depIdxs = nil
+}
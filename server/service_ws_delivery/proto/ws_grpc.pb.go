@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Dispatcher_SendMessage_FullMethodName = "/pb.Dispatcher/SendMessage"
+	Dispatcher_SendMessage_FullMethodName        = "/pb.Dispatcher/SendMessage"
+	Dispatcher_SendToDevice_FullMethodName       = "/pb.Dispatcher/SendToDevice"
+	Dispatcher_BroadcastToCompany_FullMethodName = "/pb.Dispatcher/BroadcastToCompany"
 )
 
 // DispatcherClient is the client API for Dispatcher service.
@@ -27,6 +29,10 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DispatcherClient interface {
 	SendMessage(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
+	// Gửi tới device dù device đang kết nối ở replica nào
+	SendToDevice(ctx context.Context, in *SendToDeviceRequest, opts ...grpc.CallOption) (*SendToDeviceResponse, error)
+	// Gửi tới tất cả device đang kết nối của công ty
+	BroadcastToCompany(ctx context.Context, in *BroadcastToCompanyRequest, opts ...grpc.CallOption) (*BroadcastToCompanyResponse, error)
 }
 
 type dispatcherClient struct {
@@ -47,11 +53,35 @@ func (c *dispatcherClient) SendMessage(ctx context.Context, in *MessageRequest,
 	return out, nil
 }
 
+func (c *dispatcherClient) SendToDevice(ctx context.Context, in *SendToDeviceRequest, opts ...grpc.CallOption) (*SendToDeviceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendToDeviceResponse)
+	err := c.cc.Invoke(ctx, Dispatcher_SendToDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherClient) BroadcastToCompany(ctx context.Context, in *BroadcastToCompanyRequest, opts ...grpc.CallOption) (*BroadcastToCompanyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BroadcastToCompanyResponse)
+	err := c.cc.Invoke(ctx, Dispatcher_BroadcastToCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DispatcherServer is the server API for Dispatcher service.
 // All implementations must embed UnimplementedDispatcherServer
 // for forward compatibility.
 type DispatcherServer interface {
 	SendMessage(context.Context, *MessageRequest) (*SendMessageResponse, error)
+	// Gửi tới device dù device đang kết nối ở replica nào
+	SendToDevice(context.Context, *SendToDeviceRequest) (*SendToDeviceResponse, error)
+	// Gửi tới tất cả device đang kết nối của công ty
+	BroadcastToCompany(context.Context, *BroadcastToCompanyRequest) (*BroadcastToCompanyResponse, error)
 	mustEmbedUnimplementedDispatcherServer()
 }
 
@@ -65,6 +95,12 @@ type UnimplementedDispatcherServer struct{}
 func (UnimplementedDispatcherServer) SendMessage(context.Context, *MessageRequest) (*SendMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
 }
+func (UnimplementedDispatcherServer) SendToDevice(context.Context, *SendToDeviceRequest) (*SendToDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendToDevice not implemented")
+}
+func (UnimplementedDispatcherServer) BroadcastToCompany(context.Context, *BroadcastToCompanyRequest) (*BroadcastToCompanyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastToCompany not implemented")
+}
 func (UnimplementedDispatcherServer) mustEmbedUnimplementedDispatcherServer() {}
 func (UnimplementedDispatcherServer) testEmbeddedByValue()                    {}
 
@@ -104,6 +140,42 @@ func _Dispatcher_SendMessage_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dispatcher_SendToDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendToDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).SendToDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dispatcher_SendToDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).SendToDevice(ctx, req.(*SendToDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dispatcher_BroadcastToCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastToCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).BroadcastToCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dispatcher_BroadcastToCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).BroadcastToCompany(ctx, req.(*BroadcastToCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Dispatcher_ServiceDesc is the grpc.ServiceDesc for Dispatcher service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +187,14 @@ var Dispatcher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendMessage",
 			Handler:    _Dispatcher_SendMessage_Handler,
 		},
+		{
+			MethodName: "SendToDevice",
+			Handler:    _Dispatcher_SendToDevice_Handler,
+		},
+		{
+			MethodName: "BroadcastToCompany",
+			Handler:    _Dispatcher_BroadcastToCompany_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/ws.proto",